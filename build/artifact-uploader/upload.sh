@@ -0,0 +1,39 @@
+#!/bin/sh
+
+# Waits for the pod to terminate and uploads the logs of its containers and
+# the results directory, if any, to
+# $ARTIFACTS_DESTINATION/$K_MPI_JOB_NAMESPACE/$K_MPI_JOB_NAME/$POD_NAME.
+# The results are uploaded to the $ARTIFACTS_RESULTS_NAME subdirectory, which
+# defaults to results.
+
+remote() {
+  case "$1" in
+    s3://*) echo "s3:${1#s3://}" ;;
+    gs://*) echo "gs:${1#gs://}" ;;
+    *) echo "$1" ;;
+  esac
+}
+
+upload() {
+  dest="$(remote "${ARTIFACTS_DESTINATION%/}")/${K_MPI_JOB_NAMESPACE}/${K_MPI_JOB_NAME}/${POD_NAME}"
+  rc=0
+  if [ -d "$ARTIFACTS_LOGS_DIR" ]; then
+    echo "Uploading the logs to $dest/logs"
+    rclone copy --exclude "artifact-uploader/**" "$ARTIFACTS_LOGS_DIR" "$dest/logs" || rc=1
+  fi
+  if [ -n "$ARTIFACTS_RESULTS_DIR" ] && [ -d "$ARTIFACTS_RESULTS_DIR" ]; then
+    results="$dest/${ARTIFACTS_RESULTS_NAME:-results}"
+    echo "Uploading the results to $results"
+    rclone copy "$ARTIFACTS_RESULTS_DIR" "$results" || rc=1
+  fi
+  exit $rc
+}
+
+# The kubelet stops the sidecar once the other containers of the pod exit, or
+# when the pod is deleted.
+trap upload TERM INT
+
+while true; do
+  sleep 5 &
+  wait $!
+done
@@ -0,0 +1,91 @@
+#!/bin/sh
+
+# Runs the command of the launcher, like mpirun, up to
+# $LAUNCH_WRAPPER_MAX_ATTEMPTS times in the launcher pod, terminating the
+# attempts that run for more than $LAUNCH_WRAPPER_ATTEMPT_TIMEOUT_SECONDS, and
+# reports the outcome to the operator in a line of the termination message:
+#
+#   mpi-launch-wrapper: {"attempts":2,"reason":"TimedOut","exitCode":124}
+#
+# When the command fails without writing a termination message, the report is
+# printed as the last line of the output instead, so that the termination
+# message, read from the logs, keeps the reports of mpirun.
+
+max_attempts="${LAUNCH_WRAPPER_MAX_ATTEMPTS:-1}"
+timeout="${LAUNCH_WRAPPER_ATTEMPT_TIMEOUT_SECONDS:-0}"
+delay="${LAUNCH_WRAPPER_RETRY_DELAY_SECONDS:-10}"
+retry_on="${LAUNCH_WRAPPER_RETRY_ON_EXIT_CODES:-}"
+termination_log="${LAUNCH_WRAPPER_TERMINATION_LOG:-/dev/termination-log}"
+timed_out="${TMPDIR:-/tmp}/mpi-launch-wrapper.$$.timed-out"
+grace_period=10
+child=
+terminating=
+
+report() {
+  line="mpi-launch-wrapper: {\"attempts\":$1,\"reason\":\"$2\",\"exitCode\":$3}"
+  if [ "$3" -eq 0 ] || [ -s "$termination_log" ]; then
+    echo "$line" >> "$termination_log"
+  else
+    echo "$line"
+  fi
+}
+
+retryable() {
+  [ -z "$retry_on" ] && return 0
+  for retry_code in $retry_on; do
+    [ "$retry_code" -eq "$1" ] && return 0
+  done
+  return 1
+}
+
+# The termination of the pod is forwarded to the command, which isn't retried.
+trap 'terminating=1; [ -n "$child" ] && kill -TERM "$child" 2>/dev/null' TERM INT
+
+attempt=0
+while true; do
+  attempt=$((attempt + 1))
+  rm -f "$timed_out"
+  "$@" &
+  child=$!
+  timer=
+  if [ "$timeout" -gt 0 ]; then
+    (
+      sleep "$timeout"
+      : > "$timed_out"
+      echo "mpi-launch-wrapper: attempt $attempt timed out after ${timeout}s" >&2
+      kill -TERM "$child" 2>/dev/null
+      sleep "$grace_period"
+      kill -KILL "$child" 2>/dev/null
+    ) &
+    timer=$!
+  fi
+  # wait returns early when a trapped signal is received.
+  while true; do
+    wait "$child"
+    code=$?
+    kill -0 "$child" 2>/dev/null || break
+  done
+  child=
+  [ -n "$timer" ] && kill "$timer" 2>/dev/null
+  reason=Failed
+  if [ -f "$timed_out" ]; then
+    rm -f "$timed_out"
+    reason=TimedOut
+    code=124
+  fi
+  if [ "$code" -eq 0 ]; then
+    report "$attempt" Succeeded 0
+    exit 0
+  fi
+  if [ -n "$terminating" ] || [ "$attempt" -ge "$max_attempts" ] || ! retryable "$code"; then
+    report "$attempt" "$reason" "$code"
+    exit "$code"
+  fi
+  echo "mpi-launch-wrapper: attempt $attempt of $max_attempts exited with code $code, retrying in ${delay}s" >&2
+  sleep "$delay" &
+  wait $!
+  if [ -n "$terminating" ]; then
+    report "$attempt" "$reason" "$code"
+    exit "$code"
+  fi
+done
@@ -0,0 +1,60 @@
+#!/bin/sh
+
+# Exits with code 3, and the reason in its termination message, once the MPI
+# processes of the pod make no progress for $WATCHDOG_TIMEOUT_SECONDS: when no
+# file of $WATCHDOG_HEARTBEAT_DIR was modified, if set, or else when the
+# processes of the other containers didn't use any CPU. The kubelet then
+# restarts the watchdog, and the operator reads the termination message.
+
+timeout="${WATCHDOG_TIMEOUT_SECONDS:-1800}"
+interval="${WATCHDOG_INTERVAL_SECONDS:-30}"
+self_cgroup="$(cat /proc/self/cgroup)"
+
+stalled() {
+  echo "$1" | tee /dev/termination-log
+  exit 3
+}
+
+# cpu_ticks prints the CPU time, in clock ticks, of the processes of the other
+# containers of the pod, except the pause process and sshd, or nothing if there
+# are none. It requires the pod to share its process namespace.
+cpu_ticks() {
+  for dir in /proc/[0-9]*; do
+    [ "$(cat "$dir/cgroup" 2>/dev/null)" = "$self_cgroup" ] && continue
+    cat "$dir/stat" 2>/dev/null
+  done | awk '{
+    end = match($0, /\) [A-Za-z] /)
+    start = index($0, "(")
+    comm = substr($0, start + 1, end - start - 1)
+    if (comm == "pause" || comm == "sshd") next
+    # The state is the 3rd field of the stat file, and utime and stime are the
+    # 14th and 15th.
+    split(substr($0, end + 2), fields, " ")
+    ticks += fields[12] + fields[13]
+    found = 1
+  } END { if (found) print ticks }'
+}
+
+last_progress="$(date +%s)"
+last_ticks=
+while true; do
+  sleep "$interval"
+  now="$(date +%s)"
+  if [ -n "$WATCHDOG_HEARTBEAT_DIR" ]; then
+    newest="$(find "$WATCHDOG_HEARTBEAT_DIR" -type f -exec stat -c %Y {} + 2>/dev/null | sort -n | tail -n 1)"
+    if [ -n "$newest" ] && [ $((now - newest)) -ge "$timeout" ]; then
+      stalled "No heartbeat in $WATCHDOG_HEARTBEAT_DIR for $((now - newest))s"
+    fi
+    continue
+  fi
+  ticks="$(cpu_ticks)"
+  # The MPI processes aren't running, or used at least 1% of a CPU.
+  if [ -z "$ticks" ] || [ -z "$last_ticks" ] || [ $((ticks - last_ticks)) -ge "$interval" ] || [ "$ticks" -lt "$last_ticks" ]; then
+    last_ticks="$ticks"
+    last_progress="$now"
+    continue
+  fi
+  if [ $((now - last_progress)) -ge "$timeout" ]; then
+    stalled "The MPI processes used no CPU for $((now - last_progress))s"
+  fi
+done
@@ -0,0 +1,199 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	kubeclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	mpijobclientset "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
+)
+
+// Options holds the flags shared by every subcommand.
+type Options struct {
+	Kubeconfig string
+	Context    string
+	Namespace  string
+}
+
+// AddFlags adds the shared flags to the specified FlagSet.
+func (o *Options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", "", "Path to the kubeconfig file to use.")
+	fs.StringVar(&o.Context, "context", "", "The name of the kubeconfig context to use.")
+	fs.StringVar(&o.Namespace, "namespace", "", "The namespace of the MPIJob. Defaults to the namespace of the current context.")
+	fs.StringVar(&o.Namespace, "n", "", "Shorthand for --namespace.")
+}
+
+// runFunc executes a subcommand with its positional arguments.
+type runFunc func(ctx context.Context, c *cmdContext, args []string) error
+
+// command is a kubectl-mpi subcommand.
+type command struct {
+	name    string
+	usage   string
+	summary string
+	// setup registers the subcommand specific flags and returns the
+	// function that runs the subcommand.
+	setup func(fs *flag.FlagSet) runFunc
+}
+
+// cmdContext carries the clients and output streams of a subcommand.
+type cmdContext struct {
+	namespace  string
+	kubeClient kubeclientset.Interface
+	mpiClient  mpijobclientset.Interface
+	out        io.Writer
+	errOut     io.Writer
+}
+
+var errUsage = errors.New("invalid usage")
+
+// Run executes the kubectl-mpi subcommand named by the first argument.
+func Run(args []string, out, errOut io.Writer) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(out)
+		return nil
+	}
+	cmd := lookupCommand(args[0])
+	if cmd == nil {
+		printUsage(errOut)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+
+	opts := &Options{}
+	fs := flag.NewFlagSet("kubectl mpi "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(errOut)
+	fs.Usage = func() {
+		fmt.Fprintf(errOut, "Usage: kubectl mpi %s\n\n%s\n\nFlags:\n", cmd.usage, cmd.summary)
+		fs.PrintDefaults()
+	}
+	opts.AddFlags(fs)
+	run := cmd.setup(fs)
+	positional, err := parseInterspersed(fs, args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	c, err := newCmdContext(opts, out, errOut)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if err := run(ctx, c, positional); err != nil {
+		if errors.Is(err, errUsage) {
+			fs.Usage()
+		}
+		return err
+	}
+	return nil
+}
+
+func lookupCommand(name string) *command {
+	for i := range commands {
+		if commands[i].name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "kubectl mpi inspects and manages MPIJobs.\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(w, "\nUse \"kubectl mpi <command> --help\" for more information about a command.\n")
+}
+
+// parseInterspersed parses flags that may appear before or after the
+// positional arguments, as kubectl does. Arguments after "--" are returned
+// as positional arguments without being parsed.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var rest []string
+	for i, arg := range args {
+		if arg == "--" {
+			args, rest = args[:i], args[i+1:]
+			break
+		}
+	}
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return append(positional, rest...), nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func newCmdContext(opts *Options, out, errOut io.Writer) (*cmdContext, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = opts.Kubeconfig
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: opts.Context}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, fmt.Errorf("getting namespace from kubeconfig: %w", err)
+	}
+	if opts.Namespace != "" {
+		namespace = opts.Namespace
+	}
+	cfg, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("building kubeconfig: %w", err)
+	}
+	kubeClient, err := kubeclientset.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating kubernetes client: %w", err)
+	}
+	mpiClient, err := mpijobclientset.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating MPIJob client: %w", err)
+	}
+	return &cmdContext{
+		namespace:  namespace,
+		kubeClient: kubeClient,
+		mpiClient:  mpiClient,
+		out:        out,
+		errOut:     errOut,
+	}, nil
+}
+
+// jobNameArg returns the single MPIJob name expected in args.
+func jobNameArg(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%w: expected exactly one MPIJob name, got %d arguments", errUsage, len(args))
+	}
+	return args[0], nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kubeclientset "k8s.io/client-go/kubernetes"
+
+	mpijobclientset "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
+)
+
+// runTestCommand runs the subcommand like Run does, but with the given
+// clients in the default namespace, and returns its output.
+func runTestCommand(t *testing.T, name string, kubeClient kubeclientset.Interface, mpiClient mpijobclientset.Interface, args ...string) (string, error) {
+	t.Helper()
+	cmd := lookupCommand(name)
+	if cmd == nil {
+		t.Fatalf("Unknown command %q", name)
+	}
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	run := cmd.setup(fs)
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	c := &cmdContext{
+		namespace:  "default",
+		kubeClient: kubeClient,
+		mpiClient:  mpiClient,
+		out:        &out,
+		errOut:     io.Discard,
+	}
+	err = run(context.Background(), c, positional)
+	return out.String(), err
+}
+
+func TestParseInterspersed(t *testing.T) {
+	cases := map[string]struct {
+		args           []string
+		wantPositional []string
+		wantRole       string
+		wantFollow     bool
+		wantErr        bool
+	}{
+		"flags after the name": {
+			args:           []string{"pi", "--role=worker", "-f"},
+			wantPositional: []string{"pi"},
+			wantRole:       "worker",
+			wantFollow:     true,
+		},
+		"flags before the name": {
+			args:           []string{"--role", "worker", "pi"},
+			wantPositional: []string{"pi"},
+			wantRole:       "worker",
+		},
+		"arguments after the separator": {
+			args:           []string{"pi", "--", "mpirun", "--role=x"},
+			wantPositional: []string{"pi", "mpirun", "--role=x"},
+		},
+		"unknown flag": {
+			args:    []string{"pi", "--unknown"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			role := fs.String("role", "", "")
+			follow := fs.Bool("f", false, "")
+			positional, err := parseInterspersed(fs, tc.args)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("parseInterspersed() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.wantPositional, positional); diff != "" {
+				t.Errorf("Unexpected positional arguments (-want,+got):\n%s", diff)
+			}
+			if *role != tc.wantRole || *follow != tc.wantFollow {
+				t.Errorf("Parsed --role=%q -f=%t, want --role=%q -f=%t", *role, *follow, tc.wantRole, tc.wantFollow)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	var out, errOut bytes.Buffer
+	if err := Run([]string{"help"}, &out, &errOut); err != nil {
+		t.Fatalf("Run(help): %v", err)
+	}
+	for _, cmd := range commands {
+		if !strings.Contains(out.String(), cmd.name) {
+			t.Errorf("The usage doesn't list command %q:\n%s", cmd.name, out.String())
+		}
+	}
+	if err := Run([]string{"unknown"}, &out, &errOut); err == nil {
+		t.Errorf("Run(unknown) succeeded")
+	}
+}
+
+func TestJobNameArg(t *testing.T) {
+	if name, err := jobNameArg([]string{"pi"}); err != nil || name != "pi" {
+		t.Errorf("jobNameArg([pi]) = %q, %v, want pi", name, err)
+	}
+	for _, args := range [][]string{nil, {"pi", "extra"}} {
+		if _, err := jobNameArg(args); !errors.Is(err, errUsage) {
+			t.Errorf("jobNameArg(%q) returned %v, want a usage error", args, err)
+		}
+	}
+}
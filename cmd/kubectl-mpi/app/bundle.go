@@ -0,0 +1,65 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kubeflow/mpi-operator/pkg/supportbundle"
+)
+
+func bundleCommand(fs *flag.FlagSet) runFunc {
+	output := fs.String("output", "", "The file to write the support bundle to. Defaults to NAME-support-bundle.tar.gz; use - for the standard output.")
+	fs.StringVar(output, "o", "", "Shorthand for --output.")
+	tail := fs.Int64("tail", supportbundle.DefaultTailLines, "Number of recent lines to collect from each container log. 0 means all lines.")
+
+	return func(ctx context.Context, c *cmdContext, args []string) error {
+		name, err := jobNameArg(args)
+		if err != nil {
+			return err
+		}
+		if *tail < 0 {
+			return fmt.Errorf("%w: --tail can't be negative", errUsage)
+		}
+		job, err := c.getMPIJob(ctx, name)
+		if err != nil {
+			return err
+		}
+		opts := supportbundle.Options{TailLines: *tail}
+		if *output == "-" {
+			return supportbundle.Collect(ctx, c.kubeClient, job, opts, c.out)
+		}
+		path := *output
+		if path == "" {
+			path = name + "-support-bundle.tar.gz"
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("creating support bundle file: %w", err)
+		}
+		if err := supportbundle.Collect(ctx, c.kubeClient, job, opts, f); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("writing support bundle file: %w", err)
+		}
+		fmt.Fprintf(c.errOut, "Support bundle of MPIJob %s/%s written to %s\n", c.namespace, name, path)
+		return nil
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+var commands = []command{
+	{
+		name:    "create",
+		usage:   "create NAME --image=IMAGE [flags] -- mpirun [mpirun flags] COMMAND",
+		summary: "Create an MPIJob from an mpirun command.",
+		setup:   createCommand,
+	},
+	{
+		name:    "status",
+		usage:   "status NAME [flags]",
+		summary: "Show the state, replica statuses and pods of an MPIJob.",
+		setup:   statusCommand,
+	},
+	{
+		name:    "describe",
+		usage:   "describe NAME [flags]",
+		summary: "Show the spec, conditions, pods and events of an MPIJob.",
+		setup:   describeCommand,
+	},
+	{
+		name:    "logs",
+		usage:   "logs NAME [--role=launcher|worker|all] [--index=N] [flags]",
+		summary: "Print the logs of the launcher or the workers of an MPIJob, merged and prefixed by pod name.",
+		setup:   logsCommand,
+	},
+	{
+		name:    "bundle",
+		usage:   "bundle NAME [--output=FILE] [--tail=N] [flags]",
+		summary: "Collect the pods, logs, events and configuration of an MPIJob into a .tar.gz support bundle.",
+		setup:   bundleCommand,
+	},
+	{
+		name:    "suspend",
+		usage:   "suspend NAME [flags]",
+		summary: "Suspend an MPIJob, deleting its running pods.",
+		setup:   suspendCommand,
+	},
+	{
+		name:    "resume",
+		usage:   "resume NAME [flags]",
+		summary: "Resume a suspended MPIJob.",
+		setup:   resumeCommand,
+	},
+	{
+		name:    "delete",
+		usage:   "delete NAME [--cascade=background|foreground|orphan] [flags]",
+		summary: "Delete an MPIJob and, depending on --cascade, the resources it owns.",
+		setup:   deleteCommand,
+	},
+}
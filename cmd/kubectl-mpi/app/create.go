@@ -0,0 +1,267 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/validation"
+)
+
+// createOptions are the flags of the create subcommand that shape the
+// generated MPIJob.
+type createOptions struct {
+	image             string
+	workers           int
+	slotsPerWorker    int
+	gpusPerWorker     int
+	gpuResource       string
+	cpusPerWorker     string
+	memoryPerWorker   string
+	mpiImplementation string
+	workerCommand     string
+	sshAuthMountPath  string
+}
+
+var (
+	// mpirunNPFlags are the mpirun flags that set the total number of
+	// processes, across OpenMPI, Intel MPI and MPICH.
+	mpirunNPFlags = map[string]bool{"-n": true, "-np": true, "--n": true, "--np": true, "-c": true}
+	// mpirunPPNFlags are the mpirun flags that set the number of processes
+	// per node.
+	mpirunPPNFlags = map[string]bool{"-N": true, "-npernode": true, "--npernode": true, "-ppn": true, "--ppn": true, "-perhost": true}
+)
+
+func createCommand(fs *flag.FlagSet) runFunc {
+	opts := &createOptions{}
+	fs.StringVar(&opts.image, "image", "", "The container image of the launcher and the workers. Required.")
+	fs.IntVar(&opts.workers, "workers", 0, "The number of workers. Defaults to the number of processes of the mpirun command divided by the slots per worker.")
+	fs.IntVar(&opts.slotsPerWorker, "slots-per-worker", 0, "The number of slots per worker. Defaults to the processes per node of the mpirun command, or to --gpus-per-worker, or to 1.")
+	fs.IntVar(&opts.gpusPerWorker, "gpus-per-worker", 0, "The number of GPUs to request for each worker.")
+	fs.StringVar(&opts.gpuResource, "gpu-resource", "nvidia.com/gpu", "The extended resource name of the GPUs.")
+	fs.StringVar(&opts.cpusPerWorker, "cpus-per-worker", "", "The CPU limit of each worker, as a resource quantity.")
+	fs.StringVar(&opts.memoryPerWorker, "memory-per-worker", "", "The memory limit of each worker, as a resource quantity.")
+	fs.StringVar(&opts.mpiImplementation, "mpi-implementation", string(kubeflow.MPIImplementationOpenMPI), "The MPI implementation: OpenMPI, Intel or MPICH.")
+	fs.StringVar(&opts.workerCommand, "worker-command", "", "The command of the workers, for images that don't start sshd by default.")
+	fs.StringVar(&opts.sshAuthMountPath, "ssh-auth-mount-path", "", "The directory where SSH keys are mounted. Defaults to /root/.ssh.")
+	dryRun := fs.Bool("dry-run", false, "Only print the MPIJob, without submitting it.")
+	output := fs.String("output", "yaml", "The format to print the MPIJob in with --dry-run: yaml or json.")
+	fs.StringVar(output, "o", "yaml", "Shorthand for --output.")
+
+	return func(ctx context.Context, c *cmdContext, args []string) error {
+		if len(args) < 2 {
+			return fmt.Errorf("%w: expected an MPIJob name followed by the mpirun command", errUsage)
+		}
+		job, err := newMPIJobFromMPIRun(args[0], c.namespace, opts, args[1:])
+		if err != nil {
+			return err
+		}
+		if *dryRun {
+			return printObject(c, job, *output)
+		}
+		job, err = c.mpiClient.KubeflowV2beta1().MPIJobs(c.namespace).Create(ctx, job, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("creating MPIJob %s/%s: %w", c.namespace, args[0], err)
+		}
+		fmt.Fprintf(c.out, "mpijob.kubeflow.org/%s created\n", job.Name)
+		return nil
+	}
+}
+
+// newMPIJobFromMPIRun builds an MPIJob whose launcher runs the given mpirun
+// command. The number of workers and slots per worker are inferred from the
+// mpirun flags unless set explicitly.
+func newMPIJobFromMPIRun(name, namespace string, opts *createOptions, command []string) (*kubeflow.MPIJob, error) {
+	if opts.image == "" {
+		return nil, fmt.Errorf("%w: --image is required", errUsage)
+	}
+	np, ppn, err := parseMPIRun(command)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := opts.slotsPerWorker
+	if slots <= 0 {
+		switch {
+		case ppn > 0:
+			slots = ppn
+		case opts.gpusPerWorker > 0:
+			slots = opts.gpusPerWorker
+		default:
+			slots = 1
+		}
+	}
+	workers := opts.workers
+	if workers <= 0 {
+		if np <= 0 {
+			return nil, fmt.Errorf("%w: can't infer the number of workers from the mpirun command; set --workers", errUsage)
+		}
+		workers = (np + slots - 1) / slots
+	}
+	if np > workers*slots {
+		return nil, fmt.Errorf("mpirun requests %d processes, but %d workers with %d slots each only provide %d", np, workers, slots, workers*slots)
+	}
+
+	workerResources, err := opts.workerResources()
+	if err != nil {
+		return nil, err
+	}
+	launcher := corev1.Container{
+		Name:    "mpi-launcher",
+		Image:   opts.image,
+		Command: command[:1],
+		Args:    command[1:],
+	}
+	worker := corev1.Container{
+		Name:      "mpi-worker",
+		Image:     opts.image,
+		Resources: workerResources,
+	}
+	if fields := strings.Fields(opts.workerCommand); len(fields) > 0 {
+		worker.Command = fields[:1]
+		worker.Args = fields[1:]
+	}
+
+	job := &kubeflow.MPIJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubeflow.SchemeGroupVersion.String(),
+			Kind:       kubeflow.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: kubeflow.MPIJobSpec{
+			SlotsPerWorker:    ptr.To(int32(slots)),
+			SSHAuthMountPath:  opts.sshAuthMountPath,
+			MPIImplementation: kubeflow.MPIImplementation(opts.mpiImplementation),
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Replicas: ptr.To[int32](1),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{launcher}},
+					},
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Replicas: ptr.To(int32(workers)),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{worker}},
+					},
+				},
+			},
+		},
+	}
+
+	// Validate a defaulted copy, so that the printed manifest stays minimal.
+	defaulted := job.DeepCopy()
+	kubeflow.SetDefaults_MPIJob(defaulted)
+	if errs := validation.ValidateMPIJob(defaulted); len(errs) > 0 {
+		return nil, fmt.Errorf("generated MPIJob is invalid: %w", errs.ToAggregate())
+	}
+	return job, nil
+}
+
+// parseMPIRun returns the total number of processes and the number of
+// processes per node requested by an mpirun or mpiexec command, or 0 when
+// the command doesn't set them.
+func parseMPIRun(command []string) (np, ppn int, err error) {
+	if len(command) == 0 {
+		return 0, 0, fmt.Errorf("%w: missing mpirun command", errUsage)
+	}
+	if launcher := path.Base(command[0]); launcher != "mpirun" && launcher != "mpiexec" && launcher != "mpiexec.hydra" {
+		// Wrapper scripts are allowed, but their flags can't be interpreted.
+		return 0, 0, nil
+	}
+	for i := 1; i < len(command); i++ {
+		flagName, value, hasValue := strings.Cut(command[i], "=")
+		isNP, isPPN := mpirunNPFlags[flagName], mpirunPPNFlags[flagName]
+		if !isNP && !isPPN {
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(command) {
+				return 0, 0, fmt.Errorf("mpirun flag %s is missing a value", flagName)
+			}
+			i++
+			value = command[i]
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return 0, 0, fmt.Errorf("mpirun flag %s must be a positive integer, got %q", flagName, value)
+		}
+		if isNP {
+			np = n
+		} else {
+			ppn = n
+		}
+	}
+	return np, ppn, nil
+}
+
+func (o *createOptions) workerResources() (corev1.ResourceRequirements, error) {
+	limits := corev1.ResourceList{}
+	if o.gpusPerWorker > 0 {
+		limits[corev1.ResourceName(o.gpuResource)] = *resource.NewQuantity(int64(o.gpusPerWorker), resource.DecimalSI)
+	}
+	for name, value := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:    o.cpusPerWorker,
+		corev1.ResourceMemory: o.memoryPerWorker,
+	} {
+		if value == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return corev1.ResourceRequirements{}, fmt.Errorf("parsing %s per worker: %w", name, err)
+		}
+		limits[name] = q
+	}
+	if len(limits) == 0 {
+		return corev1.ResourceRequirements{}, nil
+	}
+	return corev1.ResourceRequirements{Limits: limits}, nil
+}
+
+func printObject(c *cmdContext, obj interface{}, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case "yaml":
+		data, err = yaml.Marshal(obj)
+	case "json":
+		data, err = json.MarshalIndent(obj, "", "  ")
+		data = append(data, '\n')
+	default:
+		return fmt.Errorf("%w: --output must be yaml or json, got %q", errUsage, format)
+	}
+	if err != nil {
+		return fmt.Errorf("encoding object: %w", err)
+	}
+	_, err = c.out.Write(data)
+	return err
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestNewMPIJobFromMPIRun(t *testing.T) {
+	cases := map[string]struct {
+		opts    createOptions
+		command []string
+		wantJob *kubeflow.MPIJob
+		wantErr bool
+	}{
+		"workers inferred from np and gpus": {
+			opts: createOptions{
+				image:             "horovod:latest",
+				gpusPerWorker:     8,
+				gpuResource:       "nvidia.com/gpu",
+				mpiImplementation: "OpenMPI",
+			},
+			command: []string{"mpirun", "-np", "64", "python", "train.py"},
+			wantJob: newTestMPIJob(8, 8, []string{"-np", "64", "python", "train.py"}, corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					"nvidia.com/gpu": resource.MustParse("8"),
+				},
+			}),
+		},
+		"slots from processes per node": {
+			opts: createOptions{
+				image:             "horovod:latest",
+				mpiImplementation: "OpenMPI",
+			},
+			command: []string{"mpirun", "--np=6", "-N", "2", "./app"},
+			wantJob: newTestMPIJob(3, 2, []string{"--np=6", "-N", "2", "./app"}, corev1.ResourceRequirements{}),
+		},
+		"explicit workers": {
+			opts: createOptions{
+				image:             "horovod:latest",
+				workers:           4,
+				cpusPerWorker:     "2",
+				mpiImplementation: "OpenMPI",
+			},
+			command: []string{"mpirun", "./app"},
+			wantJob: newTestMPIJob(4, 1, []string{"./app"}, corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("2"),
+				},
+			}),
+		},
+		"missing image": {
+			opts:    createOptions{mpiImplementation: "OpenMPI"},
+			command: []string{"mpirun", "-np", "2", "./app"},
+			wantErr: true,
+		},
+		"can't infer workers": {
+			opts:    createOptions{image: "horovod:latest", mpiImplementation: "OpenMPI"},
+			command: []string{"mpirun", "./app"},
+			wantErr: true,
+		},
+		"not enough slots": {
+			opts:    createOptions{image: "horovod:latest", workers: 2, mpiImplementation: "OpenMPI"},
+			command: []string{"mpirun", "-np", "4", "./app"},
+			wantErr: true,
+		},
+		"invalid np": {
+			opts:    createOptions{image: "horovod:latest", mpiImplementation: "OpenMPI"},
+			command: []string{"mpirun", "-np", "many", "./app"},
+			wantErr: true,
+		},
+		"invalid implementation": {
+			opts:    createOptions{image: "horovod:latest", mpiImplementation: "LAM"},
+			command: []string{"mpirun", "-np", "2", "./app"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job, err := newMPIJobFromMPIRun("train", "default", &tc.opts, tc.command)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("newMPIJobFromMPIRun() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantJob, job); diff != "" {
+				t.Errorf("Unexpected MPIJob (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func newTestMPIJob(workers, slots int32, launcherArgs []string, workerResources corev1.ResourceRequirements) *kubeflow.MPIJob {
+	return &kubeflow.MPIJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "kubeflow.org/v2beta1",
+			Kind:       "MPIJob",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "train",
+			Namespace: "default",
+		},
+		Spec: kubeflow.MPIJobSpec{
+			SlotsPerWorker:    ptr.To(slots),
+			MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Replicas: ptr.To[int32](1),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name:    "mpi-launcher",
+								Image:   "horovod:latest",
+								Command: []string{"mpirun"},
+								Args:    launcherArgs,
+							}},
+						},
+					},
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Replicas: ptr.To(workers),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name:      "mpi-worker",
+								Image:     "horovod:latest",
+								Resources: workerResources,
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// cascadePolicies maps the values of --cascade to deletion propagation
+// policies, following kubectl delete.
+var cascadePolicies = map[string]metav1.DeletionPropagation{
+	"background": metav1.DeletePropagationBackground,
+	"foreground": metav1.DeletePropagationForeground,
+	"orphan":     metav1.DeletePropagationOrphan,
+}
+
+func deleteCommand(fs *flag.FlagSet) runFunc {
+	cascade := fs.String("cascade", "background", "How to delete the launcher Job, worker pods and other resources owned by the MPIJob: background, foreground or orphan.")
+
+	return func(ctx context.Context, c *cmdContext, args []string) error {
+		name, err := jobNameArg(args)
+		if err != nil {
+			return err
+		}
+		policy, ok := cascadePolicies[*cascade]
+		if !ok {
+			return fmt.Errorf("%w: --cascade must be background, foreground or orphan, got %q", errUsage, *cascade)
+		}
+		err = c.mpiClient.KubeflowV2beta1().MPIJobs(c.namespace).Delete(ctx, name, metav1.DeleteOptions{
+			PropagationPolicy: &policy,
+		})
+		if err != nil {
+			return fmt.Errorf("deleting MPIJob %s/%s: %w", c.namespace, name, err)
+		}
+		fmt.Fprintf(c.out, "mpijob.kubeflow.org/%s deleted\n", name)
+		return nil
+	}
+}
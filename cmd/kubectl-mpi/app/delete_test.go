@@ -0,0 +1,101 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	mpijobfake "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+)
+
+func TestDeleteCommand(t *testing.T) {
+	cases := map[string]struct {
+		args       []string
+		wantPolicy metav1.DeletionPropagation
+		wantOut    string
+		wantErr    bool
+		wantUsage  bool
+	}{
+		"default cascade": {
+			args:       []string{"pi"},
+			wantPolicy: metav1.DeletePropagationBackground,
+			wantOut:    "mpijob.kubeflow.org/pi deleted\n",
+		},
+		"foreground": {
+			args:       []string{"pi", "--cascade=foreground"},
+			wantPolicy: metav1.DeletePropagationForeground,
+			wantOut:    "mpijob.kubeflow.org/pi deleted\n",
+		},
+		"orphan": {
+			args:       []string{"--cascade", "orphan", "pi"},
+			wantPolicy: metav1.DeletePropagationOrphan,
+			wantOut:    "mpijob.kubeflow.org/pi deleted\n",
+		},
+		"invalid cascade": {
+			args:      []string{"pi", "--cascade=true"},
+			wantErr:   true,
+			wantUsage: true,
+		},
+		"missing name": {
+			wantErr:   true,
+			wantUsage: true,
+		},
+		"not found": {
+			args:    []string{"missing"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiClient := mpijobfake.NewSimpleClientset(&kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "default"},
+			})
+			out, err := runTestCommand(t, "delete", fake.NewSimpleClientset(), mpiClient, tc.args...)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("delete returned error %v, want error %t", err, tc.wantErr)
+			}
+			if gotUsage := errors.Is(err, errUsage); gotUsage != tc.wantUsage {
+				t.Errorf("delete returned error %v, want usage error %t", err, tc.wantUsage)
+			}
+			if out != tc.wantOut {
+				t.Errorf("delete printed %q, want %q", out, tc.wantOut)
+			}
+			var deletes []k8stesting.DeleteActionImpl
+			for _, action := range mpiClient.Actions() {
+				if a, ok := action.(k8stesting.DeleteActionImpl); ok {
+					deletes = append(deletes, a)
+				}
+			}
+			if tc.wantUsage && len(deletes) > 0 {
+				t.Errorf("Deleted the MPIJob after a usage error: %v", deletes)
+			}
+			if tc.wantPolicy == "" {
+				return
+			}
+			if len(deletes) != 1 {
+				t.Fatalf("Got %d deletions, want 1", len(deletes))
+			}
+			if got := deletes[0].GetDeleteOptions().PropagationPolicy; got == nil || *got != tc.wantPolicy {
+				t.Errorf("Deleted with propagation policy %v, want %s", got, tc.wantPolicy)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func describeCommand(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context, c *cmdContext, args []string) error {
+		name, err := jobNameArg(args)
+		if err != nil {
+			return err
+		}
+		job, err := c.getMPIJob(ctx, name)
+		if err != nil {
+			return err
+		}
+		pods, err := c.listJobPods(ctx, name, "")
+		if err != nil {
+			return err
+		}
+		events, err := c.kubeClient.CoreV1().Events(c.namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.Set{
+				"involvedObject.kind": kubeflow.Kind,
+				"involvedObject.name": name,
+				"involvedObject.uid":  string(job.UID),
+			}.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("listing events of MPIJob %s/%s: %w", c.namespace, name, err)
+		}
+
+		w := tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+		printJobSummary(w, job)
+		printJobSpec(w, job)
+		fmt.Fprintln(w)
+		printConditions(w, job.Status.Conditions)
+		fmt.Fprintln(w)
+		printReplicaStatuses(w, job)
+		fmt.Fprintln(w)
+		printPods(w, pods)
+		fmt.Fprintln(w)
+		printEvents(w, events.Items)
+		return w.Flush()
+	}
+}
+
+func printJobSpec(w io.Writer, job *kubeflow.MPIJob) {
+	spec := &job.Spec
+	fmt.Fprintf(w, "MPI Implementation:\t%s\n", spec.MPIImplementation)
+	fmt.Fprintf(w, "Slots Per Worker:\t%d\n", ptr.Deref(spec.SlotsPerWorker, 1))
+	fmt.Fprintf(w, "Launcher Creation Policy:\t%s\n", spec.LauncherCreationPolicy)
+	fmt.Fprintf(w, "Run Launcher As Worker:\t%t\n", ptr.Deref(spec.RunLauncherAsWorker, false))
+	fmt.Fprintf(w, "Clean Pod Policy:\t%s\n", ptr.Deref(spec.RunPolicy.CleanPodPolicy, ""))
+	fmt.Fprintf(w, "Suspend:\t%t\n", ptr.Deref(spec.RunPolicy.Suspend, false))
+	if spec.RunPolicy.BackoffLimit != nil {
+		fmt.Fprintf(w, "Backoff Limit:\t%d\n", *spec.RunPolicy.BackoffLimit)
+	}
+	if spec.RunPolicy.ActiveDeadlineSeconds != nil {
+		fmt.Fprintf(w, "Active Deadline Seconds:\t%d\n", *spec.RunPolicy.ActiveDeadlineSeconds)
+	}
+	if spec.RunPolicy.TTLSecondsAfterFinished != nil {
+		fmt.Fprintf(w, "TTL Seconds After Finished:\t%d\n", *spec.RunPolicy.TTLSecondsAfterFinished)
+	}
+	if spec.RunPolicy.ManagedBy != nil {
+		fmt.Fprintf(w, "Managed By:\t%s\n", *spec.RunPolicy.ManagedBy)
+	}
+	for _, rtype := range []kubeflow.MPIReplicaType{kubeflow.MPIReplicaTypeLauncher, kubeflow.MPIReplicaTypeWorker} {
+		rspec := spec.MPIReplicaSpecs[rtype]
+		if rspec == nil {
+			continue
+		}
+		fmt.Fprintf(w, "%s:\n", rtype)
+		fmt.Fprintf(w, "  Replicas:\t%d\n", ptr.Deref(rspec.Replicas, 1))
+		fmt.Fprintf(w, "  Restart Policy:\t%s\n", rspec.RestartPolicy)
+		for _, container := range rspec.Template.Spec.Containers {
+			fmt.Fprintf(w, "  Container %s:\t%s\n", container.Name, container.Image)
+			if len(container.Command) > 0 || len(container.Args) > 0 {
+				fmt.Fprintf(w, "    Command:\t%s\n", strings.Join(append(append([]string{}, container.Command...), container.Args...), " "))
+			}
+		}
+	}
+}
+
+func printConditions(w io.Writer, conditions []kubeflow.JobCondition) {
+	if len(conditions) == 0 {
+		fmt.Fprintln(w, "No conditions.")
+		return
+	}
+	fmt.Fprintln(w, "CONDITION\tSTATUS\tREASON\tLAST TRANSITION\tMESSAGE")
+	for _, cond := range conditions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", cond.Type, cond.Status, cond.Reason,
+			formatTime(&cond.LastTransitionTime), cond.Message)
+	}
+}
+
+func printEvents(w io.Writer, events []corev1.Event) {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "No events.")
+		return
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+	fmt.Fprintln(w, "TYPE\tREASON\tAGE\tMESSAGE")
+	for i := range events {
+		e := &events[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Type, e.Reason, duration.HumanDuration(time.Since(eventTime(e))), e.Message)
+	}
+}
+
+func eventTime(e *corev1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	if !e.EventTime.IsZero() {
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
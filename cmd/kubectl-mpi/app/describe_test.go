@@ -0,0 +1,134 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	mpijobfake "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+)
+
+func TestDescribeCommand(t *testing.T) {
+	involvedObject := corev1.ObjectReference{Kind: kubeflow.Kind, Name: "pi", Namespace: "default", UID: "pi-uid"}
+	events := []*corev1.Event{
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "pi.succeeded", Namespace: "default"},
+			InvolvedObject: involvedObject,
+			Type:           corev1.EventTypeNormal,
+			Reason:         kubeflow.JobSucceededReason,
+			Message:        "MPIJob default/pi successfully completed.",
+			LastTimestamp:  metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		},
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "pi.created", Namespace: "default"},
+			InvolvedObject: involvedObject,
+			Type:           corev1.EventTypeNormal,
+			Reason:         kubeflow.JobCreatedReason,
+			Message:        "MPIJob default/pi is created.",
+			EventTime:      metav1.NewMicroTime(time.Now().Add(-10 * time.Minute)),
+		},
+	}
+	cases := map[string]struct {
+		events  []*corev1.Event
+		wantOut string
+	}{
+		"with events": {
+			events: events,
+			wantOut: `Name:                      pi
+Namespace:                 default
+State:                     Succeeded
+Start Time:                2025-03-01T10:00:00Z
+Completion Time:           2025-03-01T10:30:00Z
+Duration:                  30m
+Launcher Restarts:         1
+MPI Implementation:        OpenMPI
+Slots Per Worker:          4
+Launcher Creation Policy:  AtStartup
+Run Launcher As Worker:    false
+Clean Pod Policy:          Running
+Suspend:                   false
+Backoff Limit:             3
+Launcher:
+  Replicas:            1
+  Restart Policy:      OnFailure
+  Container launcher:  mpi-pi:latest
+    Command:           mpirun -n 8 /home/mpiuser/pi
+Worker:
+  Replicas:          2
+  Restart Policy:    Never
+  Container worker:  mpi-pi:latest
+
+CONDITION  STATUS  REASON           LAST TRANSITION       MESSAGE
+Created    True    MPIJobCreated    2025-03-01T10:00:00Z  MPIJob default/pi is created.
+Succeeded  True    MPIJobSucceeded  2025-03-01T10:30:00Z  MPIJob default/pi successfully completed.
+
+REPLICA   DESIRED  ACTIVE  READY  SUCCEEDED  FAILED
+Launcher  1        0       0      1          0
+Worker    2        2       2      0          0
+
+POD                ROLE      INDEX  STATUS     RESTARTS  NODE    AGE
+pi-launcher-x7k2p  launcher  -      Succeeded  0         node-a  5m
+pi-worker-0        worker    0      Running    2         node-a  5m
+pi-worker-1        worker    1      Running    0         <none>  5m
+
+TYPE    REASON           AGE  MESSAGE
+Normal  MPIJobCreated    10m  MPIJob default/pi is created.
+Normal  MPIJobSucceeded  2m   MPIJob default/pi successfully completed.
+`,
+		},
+		"without events": {
+			wantOut: "No events.\n",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			for _, pod := range newTestJobPods() {
+				if err := kubeClient.Tracker().Add(pod); err != nil {
+					t.Fatalf("Adding pod: %v", err)
+				}
+			}
+			for _, event := range tc.events {
+				if err := kubeClient.Tracker().Add(event); err != nil {
+					t.Fatalf("Adding event: %v", err)
+				}
+			}
+			out, err := runTestCommand(t, "describe", kubeClient, mpijobfake.NewSimpleClientset(newTestJob()), "pi")
+			if err != nil {
+				t.Fatalf("describe: %v", err)
+			}
+			if len(tc.events) == 0 {
+				// Only the end of the output depends on the events.
+				out = out[len(out)-len(tc.wantOut):]
+			}
+			if diff := cmp.Diff(tc.wantOut, out); diff != "" {
+				t.Errorf("Unexpected output (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDescribeCommandNotFound(t *testing.T) {
+	if _, err := runTestCommand(t, "describe", fake.NewSimpleClientset(), mpijobfake.NewSimpleClientset(), "missing"); err == nil {
+		t.Errorf("describe succeeded for a missing MPIJob")
+	}
+}
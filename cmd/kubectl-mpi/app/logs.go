@@ -0,0 +1,111 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeflow/mpi-operator/pkg/logs"
+)
+
+// allRoles is the value of --role that selects the launcher and all workers.
+const allRoles = "all"
+
+func logsCommand(fs *flag.FlagSet) runFunc {
+	role := fs.String("role", launcherRole, "The role of the pods to print the logs of: launcher, worker or all.")
+	index := fs.Int("index", -1, "The replica index of the worker pod. Defaults to all workers. Only used with --role=worker.")
+	container := fs.String("container", "", "The container to print the logs of. Defaults to the first container.")
+	follow := fs.Bool("follow", false, "Stream the logs as they are produced.")
+	fs.BoolVar(follow, "f", false, "Shorthand for --follow.")
+	tail := fs.Int64("tail", -1, "Number of recent lines to print. Defaults to all lines.")
+	since := fs.Duration("since", 0, "Only print logs newer than a relative duration, like 5s, 2m or 3h.")
+	sinceTime := fs.String("since-time", "", "Only print logs after a RFC3339 timestamp.")
+	timestamps := fs.Bool("timestamps", false, "Include timestamps on each line.")
+	previous := fs.Bool("previous", false, "Print the logs of the previous instance of the container.")
+
+	return func(ctx context.Context, c *cmdContext, args []string) error {
+		name, err := jobNameArg(args)
+		if err != nil {
+			return err
+		}
+		opts := &corev1.PodLogOptions{
+			Container:  *container,
+			Follow:     *follow,
+			Previous:   *previous,
+			Timestamps: *timestamps,
+		}
+		if *tail >= 0 {
+			opts.TailLines = tail
+		}
+		if *since != 0 && *sinceTime != "" {
+			return fmt.Errorf("%w: only one of --since and --since-time can be set", errUsage)
+		}
+		if *since != 0 {
+			opts.SinceSeconds = ptr.To(int64(since.Round(time.Second).Seconds()))
+		}
+		if *sinceTime != "" {
+			t, err := time.Parse(time.RFC3339, *sinceTime)
+			if err != nil {
+				return fmt.Errorf("%w: parsing --since-time: %v", errUsage, err)
+			}
+			opts.SinceTime = &metav1.Time{Time: t}
+		}
+
+		var pod *corev1.Pod
+		switch {
+		case *role == allRoles:
+			return logs.StreamMPIJob(ctx, c.kubeClient, c.namespace, name, opts, c.out)
+		case *role == workerRole && *index < 0:
+			pods, err := c.listJobPods(ctx, name, workerRole)
+			if err != nil {
+				return err
+			}
+			if len(pods) == 0 {
+				return fmt.Errorf("MPIJob %s/%s has no worker pods", c.namespace, name)
+			}
+			return logs.StreamPods(ctx, c.kubeClient, pods, opts, c.out)
+		case *role == launcherRole:
+			pod, err = c.launcherPod(ctx, name)
+		case *role == workerRole:
+			pod, err = c.workerPod(ctx, name, *index)
+		default:
+			return fmt.Errorf("%w: --role must be %s, %s or %s, got %q", errUsage, launcherRole, workerRole, allRoles, *role)
+		}
+		if err != nil {
+			return err
+		}
+		return c.streamPodLogs(ctx, pod, opts, c.out)
+	}
+}
+
+func (c *cmdContext) streamPodLogs(ctx context.Context, pod *corev1.Pod, opts *corev1.PodLogOptions, w io.Writer) error {
+	stream, err := c.kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("getting logs of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	defer stream.Close()
+	if _, err := io.Copy(w, stream); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("reading logs of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	return nil
+}
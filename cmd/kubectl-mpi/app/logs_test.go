@@ -0,0 +1,127 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	mpijobfake "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+)
+
+func TestLogsCommand(t *testing.T) {
+	cases := map[string]struct {
+		args        []string
+		wantOut     []string
+		wantOptions *corev1.PodLogOptions
+		wantErr     bool
+		wantUsage   bool
+	}{
+		"launcher": {
+			args:        []string{"pi"},
+			wantOut:     []string{"fake logs"},
+			wantOptions: &corev1.PodLogOptions{},
+		},
+		"worker with options": {
+			args:    []string{"pi", "--role=worker", "--index=1", "--tail=10", "--since=90s", "-f", "--container=worker", "--timestamps"},
+			wantOut: []string{"fake logs"},
+			wantOptions: &corev1.PodLogOptions{
+				Container:    "worker",
+				Follow:       true,
+				Timestamps:   true,
+				TailLines:    ptr.To[int64](10),
+				SinceSeconds: ptr.To[int64](90),
+			},
+		},
+		"all workers": {
+			args:        []string{"pi", "--role=worker", "--previous"},
+			wantOut:     []string{"[pi-worker-0] fake logs", "[pi-worker-1] fake logs"},
+			wantOptions: &corev1.PodLogOptions{Previous: true},
+		},
+		"missing worker": {
+			args:    []string{"pi", "--role=worker", "--index=5"},
+			wantErr: true,
+		},
+		"invalid role": {
+			args:      []string{"pi", "--role=master"},
+			wantErr:   true,
+			wantUsage: true,
+		},
+		"since and since-time": {
+			args:      []string{"pi", "--since=1m", "--since-time=2025-03-01T10:00:00Z"},
+			wantErr:   true,
+			wantUsage: true,
+		},
+		"invalid since-time": {
+			args:      []string{"pi", "--since-time=yesterday"},
+			wantErr:   true,
+			wantUsage: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			for _, pod := range newTestJobPods() {
+				if err := kubeClient.Tracker().Add(pod); err != nil {
+					t.Fatalf("Adding pod: %v", err)
+				}
+			}
+			out, err := runTestCommand(t, "logs", kubeClient, mpijobfake.NewSimpleClientset(), tc.args...)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("logs returned error %v, want error %t", err, tc.wantErr)
+			}
+			if gotUsage := errors.Is(err, errUsage); gotUsage != tc.wantUsage {
+				t.Errorf("logs returned error %v, want usage error %t", err, tc.wantUsage)
+			}
+			var lines []string
+			if out != "" {
+				// The logs of several pods are interleaved.
+				lines = strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+				sort.Strings(lines)
+			}
+			if diff := cmp.Diff(tc.wantOut, lines); diff != "" {
+				t.Errorf("Unexpected output (-want,+got):\n%s", diff)
+			}
+			var options []*corev1.PodLogOptions
+			for _, action := range kubeClient.Actions() {
+				if a, ok := action.(k8stesting.GenericActionImpl); ok && a.GetSubresource() == "log" {
+					options = append(options, a.Value.(*corev1.PodLogOptions))
+				}
+			}
+			if tc.wantOptions == nil {
+				if len(options) > 0 {
+					t.Errorf("Unexpected requests of logs: %v", options)
+				}
+				return
+			}
+			if len(options) != len(tc.wantOut) {
+				t.Fatalf("Got %d requests of logs, want %d", len(options), len(tc.wantOut))
+			}
+			for _, got := range options {
+				if diff := cmp.Diff(tc.wantOptions, got); diff != "" {
+					t.Errorf("Unexpected options of the logs (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
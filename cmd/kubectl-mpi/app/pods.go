@@ -0,0 +1,140 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// Values of the kubeflow.JobRoleLabel set by the operator.
+	launcherRole = "launcher"
+	workerRole   = "worker"
+)
+
+// jobPodSelector returns the selector matching the pods of the MPIJob with
+// the given role. An empty role matches the pods of every role.
+func jobPodSelector(jobName, role string) labels.Selector {
+	set := labels.Set{
+		kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+		kubeflow.JobNameLabel:      jobName,
+	}
+	if role != "" {
+		set[kubeflow.JobRoleLabel] = role
+	}
+	return labels.SelectorFromSet(set)
+}
+
+// listJobPods lists the pods of the MPIJob with the given role, with the
+// launcher first and the workers ordered by replica index.
+func (c *cmdContext) listJobPods(ctx context.Context, jobName, role string) ([]corev1.Pod, error) {
+	pods, err := c.kubeClient.CoreV1().Pods(c.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: jobPodSelector(jobName, role).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods of MPIJob %s/%s: %w", c.namespace, jobName, err)
+	}
+	sortPods(pods.Items)
+	return pods.Items, nil
+}
+
+// launcherPod returns the most recently created launcher pod of the MPIJob.
+func (c *cmdContext) launcherPod(ctx context.Context, jobName string) (*corev1.Pod, error) {
+	pods, err := c.listJobPods(ctx, jobName, launcherRole)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("MPIJob %s/%s has no launcher pod", c.namespace, jobName)
+	}
+	return &pods[len(pods)-1], nil
+}
+
+// workerPod returns the worker pod of the MPIJob with the given index.
+func (c *cmdContext) workerPod(ctx context.Context, jobName string, index int) (*corev1.Pod, error) {
+	pods, err := c.listJobPods(ctx, jobName, workerRole)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods {
+		if podIndex(&pods[i]) == index {
+			return &pods[i], nil
+		}
+	}
+	return nil, fmt.Errorf("MPIJob %s/%s has no worker pod with index %d", c.namespace, jobName, index)
+}
+
+func podRole(pod *corev1.Pod) string {
+	return pod.Labels[kubeflow.JobRoleLabel]
+}
+
+// podIndex returns the replica index of the pod, or -1 if it has none.
+func podIndex(pod *corev1.Pod) int {
+	index, err := strconv.Atoi(pod.Labels[kubeflow.ReplicaIndexLabel])
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+func sortPods(pods []corev1.Pod) {
+	sort.SliceStable(pods, func(i, j int) bool {
+		ri, rj := podRole(&pods[i]), podRole(&pods[j])
+		if ri != rj {
+			return ri == launcherRole
+		}
+		if ri == launcherRole {
+			return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+		}
+		return podIndex(&pods[i]) < podIndex(&pods[j])
+	})
+}
+
+// podState returns a short human readable state of the pod, similar to the
+// STATUS column of `kubectl get pods`.
+func podState(pod *corev1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" {
+			return cs.State.Waiting.Reason
+		}
+		if cs.State.Terminated != nil && cs.State.Terminated.Reason != "" && pod.Status.Phase != corev1.PodSucceeded {
+			return cs.State.Terminated.Reason
+		}
+	}
+	if pod.Status.Reason != "" {
+		return pod.Status.Reason
+	}
+	return string(pod.Status.Phase)
+}
+
+func podRestarts(pod *corev1.Pod) int32 {
+	var restarts int32
+	for _, cs := range pod.Status.ContainerStatuses {
+		restarts += cs.RestartCount
+	}
+	return restarts
+}
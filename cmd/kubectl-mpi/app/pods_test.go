@@ -0,0 +1,172 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// newTestPod returns a pod of the MPIJob pi with the role and, for the
+// workers, the index, created age ago.
+func newTestPod(name, role string, index int, age time.Duration) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			Labels: map[string]string{
+				kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+				kubeflow.JobNameLabel:      "pi",
+				kubeflow.JobRoleLabel:      role,
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if index >= 0 {
+		pod.Labels[kubeflow.ReplicaIndexLabel] = strconv.Itoa(index)
+	}
+	return pod
+}
+
+func TestSortPods(t *testing.T) {
+	pods := []corev1.Pod{
+		*newTestPod("pi-worker-10", workerRole, 10, time.Hour),
+		*newTestPod("pi-launcher-b", launcherRole, -1, time.Minute),
+		*newTestPod("pi-worker-2", workerRole, 2, time.Hour),
+		*newTestPod("pi-launcher-a", launcherRole, -1, time.Hour),
+		*newTestPod("pi-worker-0", workerRole, 0, time.Hour),
+	}
+	sortPods(pods)
+	var got []string
+	for _, pod := range pods {
+		got = append(got, pod.Name)
+	}
+	want := []string{"pi-launcher-a", "pi-launcher-b", "pi-worker-0", "pi-worker-2", "pi-worker-10"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected order of the pods (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPodState(t *testing.T) {
+	cases := map[string]struct {
+		status      corev1.PodStatus
+		terminating bool
+		want        string
+	}{
+		"running": {
+			status: corev1.PodStatus{Phase: corev1.PodRunning},
+			want:   "Running",
+		},
+		"terminating": {
+			status:      corev1.PodStatus{Phase: corev1.PodRunning},
+			terminating: true,
+			want:        "Terminating",
+		},
+		"waiting container": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+				}},
+			},
+			want: "ImagePullBackOff",
+		},
+		"terminated container": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+				}},
+			},
+			want: "OOMKilled",
+		},
+		"succeeded": {
+			status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+				}},
+			},
+			want: "Succeeded",
+		},
+		"evicted": {
+			status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"},
+			want:   "Evicted",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pod := &corev1.Pod{Status: tc.status}
+			if tc.terminating {
+				pod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}
+			if got := podState(pod); got != tc.want {
+				t.Errorf("podState() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJobPods(t *testing.T) {
+	other := newTestPod("other-worker-0", workerRole, 0, time.Hour)
+	other.Labels[kubeflow.JobNameLabel] = "other"
+	kubeClient := fake.NewSimpleClientset(
+		newTestPod("pi-launcher-old", launcherRole, -1, time.Hour),
+		newTestPod("pi-launcher-new", launcherRole, -1, time.Minute),
+		newTestPod("pi-worker-0", workerRole, 0, time.Hour),
+		newTestPod("pi-worker-1", workerRole, 1, time.Hour),
+		other,
+	)
+	c := &cmdContext{namespace: "default", kubeClient: kubeClient}
+	ctx := context.Background()
+
+	pods, err := c.listJobPods(ctx, "pi", "")
+	if err != nil {
+		t.Fatalf("listJobPods(): %v", err)
+	}
+	if got := len(pods); got != 4 {
+		t.Errorf("listJobPods() returned %d pods, want 4", got)
+	}
+	launcher, err := c.launcherPod(ctx, "pi")
+	if err != nil {
+		t.Fatalf("launcherPod(): %v", err)
+	}
+	if launcher.Name != "pi-launcher-new" {
+		t.Errorf("launcherPod() = %s, want the most recent launcher pi-launcher-new", launcher.Name)
+	}
+	worker, err := c.workerPod(ctx, "pi", 1)
+	if err != nil {
+		t.Fatalf("workerPod(1): %v", err)
+	}
+	if worker.Name != "pi-worker-1" {
+		t.Errorf("workerPod(1) = %s, want pi-worker-1", worker.Name)
+	}
+	if _, err := c.workerPod(ctx, "pi", 2); err == nil {
+		t.Errorf("workerPod(2) succeeded for a missing worker")
+	}
+	if _, err := c.launcherPod(ctx, "other"); err == nil {
+		t.Errorf("launcherPod() succeeded for an MPIJob without launcher")
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func statusCommand(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context, c *cmdContext, args []string) error {
+		name, err := jobNameArg(args)
+		if err != nil {
+			return err
+		}
+		job, err := c.getMPIJob(ctx, name)
+		if err != nil {
+			return err
+		}
+		pods, err := c.listJobPods(ctx, name, "")
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+		printJobSummary(w, job)
+		fmt.Fprintln(w)
+		printReplicaStatuses(w, job)
+		fmt.Fprintln(w)
+		printPods(w, pods)
+		return w.Flush()
+	}
+}
+
+func (c *cmdContext) getMPIJob(ctx context.Context, name string) (*kubeflow.MPIJob, error) {
+	job, err := c.mpiClient.KubeflowV2beta1().MPIJobs(c.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting MPIJob %s/%s: %w", c.namespace, name, err)
+	}
+	return job, nil
+}
+
+// jobState returns the state of the MPIJob, or "Pending" if it has no true
+// condition yet.
+func jobState(job *kubeflow.MPIJob) string {
+	if state := kubeflow.StateOf(job.Status); state != "" {
+		return string(state)
+	}
+	return "Pending"
+}
+
+func printJobSummary(w io.Writer, job *kubeflow.MPIJob) {
+	fmt.Fprintf(w, "Name:\t%s\n", job.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", job.Namespace)
+	fmt.Fprintf(w, "State:\t%s\n", jobState(job))
+	fmt.Fprintf(w, "Start Time:\t%s\n", formatTime(job.Status.StartTime))
+	fmt.Fprintf(w, "Completion Time:\t%s\n", formatTime(job.Status.CompletionTime))
+	if job.Status.StartTime != nil {
+		end := time.Now()
+		if job.Status.CompletionTime != nil {
+			end = job.Status.CompletionTime.Time
+		}
+		fmt.Fprintf(w, "Duration:\t%s\n", duration.HumanDuration(end.Sub(job.Status.StartTime.Time)))
+	}
+	fmt.Fprintf(w, "Launcher Restarts:\t%d\n", job.Status.LauncherRestartCount)
+}
+
+func printReplicaStatuses(w io.Writer, job *kubeflow.MPIJob) {
+	fmt.Fprintln(w, "REPLICA\tDESIRED\tACTIVE\tREADY\tSUCCEEDED\tFAILED")
+	for _, rtype := range []kubeflow.MPIReplicaType{kubeflow.MPIReplicaTypeLauncher, kubeflow.MPIReplicaTypeWorker} {
+		spec := job.Spec.MPIReplicaSpecs[rtype]
+		if spec == nil {
+			continue
+		}
+		var desired int32 = 1
+		if spec.Replicas != nil {
+			desired = *spec.Replicas
+		}
+		status := job.Status.ReplicaStatuses[rtype]
+		if status == nil {
+			status = &kubeflow.ReplicaStatus{}
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\n", rtype, desired, status.Active, status.Ready, status.Succeeded, status.Failed)
+	}
+}
+
+func printPods(w io.Writer, pods []corev1.Pod) {
+	if len(pods) == 0 {
+		fmt.Fprintln(w, "No pods found.")
+		return
+	}
+	fmt.Fprintln(w, "POD\tROLE\tINDEX\tSTATUS\tRESTARTS\tNODE\tAGE")
+	for i := range pods {
+		pod := &pods[i]
+		index := "-"
+		if idx := podIndex(pod); idx >= 0 {
+			index = fmt.Sprint(idx)
+		}
+		node := pod.Spec.NodeName
+		if node == "" {
+			node = "<none>"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", pod.Name, podRole(pod), index, podState(pod),
+			podRestarts(pod), node, duration.HumanDuration(time.Since(pod.CreationTimestamp.Time)))
+	}
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil {
+		return "<none>"
+	}
+	return t.Format(time.RFC3339)
+}
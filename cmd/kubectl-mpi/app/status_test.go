@@ -0,0 +1,158 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	mpijobfake "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+)
+
+var (
+	testStartTime      = metav1.NewTime(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC))
+	testCompletionTime = metav1.NewTime(time.Date(2025, 3, 1, 10, 30, 0, 0, time.UTC))
+)
+
+// newTestJob returns the finished MPIJob pi with 2 workers.
+func newTestJob() *kubeflow.MPIJob {
+	return &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "default", UID: "pi-uid"},
+		Spec: kubeflow.MPIJobSpec{
+			SlotsPerWorker:         ptr.To[int32](4),
+			MPIImplementation:      kubeflow.MPIImplementationOpenMPI,
+			LauncherCreationPolicy: kubeflow.LauncherCreationPolicyAtStartup,
+			RunPolicy: kubeflow.RunPolicy{
+				CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+				BackoffLimit:   ptr.To[int32](3),
+			},
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					RestartPolicy: kubeflow.RestartPolicyOnFailure,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name:    "launcher",
+								Image:   "mpi-pi:latest",
+								Command: []string{"mpirun"},
+								Args:    []string{"-n", "8", "/home/mpiuser/pi"},
+							}},
+						},
+					},
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Replicas:      ptr.To[int32](2),
+					RestartPolicy: kubeflow.RestartPolicyNever,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "worker", Image: "mpi-pi:latest"}},
+						},
+					},
+				},
+			},
+		},
+		Status: kubeflow.JobStatus{
+			Conditions: []kubeflow.JobCondition{
+				{Type: kubeflow.JobCreated, Status: corev1.ConditionTrue, Reason: kubeflow.JobCreatedReason, Message: "MPIJob default/pi is created.", LastTransitionTime: testStartTime},
+				{Type: kubeflow.JobSucceeded, Status: corev1.ConditionTrue, Reason: kubeflow.JobSucceededReason, Message: "MPIJob default/pi successfully completed.", LastTransitionTime: testCompletionTime},
+			},
+			ReplicaStatuses: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {Succeeded: 1},
+				kubeflow.MPIReplicaTypeWorker:   {Active: 2, Ready: 2},
+			},
+			StartTime:            &testStartTime,
+			CompletionTime:       &testCompletionTime,
+			LauncherRestartCount: 1,
+		},
+	}
+}
+
+// newTestJobPods returns the launcher and the workers of the MPIJob pi.
+func newTestJobPods() []*corev1.Pod {
+	launcher := newTestPod("pi-launcher-x7k2p", launcherRole, -1, 5*time.Minute)
+	launcher.Spec.NodeName = "node-a"
+	launcher.Status.Phase = corev1.PodSucceeded
+	worker0 := newTestPod("pi-worker-0", workerRole, 0, 5*time.Minute)
+	worker0.Spec.NodeName = "node-a"
+	worker0.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "worker", RestartCount: 2}}
+	worker1 := newTestPod("pi-worker-1", workerRole, 1, 5*time.Minute)
+	return []*corev1.Pod{worker1, launcher, worker0}
+}
+
+func TestStatusCommand(t *testing.T) {
+	cases := map[string]struct {
+		args      []string
+		wantOut   string
+		wantErr   bool
+		wantUsage bool
+	}{
+		"finished job": {
+			args: []string{"pi"},
+			wantOut: `Name:               pi
+Namespace:          default
+State:              Succeeded
+Start Time:         2025-03-01T10:00:00Z
+Completion Time:    2025-03-01T10:30:00Z
+Duration:           30m
+Launcher Restarts:  1
+
+REPLICA   DESIRED  ACTIVE  READY  SUCCEEDED  FAILED
+Launcher  1        0       0      1          0
+Worker    2        2       2      0          0
+
+POD                ROLE      INDEX  STATUS     RESTARTS  NODE    AGE
+pi-launcher-x7k2p  launcher  -      Succeeded  0         node-a  5m
+pi-worker-0        worker    0      Running    2         node-a  5m
+pi-worker-1        worker    1      Running    0         <none>  5m
+`,
+		},
+		"not found": {
+			args:    []string{"missing"},
+			wantErr: true,
+		},
+		"missing name": {
+			wantErr:   true,
+			wantUsage: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			for _, pod := range newTestJobPods() {
+				if err := kubeClient.Tracker().Add(pod); err != nil {
+					t.Fatalf("Adding pod: %v", err)
+				}
+			}
+			out, err := runTestCommand(t, "status", kubeClient, mpijobfake.NewSimpleClientset(newTestJob()), tc.args...)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("status returned error %v, want error %t", err, tc.wantErr)
+			}
+			if gotUsage := errors.Is(err, errUsage); gotUsage != tc.wantUsage {
+				t.Errorf("status returned error %v, want usage error %t", err, tc.wantUsage)
+			}
+			if diff := cmp.Diff(tc.wantOut, out); diff != "" {
+				t.Errorf("Unexpected output (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func suspendCommand(fs *flag.FlagSet) runFunc {
+	return setSuspendCommand(true)
+}
+
+func resumeCommand(fs *flag.FlagSet) runFunc {
+	return setSuspendCommand(false)
+}
+
+// setSuspendCommand returns a subcommand that sets .spec.runPolicy.suspend
+// of the MPIJob. The operator takes care of deleting or recreating the pods.
+func setSuspendCommand(suspend bool) runFunc {
+	return func(ctx context.Context, c *cmdContext, args []string) error {
+		name, err := jobNameArg(args)
+		if err != nil {
+			return err
+		}
+		patch := fmt.Sprintf(`{"spec":{"runPolicy":{"suspend":%t}}}`, suspend)
+		_, err = c.mpiClient.KubeflowV2beta1().MPIJobs(c.namespace).Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("patching MPIJob %s/%s: %w", c.namespace, name, err)
+		}
+		verb := "resumed"
+		if suspend {
+			verb = "suspended"
+		}
+		fmt.Fprintf(c.out, "mpijob.kubeflow.org/%s %s\n", name, verb)
+		return nil
+	}
+}
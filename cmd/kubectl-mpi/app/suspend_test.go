@@ -0,0 +1,97 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	mpijobfake "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+)
+
+func TestSetSuspendCommand(t *testing.T) {
+	cases := map[string]struct {
+		command     string
+		suspended   bool
+		wantPatch   string
+		wantSuspend bool
+		wantOut     string
+	}{
+		"suspend": {
+			command:     "suspend",
+			wantPatch:   `{"spec":{"runPolicy":{"suspend":true}}}`,
+			wantSuspend: true,
+			wantOut:     "mpijob.kubeflow.org/pi suspended\n",
+		},
+		"resume": {
+			command:   "resume",
+			suspended: true,
+			wantPatch: `{"spec":{"runPolicy":{"suspend":false}}}`,
+			wantOut:   "mpijob.kubeflow.org/pi resumed\n",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiClient := mpijobfake.NewSimpleClientset(&kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "default"},
+				Spec: kubeflow.MPIJobSpec{
+					RunPolicy: kubeflow.RunPolicy{Suspend: ptr.To(tc.suspended)},
+				},
+			})
+			out, err := runTestCommand(t, tc.command, fake.NewSimpleClientset(), mpiClient, "pi")
+			if err != nil {
+				t.Fatalf("%s: %v", tc.command, err)
+			}
+			if out != tc.wantOut {
+				t.Errorf("%s printed %q, want %q", tc.command, out, tc.wantOut)
+			}
+			var patches []k8stesting.PatchActionImpl
+			for _, action := range mpiClient.Actions() {
+				if a, ok := action.(k8stesting.PatchActionImpl); ok {
+					patches = append(patches, a)
+				}
+			}
+			if len(patches) != 1 {
+				t.Fatalf("Got %d patches, want 1", len(patches))
+			}
+			if got := patches[0].GetPatchType(); got != types.MergePatchType {
+				t.Errorf("Patched with type %s, want %s", got, types.MergePatchType)
+			}
+			if got := string(patches[0].GetPatch()); got != tc.wantPatch {
+				t.Errorf("Patched with %s, want %s", got, tc.wantPatch)
+			}
+			job, err := mpiClient.KubeflowV2beta1().MPIJobs("default").Get(context.Background(), "pi", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting the MPIJob: %v", err)
+			}
+			if got := ptr.Deref(job.Spec.RunPolicy.Suspend, false); got != tc.wantSuspend {
+				t.Errorf("spec.runPolicy.suspend = %t, want %t", got, tc.wantSuspend)
+			}
+		})
+	}
+}
+
+func TestSetSuspendCommandNotFound(t *testing.T) {
+	if _, err := runTestCommand(t, "suspend", fake.NewSimpleClientset(), mpijobfake.NewSimpleClientset(), "missing"); err == nil {
+		t.Errorf("suspend succeeded for a missing MPIJob")
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// kubectl-mpi is a kubectl plugin to inspect and manage MPIJobs.
+// Install it anywhere in your PATH and invoke it as `kubectl mpi`.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kubeflow/mpi-operator/cmd/kubectl-mpi/app"
+)
+
+func main() {
+	if err := app.Run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// auditRecord is a line of the audit log, describing a change that the
+// operator made to the cluster.
+type auditRecord struct {
+	Time        time.Time `json:"time"`
+	Verb        string    `json:"verb"`
+	Resource    string    `json:"resource"`
+	Subresource string    `json:"subresource,omitempty"`
+	Namespace   string    `json:"namespace,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	// MPIJob is the namespace/name of the MPIJob that the change was made
+	// for, if known.
+	MPIJob  string `json:"mpiJob,omitempty"`
+	Summary string `json:"summary,omitempty"`
+	DryRun  bool   `json:"dryRun,omitempty"`
+	// Code is the HTTP status code of the response of the API server.
+	Code  int    `json:"code,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// auditRoundTripper writes a JSON line to the audit log for every create,
+// update, patch and delete request of the operator, other than the Events and
+// the renewals of the leader election lock.
+type auditRoundTripper struct {
+	rt    http.RoundTripper
+	clock clock.PassiveClock
+
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// openAuditLog opens the destination of the audit log, which is stdout for
+// "-" and otherwise a file that the records are appended to.
+func openAuditLog(path string) (io.Writer, error) {
+	if path == "-" {
+		return os.Stdout, nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening the audit log: %w", err)
+	}
+	return f, nil
+}
+
+// newAuditRoundTripper returns a function wrapping the transports of the
+// clients with an auditRoundTripper writing to w.
+func newAuditRoundTripper(w io.Writer) func(http.RoundTripper) http.RoundTripper {
+	enc := json.NewEncoder(w)
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &auditRoundTripper{rt: rt, clock: clock.RealClock{}, enc: enc}
+	}
+}
+
+// auditedResource returns whether the changes to the resource are audited.
+func auditedResource(resource string) bool {
+	return resource != "events" && resource != "events.events.k8s.io" && resource != "leases.coordination.k8s.io"
+}
+
+func (a *auditRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	verb, ok := mutatingVerbs[req.Method]
+	target := parseRequestPath(req.URL.Path)
+	if !ok || !auditedResource(target.resource) {
+		return a.rt.RoundTrip(req)
+	}
+	reqBody := requestBody(req)
+	resp, err := a.rt.RoundTrip(req)
+
+	record := auditRecord{
+		Time:        a.clock.Now().UTC(),
+		Verb:        verb,
+		Resource:    target.resource,
+		Subresource: target.subresource,
+		Namespace:   target.namespace,
+		Name:        target.name,
+		DryRun:      req.URL.Query().Has("dryRun"),
+	}
+	reqObj := decodeObjectMeta(reqBody)
+	var respObj *metav1.ObjectMeta
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Code = resp.StatusCode
+		var respBody []byte
+		if respBody, err = io.ReadAll(resp.Body); err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		if resp.StatusCode < http.StatusBadRequest {
+			respObj = decodeObjectMeta(respBody)
+		} else {
+			record.Error = decodeStatusMessage(respBody)
+		}
+	}
+	if record.Name == "" && respObj != nil {
+		record.Name = respObj.Name
+	}
+	if record.Name == "" && reqObj != nil {
+		record.Name = reqObj.Name
+	}
+	record.MPIJob = auditedMPIJob(target, record.Name, respObj, reqObj)
+	record.Summary = auditSummary(req, reqBody, reqObj, respObj)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// An audit log that can't be written is reported, but doesn't stop the
+	// operator.
+	if encErr := a.enc.Encode(record); encErr != nil {
+		fmt.Fprintf(os.Stderr, "Writing the audit log: %v\n", encErr)
+	}
+	return resp, err
+}
+
+// decodeObjectMeta returns the metadata of the object in the JSON body, if
+// any.
+func decodeObjectMeta(data []byte) *metav1.ObjectMeta {
+	var obj struct {
+		Kind     string            `json:"kind"`
+		Metadata metav1.ObjectMeta `json:"metadata"`
+	}
+	if len(data) == 0 || json.Unmarshal(data, &obj) != nil || obj.Kind == "Status" {
+		return nil
+	}
+	return &obj.Metadata
+}
+
+// decodeStatusMessage returns the message of the Status in the JSON body of an
+// error response.
+func decodeStatusMessage(data []byte) string {
+	var status metav1.Status
+	if json.Unmarshal(data, &status) != nil {
+		return ""
+	}
+	return status.Message
+}
+
+// auditedMPIJob returns the namespace/name of the MPIJob that owns the object,
+// from the label of the job name or the controller reference.
+func auditedMPIJob(target requestTarget, name string, objs ...*metav1.ObjectMeta) string {
+	if target.resource == "mpijobs."+kubeflow.GroupName {
+		return target.namespace + "/" + name
+	}
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		if job, ok := obj.Labels[kubeflow.JobNameLabel]; ok {
+			return target.namespace + "/" + job
+		}
+		if ref := metav1.GetControllerOfNoCopy(obj); ref != nil && ref.Kind == kubeflow.Kind {
+			return target.namespace + "/" + ref.Name
+		}
+	}
+	return ""
+}
+
+// auditSummary summarizes the change: the fields set by a patch, and the
+// resource versions and generations of an update, since the operator doesn't
+// know the previous state of the objects that it updates.
+func auditSummary(req *http.Request, body []byte, reqObj, respObj *metav1.ObjectMeta) string {
+	switch req.Method {
+	case http.MethodPatch:
+		if paths := patchPaths(body); len(paths) > 0 {
+			return "fields " + strings.Join(paths, ", ")
+		}
+	case http.MethodPut:
+		if reqObj == nil || respObj == nil {
+			return ""
+		}
+		summary := fmt.Sprintf("resourceVersion %s -> %s", reqObj.ResourceVersion, respObj.ResourceVersion)
+		if reqObj.Generation != respObj.Generation {
+			summary += fmt.Sprintf(", generation %d -> %d", reqObj.Generation, respObj.Generation)
+		}
+		return summary
+	}
+	return ""
+}
+
+// patchPaths returns the paths set by a JSON patch, or the fields of a merge
+// patch up to the second level, like spec.runPolicy.
+func patchPaths(body []byte) []string {
+	var ops []struct {
+		Op   string `json:"op"`
+		Path string `json:"path"`
+	}
+	if json.Unmarshal(body, &ops) == nil {
+		var paths []string
+		for _, op := range ops {
+			paths = append(paths, op.Op+" "+op.Path)
+		}
+		return paths
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(body, &fields) != nil {
+		return nil
+	}
+	var paths []string
+	for field, value := range fields {
+		if field == "apiVersion" || field == "kind" {
+			continue
+		}
+		var nested map[string]json.RawMessage
+		if json.Unmarshal(value, &nested) != nil || len(nested) == 0 {
+			paths = append(paths, field)
+			continue
+		}
+		for nestedField := range nested {
+			paths = append(paths, field+"."+nestedField)
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
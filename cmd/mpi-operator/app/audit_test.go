@@ -0,0 +1,163 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+type respondingRoundTripper struct {
+	code int
+	body string
+}
+
+func (r *respondingRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: r.code, Body: io.NopCloser(strings.NewReader(r.body))}, nil
+}
+
+func TestAuditRoundTripper(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	cases := map[string]struct {
+		method   string
+		path     string
+		body     string
+		code     int
+		respBody string
+		want     []auditRecord
+	}{
+		"get": {
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/default/pods/pi-worker-0",
+			code:   http.StatusOK,
+		},
+		"event": {
+			method: http.MethodPost,
+			path:   "/api/v1/namespaces/default/events",
+			body:   `{"kind":"Event","metadata":{"name":"pi.1"}}`,
+			code:   http.StatusCreated,
+		},
+		"create worker": {
+			method:   http.MethodPost,
+			path:     "/api/v1/namespaces/default/pods",
+			body:     `{"kind":"Pod","metadata":{"name":"pi-worker-0","labels":{"training.kubeflow.org/job-name":"pi"}}}`,
+			code:     http.StatusCreated,
+			respBody: `{"kind":"Pod","metadata":{"name":"pi-worker-0","resourceVersion":"2","labels":{"training.kubeflow.org/job-name":"pi"}}}`,
+			want: []auditRecord{{
+				Time:      now,
+				Verb:      "create",
+				Resource:  "pods",
+				Namespace: "default",
+				Name:      "pi-worker-0",
+				MPIJob:    "default/pi",
+				Code:      http.StatusCreated,
+			}},
+		},
+		"update status": {
+			method:   http.MethodPut,
+			path:     "/apis/kubeflow.org/v2beta1/namespaces/default/mpijobs/pi/status",
+			body:     `{"kind":"MPIJob","metadata":{"name":"pi","resourceVersion":"5","generation":1}}`,
+			code:     http.StatusOK,
+			respBody: `{"kind":"MPIJob","metadata":{"name":"pi","resourceVersion":"6","generation":1}}`,
+			want: []auditRecord{{
+				Time:        now,
+				Verb:        "update",
+				Resource:    "mpijobs.kubeflow.org",
+				Subresource: "status",
+				Namespace:   "default",
+				Name:        "pi",
+				MPIJob:      "default/pi",
+				Summary:     "resourceVersion 5 -> 6",
+				Code:        http.StatusOK,
+			}},
+		},
+		"patch launcher": {
+			method: http.MethodPatch,
+			path:   "/apis/batch/v1/namespaces/default/jobs/pi-launcher",
+			body:   `{"spec":{"suspend":false},"metadata":{"labels":{"a":"b"}}}`,
+			code:   http.StatusOK,
+			respBody: `{"kind":"Job","metadata":{"name":"pi-launcher","ownerReferences":[` +
+				`{"apiVersion":"kubeflow.org/v2beta1","kind":"MPIJob","name":"pi","uid":"1","controller":true}]}}`,
+			want: []auditRecord{{
+				Time:      now,
+				Verb:      "patch",
+				Resource:  "jobs.batch",
+				Namespace: "default",
+				Name:      "pi-launcher",
+				MPIJob:    "default/pi",
+				Summary:   "fields metadata.labels, spec.suspend",
+				Code:      http.StatusOK,
+			}},
+		},
+		"rejected delete": {
+			method:   http.MethodDelete,
+			path:     "/api/v1/namespaces/default/pods/pi-worker-0",
+			code:     http.StatusForbidden,
+			respBody: `{"kind":"Status","message":"pods \"pi-worker-0\" is forbidden"}`,
+			want: []auditRecord{{
+				Time:      now,
+				Verb:      "delete",
+				Resource:  "pods",
+				Namespace: "default",
+				Name:      "pi-worker-0",
+				Code:      http.StatusForbidden,
+				Error:     `pods "pi-worker-0" is forbidden`,
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var log bytes.Buffer
+			rt := newAuditRoundTripper(&log)(&respondingRoundTripper{code: tc.code, body: tc.respBody}).(*auditRoundTripper)
+			rt.clock = clocktesting.NewFakePassiveClock(now)
+			var body io.Reader = http.NoBody
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			req, err := http.NewRequest(tc.method, "https://kubernetes"+tc.path, body)
+			if err != nil {
+				t.Fatalf("Creating request: %v", err)
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("RoundTrip(): %v", err)
+			}
+			if got, _ := io.ReadAll(resp.Body); string(got) != tc.respBody {
+				t.Errorf("Response body is %q, want %q", got, tc.respBody)
+			}
+
+			var got []auditRecord
+			dec := json.NewDecoder(&log)
+			for dec.More() {
+				var record auditRecord
+				if err := dec.Decode(&record); err != nil {
+					t.Fatalf("Decoding the audit log: %v", err)
+				}
+				got = append(got, record)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected audit log (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
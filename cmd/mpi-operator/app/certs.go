@@ -0,0 +1,88 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+)
+
+// servingCertificate is the certificate of the HTTPS listeners. The files are
+// watched and reloaded when they change, like when cert-manager rotates the
+// certificate of a mounted Secret, so that the leader doesn't restart and
+// give up the lease for a rotation.
+type servingCertificate struct {
+	content *dynamiccertificates.DynamicCertKeyPairContent
+
+	mu      sync.Mutex
+	certPEM []byte
+	keyPEM  []byte
+	cert    *tls.Certificate
+}
+
+// newServingTLSConfig returns the TLS configuration of the listeners serving
+// the certificate and key files, or nil if they are unset. The files are
+// watched until the context is done.
+func newServingTLSConfig(ctx context.Context, certFile, keyFile string) (*tls.Config, error) {
+	if certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("--tls-cert-file and --tls-key-file must be set together")
+	}
+	content, err := dynamiccertificates.NewDynamicServingContentFromFiles("serving-cert", certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading the serving certificate: %w", err)
+	}
+	go content.Run(ctx, 1)
+	s := &servingCertificate{content: content}
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: s.getCertificate,
+	}, nil
+}
+
+// getCertificate returns the latest valid certificate. An invalid certificate
+// written by a rotation is ignored until it is fixed.
+func (s *servingCertificate) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	certPEM, keyPEM := s.content.CurrentCertKeyContent()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cert == nil || !bytes.Equal(certPEM, s.certPEM) || !bytes.Equal(keyPEM, s.keyPEM) {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the serving certificate: %w", err)
+		}
+		s.cert, s.certPEM, s.keyPEM = &cert, certPEM, keyPEM
+	}
+	return s.cert, nil
+}
+
+// listenAndServe serves HTTPS if the TLS configuration is set, and HTTP
+// otherwise.
+func listenAndServe(server *http.Server, tlsConfig *tls.Config) error {
+	if tlsConfig == nil {
+		return server.ListenAndServe()
+	}
+	server.TLSConfig = tlsConfig
+	return server.ListenAndServeTLS("", "")
+}
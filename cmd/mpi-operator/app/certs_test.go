@@ -0,0 +1,83 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func TestServingTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	writeCert := func(host string) []byte {
+		t.Helper()
+		certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey(host, nil, nil)
+		if err != nil {
+			t.Fatalf("Generating certificate: %v", err)
+		}
+		if err := os.WriteFile(certFile, certPEM, 0o600); err != nil {
+			t.Fatalf("Writing certificate: %v", err)
+		}
+		if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+			t.Fatalf("Writing key: %v", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			t.Fatalf("Parsing certificate: %v", err)
+		}
+		return cert.Certificate[0]
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if cfg, err := newServingTLSConfig(ctx, "", ""); cfg != nil || err != nil {
+		t.Errorf("newServingTLSConfig() without files = %v, %v, want nil", cfg, err)
+	}
+	if _, err := newServingTLSConfig(ctx, certFile, ""); err == nil {
+		t.Error("newServingTLSConfig() succeeded without the key file")
+	}
+
+	first := writeCert("first.example.com")
+	cfg, err := newServingTLSConfig(ctx, certFile, keyFile)
+	if err != nil {
+		t.Fatalf("newServingTLSConfig(): %v", err)
+	}
+	cert, err := cfg.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate(): %v", err)
+	}
+	if !bytes.Equal(cert.Certificate[0], first) {
+		t.Error("Serving a different certificate than the one in the files")
+	}
+
+	rotated := writeCert("rotated.example.com")
+	err = wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+		cert, err := cfg.GetCertificate(nil)
+		return err == nil && bytes.Equal(cert.Certificate[0], rotated), nil
+	})
+	if err != nil {
+		t.Errorf("Rotated certificate wasn't served: %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+// installCRD server-side applies a CRD embedded in the binary. It refuses to
+// apply it when it doesn't have all the versions that its objects are stored
+// in, since the API server could no longer read them.
+func installCRD(ctx context.Context, client apiextensionsclientset.Interface, manifest []byte) error {
+	data, err := yaml.YAMLToJSON(manifest)
+	if err != nil {
+		return fmt.Errorf("converting the embedded CRD to JSON: %w", err)
+	}
+	var crd apiextensionsv1.CustomResourceDefinition
+	if err := yaml.Unmarshal(data, &crd); err != nil {
+		return fmt.Errorf("decoding the embedded CRD: %w", err)
+	}
+	existing, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crd.Name, metav1.GetOptions{})
+	if err == nil {
+		if err := checkStoredVersions(existing, &crd); err != nil {
+			return err
+		}
+	} else if !errors.IsNotFound(err) {
+		return fmt.Errorf("getting CRD %s: %w", crd.Name, err)
+	}
+	klog.Infof("Applying CRD %s", crd.Name)
+	_, err = client.ApiextensionsV1().CustomResourceDefinitions().Patch(ctx, crd.Name, types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: controllerName,
+		Force:        ptr.To(true),
+	})
+	if err != nil {
+		return fmt.Errorf("applying CRD %s: %w", crd.Name, err)
+	}
+	return nil
+}
+
+// checkStoredVersions returns an error if any of the versions that the
+// existing CRD stored objects in is missing from the CRD to apply.
+func checkStoredVersions(existing, crd *apiextensionsv1.CustomResourceDefinition) error {
+	for _, stored := range existing.Status.StoredVersions {
+		if !slices.ContainsFunc(crd.Spec.Versions, func(v apiextensionsv1.CustomResourceDefinitionVersion) bool {
+			return v.Name == stored
+		}) {
+			return fmt.Errorf("CRD %s has objects stored in version %s, which the embedded CRD doesn't have; migrate them with `mpi-operator migrate --update-stored-versions` first", crd.Name, stored)
+		}
+	}
+	return nil
+}
+
+// waitForCRD watches the MPIJob CRD until it is established, so that the
+// operator can start before the CRD is installed, like when they are installed
+// by the same Helm release.
+func waitForCRD(ctx context.Context, client apiextensionsclientset.Interface, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", mpiJobCRDName).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return client.ApiextensionsV1().CustomResourceDefinitions().List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return client.ApiextensionsV1().CustomResourceDefinitions().Watch(ctx, options)
+		},
+	}
+	precondition := func(store cache.Store) (bool, error) {
+		obj, exists, err := store.GetByKey(mpiJobCRDName)
+		if err != nil {
+			return false, err
+		}
+		if crd, ok := obj.(*apiextensionsv1.CustomResourceDefinition); exists && ok && isCRDEstablished(crd) {
+			return true, nil
+		}
+		klog.Infof("Waiting up to %v for CRD %s to be installed and established", timeout, mpiJobCRDName)
+		return false, nil
+	}
+	_, err := watchtools.UntilWithSync(ctx, lw, &apiextensionsv1.CustomResourceDefinition{}, precondition, func(event watch.Event) (bool, error) {
+		crd, ok := event.Object.(*apiextensionsv1.CustomResourceDefinition)
+		if !ok || crd.Name != mpiJobCRDName {
+			return false, nil
+		}
+		switch event.Type {
+		case watch.Added, watch.Modified:
+			return isCRDEstablished(crd), nil
+		case watch.Deleted:
+			klog.Infof("CRD %s was deleted, waiting for it to be installed again", mpiJobCRDName)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for CRD %s to be established: %w", mpiJobCRDName, err)
+	}
+	klog.Infof("CRD %s is established", mpiJobCRDName)
+	return nil
+}
+
+func isCRDEstablished(crd *apiextensionsv1.CustomResourceDefinition) bool {
+	for _, cond := range crd.Status.Conditions {
+		if cond.Type == apiextensionsv1.Established {
+			return cond.Status == apiextensionsv1.ConditionTrue
+		}
+	}
+	return false
+}
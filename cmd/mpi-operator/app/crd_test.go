@@ -0,0 +1,144 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kubeflow/mpi-operator/manifests"
+)
+
+func TestWaitForCRD(t *testing.T) {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: mpiJobCRDName},
+	}
+	established := crd.DeepCopy()
+	established.Status.Conditions = []apiextensionsv1.CustomResourceDefinitionCondition{{
+		Type:   apiextensionsv1.Established,
+		Status: apiextensionsv1.ConditionTrue,
+	}}
+
+	t.Run("established", func(t *testing.T) {
+		client := apiextensionsfake.NewSimpleClientset(established)
+		if err := waitForCRD(context.Background(), client, time.Second); err != nil {
+			t.Errorf("waitForCRD(): %v", err)
+		}
+	})
+	t.Run("installed later", func(t *testing.T) {
+		client := apiextensionsfake.NewSimpleClientset()
+		go func() {
+			crds := client.ApiextensionsV1().CustomResourceDefinitions()
+			time.Sleep(100 * time.Millisecond)
+			if _, err := crds.Create(context.Background(), crd, metav1.CreateOptions{}); err != nil {
+				t.Errorf("Creating the CRD: %v", err)
+			}
+			time.Sleep(100 * time.Millisecond)
+			if _, err := crds.UpdateStatus(context.Background(), established, metav1.UpdateOptions{}); err != nil {
+				t.Errorf("Establishing the CRD: %v", err)
+			}
+		}()
+		if err := waitForCRD(context.Background(), client, 10*time.Second); err != nil {
+			t.Errorf("waitForCRD(): %v", err)
+		}
+	})
+	t.Run("timeout", func(t *testing.T) {
+		client := apiextensionsfake.NewSimpleClientset(crd)
+		if err := waitForCRD(context.Background(), client, 100*time.Millisecond); err == nil {
+			t.Error("waitForCRD() succeeded for a CRD that isn't established")
+		}
+	})
+}
+
+func TestInstallCRD(t *testing.T) {
+	cases := map[string]struct {
+		existing  *apiextensionsv1.CustomResourceDefinition
+		wantApply bool
+		wantErr   string
+	}{
+		"not installed": {
+			wantApply: true,
+		},
+		"upgrade": {
+			existing: &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: mpiJobCRDName},
+				Status: apiextensionsv1.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"v2beta1"},
+				},
+			},
+			wantApply: true,
+		},
+		"drops a stored version": {
+			existing: &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: mpiJobCRDName},
+				Status: apiextensionsv1.CustomResourceDefinitionStatus{
+					StoredVersions: []string{"v1", "v2beta1"},
+				},
+			},
+			wantErr: "version v1",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := apiextensionsfake.NewSimpleClientset()
+			if tc.existing != nil {
+				client = apiextensionsfake.NewSimpleClientset(tc.existing)
+			}
+			var applied *apiextensionsv1.CustomResourceDefinition
+			client.PrependReactor("patch", "customresourcedefinitions", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				patch := action.(clienttesting.PatchAction)
+				if patch.GetPatchType() != types.ApplyPatchType {
+					t.Errorf("Got patch type %s, want %s", patch.GetPatchType(), types.ApplyPatchType)
+				}
+				applied = &apiextensionsv1.CustomResourceDefinition{}
+				if err := json.Unmarshal(patch.GetPatch(), applied); err != nil {
+					t.Fatalf("Decoding the applied CRD: %v", err)
+				}
+				return true, applied, nil
+			})
+
+			err := installCRD(context.Background(), client, manifests.MPIJobCRD)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Errorf("installCRD() returned %v, want an error containing %q", err, tc.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("installCRD(): %v", err)
+			}
+			if !tc.wantApply {
+				if applied != nil {
+					t.Error("CRD was applied")
+				}
+				return
+			}
+			if applied == nil {
+				t.Fatal("CRD wasn't applied")
+			}
+			if applied.Name != mpiJobCRDName || len(applied.Spec.Versions) == 0 || applied.Spec.Versions[0].Name != "v2beta1" {
+				t.Errorf("Applied CRD %s with versions %v, want %s with v2beta1", applied.Name, applied.Spec.Versions, mpiJobCRDName)
+			}
+		})
+	}
+}
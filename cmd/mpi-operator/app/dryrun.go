@@ -0,0 +1,178 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+)
+
+// mutatingVerbs maps the HTTP methods of mutating requests to the verbs logged
+// in dry-run mode and in the audit log.
+var mutatingVerbs = map[string]string{
+	http.MethodPost:   "create",
+	http.MethodPut:    "update",
+	http.MethodPatch:  "patch",
+	http.MethodDelete: "delete",
+}
+
+// dryRunRoundTripper sends every mutating request as a server-side dry-run
+// request, so that the API server validates and admits the change without
+// persisting it, and logs the change that the controller would have made.
+type dryRunRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func newDryRunRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return &dryRunRoundTripper{rt: rt}
+}
+
+func (d *dryRunRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	verb, ok := mutatingVerbs[req.Method]
+	if !ok {
+		return d.rt.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	query := req.URL.Query()
+	query.Set("dryRun", metav1.DryRunAll)
+	req.URL.RawQuery = query.Encode()
+
+	target := parseRequestPath(req.URL.Path)
+	if target.name == "" && req.Method == http.MethodPost {
+		target.name = requestObjectName(req)
+	}
+	resp, err := d.rt.RoundTrip(req)
+	// Events are a side effect of every sync; logging them adds noise.
+	if target.resource == "events" {
+		return resp, err
+	}
+	if err != nil {
+		klog.Infof("Dry run: would %s %s: %v", verb, target, err)
+		return resp, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		klog.Infof("Dry run: would %s %s, but the API server rejected it with status %d", verb, target, resp.StatusCode)
+	} else {
+		klog.Infof("Dry run: would %s %s", verb, target)
+	}
+	return resp, err
+}
+
+// requestTarget identifies the object of an API request.
+type requestTarget struct {
+	resource    string
+	subresource string
+	namespace   string
+	name        string
+}
+
+func (t requestTarget) String() string {
+	var b strings.Builder
+	b.WriteString(t.resource)
+	if t.subresource != "" {
+		b.WriteString("/" + t.subresource)
+	}
+	b.WriteString(" ")
+	if t.namespace != "" {
+		b.WriteString(t.namespace + "/")
+	}
+	if t.name != "" {
+		b.WriteString(t.name)
+	} else {
+		b.WriteString("<unknown>")
+	}
+	return b.String()
+}
+
+// parseRequestPath parses the paths of the Kubernetes API, in the forms
+// /api/v1/[namespaces/NS/]RESOURCE[/NAME[/SUBRESOURCE]] and
+// /apis/GROUP/VERSION/[namespaces/NS/]RESOURCE[/NAME[/SUBRESOURCE]].
+// Resources of a group are suffixed with the group, like jobs.batch.
+func parseRequestPath(path string) requestTarget {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	var group string
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		group = parts[1]
+		parts = parts[3:]
+	default:
+		return requestTarget{resource: path}
+	}
+	var t requestTarget
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		t.namespace = parts[1]
+		parts = parts[2:]
+	}
+	if len(parts) > 0 {
+		t.resource = parts[0]
+		if group != "" {
+			t.resource += "." + group
+		}
+	}
+	if len(parts) > 1 {
+		t.name = parts[1]
+	}
+	if len(parts) > 2 {
+		t.subresource = parts[2]
+	}
+	return t
+}
+
+// requestObjectName returns the name, or the generateName, of the object in
+// the JSON body of a create request.
+func requestObjectName(req *http.Request) string {
+	data := requestBody(req)
+	if data == nil {
+		return ""
+	}
+	var obj struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return ""
+	}
+	if obj.Metadata.Name != "" {
+		return obj.Metadata.Name
+	}
+	if obj.Metadata.GenerateName != "" {
+		return obj.Metadata.GenerateName + "*"
+	}
+	return ""
+}
+
+// requestBody returns a copy of the body of the request, if it can be read
+// without consuming it.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+	return data
+}
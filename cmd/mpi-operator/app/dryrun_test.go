@@ -0,0 +1,138 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseRequestPath(t *testing.T) {
+	cases := map[string]requestTarget{
+		"/api/v1/namespaces/default/pods": {
+			resource:  "pods",
+			namespace: "default",
+		},
+		"/api/v1/namespaces/default/pods/pi-worker-0": {
+			resource:  "pods",
+			namespace: "default",
+			name:      "pi-worker-0",
+		},
+		"/apis/kubeflow.org/v2beta1/namespaces/default/mpijobs/pi/status": {
+			resource:    "mpijobs.kubeflow.org",
+			subresource: "status",
+			namespace:   "default",
+			name:        "pi",
+		},
+		"/apis/scheduling.k8s.io/v1/priorityclasses/high": {
+			resource: "priorityclasses.scheduling.k8s.io",
+			name:     "high",
+		},
+		"/api/v1/namespaces/default": {
+			resource: "namespaces",
+			name:     "default",
+		},
+		"/healthz": {
+			resource: "/healthz",
+		},
+	}
+	for path, want := range cases {
+		t.Run(path, func(t *testing.T) {
+			if diff := cmp.Diff(want, parseRequestPath(path), cmp.AllowUnexported(requestTarget{})); diff != "" {
+				t.Errorf("Unexpected target (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type recordingRoundTripper struct {
+	requests []*http.Request
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req)
+	return &http.Response{StatusCode: http.StatusCreated, Body: http.NoBody}, nil
+}
+
+func TestDryRunRoundTripper(t *testing.T) {
+	cases := map[string]struct {
+		method     string
+		wantDryRun string
+	}{
+		"get": {
+			method: http.MethodGet,
+		},
+		"create": {
+			method:     http.MethodPost,
+			wantDryRun: "All",
+		},
+		"update": {
+			method:     http.MethodPut,
+			wantDryRun: "All",
+		},
+		"delete": {
+			method:     http.MethodDelete,
+			wantDryRun: "All",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			recorder := &recordingRoundTripper{}
+			req, err := http.NewRequest(tc.method, "https://example.com/api/v1/namespaces/default/pods?timeout=10s",
+				bytes.NewReader([]byte(`{"metadata":{"name":"pi-worker-0"}}`)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := newDryRunRoundTripper(recorder).RoundTrip(req); err != nil {
+				t.Fatalf("RoundTrip() failed: %v", err)
+			}
+			if len(recorder.requests) != 1 {
+				t.Fatalf("Got %d requests, want 1", len(recorder.requests))
+			}
+			got := recorder.requests[0].URL.Query()
+			if got.Get("dryRun") != tc.wantDryRun {
+				t.Errorf("Got dryRun=%q, want %q", got.Get("dryRun"), tc.wantDryRun)
+			}
+			if got.Get("timeout") != "10s" {
+				t.Errorf("Got timeout=%q, want the original query to be kept", got.Get("timeout"))
+			}
+			if req.URL.Query().Has("dryRun") {
+				t.Error("The original request was modified")
+			}
+		})
+	}
+}
+
+func TestRequestObjectName(t *testing.T) {
+	cases := map[string]string{
+		`{"metadata":{"name":"pi-worker-0"}}`: "pi-worker-0",
+		`{"metadata":{"generateName":"pi-"}}`: "pi-*",
+		`not json`:                            "",
+	}
+	for body, want := range cases {
+		t.Run(body, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "https://example.com/api/v1/pods", bytes.NewReader([]byte(body)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := requestObjectName(req); got != want {
+				t.Errorf("requestObjectName() = %q, want %q", got, want)
+			}
+		})
+	}
+}
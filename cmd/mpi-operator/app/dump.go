@@ -0,0 +1,45 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"k8s.io/klog"
+
+	controllersv1 "github.com/kubeflow/mpi-operator/pkg/controller"
+)
+
+// dumpStateOnSignal logs the state of the controller every time the process
+// receives SIGUSR1, until the context is done.
+func dumpStateOnSignal(ctx context.Context, controller *controllersv1.MPIJobController) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGUSR1)
+	defer signal.Stop(signals)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			var state strings.Builder
+			controller.DumpState(&state)
+			klog.Infof("State of the controller:\n%s", state.String())
+		}
+	}
+}
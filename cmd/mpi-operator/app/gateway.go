@@ -0,0 +1,110 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	kubeapiserver "k8s.io/apiserver/pkg/server"
+	restclientset "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog"
+
+	"github.com/kubeflow/mpi-operator/cmd/mpi-operator/app/options"
+	"github.com/kubeflow/mpi-operator/pkg/auth"
+	mpijobclientset "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
+	"github.com/kubeflow/mpi-operator/pkg/gateway"
+)
+
+// gatewayShutdownTimeout is how long the gateway waits for the requests in
+// flight when it is stopped.
+const gatewayShutdownTimeout = 10 * time.Second
+
+// RunGateway serves the API submitting MPIJobs from templates, getting their
+// status and cancelling them, until the process is signaled to stop.
+func RunGateway(opt *options.GatewayOption) error {
+	if opt.TokenFile == "" {
+		return fmt.Errorf("--token-file is required")
+	}
+	if opt.TemplatesDir == "" {
+		return fmt.Errorf("--templates-dir is required")
+	}
+	token, err := auth.ReadToken(opt.TokenFile)
+	if err != nil {
+		return fmt.Errorf("reading gateway token: %w", err)
+	}
+	templates, err := gateway.LoadTemplates(opt.TemplatesDir)
+	if err != nil {
+		return err
+	}
+	var namespaces []string
+	if opt.Namespaces != "" {
+		namespaces = strings.Split(opt.Namespaces, ",")
+	}
+
+	if len(os.Getenv(RecommendedKubeConfigPathEnv)) > 0 {
+		opt.Kubeconfig = os.Getenv(RecommendedKubeConfigPathEnv)
+	}
+	cfg, err := clientcmd.BuildConfigFromFlags(opt.MasterURL, opt.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("error building kubeConfig: %w", err)
+	}
+	cfg.QPS = float32(opt.QPS)
+	cfg.Burst = opt.Burst
+	mpiJobClientSet, err := mpijobclientset.NewForConfig(restclientset.AddUserAgent(cfg, "mpi-gateway"))
+	if err != nil {
+		return err
+	}
+	server, err := gateway.NewServer(mpiJobClientSet, templates, namespaces, int32(opt.MaxWorkers), token)
+	if err != nil {
+		return err
+	}
+
+	stopCh := kubeapiserver.SetupSignalHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+	tlsConfig, err := newServingTLSConfig(ctx, opt.TLSCertFile, opt.TLSKeyFile)
+	if err != nil {
+		return err
+	}
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", opt.Port),
+		Handler:           server.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), gatewayShutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			klog.Errorf("Failed to shut down the gateway: %v", err)
+		}
+	}()
+	klog.Infof("Serving the gateway with %d templates on port %d", len(templates), opt.Port)
+	if err := listenAndServe(httpServer, tlsConfig); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serving the gateway: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog"
+
+	"github.com/kubeflow/mpi-operator/cmd/mpi-operator/app/options"
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	mpijobclientset "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
+)
+
+// mpiJobCRDName is the name of the MPIJob CustomResourceDefinition.
+const mpiJobCRDName = "mpijobs." + kubeflow.GroupName
+
+// migrateStats counts the outcome of a migration.
+type migrateStats struct {
+	migrated int
+	stripped int
+	deleted  int
+}
+
+// RunMigrate rewrites every stored MPIJob, so that the API server stores it
+// in the current storage version, and drops the deprecated fields.
+func RunMigrate(opt *options.MigrateOption) error {
+	if len(os.Getenv(RecommendedKubeConfigPathEnv)) > 0 {
+		opt.Kubeconfig = os.Getenv(RecommendedKubeConfigPathEnv)
+	}
+	cfg, err := clientcmd.BuildConfigFromFlags(opt.MasterURL, opt.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("error building kubeConfig: %w", err)
+	}
+	cfg.QPS = float32(opt.QPS)
+	cfg.Burst = opt.Burst
+
+	mpiJobClientSet, err := mpijobclientset.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	stopCh := kubeapiserver.SetupSignalHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	stats, err := migrateMPIJobs(ctx, mpiJobClientSet, opt.Namespace, opt.PageSize)
+	klog.Infof("Migrated %d mpijobs, removed deprecated fields from %d, %d were deleted during the migration",
+		stats.migrated, stats.stripped, stats.deleted)
+	if err != nil {
+		return err
+	}
+
+	if opt.UpdateStoredVersions {
+		if opt.Namespace != "" {
+			return fmt.Errorf("--update-stored-versions requires migrating the mpijobs of all namespaces")
+		}
+		apiExtensionsClientSet, err := apiextensionsclientset.NewForConfig(cfg)
+		if err != nil {
+			return err
+		}
+		return updateStoredVersions(ctx, apiExtensionsClientSet)
+	}
+	return nil
+}
+
+func migrateMPIJobs(ctx context.Context, client mpijobclientset.Interface, namespace string, pageSize int64) (migrateStats, error) {
+	var stats migrateStats
+	listOpts := metav1.ListOptions{Limit: pageSize}
+	for {
+		list, err := client.KubeflowV2beta1().MPIJobs(namespace).List(ctx, listOpts)
+		if err != nil {
+			return stats, fmt.Errorf("listing mpijobs: %w", err)
+		}
+		for i := range list.Items {
+			stripped, err := migrateMPIJob(ctx, client, &list.Items[i])
+			if errors.IsNotFound(err) {
+				stats.deleted++
+				continue
+			}
+			if err != nil {
+				return stats, fmt.Errorf("migrating mpijob %s/%s: %w", list.Items[i].Namespace, list.Items[i].Name, err)
+			}
+			stats.migrated++
+			if stripped {
+				stats.stripped++
+			}
+		}
+		if list.RemainingItemCount != nil {
+			klog.Infof("Migrated %d mpijobs, about %d remaining", stats.migrated, *list.RemainingItemCount)
+		} else {
+			klog.Infof("Migrated %d mpijobs", stats.migrated)
+		}
+		if list.Continue == "" {
+			return stats, nil
+		}
+		listOpts.Continue = list.Continue
+	}
+}
+
+// migrateMPIJob writes the MPIJob back unchanged, which makes the API server
+// store it in the current storage version. The status subresource is used
+// when the status has deprecated fields to drop, since it also rewrites the
+// whole object.
+func migrateMPIJob(ctx context.Context, client mpijobclientset.Interface, mpiJob *kubeflow.MPIJob) (bool, error) {
+	var stripped bool
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		stripped = stripDeprecatedFields(mpiJob)
+		var err error
+		if stripped {
+			_, err = client.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).UpdateStatus(ctx, mpiJob, metav1.UpdateOptions{})
+		} else {
+			_, err = client.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).Update(ctx, mpiJob, metav1.UpdateOptions{})
+		}
+		if errors.IsConflict(err) {
+			latest, getErr := client.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).Get(ctx, mpiJob.Name, metav1.GetOptions{})
+			if getErr != nil {
+				return getErr
+			}
+			*mpiJob = *latest
+		}
+		return err
+	})
+	return stripped, err
+}
+
+// stripDeprecatedFields clears the deprecated fields of the MPIJob and
+// returns whether any was set.
+func stripDeprecatedFields(mpiJob *kubeflow.MPIJob) bool {
+	var stripped bool
+	for _, status := range mpiJob.Status.ReplicaStatuses {
+		if status != nil && status.LabelSelector != nil {
+			status.LabelSelector = nil
+			stripped = true
+		}
+	}
+	return stripped
+}
+
+// updateStoredVersions records that the mpijobs are only stored in the
+// current storage version of the CRD.
+func updateStoredVersions(ctx context.Context, client apiextensionsclientset.Interface) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crd, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, mpiJobCRDName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		var storageVersion string
+		for _, v := range crd.Spec.Versions {
+			if v.Storage {
+				storageVersion = v.Name
+			}
+		}
+		if storageVersion == "" {
+			return fmt.Errorf("CRD %s has no storage version", mpiJobCRDName)
+		}
+		if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == storageVersion {
+			klog.Infof("CRD %s only has the stored version %s", mpiJobCRDName, storageVersion)
+			return nil
+		}
+		klog.Infof("Updating the stored versions of CRD %s from %v to [%s]", mpiJobCRDName, crd.Status.StoredVersions, storageVersion)
+		crd.Status.StoredVersions = []string{storageVersion}
+		_, err = client.ApiextensionsV1().CustomResourceDefinitions().UpdateStatus(ctx, crd, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	core "k8s.io/client-go/testing"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+)
+
+func TestMigrateMPIJobs(t *testing.T) {
+	withDeprecated := &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: "default"},
+		Status: kubeflow.JobStatus{
+			ReplicaStatuses: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeWorker: {
+					Active:        2,
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					Selector:      "foo=bar",
+				},
+			},
+		},
+	}
+	current := &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "other"},
+	}
+	client := fake.NewSimpleClientset(withDeprecated, current)
+
+	stats, err := migrateMPIJobs(context.Background(), client, metav1.NamespaceAll, 10)
+	if err != nil {
+		t.Fatalf("migrateMPIJobs() failed: %v", err)
+	}
+	if diff := cmp.Diff(migrateStats{migrated: 2, stripped: 1}, stats, cmp.AllowUnexported(migrateStats{})); diff != "" {
+		t.Errorf("Unexpected stats (-want,+got):\n%s", diff)
+	}
+
+	var updates []string
+	for _, action := range client.Actions() {
+		if update, ok := action.(core.UpdateAction); ok {
+			obj := update.GetObject().(*kubeflow.MPIJob)
+			updates = append(updates, obj.Name+"/"+update.GetSubresource())
+		}
+	}
+	wantUpdates := []string{"new/", "old/status"}
+	if diff := cmp.Diff(wantUpdates, updates, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("Unexpected updates (-want,+got):\n%s", diff)
+	}
+
+	got, err := client.KubeflowV2beta1().MPIJobs("default").Get(context.Background(), "old", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get MPIJob: %v", err)
+	}
+	wantStatus := &kubeflow.ReplicaStatus{Active: 2, Selector: "foo=bar"}
+	if diff := cmp.Diff(wantStatus, got.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker]); diff != "" {
+		t.Errorf("Unexpected replica status (-want,+got):\n%s", diff)
+	}
+}
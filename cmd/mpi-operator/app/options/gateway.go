@@ -0,0 +1,77 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"flag"
+)
+
+// GatewayCommand is the name of the subcommand that serves the API submitting
+// MPIJobs from templates.
+const GatewayCommand = "gateway"
+
+// GatewayOption is the configuration of the gateway subcommand.
+type GatewayOption struct {
+	Kubeconfig   string
+	MasterURL    string
+	QPS          int
+	Burst        int
+	Port         int
+	TokenFile    string
+	TemplatesDir string
+	Namespaces   string
+	MaxWorkers   int
+	TLSCertFile  string
+	TLSKeyFile   string
+}
+
+// NewGatewayOption creates a new GatewayOption with a default config.
+func NewGatewayOption() *GatewayOption {
+	return &GatewayOption{}
+}
+
+// AddFlags adds flags for the gateway subcommand to the specified FlagSet.
+func (g *GatewayOption) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&g.MasterURL, "master", "",
+		`The url of the Kubernetes API server,
+		 will overrides any value in kubeconfig, only required if out-of-cluster.`)
+
+	fs.StringVar(&g.Kubeconfig, "kubeConfig", "",
+		"Path to a kubeConfig. Only required if out-of-cluster.")
+
+	fs.IntVar(&g.QPS, "kube-api-qps", 5, "QPS indicates the maximum QPS to the master from this client.")
+	fs.IntVar(&g.Burst, "kube-api-burst", 10, "Maximum burst for throttle.")
+
+	fs.IntVar(&g.Port, "port", 8443, "Port of the gateway API.")
+
+	fs.StringVar(&g.TokenFile, "token-file", "",
+		"File containing the token required to access the gateway, as a bearer token. Required.")
+
+	fs.StringVar(&g.TemplatesDir, "templates-dir", "",
+		`Directory with the MPIJob templates, one YAML file per template, typically mounted from a ConfigMap.
+		The templates are named after their files, without the extension. Required.`)
+
+	fs.StringVar(&g.Namespaces, "namespaces", "",
+		"Comma-separated list of the namespaces that MPIJobs can be submitted to. If unset, they can be submitted to any namespace.")
+
+	fs.IntVar(&g.MaxWorkers, "max-workers", 0,
+		"Maximum number of workers of the submitted MPIJobs. It can be set to \"0\" to allow any number.")
+
+	fs.StringVar(&g.TLSCertFile, "tls-cert-file", "",
+		`File with the certificate to serve the gateway over HTTPS, along with --tls-key-file.
+		The files are reloaded when they change.`)
+	fs.StringVar(&g.TLSKeyFile, "tls-key-file", "",
+		"File with the private key of --tls-cert-file.")
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"flag"
+)
+
+// MigrateCommand is the name of the subcommand that migrates the stored
+// MPIJobs to the current storage version.
+const MigrateCommand = "migrate"
+
+// MigrateOption is the configuration of the migrate subcommand.
+type MigrateOption struct {
+	Kubeconfig           string
+	MasterURL            string
+	Namespace            string
+	QPS                  int
+	Burst                int
+	PageSize             int64
+	UpdateStoredVersions bool
+}
+
+// NewMigrateOption creates a new MigrateOption with a default config.
+func NewMigrateOption() *MigrateOption {
+	return &MigrateOption{}
+}
+
+// AddFlags adds flags for the migrate subcommand to the specified FlagSet.
+func (m *MigrateOption) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&m.MasterURL, "master", "",
+		`The url of the Kubernetes API server,
+		 will overrides any value in kubeconfig, only required if out-of-cluster.`)
+
+	fs.StringVar(&m.Kubeconfig, "kubeConfig", "",
+		"Path to a kubeConfig. Only required if out-of-cluster.")
+
+	fs.StringVar(&m.Namespace, "namespace", "",
+		"The namespace of the mpijobs to migrate. If unset, it migrates the mpijobs of all namespaces.")
+
+	fs.IntVar(&m.QPS, "kube-api-qps", 5, "QPS indicates the maximum QPS to the master from this client.")
+	fs.IntVar(&m.Burst, "kube-api-burst", 10, "Maximum burst for throttle.")
+
+	fs.Int64Var(&m.PageSize, "page-size", 100, "The number of mpijobs to list per request.")
+
+	fs.BoolVar(&m.UpdateStoredVersions, "update-stored-versions", false,
+		`Once every mpijob is migrated, set the storedVersions of the MPIJob CRD status to the current storage version,
+		so that the older versions can be removed from the CRD.`)
+}
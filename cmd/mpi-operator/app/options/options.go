@@ -17,6 +17,7 @@ package options
 import (
 	"flag"
 	"os"
+	"time"
 
 	"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
 )
@@ -24,22 +25,72 @@ import (
 const (
 	GangSchedulerVolcano          = "volcano"
 	GangSchedulerSchedulerPlugins = "scheduler-plugins"
+
+	VolcanoQueueAdmissionReport = "Report"
+	VolcanoQueueAdmissionHold   = "Hold"
+
+	// DefaultWorkerCreationParallelism is the default maximum number of worker
+	// pods of an MPIJob created at the same time.
+	DefaultWorkerCreationParallelism = 16
 )
 
 // ServerOption is the main context object for the controller manager.
 type ServerOption struct {
-	Kubeconfig          string
-	MasterURL           string
-	Threadiness         int
-	MonitoringPort      int
-	PrintVersion        bool
-	GangSchedulingName  string
-	Namespace           string
-	LockNamespace       string
-	QPS                 int
-	Burst               int
-	ControllerRateLimit int
-	ControllerBurst     int
+	Kubeconfig                string
+	MasterURL                 string
+	Threadiness               int
+	MonitoringPort            int
+	PrintVersion              bool
+	GangSchedulingName        string
+	Namespace                 string
+	LockNamespace             string
+	LockName                  string
+	QPS                       int
+	Burst                     int
+	KubeflowQPS               int
+	KubeflowBurst             int
+	EventQPS                  int
+	EventBurst                int
+	ControllerRateLimit       int
+	ControllerBurst           int
+	DryRun                    bool
+	HistoryBackend            string
+	HistoryMaxAge             time.Duration
+	HistoryMaxRecords         int
+	DashboardPort             int
+	DashboardTokenFile        string
+	DashboardUI               bool
+	SupportBundleStorage      string
+	NotificationConfig        string
+	PodDefaultsConfig         string
+	PropagatedLabels          string
+	ArtifactUploaderImage     string
+	WatchdogImage             string
+	LaunchWrapperImage        string
+	GPUPreCheckImage          string
+	PropagatedAnnotations     string
+	CloudEventsSink           string
+	PushgatewayURL            string
+	GPUMetricsPrometheusURL   string
+	EnableDRA                 bool
+	DRADeviceClasses          string
+	ProvisioningRequests      bool
+	PodDisruptionBudgets      bool
+	NodeDrains                bool
+	MPIJobDefaults            bool
+	VolcanoQueueAdmission     string
+	TrainJobs                 bool
+	StatusCoalescingWindow    time.Duration
+	WorkerCreationParallelism int
+	InformerResyncPeriod      time.Duration
+	CRDWaitTimeout            time.Duration
+	InstallCRDs               bool
+	CacheSyncTimeout          time.Duration
+	QueueStallTimeout         time.Duration
+	AuditLog                  string
+	TLSCertFile               string
+	TLSKeyFile                string
+	ReleaseFinishedPods       bool
 }
 
 // NewServerOption creates a new CMServer with a default config.
@@ -73,11 +124,166 @@ func (s *ServerOption) AddFlags(fs *flag.FlagSet) {
 		`Set gang scheduler name if enable gang scheduling. Now Supporting volcano and scheduler-plugins.
                 Note: If you set another scheduler name, the mpi-operator assumes it's the scheduler-plugins`)
 
-	fs.StringVar(&s.LockNamespace, "lock-namespace", "mpi-operator", "Set locked namespace name while enabling leader election.")
+	fs.StringVar(&s.LockNamespace, "lock-namespace", "",
+		`Namespace of the lock for leader election. Defaults to the namespace of the operator pod, from the POD_NAMESPACE
+		environment variable.`)
+	fs.StringVar(&s.LockName, "lock-name", "mpi-operator",
+		`Name of the lock for leader election. Operators scoped to different namespaces with --namespace must use different
+		lock names when they share the lock namespace.`)
 
 	fs.IntVar(&s.QPS, "kube-api-qps", 5, "QPS indicates the maximum QPS to the master from this client.")
 	fs.IntVar(&s.Burst, "kube-api-burst", 10, "Maximum burst for throttle.")
+	fs.IntVar(&s.KubeflowQPS, "kubeflow-api-qps", 0,
+		"QPS of the client of the MPIJobs. If 0, --kube-api-qps is used.")
+	fs.IntVar(&s.KubeflowBurst, "kubeflow-api-burst", 0,
+		"Maximum burst of the client of the MPIJobs. If 0, --kube-api-burst is used.")
+	fs.IntVar(&s.EventQPS, "event-api-qps", 0,
+		"QPS of the client recording Events, so that Events don't use the QPS of the reconciliation. If 0, --kube-api-qps is used.")
+	fs.IntVar(&s.EventBurst, "event-api-burst", 0,
+		"Maximum burst of the client recording Events. If 0, --kube-api-burst is used.")
 
 	fs.IntVar(&s.ControllerRateLimit, "controller-queue-rate-limit", 10, "Rate limit of the controller events queue .")
 	fs.IntVar(&s.ControllerBurst, "controller-queue-burst", 100, "Maximum burst of the controller events queue.")
+	fs.IntVar(&s.WorkerCreationParallelism, "worker-creation-parallelism", DefaultWorkerCreationParallelism,
+		"Maximum number of worker pods of an MPIJob created at the same time.")
+	fs.DurationVar(&s.StatusCoalescingWindow, "status-coalescing-window", 0,
+		`Delay of the syncs triggered by pod events, so that the events of the pods of an MPIJob within the window result
+		in a single status update. Defaults to "0", syncing on every pod event. "1s" is recommended for MPIJobs with
+		hundreds of workers, whose pod events would otherwise trigger a status update each.`)
+	fs.DurationVar(&s.InformerResyncPeriod, "informer-resync-period", 0,
+		`Period of the resyncs of the informers, which sync every MPIJob again, as a safety net against missed events.
+		It can be set to "0" to disable the resyncs, which is recommended for large clusters.`)
+	fs.DurationVar(&s.CRDWaitTimeout, "crd-wait-timeout", 5*time.Minute,
+		`Maximum time to wait at startup for the MPIJob CRD to be installed and established, like when the CRD and the
+		operator are installed at the same time.`)
+	fs.DurationVar(&s.CacheSyncTimeout, "cache-sync-timeout", 10*time.Minute,
+		`Maximum time for the informer caches to sync after the operator starts leading, before /healthz fails so that the
+		operator is restarted. It can be set to "0" to disable the check.`)
+	fs.DurationVar(&s.QueueStallTimeout, "queue-stall-timeout", 10*time.Minute,
+		`Maximum time for the workqueue to have pending MPIJobs without the workers taking any, like when all of them are
+		stuck, before /healthz fails so that the operator is restarted. It can be set to "0" to disable the check.`)
+	fs.BoolVar(&s.InstallCRDs, "install-crds", false,
+		`Server-side apply the MPIJob CRD embedded in the binary at startup, and the MPIJobDefaults CRD with
+		--enable-mpijob-defaults, so that they don't need to be installed separately. A CRD isn't applied if it drops a
+		version that its objects are stored in.`)
+	fs.BoolVar(&s.ReleaseFinishedPods, "release-finished-pods", false,
+		`Label the pods left by finished MPIJobs with training.kubeflow.org/job-finished once they are cleaned up, and
+		stop caching them, so that the memory of the operator doesn't grow with the number of finished MPIJobs.`)
+
+	fs.StringVar(&s.TLSCertFile, "tls-cert-file", "",
+		`File with the certificate to serve the metrics, the health checks and the dashboard over HTTPS, along with
+		--tls-key-file. The files are reloaded when they change, like when the certificate of a mounted Secret is rotated.`)
+	fs.StringVar(&s.TLSKeyFile, "tls-key-file", "",
+		"File with the private key of --tls-cert-file.")
+	fs.StringVar(&s.AuditLog, "audit-log", "",
+		`File that the operator appends a JSON line to for every object that it creates, updates, patches or deletes, with
+		the MPIJob that the change is made for and a summary of the change. "-" writes the audit log to stdout. Events and
+		the leader election lock aren't audited.`)
+
+	fs.BoolVar(&s.DryRun, "dry-run", false,
+		`Send every create, update and delete request as a server-side dry-run request and log it, without persisting
+		any change. Leader election is disabled, so that a dry-run operator can run alongside the active one.`)
+
+	fs.StringVar(&s.HistoryBackend, "history-backend", "",
+		`URL of the backend archiving the specs, final statuses, timings and requested resources of finished MPIJobs.
+		file:///path stores a JSON file per MPIJob in the directory, which can be backed by a PersistentVolume.
+		http:// and https:// URLs receive each record as a JSON POST request. If unset, finished MPIJobs are not archived.`)
+	fs.DurationVar(&s.HistoryMaxAge, "history-max-age", 0,
+		"Delete the archived records older than this duration. It only applies to the file backend. 0 means no limit.")
+	fs.IntVar(&s.HistoryMaxRecords, "history-max-records", 0,
+		"Keep at most this number of archived records, deleting the oldest. It only applies to the file backend. 0 means no limit.")
+
+	fs.IntVar(&s.DashboardPort, "dashboard-port", 0,
+		`Port of the read-only overview of the MPIJobs, served by the leader at /api/v1/mpijobs and, with --dashboard-ui, as an HTML page.
+		It can be set to "0" to disable the dashboard.`)
+	fs.StringVar(&s.DashboardTokenFile, "dashboard-token-file", "",
+		`File containing the token required to access the dashboard, as a bearer token or as the password of HTTP basic authentication.
+		Required if --dashboard-port is set.`)
+	fs.BoolVar(&s.DashboardUI, "dashboard-ui", true, "Serve the HTML pages of the dashboard in addition to the JSON API.")
+
+	fs.StringVar(&s.SupportBundleStorage, "support-bundle-storage", "",
+		`Storage of the support bundles collected when an MPIJob fails, with the pod specs, the logs of the launcher and workers,
+		the events, the hostfile and the public SSH key. "configmap" stores each bundle in the <name>-support-bundle ConfigMap,
+		owned by the MPIJob. file:///path stores a .tar.gz file per MPIJob in the directory, which can be backed by a PersistentVolume.
+		If unset, support bundles are not collected.`)
+
+	fs.StringVar(&s.NotificationConfig, "notification-config", "",
+		`YAML file configuring the HTTP webhooks, with templated payloads, notified when MPIJobs succeed or fail.
+		If unset, no notifications are sent.`)
+
+	fs.StringVar(&s.PodDefaultsConfig, "pod-defaults-config", "",
+		`YAML file with the labels, nodeSelector, tolerations and volumes merged into the launcher and worker pods of every
+		MPIJob, and the volumeMounts and env added to their containers. The values set in the pod templates of an MPIJob
+		take precedence. If unset, the pods are not modified.`)
+
+	fs.StringVar(&s.ArtifactUploaderImage, "artifact-uploader-image", "",
+		`Image of the sidecar injected in the pods of MPIJobs with spec.artifacts, which uploads their logs and results
+		with rclone when they terminate. Defaults to mpioperator/artifact-uploader:latest.`)
+
+	fs.StringVar(&s.WatchdogImage, "watchdog-image", "",
+		`Image of the sidecar injected in the pods of MPIJobs with spec.watchdog, which reports the MPI processes making
+		no progress. Defaults to mpioperator/watchdog:latest.`)
+
+	fs.StringVar(&s.LaunchWrapperImage, "launch-wrapper-image", "",
+		`Image from which the launch wrapper is copied to the launcher pods of MPIJobs with spec.launchWrapper, which
+		retries their command. Defaults to mpioperator/launch-wrapper:latest.`)
+
+	fs.StringVar(&s.GPUPreCheckImage, "gpu-pre-check-image", "",
+		`Image of the init container checking the GPUs of the workers of MPIJobs with spec.gpuPreCheck, unless they set
+		spec.gpuPreCheck.image. Defaults to nvcr.io/nvidia/cloud-native/dcgm:4.2.3-1-ubuntu22.04.`)
+
+	fs.StringVar(&s.PropagatedLabels, "propagate-label-prefixes", "",
+		`Comma-separated prefixes of the labels of MPIJobs copied to their pods, launcher Job and Service, like
+		team.example.com/. The labels set by the operator and the pod templates take precedence. If unset, no labels are copied.`)
+
+	fs.StringVar(&s.PropagatedAnnotations, "propagate-annotation-prefixes", "",
+		`Comma-separated prefixes of the annotations of MPIJobs copied to their pods, launcher Job and Service.
+		The annotations set by the operator and the pod templates take precedence. If unset, no annotations are copied.`)
+
+	fs.StringVar(&s.CloudEventsSink, "cloudevents-sink", os.Getenv("K_SINK"),
+		`URL receiving a CloudEvent, in binary content mode, when an MPIJob is created, starts, succeeds, fails or its launcher restarts.
+		Defaults to the K_SINK environment variable, set by Knative SinkBindings. If empty, no CloudEvents are emitted.`)
+
+	fs.StringVar(&s.PushgatewayURL, "pushgateway-url", "",
+		`URL of a Prometheus Pushgateway, like http://pushgateway.monitoring:9091, receiving the final metrics of each MPIJob
+		when it finishes, so that short MPIJobs are recorded between scrapes. If unset, no metrics are pushed.`)
+
+	fs.StringVar(&s.GPUMetricsPrometheusURL, "gpu-metrics-prometheus-url", "",
+		`URL of a Prometheus server, like http://prometheus.monitoring:9090, scraping the NVIDIA DCGM exporter. When an MPIJob
+		finishes, the average and peak utilization of its GPUs and their estimated energy are queried and set in its
+		status.gpuUsage, and pushed with its final metrics. If unset, the GPU usage is not queried.`)
+
+	fs.BoolVar(&s.EnableDRA, "enable-dynamic-resource-allocation", false,
+		`Watch the resource.k8s.io/v1alpha3 ResourceClaimTemplates used by MPIJobs, to derive the slots per worker from
+		spec.slotsPerWorkerDeviceClass and to count the devices of their claims in the minResources of PodGroups.`)
+
+	fs.StringVar(&s.DRADeviceClasses, "dra-device-class-resources", "",
+		`Comma-separated DeviceClasses and the extended resources their devices are counted as in the minResources of
+		PodGroups, like gpu.nvidia.com=nvidia.com/gpu. Requires --enable-dynamic-resource-allocation.`)
+
+	fs.BoolVar(&s.ProvisioningRequests, "enable-provisioning-requests", false,
+		`Request the nodes of the workers of MPIJobs with spec.clusterAutoscaler.provisioningClassName with autoscaling.x-k8s.io/v1
+		ProvisioningRequests of the Cluster Autoscaler, and keep the workers gated until their nodes are provisioned.`)
+
+	fs.BoolVar(&s.PodDisruptionBudgets, "enable-pod-disruption-budgets", false,
+		`Create a PodDisruptionBudget with a maxUnavailable of 0 for the workers of each running MPIJob, and delete it once
+		the MPIJob finishes or is suspended, so that node drains don't evict the ranks of the MPIJobs.`)
+
+	fs.BoolVar(&s.NodeDrains, "enable-node-drain-handling", false,
+		`Watch the nodes of the running MPIJobs and, when one is cordoned, tainted for removal or has a pod being evicted,
+		restart the MPIJob on other nodes, or suspend it if its launcher has the Never restart policy, instead of letting the
+		drain evict one of its ranks.`)
+
+	fs.BoolVar(&s.MPIJobDefaults, "enable-mpijob-defaults", false,
+		`Merge the MPIJobDefaults of the namespace of each MPIJob, which set its default images, resources, tolerations,
+		slots per worker and cleanup policies, into the MPIJob. Requires the MPIJobDefaults CRD.`)
+
+	fs.StringVar(&s.VolcanoQueueAdmission, "volcano-queue-admission", "",
+		`Check the capability and allocated resources of the Volcano queues of MPIJobs, with --gang-scheduling=volcano.
+		"Report" sets the QueueFull condition of the MPIJobs whose PodGroups don't fit in their queue. "Hold" also postpones
+		the creation of their pods until the queue has capacity. If unset, the queues are not checked.`)
+
+	fs.BoolVar(&s.TrainJobs, "enable-trainjobs", false,
+		`Run the trainer.kubeflow.org/v1alpha1 TrainJobs of Kubeflow Trainer V2 with spec.managedBy set to kubeflow.org/mpi-operator
+		as MPIJobs, from TrainingRuntimes and ClusterTrainingRuntimes with an MPI policy.`)
 }
@@ -16,20 +16,28 @@ package app
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kubeapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/apiserver/pkg/server/healthz"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	kubeinformers "k8s.io/client-go/informers"
 	kubeclientset "k8s.io/client-go/kubernetes"
 	clientgokubescheme "k8s.io/client-go/kubernetes/scheme"
@@ -45,10 +53,21 @@ import (
 	volcanoclient "volcano.sh/apis/pkg/client/clientset/versioned"
 
 	"github.com/kubeflow/mpi-operator/cmd/mpi-operator/app/options"
+	"github.com/kubeflow/mpi-operator/manifests"
+	"github.com/kubeflow/mpi-operator/pkg/auth"
 	mpijobclientset "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
 	kubeflowscheme "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
 	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions"
+	"github.com/kubeflow/mpi-operator/pkg/cloudevents"
 	controllersv1 "github.com/kubeflow/mpi-operator/pkg/controller"
+	"github.com/kubeflow/mpi-operator/pkg/dashboard"
+	"github.com/kubeflow/mpi-operator/pkg/gpuusage"
+	"github.com/kubeflow/mpi-operator/pkg/history"
+	"github.com/kubeflow/mpi-operator/pkg/jobmetrics"
+	"github.com/kubeflow/mpi-operator/pkg/notification"
+	"github.com/kubeflow/mpi-operator/pkg/poddefaults"
+	"github.com/kubeflow/mpi-operator/pkg/supportbundle"
+	"github.com/kubeflow/mpi-operator/pkg/trainer"
 	"github.com/kubeflow/mpi-operator/pkg/version"
 )
 
@@ -56,6 +75,9 @@ const (
 	apiVersion                   = "v2"
 	RecommendedKubeConfigPathEnv = "KUBECONFIG"
 	controllerName               = "mpi-operator"
+	// PodNamespaceEnv is set from the downward API to the namespace of the
+	// operator pod.
+	PodNamespaceEnv = "POD_NAMESPACE"
 )
 
 var (
@@ -69,6 +91,9 @@ var (
 	// allowed for timeout. Checks within the timeout period after the lease
 	// expires will still return healthy.
 	leaderHealthzAdaptorTimeout = time.Second * 20
+	// apiServerCheckTimeout is the timeout of the readiness check of the
+	// API server.
+	apiServerCheckTimeout = 5 * time.Second
 	//exponential workqueue rate limiting config
 	workqueueExponentialBaseDelay = 5 * time.Millisecond
 	workqueueExponentialMaxDelay  = 1000 * time.Second
@@ -97,12 +122,27 @@ func Run(opt *options.ServerOption) error {
 	// To help debugging, immediately log version.
 	klog.Infof("%+v", version.Info(apiVersion))
 
+	lockNamespace, err := leaderElectionNamespace(opt.LockNamespace, os.Getenv(PodNamespaceEnv))
+	if err != nil {
+		return err
+	}
+	opt.LockNamespace = lockNamespace
+	if err := validateLockName(opt.LockName); err != nil {
+		return err
+	}
+
 	// To help debugging, immediately log opts.
 	klog.Infof("Server options: %+v", opt)
 
 	// set up signals so we handle the first shutdown signal gracefully
 	stopCh := kubeapiserver.SetupSignalHandler()
 
+	tlsConfig, err := newServingTLSConfig(wait.ContextForChannel(stopCh), opt.TLSCertFile, opt.TLSKeyFile)
+	if err != nil {
+		return err
+	}
+	startMonitoring(opt.MonitoringPort, tlsConfig)
+
 	// Note: ENV KUBECONFIG will overwrite user defined Kubeconfig option.
 	if len(os.Getenv(RecommendedKubeConfigPathEnv)) > 0 {
 		// use the current context in kubeconfig
@@ -117,15 +157,149 @@ func Run(opt *options.ServerOption) error {
 
 	cfg.QPS = float32(opt.QPS)
 	cfg.Burst = opt.Burst
+	if opt.AuditLog != "" {
+		auditLog, err := openAuditLog(opt.AuditLog)
+		if err != nil {
+			return err
+		}
+		// The audit log records the dry-run requests as such.
+		cfg.Wrap(newAuditRoundTripper(auditLog))
+	}
+	if opt.DryRun {
+		klog.Info("Running in dry-run mode, changes to the cluster are only logged")
+		cfg.Wrap(newDryRunRoundTripper)
+	}
 
 	// Create clients.
-	kubeClient, leaderElectionClientSet, mpiJobClientSet, volcanoClientSet, schedClientSet, err := createClientSets(cfg, opt.GangSchedulingName)
+	kubeClient, leaderElectionClientSet, mpiJobClientSet, volcanoClientSet, schedClientSet, err := createClientSets(cfg,
+		withRateLimits(cfg, opt.KubeflowQPS, opt.KubeflowBurst), opt.GangSchedulingName)
+	if err != nil {
+		return err
+	}
+	// Events have their own rate limits, so that a burst of Events doesn't
+	// delay the reconciliation.
+	eventClient, err := kubeclientset.NewForConfig(restclientset.AddUserAgent(withRateLimits(cfg, opt.EventQPS, opt.EventBurst), "events"))
+	if err != nil {
+		return fmt.Errorf("creating event client: %w", err)
+	}
+	var dynamicClient dynamic.Interface
+	if opt.ProvisioningRequests || opt.TrainJobs {
+		if dynamicClient, err = dynamic.NewForConfig(restclientset.AddUserAgent(cfg, "dynamic")); err != nil {
+			return fmt.Errorf("creating dynamic client: %w", err)
+		}
+	}
+	apiExtensionsClientSet, err := apiextensionsclientset.NewForConfig(cfg)
 	if err != nil {
+		return fmt.Errorf("creating apiextensions client: %w", err)
+	}
+	if opt.InstallCRDs {
+		crds := [][]byte{manifests.MPIJobCRD}
+		if opt.MPIJobDefaults {
+			crds = append(crds, manifests.MPIJobDefaultsCRD)
+		}
+		for _, crd := range crds {
+			if err := installCRD(wait.ContextForChannel(stopCh), apiExtensionsClientSet, crd); err != nil {
+				return err
+			}
+		}
+	}
+	if err := waitForCRD(wait.ContextForChannel(stopCh), apiExtensionsClientSet, opt.CRDWaitTimeout); err != nil {
 		return err
 	}
-	if !checkCRDExists(mpiJobClientSet, namespace) {
-		klog.Info("CRD doesn't exist. Exiting")
-		os.Exit(1)
+
+	var historyBackend history.Backend
+	if opt.HistoryBackend != "" {
+		if opt.DryRun {
+			// The status updates aren't persisted, so every sync would archive
+			// the MPIJob again.
+			klog.Info("Ignoring the history backend in dry-run mode")
+		} else {
+			historyBackend, err = history.NewBackend(opt.HistoryBackend, history.Retention{
+				MaxAge:     opt.HistoryMaxAge,
+				MaxRecords: opt.HistoryMaxRecords,
+			})
+			if err != nil {
+				return fmt.Errorf("creating history backend: %w", err)
+			}
+		}
+	}
+
+	var supportBundleStore supportbundle.Store
+	if opt.SupportBundleStorage != "" {
+		if opt.DryRun {
+			klog.Info("Ignoring the support bundle storage in dry-run mode")
+		} else if supportBundleStore, err = supportbundle.NewStore(opt.SupportBundleStorage, kubeClient); err != nil {
+			return fmt.Errorf("creating support bundle storage: %w", err)
+		}
+	}
+
+	var notifier notification.Notifier
+	if opt.NotificationConfig != "" {
+		if opt.DryRun {
+			klog.Info("Ignoring the notification config in dry-run mode")
+		} else {
+			cfg, err := notification.LoadConfig(opt.NotificationConfig)
+			if err != nil {
+				return err
+			}
+			if notifier, err = notification.NewNotifier(cfg); err != nil {
+				return fmt.Errorf("creating notifier: %w", err)
+			}
+		}
+	}
+
+	var podDefaults *poddefaults.Config
+	if opt.PodDefaultsConfig != "" {
+		if podDefaults, err = poddefaults.LoadConfig(opt.PodDefaultsConfig); err != nil {
+			return err
+		}
+	}
+
+	var metricsPusher jobmetrics.Pusher
+	if opt.PushgatewayURL != "" {
+		if opt.DryRun {
+			klog.Info("Ignoring the Pushgateway URL in dry-run mode")
+		} else if metricsPusher, err = jobmetrics.NewPushgatewayPusher(opt.PushgatewayURL); err != nil {
+			return err
+		}
+	}
+
+	var gpuUsageSource gpuusage.Source
+	if opt.GPUMetricsPrometheusURL != "" {
+		if gpuUsageSource, err = gpuusage.NewPrometheusSource(opt.GPUMetricsPrometheusURL); err != nil {
+			return err
+		}
+	}
+
+	var deviceClassResources map[string]corev1.ResourceName
+	if opt.DRADeviceClasses != "" {
+		if !opt.EnableDRA {
+			return fmt.Errorf("--dra-device-class-resources requires --enable-dynamic-resource-allocation")
+		}
+		if deviceClassResources, err = parseDeviceClassResources(opt.DRADeviceClasses); err != nil {
+			return err
+		}
+	}
+
+	switch opt.VolcanoQueueAdmission {
+	case "":
+	case options.VolcanoQueueAdmissionReport, options.VolcanoQueueAdmissionHold:
+		if opt.GangSchedulingName != options.GangSchedulerVolcano {
+			return fmt.Errorf("--volcano-queue-admission requires --gang-scheduling=%s", options.GangSchedulerVolcano)
+		}
+	default:
+		return fmt.Errorf("unsupported --volcano-queue-admission %q, must be %s or %s",
+			opt.VolcanoQueueAdmission, options.VolcanoQueueAdmissionReport, options.VolcanoQueueAdmissionHold)
+	}
+
+	var dashboardToken string
+	if opt.DashboardPort != 0 {
+		if opt.DashboardTokenFile == "" {
+			return fmt.Errorf("--dashboard-token-file is required to serve the dashboard")
+		}
+		if dashboardToken, err = auth.ReadToken(opt.DashboardTokenFile); err != nil {
+			return fmt.Errorf("reading dashboard token: %w", err)
+		}
 	}
 
 	// Add mpi-job-controller types to the default Kubernetes Scheme so Events
@@ -135,6 +309,9 @@ func Run(opt *options.ServerOption) error {
 		return fmt.Errorf("CoreV1 Add Scheme failed: %v", err)
 	}
 
+	// activeController is the controller of the leader, for the health checks.
+	var activeController atomic.Pointer[controllersv1.MPIJobController]
+
 	// Set leader election start function.
 	run := func(ctx context.Context) {
 		var kubeInformerFactoryOpts []kubeinformers.SharedInformerOption
@@ -143,13 +320,28 @@ func Run(opt *options.ServerOption) error {
 			kubeInformerFactoryOpts = append(kubeInformerFactoryOpts, kubeinformers.WithNamespace(namespace))
 			kubeflowInformerFactoryOpts = append(kubeflowInformerFactoryOpts, informers.WithNamespace(namespace))
 		}
-		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, 0, kubeInformerFactoryOpts...)
-		kubeflowInformerFactory := informers.NewSharedInformerFactoryWithOptions(mpiJobClientSet, 0, kubeflowInformerFactoryOpts...)
+		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, opt.InformerResyncPeriod, kubeInformerFactoryOpts...)
+		kubeflowInformerFactory := informers.NewSharedInformerFactoryWithOptions(mpiJobClientSet, opt.InformerResyncPeriod, kubeflowInformerFactoryOpts...)
+		// Only the Secrets created by the operator are cached.
+		secretInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, opt.InformerResyncPeriod, append(kubeInformerFactoryOpts,
+			kubeinformers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+				opts.LabelSelector = controllersv1.ManagedSecretsSelector
+			}))...)
+		podInformerFactory := kubeInformerFactory
+		if opt.ReleaseFinishedPods {
+			// The pods left by finished MPIJobs aren't cached.
+			podInformerFactory = kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, opt.InformerResyncPeriod, append(kubeInformerFactoryOpts,
+				kubeinformers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+					opts.LabelSelector = controllersv1.UnfinishedPodsSelector
+				}))...)
+		}
 
-		workqueueRateLimiter := workqueue.NewTypedMaxOfRateLimiter(
-			workqueue.NewTypedItemExponentialFailureRateLimiter[any](workqueueExponentialBaseDelay, workqueueExponentialMaxDelay),
-			&workqueue.TypedBucketRateLimiter[any]{Limiter: rate.NewLimiter(rate.Limit(opt.ControllerRateLimit), opt.ControllerBurst)},
-		)
+		newWorkqueueRateLimiter := func() workqueue.TypedRateLimiter[any] {
+			return workqueue.NewTypedMaxOfRateLimiter(
+				workqueue.NewTypedItemExponentialFailureRateLimiter[any](workqueueExponentialBaseDelay, workqueueExponentialMaxDelay),
+				&workqueue.TypedBucketRateLimiter[any]{Limiter: rate.NewLimiter(rate.Limit(opt.ControllerRateLimit), opt.ControllerBurst)},
+			)
+		}
 
 		controller, err := controllersv1.NewMPIJobController(
 			kubeClient,
@@ -157,26 +349,140 @@ func Run(opt *options.ServerOption) error {
 			volcanoClientSet,
 			schedClientSet,
 			kubeInformerFactory.Core().V1().ConfigMaps(),
-			kubeInformerFactory.Core().V1().Secrets(),
+			secretInformerFactory.Core().V1().Secrets(),
 			kubeInformerFactory.Core().V1().Services(),
 			kubeInformerFactory.Batch().V1().Jobs(),
-			kubeInformerFactory.Core().V1().Pods(),
+			podInformerFactory.Core().V1().Pods(),
 			kubeInformerFactory.Scheduling().V1().PriorityClasses(),
 			kubeflowInformerFactory.Kubeflow().V2beta1().MPIJobs(),
 			namespace, opt.GangSchedulingName,
-			workqueueRateLimiter)
+			newWorkqueueRateLimiter())
 		if err != nil {
 			klog.Fatalf("Failed to setup the controller")
 		}
+		controller.RecordEventsWith(eventClient)
+		controller.HistoryBackend = historyBackend
+		controller.SupportBundleStore = supportBundleStore
+		controller.Notifier = notifier
+		controller.MetricsPusher = metricsPusher
+		controller.GPUUsageSource = gpuUsageSource
+		controller.PodDefaults = podDefaults
+		controller.ArtifactUploaderImage = opt.ArtifactUploaderImage
+		controller.WatchdogImage = opt.WatchdogImage
+		controller.LaunchWrapperImage = opt.LaunchWrapperImage
+		controller.GPUPreCheckImage = opt.GPUPreCheckImage
+		controller.PropagatedLabelPrefixes = splitPrefixes(opt.PropagatedLabels)
+		controller.PropagatedAnnotationPrefixes = splitPrefixes(opt.PropagatedAnnotations)
+		controller.StatusCoalescingWindow = opt.StatusCoalescingWindow
+		controller.WorkerCreationParallelism = opt.WorkerCreationParallelism
+		controller.ReleaseFinishedPods = opt.ReleaseFinishedPods
+		controller.CacheSyncTimeout = opt.CacheSyncTimeout
+		controller.QueueStallTimeout = opt.QueueStallTimeout
+		activeController.Store(controller)
+		go dumpStateOnSignal(ctx, controller)
+		if opt.EnableDRA {
+			controller.EnableDRA(controllersv1.NewDRAResources(
+				kubeInformerFactory.Resource().V1alpha3().ResourceClaimTemplates(), deviceClassResources))
+		}
+		var dynamicInformerFactory, clusterDynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+		if dynamicClient != nil {
+			dynamicInformerFactory = dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, opt.InformerResyncPeriod, namespace, nil)
+		}
+		if opt.ProvisioningRequests {
+			provisioningRequests := controllersv1.NewProvisioningRequests(dynamicClient,
+				dynamicInformerFactory.ForResource(controllersv1.ProvisioningRequestResource))
+			if err := controller.EnableProvisioningRequests(provisioningRequests); err != nil {
+				klog.Fatalf("Failed to setup the ProvisioningRequests: %v", err)
+			}
+		}
+		if opt.PodDisruptionBudgets {
+			if err := controller.EnablePodDisruptionBudgets(kubeInformerFactory.Policy().V1().PodDisruptionBudgets()); err != nil {
+				klog.Fatalf("Failed to setup the PodDisruptionBudgets: %v", err)
+			}
+		}
+		if opt.MPIJobDefaults {
+			controller.EnableMPIJobDefaults(kubeflowInformerFactory.Kubeflow().V2beta1().MPIJobDefaults())
+		}
+		var nodeInformerFactory kubeinformers.SharedInformerFactory
+		if opt.NodeDrains {
+			// Nodes are cluster-scoped.
+			nodeInformerFactory = kubeinformers.NewSharedInformerFactory(kubeClient, opt.InformerResyncPeriod)
+			if err := controller.EnableNodeDrains(nodeInformerFactory.Core().V1().Nodes(), podInformerFactory.Core().V1().Pods()); err != nil {
+				klog.Fatalf("Failed to setup the node drain handling: %v", err)
+			}
+		}
+		var trainJobController *trainer.Controller
+		if opt.TrainJobs {
+			// ClusterTrainingRuntimes are cluster-scoped.
+			clusterDynamicInformerFactory = dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, opt.InformerResyncPeriod)
+			trainJobController, err = trainer.NewController(
+				dynamicClient,
+				mpiJobClientSet,
+				dynamicInformerFactory.ForResource(trainer.TrainJobResource),
+				dynamicInformerFactory.ForResource(trainer.TrainingRuntimeResource),
+				clusterDynamicInformerFactory.ForResource(trainer.ClusterTrainingRuntimeResource),
+				kubeflowInformerFactory.Kubeflow().V2beta1().MPIJobs(),
+				newWorkqueueRateLimiter())
+			if err != nil {
+				klog.Fatalf("Failed to setup the TrainJob controller: %v", err)
+			}
+		}
+		if opt.VolcanoQueueAdmission != "" {
+			if err := controller.EnableVolcanoQueueAdmission(opt.VolcanoQueueAdmission == options.VolcanoQueueAdmissionHold); err != nil {
+				klog.Fatalf("Failed to setup the Volcano queue admission: %v", err)
+			}
+		}
+		if opt.CloudEventsSink != "" && !opt.DryRun {
+			queue := cloudevents.NewQueue(cloudevents.NewHTTPSink(opt.CloudEventsSink), cloudevents.DefaultQueueSize)
+			go queue.Run(ctx)
+			controller.CloudEventSink = queue
+		}
+		if opt.DashboardPort != 0 {
+			server, err := dashboard.NewServer(
+				kubeflowInformerFactory.Kubeflow().V2beta1().MPIJobs().Lister(),
+				podInformerFactory.Core().V1().Pods().Lister(),
+				kubeClient,
+				dashboardToken, opt.DashboardUI)
+			if err != nil {
+				klog.Fatalf("Failed to setup the dashboard: %v", err)
+			}
+			go func() {
+				klog.Infof("Serving the dashboard on port %d", opt.DashboardPort)
+				dashboardServer := &http.Server{Addr: fmt.Sprintf(":%d", opt.DashboardPort), Handler: server.Handler()}
+				if err := listenAndServe(dashboardServer, tlsConfig); err != nil {
+					klog.Fatalf("Error serving the dashboard: %v", err)
+				}
+			}()
+		}
 
 		go kubeInformerFactory.Start(ctx.Done())
 		go kubeflowInformerFactory.Start(ctx.Done())
+		go secretInformerFactory.Start(ctx.Done())
+		if opt.ReleaseFinishedPods {
+			go podInformerFactory.Start(ctx.Done())
+		}
+		if nodeInformerFactory != nil {
+			go nodeInformerFactory.Start(ctx.Done())
+		}
+		if dynamicInformerFactory != nil {
+			go dynamicInformerFactory.Start(ctx.Done())
+		}
+		if clusterDynamicInformerFactory != nil {
+			go clusterDynamicInformerFactory.Start(ctx.Done())
+		}
 		if controller.PodGroupCtrl != nil {
 			controller.PodGroupCtrl.StartInformerFactory(ctx.Done())
 		}
 
 		// Set leader election start function.
 		isLeader.Set(1)
+		if trainJobController != nil {
+			go func() {
+				if err := trainJobController.Run(opt.Threadiness, stopCh); err != nil {
+					klog.Fatalf("Error running TrainJob controller: %s", err.Error())
+				}
+			}()
+		}
 		if err = controller.Run(opt.Threadiness, stopCh); err != nil {
 			klog.Fatalf("Error running controller: %s", err.Error())
 		}
@@ -192,13 +498,36 @@ func Run(opt *options.ServerOption) error {
 	// Prepare event clients.
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(klog.Infof)
-	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: eventClient.CoreV1().Events("")})
 	recorder := eventBroadcaster.NewRecorder(clientgokubescheme.Scheme, corev1.EventSource{Component: controllerName})
 
 	var electionChecker = election.NewLeaderHealthzAdaptor(leaderHealthzAdaptorTimeout)
 
+	// The checks of the controller only run once it is leading.
+	controllerChecks := []healthz.HealthChecker{
+		healthz.NamedCheck("informer-caches", func(r *http.Request) error {
+			if c := activeController.Load(); c != nil {
+				return c.CheckCachesSynced(r)
+			}
+			return nil
+		}),
+		healthz.NamedCheck("workqueue", func(r *http.Request) error {
+			if c := activeController.Load(); c != nil {
+				return c.CheckQueueProgress(r)
+			}
+			return nil
+		}),
+	}
+	// The leader election client isn't throttled by the syncs.
+	apiServerCheck := healthz.NamedCheck("apiserver", func(r *http.Request) error {
+		ctx, cancel := context.WithTimeout(r.Context(), apiServerCheckTimeout)
+		defer cancel()
+		return leaderElectionClientSet.Discovery().RESTClient().Get().AbsPath("/readyz").Do(ctx).Error()
+	})
+
 	mux := http.NewServeMux()
-	healthz.InstallPathHandler(mux, "/healthz", electionChecker)
+	healthz.InstallPathHandler(mux, "/healthz", append([]healthz.HealthChecker{electionChecker}, controllerChecks...)...)
+	healthz.InstallPathHandler(mux, "/readyz", append([]healthz.HealthChecker{apiServerCheck}, controllerChecks...)...)
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", healthCheckPort),
@@ -208,22 +537,12 @@ func Run(opt *options.ServerOption) error {
 	go func() {
 		klog.Infof("Start listening to %d for health check", healthCheckPort)
 
-		if err := server.ListenAndServe(); err != nil {
+		if err := listenAndServe(server, tlsConfig); err != nil {
 			klog.Fatalf("Error starting server for health check: %v", err)
 		}
 	}()
 
-	rl := &resourcelock.LeaseLock{
-		LeaseMeta: metav1.ObjectMeta{
-			Namespace: opt.LockNamespace,
-			Name:      controllerName,
-		},
-		Client: leaderElectionClientSet.CoordinationV1(),
-		LockConfig: resourcelock.ResourceLockConfig{
-			Identity:      id,
-			EventRecorder: recorder,
-		},
-	}
+	rl := newLeaderElectionLock(opt.LockNamespace, opt.LockName, leaderElectionClientSet, id, recorder)
 
 	ctx, cancel := context.WithCancel(context.TODO())
 	defer cancel()
@@ -236,6 +555,12 @@ func Run(opt *options.ServerOption) error {
 		}
 	}()
 
+	// A dry-run operator doesn't take the lease from the active operator.
+	if opt.DryRun {
+		run(ctx)
+		return nil
+	}
+
 	// Start leader election.
 	election.RunOrDie(ctx, election.LeaderElectionConfig{
 		Lock:          rl,
@@ -265,8 +590,23 @@ func Run(opt *options.ServerOption) error {
 	return fmt.Errorf("finished without leader elect")
 }
 
+func startMonitoring(monitoringPort int, tlsConfig *tls.Config) {
+	if monitoringPort != 0 {
+		go func() {
+			klog.Infof("Setting up client for monitoring on port: %d", monitoringPort)
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			server := &http.Server{Addr: fmt.Sprintf(":%d", monitoringPort), Handler: mux}
+			if err := listenAndServe(server, tlsConfig); err != nil {
+				klog.Error("Monitoring endpoint setup failure.", err)
+			}
+		}()
+	}
+}
+
 func createClientSets(
 	config *restclientset.Config,
+	kubeflowConfig *restclientset.Config,
 	gangSchedulingName string,
 ) (
 	kubeclientset.Interface,
@@ -287,7 +627,7 @@ func createClientSets(
 		return nil, nil, nil, nil, nil, err
 	}
 
-	mpiJobClientSet, err := mpijobclientset.NewForConfig(config)
+	mpiJobClientSet, err := mpijobclientset.NewForConfig(kubeflowConfig)
 	if err != nil {
 		return nil, nil, nil, nil, nil, err
 	}
@@ -309,16 +649,83 @@ func createClientSets(
 	return kubeClientSet, leaderElectionClientSet, mpiJobClientSet, volcanoClientSet, schedClientSet, nil
 }
 
-func checkCRDExists(clientset mpijobclientset.Interface, namespace string) bool {
-	_, err := clientset.KubeflowV2beta1().MPIJobs(namespace).List(context.TODO(), metav1.ListOptions{})
+// withRateLimits returns a copy of the config with the QPS and the burst,
+// unless they are 0.
+func withRateLimits(config *restclientset.Config, qps, burst int) *restclientset.Config {
+	config = restclientset.CopyConfig(config)
+	if qps > 0 {
+		config.QPS = float32(qps)
+	}
+	if burst > 0 {
+		config.Burst = burst
+	}
+	return config
+}
+
+// parseDeviceClassResources parses DeviceClasses and their extended resources,
+// like gpu.nvidia.com=nvidia.com/gpu,nic.example.com=example.com/rdma.
+func parseDeviceClassResources(value string) (map[string]corev1.ResourceName, error) {
+	result := make(map[string]corev1.ResourceName)
+	for _, pair := range strings.Split(value, ",") {
+		class, resourceName, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || class == "" || resourceName == "" {
+			return nil, fmt.Errorf("invalid DeviceClass resource %q, must be <DeviceClass>=<resource>", pair)
+		}
+		if errs := validation.IsQualifiedName(resourceName); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid resource name %q of DeviceClass %s: %s", resourceName, class, strings.Join(errs, ", "))
+		}
+		result[class] = corev1.ResourceName(resourceName)
+	}
+	return result, nil
+}
 
-	if err != nil {
-		klog.Error(err)
-		if _, ok := err.(*errors.StatusError); ok {
-			if errors.IsNotFound(err) {
-				return false
-			}
+// leaderElectionNamespace returns the namespace of the Lease for leader
+// election, which defaults to the namespace of the operator pod.
+func leaderElectionNamespace(lockNamespace, podNamespace string) (string, error) {
+	if lockNamespace == "" {
+		if podNamespace == "" {
+			return "", fmt.Errorf("--lock-namespace is unset and the %s environment variable is empty, set either of them to the namespace of the operator", PodNamespaceEnv)
+		}
+		lockNamespace = podNamespace
+	}
+	if errs := validation.IsDNS1123Label(lockNamespace); len(errs) > 0 {
+		return "", fmt.Errorf("invalid namespace %q for leader election: %s", lockNamespace, strings.Join(errs, ", "))
+	}
+	return lockNamespace, nil
+}
+
+// validateLockName validates the name of the Lease for leader election.
+func validateLockName(name string) error {
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("invalid --lock-name %q: %s", name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// newLeaderElectionLock returns the lock for leader election. Only Leases are
+// possible: client-go removed the endpoints and configmaps locks.
+func newLeaderElectionLock(namespace, name string, client kubeclientset.Interface, id string, recorder record.EventRecorder) resourcelock.Interface {
+	return &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Client: client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity:      id,
+			EventRecorder: recorder,
+		},
+	}
+}
+
+// splitPrefixes splits a comma-separated list of prefixes, ignoring the empty
+// ones. A trailing "*", as in team.example.com/*, is optional.
+func splitPrefixes(value string) []string {
+	var prefixes []string
+	for _, prefix := range strings.Split(value, ",") {
+		if prefix = strings.TrimSuffix(strings.TrimSpace(prefix), "*"); prefix != "" {
+			prefixes = append(prefixes, prefix)
 		}
 	}
-	return true
+	return prefixes
 }
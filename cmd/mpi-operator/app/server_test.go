@@ -0,0 +1,147 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	restclientset "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+func TestParseDeviceClassResources(t *testing.T) {
+	got, err := parseDeviceClassResources("gpu.nvidia.com=nvidia.com/gpu, nic.example.com=example.com/rdma")
+	if err != nil {
+		t.Fatalf("parseDeviceClassResources(): %v", err)
+	}
+	want := map[string]corev1.ResourceName{
+		"gpu.nvidia.com":  "nvidia.com/gpu",
+		"nic.example.com": "example.com/rdma",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected resources (-want,+got):\n%s", diff)
+	}
+	for _, value := range []string{"gpu.nvidia.com", "=nvidia.com/gpu", "gpu.nvidia.com=nvidia.com/gpu/a"} {
+		if _, err := parseDeviceClassResources(value); err == nil {
+			t.Errorf("parseDeviceClassResources(%q) succeeded, want error", value)
+		}
+	}
+}
+
+func TestWithRateLimits(t *testing.T) {
+	cfg := &restclientset.Config{Host: "https://kubernetes", QPS: 5, Burst: 10}
+	got := withRateLimits(cfg, 50, 0)
+	if got.QPS != 50 || got.Burst != 10 || got.Host != cfg.Host {
+		t.Errorf("Unexpected config with QPS 50: QPS %v, burst %d, host %s", got.QPS, got.Burst, got.Host)
+	}
+	if cfg.QPS != 5 {
+		t.Errorf("The original config was modified")
+	}
+	if got := withRateLimits(cfg, 0, 0); got.QPS != 5 || got.Burst != 10 {
+		t.Errorf("Unexpected config without rate limits: QPS %v, burst %d", got.QPS, got.Burst)
+	}
+}
+
+func TestLeaderElectionNamespace(t *testing.T) {
+	cases := map[string]struct {
+		lockNamespace string
+		podNamespace  string
+		want          string
+		wantErr       bool
+	}{
+		"flag": {
+			lockNamespace: "kubeflow",
+			podNamespace:  "mpi-operator",
+			want:          "kubeflow",
+		},
+		"pod namespace": {
+			podNamespace: "mpi-operator",
+			want:         "mpi-operator",
+		},
+		"unset": {
+			wantErr: true,
+		},
+		"invalid": {
+			lockNamespace: "MPI_Operator",
+			wantErr:       true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := leaderElectionNamespace(tc.lockNamespace, tc.podNamespace)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("leaderElectionNamespace() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("leaderElectionNamespace() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateLockName(t *testing.T) {
+	for name, wantErr := range map[string]bool{
+		"mpi-operator":           false,
+		"mpi-operator.team-a":    false,
+		"":                       true,
+		"MPI_Operator":           true,
+		"mpi-operator/team-a":    true,
+		strings.Repeat("a", 254): true,
+	} {
+		if err := validateLockName(name); (err != nil) != wantErr {
+			t.Errorf("validateLockName(%q) returned error %v, want error %t", name, err, wantErr)
+		}
+	}
+}
+
+func TestNewLeaderElectionLock(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	lock := newLeaderElectionLock("kubeflow", "mpi-operator-team-a", client, "operator-0", record.NewFakeRecorder(10))
+	if got, want := lock.Describe(), "kubeflow/mpi-operator-team-a"; got != want {
+		t.Errorf("Lock is %q, want %q", got, want)
+	}
+	if got, want := lock.Identity(), "operator-0"; got != want {
+		t.Errorf("Lock identity is %q, want %q", got, want)
+	}
+	if err := lock.Create(ctx, resourcelock.LeaderElectionRecord{HolderIdentity: "operator-0"}); err != nil {
+		t.Fatalf("Creating the lock: %v", err)
+	}
+	lease, err := client.CoordinationV1().Leases("kubeflow").Get(ctx, "mpi-operator-team-a", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting the Lease: %v", err)
+	}
+	if diff := cmp.Diff(ptr.To("operator-0"), lease.Spec.HolderIdentity); diff != "" {
+		t.Errorf("Unexpected holder of the Lease (-want,+got):\n%s", diff)
+	}
+}
+
+func TestSplitPrefixes(t *testing.T) {
+	got := splitPrefixes("team.example.com/*, cost-center ,,")
+	if diff := cmp.Diff([]string{"team.example.com/", "cost-center"}, got); diff != "" {
+		t.Errorf("Unexpected prefixes (-want,+got):\n%s", diff)
+	}
+	if got := splitPrefixes(""); got != nil {
+		t.Errorf("Unexpected prefixes %q, want none", got)
+	}
+}
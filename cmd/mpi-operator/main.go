@@ -16,38 +16,56 @@ package main
 
 import (
 	"flag"
-	"fmt"
-	"net/http"
+	"os"
 
 	"k8s.io/klog"
 
 	"github.com/kubeflow/mpi-operator/cmd/mpi-operator/app"
 	"github.com/kubeflow/mpi-operator/cmd/mpi-operator/app/options"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func startMonitoring(monitoringPort int) {
-	if monitoringPort != 0 {
-		go func() {
-			klog.Infof("Setting up client for monitoring on port: %d", monitoringPort)
-			http.Handle("/metrics", promhttp.Handler())
-			err := http.ListenAndServe(fmt.Sprintf(":%d", monitoringPort), nil)
-			if err != nil {
-				klog.Error("Monitoring endpoint setup failure.", err)
-			}
-		}()
+func runMigrate(args []string) {
+	fs := flag.NewFlagSet(options.MigrateCommand, flag.ExitOnError)
+	klog.InitFlags(fs)
+	m := options.NewMigrateOption()
+	m.AddFlags(fs)
+
+	_ = fs.Parse(args)
+
+	if err := app.RunMigrate(m); err != nil {
+		klog.Fatalf("%v\n", err)
+	}
+}
+
+func runGateway(args []string) {
+	fs := flag.NewFlagSet(options.GatewayCommand, flag.ExitOnError)
+	klog.InitFlags(fs)
+	g := options.NewGatewayOption()
+	g.AddFlags(fs)
+
+	_ = fs.Parse(args)
+
+	if err := app.RunGateway(g); err != nil {
+		klog.Fatalf("%v\n", err)
 	}
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == options.MigrateCommand {
+		runMigrate(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == options.GatewayCommand {
+		runGateway(os.Args[2:])
+		return
+	}
+
 	klog.InitFlags(nil)
 	s := options.NewServerOption()
 	s.AddFlags(flag.CommandLine)
 
 	flag.Parse()
 
-	startMonitoring(s.MonitoringPort)
-
 	if err := app.Run(s); err != nil {
 		klog.Fatalf("%v\n", err)
 	}
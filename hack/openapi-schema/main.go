@@ -0,0 +1,66 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+// This program prints the OpenAPI schema of the MPIJob and of the Kubernetes
+// types that it references, which applyconfiguration-gen generates the
+// Extract functions of the apply configurations from. The definitions are
+// generated in _output/openapi by hack/update-codegen.sh.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/klog"
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/util"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"github.com/kubeflow/mpi-operator/_output/openapi"
+)
+
+func main() {
+	refCallback := func(name string) spec.Ref {
+		return spec.MustCreateRef("#/definitions/" + common.EscapeJsonPointer(util.ToRESTFriendlyName(name)))
+	}
+	defs := spec.Definitions{}
+	for name, def := range openapi.GetOpenAPIDefinitions(refCallback) {
+		schema := def.Schema
+		// Types like resource.Quantity have a different schema in OpenAPI v2.
+		if v2Schema, ok := schema.Extensions[common.ExtensionV2Schema].(spec.Schema); ok {
+			schema = v2Schema
+		}
+		defs[util.ToRESTFriendlyName(name)] = schema
+	}
+	swagger := spec.Swagger{
+		SwaggerProps: spec.SwaggerProps{
+			Swagger:     "2.0",
+			Definitions: defs,
+			Paths:       &spec.Paths{Paths: map[string]spec.PathItem{}},
+			Info: &spec.Info{
+				InfoProps: spec.InfoProps{
+					Title:   "mpi-operator",
+					Version: "v2beta1",
+				},
+			},
+		},
+	}
+	jsonBytes, err := json.MarshalIndent(swagger, "", "  ")
+	if err != nil {
+		klog.Fatal(err.Error())
+	}
+	fmt.Println(string(jsonBytes))
+}
@@ -42,9 +42,31 @@ kube::codegen::gen_openapi \
   --update-report \
   "${MPI_OPERATOR_ROOT}/pkg/apis/kubeflow/v2beta1"
 
+# Generating the OpenAPI schema of the MPIJob and of the Kubernetes types that
+# it references, which the Extract functions of the apply configurations need.
+OPENAPI_SCHEMA_DIR="${MPI_OPERATOR_ROOT}/_output/openapi"
+trap 'rm -rf "${OPENAPI_SCHEMA_DIR}"' EXIT
+mkdir -p "${OPENAPI_SCHEMA_DIR}"
+
+kube::codegen::gen_openapi \
+  --boilerplate "${MPI_OPERATOR_ROOT}/hack/custom-boilerplate.go.txt" \
+  --output-dir "${OPENAPI_SCHEMA_DIR}" \
+  --output-pkg "${MPI_OPERATOR_PKG}/_output/openapi" \
+  --extra-pkgs k8s.io/api/core/v1 \
+  --extra-pkgs k8s.io/api/batch/v1 \
+  --extra-pkgs k8s.io/apimachinery/pkg/api/resource \
+  --extra-pkgs k8s.io/apimachinery/pkg/util/intstr \
+  --extra-pkgs "${MPI_OPERATOR_PKG}/pkg/apis/kubeflow/v2beta1" \
+  --update-report \
+  "${OPENAPI_SCHEMA_DIR}"
+
+go run "${MPI_OPERATOR_ROOT}/hack/openapi-schema/main.go" > "${OPENAPI_SCHEMA_DIR}/swagger.json"
+
 kube::codegen::gen_client \
   --with-watch \
+  --plural-exceptions "Endpoints:Endpoints,MPIJobDefaults:MPIJobDefaults" \
   --with-applyconfig \
+  --applyconfig-openapi-schema "${OPENAPI_SCHEMA_DIR}/swagger.json" \
   --output-dir "${MPI_OPERATOR_ROOT}/pkg/client" \
   --output-pkg "${MPI_OPERATOR_PKG}/pkg/client" \
   --boilerplate "${MPI_OPERATOR_ROOT}/hack/custom-boilerplate.go.txt" \
@@ -0,0 +1,29 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package manifests embeds the manifests that the operator installs itself.
+package manifests
+
+import _ "embed"
+
+// MPIJobCRD is the MPIJob CustomResourceDefinition, generated by `make crd`.
+//
+//go:embed base/kubeflow.org_mpijobs.yaml
+var MPIJobCRD []byte
+
+// MPIJobDefaultsCRD is the MPIJobDefaults CustomResourceDefinition, generated
+// by `make crd`.
+//
+//go:embed base/kubeflow.org_mpijobdefaults.yaml
+var MPIJobDefaultsCRD []byte
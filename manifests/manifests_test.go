@@ -0,0 +1,104 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"sigs.k8s.io/yaml"
+)
+
+// mpiJobCRDSizeBudget bounds the size of the MPIJob CRD. The CRD is stored in
+// etcd as JSON with its managedFields, within the 1.5 MiB limit of an object,
+// so embedded pod or Job templates must leave their schema out of the CRD.
+const mpiJobCRDSizeBudget = 700 * 1024
+
+func TestMPIJobCRDSize(t *testing.T) {
+	if size := len(MPIJobCRD); size > mpiJobCRDSizeBudget {
+		t.Errorf("MPIJob CRD is %d bytes, over the budget of %d bytes", size, mpiJobCRDSizeBudget)
+	}
+}
+
+func TestMPIJobCRDValidationRules(t *testing.T) {
+	var crd apiextensionsv1.CustomResourceDefinition
+	if err := yaml.Unmarshal(MPIJobCRD, &crd); err != nil {
+		t.Fatalf("Parsing the MPIJob CRD: %v", err)
+	}
+	var props apiextensions.JSONSchemaProps
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(crd.Spec.Versions[0].Schema.OpenAPIV3Schema, &props, nil); err != nil {
+		t.Fatalf("Converting the schema: %v", err)
+	}
+	structural, err := schema.NewStructural(&props)
+	if err != nil {
+		t.Fatalf("Building the structural schema: %v", err)
+	}
+	validator := cel.NewValidator(structural, true, celconfig.PerCallLimit)
+
+	mpiJob := func(naming map[string]any, started bool) map[string]any {
+		spec := map[string]any{}
+		if naming != nil {
+			spec["workerNaming"] = naming
+		}
+		job := map[string]any{"spec": spec}
+		if started {
+			job["status"] = map[string]any{"startTime": "2025-01-01T00:00:00Z"}
+		}
+		return job
+	}
+	naming := map[string]any{"prefix": "node"}
+	cases := map[string]struct {
+		old, new map[string]any
+		wantErrs []string
+	}{
+		"naming set before the start": {
+			old: mpiJob(nil, false),
+			new: mpiJob(naming, false),
+		},
+		"naming unchanged after the start": {
+			old: mpiJob(naming, true),
+			new: mpiJob(naming, true),
+		},
+		"naming changed after the start": {
+			old:      mpiJob(naming, true),
+			new:      mpiJob(map[string]any{"prefix": "host"}, true),
+			wantErrs: []string{"<nil>: Invalid value: \"object\": workerNaming can't change once the MPIJob started"},
+		},
+		"naming set after the start": {
+			old:      mpiJob(nil, true),
+			new:      mpiJob(naming, true),
+			wantErrs: []string{"<nil>: Invalid value: \"object\": workerNaming can't change once the MPIJob started"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			errs, _ := validator.Validate(context.Background(), nil, structural, tc.new, tc.old, math.MaxInt64)
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			if diff := cmp.Diff(tc.wantErrs, got); diff != "" {
+				t.Errorf("Unexpected errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
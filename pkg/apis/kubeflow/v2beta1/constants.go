@@ -23,6 +23,41 @@ const (
 	DefaultLauncherRestartPolicy = RestartPolicyOnFailure
 	// OperatorName is the name of the operator used as value to the label common.OperatorLabelName
 	OperatorName = "mpi-operator"
+	// NetworksAnnotation is the annotation of the pods selecting their Multus
+	// secondary networks.
+	NetworksAnnotation = "k8s.v1.cni.cncf.io/networks"
+	// ProgressAnnotation is the annotation of the launcher pod reporting the
+	// progress of the application, which the operator copies to the status.
+	ProgressAnnotation = "training.kubeflow.org/progress"
+	// MPIJobDefaultsAnnotation is the annotation of the MPIJobs listing the
+	// names of the MPIJobDefaults that the operator merged into them,
+	// separated by commas.
+	MPIJobDefaultsAnnotation = "training.kubeflow.org/mpijob-defaults"
+)
+
+// Annotations of MPIJobs overriding the configuration of the operator for a
+// single MPIJob, without changing its spec. The Skip annotations take effect
+// when set to "true".
+const (
+	// ArtifactUploaderImageAnnotation overrides the --artifact-uploader-image
+	// of the operator.
+	ArtifactUploaderImageAnnotation = "training.kubeflow.org/artifact-uploader-image"
+	// WatchdogImageAnnotation overrides the --watchdog-image of the operator.
+	WatchdogImageAnnotation = "training.kubeflow.org/watchdog-image"
+	// LaunchWrapperImageAnnotation overrides the --launch-wrapper-image of
+	// the operator.
+	LaunchWrapperImageAnnotation = "training.kubeflow.org/launch-wrapper-image"
+	// SkipDiagnosticsAnnotation skips the diagnostics of spec.diagnostics.
+	SkipDiagnosticsAnnotation = "training.kubeflow.org/skip-diagnostics"
+	// SkipPodDefaultsAnnotation skips the --pod-defaults-config of the
+	// operator.
+	SkipPodDefaultsAnnotation = "training.kubeflow.org/skip-pod-defaults"
+	// SkipPodDisruptionBudgetAnnotation skips the PodDisruptionBudget of the
+	// workers.
+	SkipPodDisruptionBudgetAnnotation = "training.kubeflow.org/skip-pod-disruption-budget"
+	// SkipNodeDrainsAnnotation skips the restart of the MPIJob when its pods
+	// are on draining nodes.
+	SkipNodeDrainsAnnotation = "training.kubeflow.org/skip-node-drains"
 )
 
 // merge from common.v1
@@ -43,4 +78,110 @@ const (
 
 	// JobRoleLabel represents the label key for the job role, e.g. master.
 	JobRoleLabel = "training.kubeflow.org/job-role"
+
+	// WorkerPoolLabel represents the label key for the worker pool of the
+	// workers placed on other clusters.
+	WorkerPoolLabel = "training.kubeflow.org/worker-pool"
+
+	// WorkerGroupLabel represents the label key for the worker group of the
+	// workers of spec.workerGroups.
+	WorkerGroupLabel = "training.kubeflow.org/worker-group"
+
+	// JobFinishedLabel represents the label key of the pods left by finished
+	// jobs, which the operator can stop caching.
+	JobFinishedLabel = "training.kubeflow.org/job-finished"
+)
+
+// Reasons of the conditions of MPIJobs.
+const (
+	// JobCreatedReason is the reason of the Created condition.
+	JobCreatedReason = "MPIJobCreated"
+	// JobRunningReason is the reason of the Running condition once the
+	// launcher started.
+	JobRunningReason = "MPIJobRunning"
+	// JobPodsReadyReason is the reason of the PodsReady condition once the
+	// launcher and all the workers are ready.
+	JobPodsReadyReason = "MPIJobPodsReady"
+	// JobPodsNotReadyReason is the reason of the PodsReady condition once a
+	// pod is no longer ready.
+	JobPodsNotReadyReason = "MPIJobPodsNotReady"
+	// JobSucceededReason is the reason of the Succeeded condition.
+	JobSucceededReason = "MPIJobSucceeded"
+	// JobSuspendedReason is the reason of the Suspended condition, and of the
+	// Running condition of suspended jobs.
+	JobSuspendedReason = "MPIJobSuspended"
+	// JobResumedReason is the reason of the Suspended condition once the job
+	// is resumed.
+	JobResumedReason = "MPIJobResumed"
+	// GangNotSchedulableReason is the reason of the Running condition, set to
+	// false, while the PodGroup of the job reports that its pods can't be
+	// scheduled together.
+	GangNotSchedulableReason = "GangNotSchedulable"
+
+	// JobFailedReason is the reason of the Failed condition when the
+	// launcher Job failed without reason.
+	JobFailedReason = "MPIJobFailed"
+	// WorkerFailedReason is the reason of the Failed condition when the
+	// launcher Job failed, but not because of its deadline or memory, and
+	// containers of the workers terminated with a non-zero exit code.
+	WorkerFailedReason = "WorkerFailed"
+	// JobEvictedReason is the reason of the Failed condition when workers
+	// were evicted.
+	JobEvictedReason = "MPIJobEvicted"
+	// NodeDrainReason is the reason of the Restarting condition when the
+	// pods of the job are moved off draining nodes.
+	NodeDrainReason = "NodeDrain"
+	// JobStalledReason is the reason of the Stalled condition, and of the
+	// Restarting condition with the Restart action of spec.watchdog, when
+	// the MPI processes make no progress.
+	JobStalledReason = "MPIJobStalled"
+	// JobProgressResumedReason is the reason of the Stalled condition when
+	// the MPI processes make progress again.
+	JobProgressResumedReason = "MPIJobProgressResumed"
+	// DeadlineExceededReason is the reason of the Failed condition when the
+	// job ran longer than spec.runPolicy.activeDeadlineSeconds.
+	DeadlineExceededReason = "DeadlineExceeded"
+	// BackoffLimitExceededReason is the reason of the Failed condition when
+	// the launcher failed more than spec.runPolicy.backoffLimit times, and
+	// no worker failed. It is followed by "/" and the reason of the last
+	// failed launcher pod.
+	BackoffLimitExceededReason = "BackoffLimitExceeded"
+	// LauncherOOMReason is the reason of the Failed condition when the last
+	// failed launcher pod ran out of memory.
+	LauncherOOMReason = "LauncherOOMKilled"
+	// DiagnosticsFailedReason is the reason of the Failed condition when the
+	// diagnostics of the workers failed.
+	DiagnosticsFailedReason = "DiagnosticsFailed"
+	// GPUPreCheckFailedReason is the reason of the Failed condition when the
+	// GPU pre-check of the workers failed on too many nodes.
+	GPUPreCheckFailedReason = "GPUPreCheckFailed"
+	// PreRunHookFailedReason is the reason of the Failed condition when the
+	// pre-run hook failed.
+	PreRunHookFailedReason = "PreRunHookFailed"
+
+	// HookRunningReason is the reason of the PreRunHookCompleted and
+	// PostRunHookCompleted conditions while the hook runs.
+	HookRunningReason = "HookRunning"
+	// HookSucceededReason is the reason of the PreRunHookCompleted and
+	// PostRunHookCompleted conditions once the hook succeeded.
+	HookSucceededReason = "HookSucceeded"
+	// HookFailedReason is the reason of the PreRunHookCompleted and
+	// PostRunHookCompleted conditions once the hook failed.
+	HookFailedReason = "HookFailed"
+
+	// QueueFullReason is the reason of the QueueFull condition when the
+	// Volcano queue lacks resources for the PodGroup.
+	QueueFullReason = "QueueFull"
+	// QueueClosedReason is the reason of the QueueFull condition when the
+	// Volcano queue is closed.
+	QueueClosedReason = "QueueClosed"
+	// QueueNotFoundReason is the reason of the QueueFull condition when the
+	// Volcano queue doesn't exist.
+	QueueNotFoundReason = "QueueNotFound"
+	// QueueHasCapacityReason is the reason of the QueueFull condition once
+	// the Volcano queue has capacity for the PodGroup.
+	QueueHasCapacityReason = "QueueHasCapacity"
+	// PodGroupAdmittedReason is the reason of the QueueFull condition once
+	// the PodGroup is admitted.
+	PodGroupAdmittedReason = "PodGroupAdmitted"
 )
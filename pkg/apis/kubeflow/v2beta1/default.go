@@ -15,6 +15,8 @@
 package v2beta1
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 )
@@ -57,6 +59,18 @@ func setDefaultsRunPolicy(policy *RunPolicy) {
 	// own defaulting.
 }
 
+// setDefaultsNetwork names the interfaces of the attachments as Multus does.
+func setDefaultsNetwork(network *Network) {
+	if network == nil {
+		return
+	}
+	for i := range network.Attachments {
+		if network.Attachments[i].Interface == "" {
+			network.Attachments[i].Interface = fmt.Sprintf("net%d", i+1)
+		}
+	}
+}
+
 func SetDefaults_MPIJob(mpiJob *MPIJob) {
 	setDefaultsRunPolicy(&mpiJob.Spec.RunPolicy)
 	if mpiJob.Spec.SlotsPerWorker == nil {
@@ -71,6 +85,7 @@ func SetDefaults_MPIJob(mpiJob *MPIJob) {
 	if mpiJob.Spec.LauncherCreationPolicy == "" {
 		mpiJob.Spec.LauncherCreationPolicy = LauncherCreationPolicyAtStartup
 	}
+	setDefaultsNetwork(mpiJob.Spec.Network)
 
 	// set default to Launcher
 	setDefaultsTypeLauncher(mpiJob.Spec.MPIReplicaSpecs[MPIReplicaTypeLauncher])
@@ -151,6 +151,37 @@ func TestSetDefaults_MPIJob(t *testing.T) {
 				},
 			},
 		},
+		"network defaults": {
+			job: MPIJob{
+				Spec: MPIJobSpec{
+					Network: &Network{
+						Attachments: []NetworkAttachment{
+							{Name: "sriov-a"},
+							{Name: "sriov-b", Interface: "ib0"},
+							{Name: "sriov-c", Namespace: "networks"},
+						},
+					},
+				},
+			},
+			want: MPIJob{
+				Spec: MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](1),
+					RunPolicy: RunPolicy{
+						CleanPodPolicy: ptr.To(CleanPodPolicyNone),
+					},
+					SSHAuthMountPath:       "/root/.ssh",
+					MPIImplementation:      MPIImplementationOpenMPI,
+					LauncherCreationPolicy: "AtStartup",
+					Network: &Network{
+						Attachments: []NetworkAttachment{
+							{Name: "sriov-a", Interface: "net1"},
+							{Name: "sriov-b", Interface: "ib0"},
+							{Name: "sriov-c", Namespace: "networks", Interface: "net3"},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,43 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2beta1
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// WorkerName returns the name, and hostname, of the worker of the MPIJob with
+// the index, following spec.workerNaming.
+func WorkerName(job *MPIJob, index int) string {
+	naming := job.Spec.WorkerNaming
+	if naming == nil {
+		return fmt.Sprintf("%s-worker-%d", job.Name, index)
+	}
+	prefix := naming.Prefix
+	if prefix == "" {
+		prefix = job.Name + "-worker-"
+	}
+	if naming.IncludeJobHash != nil && *naming.IncludeJobHash {
+		hash := fnv.New32a()
+		hash.Write([]byte(job.UID))
+		prefix += fmt.Sprintf("%08x-", hash.Sum32())
+	}
+	var width int
+	if naming.IndexWidth != nil {
+		width = int(*naming.IndexWidth)
+	}
+	return fmt.Sprintf("%s%0*d", prefix, width, index)
+}
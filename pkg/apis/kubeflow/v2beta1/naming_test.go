@@ -0,0 +1,64 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2beta1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestWorkerName(t *testing.T) {
+	cases := map[string]struct {
+		naming *WorkerNaming
+		want   string
+	}{
+		"default": {
+			want: "train-worker-7",
+		},
+		"empty naming": {
+			naming: &WorkerNaming{},
+			want:   "train-worker-7",
+		},
+		"prefix": {
+			naming: &WorkerNaming{Prefix: "node"},
+			want:   "node7",
+		},
+		"index width": {
+			naming: &WorkerNaming{IndexWidth: ptr.To[int32](3)},
+			want:   "train-worker-007",
+		},
+		"zero index width": {
+			naming: &WorkerNaming{IndexWidth: ptr.To[int32](0)},
+			want:   "train-worker-7",
+		},
+		"job hash": {
+			naming: &WorkerNaming{Prefix: "w-", IndexWidth: ptr.To[int32](2), IncludeJobHash: ptr.To(true)},
+			want:   "w-06e0c7c0-07",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job := &MPIJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "train", UID: "4c1c2f1e-1f6e-4d6b-9a58-0d4c1a3e3a4b"},
+				Spec:       MPIJobSpec{WorkerNaming: tc.naming},
+			}
+			if got := WorkerName(job, 7); got != tc.want {
+				t.Errorf("WorkerName() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
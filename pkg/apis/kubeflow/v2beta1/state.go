@@ -0,0 +1,43 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2beta1
+
+import corev1 "k8s.io/api/core/v1"
+
+// stateConditions lists the condition types that determine the state of an
+// MPIJob, in order of precedence.
+var stateConditions = []JobConditionType{
+	JobFailed,
+	JobSucceeded,
+	JobSuspended,
+	JobRestarting,
+	JobRunning,
+	JobCreated,
+}
+
+// StateOf returns the state of the MPIJob with the status: the type of the
+// condition that finished it or, otherwise, of the first of the Suspended,
+// Restarting, Running and Created conditions that is true. It's empty if
+// none is true.
+func StateOf(status JobStatus) JobConditionType {
+	for _, condType := range stateConditions {
+		for _, cond := range status.Conditions {
+			if cond.Type == condType && cond.Status == corev1.ConditionTrue {
+				return condType
+			}
+		}
+	}
+	return ""
+}
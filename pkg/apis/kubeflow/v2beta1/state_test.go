@@ -0,0 +1,72 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2beta1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestStateOf(t *testing.T) {
+	cases := map[string]struct {
+		conditions []JobCondition
+		want       JobConditionType
+	}{
+		"no conditions": {},
+		"created": {
+			conditions: []JobCondition{{Type: JobCreated, Status: corev1.ConditionTrue}},
+			want:       JobCreated,
+		},
+		"running with pods ready": {
+			conditions: []JobCondition{
+				{Type: JobCreated, Status: corev1.ConditionTrue},
+				{Type: JobRunning, Status: corev1.ConditionTrue},
+				{Type: JobPodsReady, Status: corev1.ConditionTrue},
+			},
+			want: JobRunning,
+		},
+		"suspended": {
+			conditions: []JobCondition{
+				{Type: JobCreated, Status: corev1.ConditionTrue},
+				{Type: JobRunning, Status: corev1.ConditionFalse},
+				{Type: JobSuspended, Status: corev1.ConditionTrue},
+			},
+			want: JobSuspended,
+		},
+		"resumed": {
+			conditions: []JobCondition{
+				{Type: JobCreated, Status: corev1.ConditionTrue},
+				{Type: JobSuspended, Status: corev1.ConditionFalse},
+			},
+			want: JobCreated,
+		},
+		"failed": {
+			conditions: []JobCondition{
+				{Type: JobCreated, Status: corev1.ConditionTrue},
+				{Type: JobRunning, Status: corev1.ConditionFalse},
+				{Type: JobFailed, Status: corev1.ConditionTrue},
+			},
+			want: JobFailed,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := StateOf(JobStatus{Conditions: tc.conditions}); got != tc.want {
+				t.Errorf("Unexpected state %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
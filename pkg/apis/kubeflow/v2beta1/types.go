@@ -15,6 +15,7 @@
 package v2beta1
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -23,6 +24,17 @@ import (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.mpiReplicaSpecs.Worker.replicas,statuspath=.status.replicaStatuses.Worker.active,selectorpath=.status.replicaStatuses.Worker.selector
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Workers",type=integer,JSONPath=`.spec.mpiReplicaSpecs.Worker.replicas`
+// +kubebuilder:printcolumn:name="Workers Ready",type=integer,JSONPath=`.status.replicaStatuses.Worker.ready`
+// +kubebuilder:printcolumn:name="Restarts",type=integer,JSONPath=`.status.launcherRestartCount`
+// +kubebuilder:printcolumn:name="Progress",type=string,JSONPath=`.status.progress`,priority=1
+// +kubebuilder:printcolumn:name="Queue",type=string,JSONPath=`.spec.runPolicy.schedulingPolicy.queue`,priority=1
+// +kubebuilder:printcolumn:name="Started",type=date,JSONPath=`.status.startTime`
+// +kubebuilder:printcolumn:name="Duration",type=string,JSONPath=`.status.duration`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:validation:XValidation:rule="has(self.spec.workerNaming) == has(oldSelf.spec.workerNaming) && (!has(self.spec.workerNaming) || self.spec.workerNaming == oldSelf.spec.workerNaming) || !has(oldSelf.status) || !has(oldSelf.status.startTime)",message="workerNaming can't change once the MPIJob started"
 
 type MPIJob struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -40,6 +52,75 @@ type MPIJobList struct {
 	Items           []MPIJob `json:"items"`
 }
 
+// +genclient
+// +resourceName=mpijobdefaults
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=mpijobdefaults,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// MPIJobDefaults are the defaults of the MPIJobs of its namespace, which the
+// operator merges into the MPIJobs when it syncs them. The values set in an
+// MPIJob take precedence. With several MPIJobDefaults in a namespace, they
+// are merged in the order of their names, so that the first one setting a
+// field takes precedence.
+type MPIJobDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MPIJobDefaultsSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+type MPIJobDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []MPIJobDefaults `json:"items"`
+}
+
+type MPIJobDefaultsSpec struct {
+	// SlotsPerWorker is the default of spec.slotsPerWorker.
+	// +optional
+	SlotsPerWorker *int32 `json:"slotsPerWorker,omitempty"`
+
+	// CleanPodPolicy is the default of spec.runPolicy.cleanPodPolicy.
+	// +kubebuilder:validation:Enum:=None;Running;All
+	// +optional
+	CleanPodPolicy *CleanPodPolicy `json:"cleanPodPolicy,omitempty"`
+
+	// TTLSecondsAfterFinished is the default of
+	// spec.runPolicy.ttlSecondsAfterFinished.
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Launcher are the defaults of the launcher pod.
+	// +optional
+	Launcher *ReplicaDefaults `json:"launcher,omitempty"`
+
+	// Worker are the defaults of the worker pods.
+	// +optional
+	Worker *ReplicaDefaults `json:"worker,omitempty"`
+}
+
+// ReplicaDefaults are the defaults of the pods of a replica type.
+type ReplicaDefaults struct {
+	// Image is the image of the first container of the pods, if it has none.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources are the requests and limits of the first container of the
+	// pods, for the resources that it doesn't request or limit.
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Tolerations are added to the pods, unless they have a toleration with
+	// the same key and effect.
+	// +listType=atomic
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+}
+
 // CleanPodPolicy describes how to deal with pods when the job is finished.
 type CleanPodPolicy string
 
@@ -150,6 +231,14 @@ type RunPolicy struct {
 	// The field is immutable.
 	// +optional
 	ManagedBy *string `json:"managedBy,omitempty"`
+
+	// TerminationGracePeriodSeconds overrides the terminationGracePeriodSeconds
+	// of the launcher and worker pods. It bounds the time that their preStop
+	// hooks and processes have to finish when the pods are deleted on cleanup,
+	// suspension or restart.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
 }
 
 type LauncherCreationPolicy string
@@ -163,6 +252,11 @@ const (
 	// postponed until all workers are in ready state so that the Launcher
 	// does not fail trying to connect to worker.
 	LauncherCreationPolicyWaitForWorkersReady LauncherCreationPolicy = "WaitForWorkersReady"
+
+	// LauncherCreationPolicyWaitForWorkersScheduled makes Launcher creation
+	// postponed until all workers are scheduled to nodes, so that the
+	// Launcher does not run while the nodes of the workers are provisioned.
+	LauncherCreationPolicyWaitForWorkersScheduled LauncherCreationPolicy = "WaitForWorkersScheduled"
 )
 
 type MPIJobSpec struct {
@@ -173,6 +267,14 @@ type MPIJobSpec struct {
 	// +kubebuilder:default:=1
 	SlotsPerWorker *int32 `json:"slotsPerWorker,omitempty"`
 
+	// SlotsPerWorkerDeviceClass derives the slots per worker from the devices
+	// requested by the ResourceClaimTemplates of the worker pod template,
+	// with one slot per device of this DeviceClass, like gpu.nvidia.com.
+	// It takes precedence over slotsPerWorker, which is used if the devices
+	// can't be counted. Requires the operator to watch ResourceClaimTemplates.
+	// +optional
+	SlotsPerWorkerDeviceClass string `json:"slotsPerWorkerDeviceClass,omitempty"`
+
 	// RunLauncherAsWorker indicates whether to run worker process in launcher
 	// Defaults to false.
 	// +optional
@@ -191,8 +293,9 @@ type MPIJobSpec struct {
 	// +kubebuilder:default:="/root/.ssh"
 	SSHAuthMountPath string `json:"sshAuthMountPath,omitempty"`
 
-	// launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. Defaults to AtStartup.
-	// +kubebuilder:validation:Enum:AtStartup;WaitForWorkersReady
+	// launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state.
+	// If WaitForWorkersScheduled, the launcher is created only after all workers are scheduled to nodes. Defaults to AtStartup.
+	// +kubebuilder:validation:Enum:AtStartup;WaitForWorkersReady;WaitForWorkersScheduled
 	// +kubebuilder:default:=AtStartup
 	LauncherCreationPolicy LauncherCreationPolicy `json:"launcherCreationPolicy,omitempty"`
 
@@ -201,6 +304,687 @@ type MPIJobSpec struct {
 	// +kubebuilder:validation:Enum:=OpenMPI;Intel;MPICH
 	// +kubebuilder:default:=OpenMPI
 	MPIImplementation MPIImplementation `json:"mpiImplementation,omitempty"`
+
+	// Diagnostics configures a sanity test of the interconnect, run across the
+	// workers before the launcher command.
+	// +optional
+	Diagnostics *Diagnostics `json:"diagnostics,omitempty"`
+
+	// Benchmark turns the MPIJob into a network benchmark: the launcher runs
+	// the benchmark instead of its command, and the results are stored in the
+	// <name>-benchmark ConfigMap when the MPIJob succeeds.
+	// +optional
+	Benchmark *Benchmark `json:"benchmark,omitempty"`
+
+	// Network attaches the workers to secondary networks with Multus, and
+	// selects their interfaces for the MPI, NCCL and UCX traffic.
+	// +optional
+	Network *Network `json:"network,omitempty"`
+
+	// TCPInterfaceSelection selects the interfaces of the TCP traffic of
+	// MPI. With Auto, the launcher restricts the traffic of mpirun and of
+	// the ranks to the interface of the pod network, or the ranks to the
+	// interfaces of spec.network, instead of the defaults of the MPI
+	// implementation, which can pick bridges like docker0 on multi-homed
+	// nodes. Defaults to None, which keeps the defaults.
+	// +kubebuilder:validation:Enum:=None;Auto
+	// +optional
+	TCPInterfaceSelection TCPInterfaceSelection `json:"tcpInterfaceSelection,omitempty"`
+
+	// ServiceMesh configures the pods for the sidecars injected by a service
+	// mesh, so that mpirun waits for them and the launcher Job completes when
+	// the launcher command exits.
+	// +optional
+	ServiceMesh *ServiceMesh `json:"serviceMesh,omitempty"`
+
+	// MultiCluster places workers on other clusters, while the launcher stays
+	// in the cluster of the MPIJob. This is an alpha feature.
+	// +optional
+	MultiCluster *MultiCluster `json:"multiCluster,omitempty"`
+
+	// ClusterAutoscaler configures the pods for the Cluster Autoscaler, which
+	// provisions the nodes of the MPIJob.
+	// +optional
+	ClusterAutoscaler *ClusterAutoscaler `json:"clusterAutoscaler,omitempty"`
+
+	// WorkerOverrides replace fields of the worker pod template for ranges of
+	// worker indexes, like more memory for worker-0 when it aggregates the
+	// I/O of the other workers. When ranges overlap, the last override takes
+	// precedence.
+	// +optional
+	// +listType=atomic
+	WorkerOverrides []WorkerOverride `json:"workerOverrides,omitempty"`
+
+	// WorkerGroups are workers with their own pod template, replica count and
+	// slots, like GPU workers for a simulation and CPU workers for its
+	// analysis, in the same MPI run. The workers of the groups follow the
+	// Worker replicas in the hostfile, in the order of the groups, and share
+	// the restart policy of the Worker replicas.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	WorkerGroups []WorkerGroup `json:"workerGroups,omitempty"`
+
+	// WorkerNaming customizes the names of the workers, which are also
+	// their hostnames in the Service of the MPIJob and in the hostfile. The
+	// workers are named <mpijob>-worker-<index> by default. It can't change
+	// once the MPIJob started, since the workers aren't renamed.
+	// +optional
+	WorkerNaming *WorkerNaming `json:"workerNaming,omitempty"`
+
+	// LauncherJob customizes the batch/v1 Job generated for the launcher,
+	// for the systems, like Kueue or cost tools, that read its metadata.
+	// +optional
+	LauncherJob *LauncherJobTemplate `json:"launcherJob,omitempty"`
+
+	// Hooks are run by the operator before the launcher is created and after
+	// the MPIJob finishes, like staging data or uploading results.
+	// +optional
+	Hooks *Hooks `json:"hooks,omitempty"`
+
+	// Artifacts injects a sidecar that uploads the logs of the launcher, and
+	// optionally of the workers, and a results directory to object storage,
+	// so that they outlive the pods.
+	// +optional
+	Artifacts *Artifacts `json:"artifacts,omitempty"`
+
+	// Service customizes the headless Service generated for the launcher and
+	// the workers, which resolves their hostnames.
+	// +optional
+	Service *ServiceTemplate `json:"service,omitempty"`
+
+	// Profiling wraps the MPI processes started by the launcher command with
+	// a profiler, which writes the profiles to a volume mounted in the
+	// launcher and the workers.
+	// +optional
+	Profiling *Profiling `json:"profiling,omitempty"`
+
+	// CoreDumps collects the core files of the MPI processes that crash, and
+	// records their ranks in the status.
+	// +optional
+	CoreDumps *CoreDumps `json:"coreDumps,omitempty"`
+
+	// Watchdog injects a sidecar in the workers that detects MPI processes
+	// making no progress, and marks the MPIJob as Stalled.
+	// +optional
+	Watchdog *Watchdog `json:"watchdog,omitempty"`
+
+	// LaunchWrapper runs the command of the launcher with an entrypoint of
+	// the operator that bounds the duration of each attempt, retries it in
+	// the launcher pod, and reports the outcome in the status.
+	// +optional
+	LaunchWrapper *LaunchWrapper `json:"launchWrapper,omitempty"`
+
+	// GPUPreCheck checks the GPUs of each worker in an init container before
+	// the worker starts, and recreates the workers whose check failed on
+	// other nodes, before the launcher runs.
+	// +optional
+	GPUPreCheck *GPUPreCheck `json:"gpuPreCheck,omitempty"`
+}
+
+// LaunchWrapper retries the command of the launcher, like mpirun, in the
+// same launcher pod, so that a transient failure doesn't recreate the
+// workers. The command of the launcher container must be set, and its image
+// must have /bin/sh.
+type LaunchWrapper struct {
+	// MaxAttempts is the number of times the command is run in a launcher
+	// pod before it fails. Defaults to 1.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+
+	// AttemptTimeoutSeconds is how long an attempt can run before it is
+	// terminated and fails. If unset, the attempts have no timeout.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	AttemptTimeoutSeconds *int32 `json:"attemptTimeoutSeconds,omitempty"`
+
+	// RetryDelaySeconds is how long to wait between attempts. Defaults to 10.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	RetryDelaySeconds *int32 `json:"retryDelaySeconds,omitempty"`
+
+	// RetryOnExitCodes are the exit codes of the command that are retried.
+	// The exit code 124 is the one of the attempts that timed out. If
+	// empty, any non-zero exit code is retried.
+	// +optional
+	// +listType=atomic
+	RetryOnExitCodes []int32 `json:"retryOnExitCodes,omitempty"`
+}
+
+// GPUPreCheck is a health check of the GPUs of the workers, like the DCGM
+// diagnostics, that runs in an init container with the GPUs of the worker.
+type GPUPreCheck struct {
+	// Image runs the check. Defaults to the DCGM image configured in the
+	// operator.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Command is the check, which fails with a non-zero exit code.
+	// Defaults to the quick DCGM diagnostics:
+	// ["/bin/sh", "-c", "nv-hostengine && dcgmi diag -r 1"].
+	// +optional
+	// +listType=atomic
+	Command []string `json:"command,omitempty"`
+
+	// ResourceName is the extended resource of the GPUs. Only the workers
+	// requesting it run the check. Defaults to nvidia.com/gpu.
+	// +optional
+	ResourceName v1.ResourceName `json:"resourceName,omitempty"`
+
+	// MaxFailedNodes is the number of nodes on which the check can fail
+	// before the MPIJob fails. Defaults to 3.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxFailedNodes *int32 `json:"maxFailedNodes,omitempty"`
+}
+
+type WatchdogAction string
+
+const (
+	// WatchdogActionEvent sets the Stalled condition and records an event.
+	WatchdogActionEvent WatchdogAction = "Event"
+	// WatchdogActionRestart also restarts the launcher and the workers.
+	WatchdogActionRestart WatchdogAction = "Restart"
+)
+
+// Watchdog detects the MPI processes of a pod that make no progress, like
+// ranks blocked in a collective that never completes. The MPI processes are
+// stalled when no file of the heartbeat directory was modified for the
+// timeout or, without heartbeat directory, when they didn't use any CPU for
+// the timeout. As NCCL busy-waits, a heartbeat is needed to detect hung
+// collectives on GPUs.
+type Watchdog struct {
+	// TimeoutSeconds is how long the MPI processes can make no progress
+	// before they are stalled. Defaults to 1800.
+	// +kubebuilder:validation:Minimum:=60
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// HeartbeatDir is a directory, mounted in the launcher and workers, in
+	// which the MPI processes modify files as they make progress, like
+	// $HEARTBEAT_DIR/rank-$OMPI_COMM_WORLD_RANK after each step. The watchdog
+	// only starts once a file is written.
+	// +optional
+	HeartbeatDir string `json:"heartbeatDir,omitempty"`
+
+	// Action is what the operator does once the MPI processes are stalled.
+	// Options are "Event" (default), which sets the Stalled condition and
+	// records an event, and "Restart", which also restarts the launcher and
+	// the workers.
+	// +kubebuilder:validation:Enum:=Event;Restart
+	// +kubebuilder:default:=Event
+	// +optional
+	Action WatchdogAction `json:"action,omitempty"`
+}
+
+type ProfilingTool string
+
+const (
+	// ProfilingToolNone doesn't profile the MPI processes.
+	ProfilingToolNone ProfilingTool = "None"
+	// ProfilingToolNsys profiles the MPI processes with NVIDIA Nsight Systems.
+	ProfilingToolNsys ProfilingTool = "Nsys"
+	// ProfilingToolHPCToolkit profiles the MPI processes with hpcrun.
+	ProfilingToolHPCToolkit ProfilingTool = "HPCToolkit"
+	// ProfilingToolCustom profiles the MPI processes with the command of
+	// spec.profiling.command.
+	ProfilingToolCustom ProfilingTool = "Custom"
+)
+
+// Profiling inserts a profiler before the program of the first mpirun or
+// mpiexec of the launcher command, so that it wraps every MPI process.
+// The profiles are written to /profiles, whose path is also set in the
+// MPI_PROFILES_DIR environment variable.
+type Profiling struct {
+	// Tool is the profiler.
+	// Options are "None" (default), "Nsys", "HPCToolkit" and "Custom".
+	// +kubebuilder:validation:Enum:=None;Nsys;HPCToolkit;Custom
+	// +kubebuilder:default:=None
+	// +optional
+	Tool ProfilingTool `json:"tool,omitempty"`
+
+	// Command is the profiler of the Custom tool, which is followed by the
+	// program of the MPI processes.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args are appended to the command of the profiler, like
+	// ["--trace=cuda,nvtx,mpi"] for Nsys.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Image contains the profiler in its /profiler directory, which an init
+	// container copies to /opt/mpi-profiler in the launcher and the workers.
+	// The Nsys and HPCToolkit tools then run from /opt/mpi-profiler/bin.
+	// If unset, the profiler must be installed in the images of the launcher
+	// and the workers.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// OutputClaimName is the PersistentVolumeClaim mounted at /profiles.
+	// It must support the ReadWriteMany access mode when the pods run on
+	// several nodes. If unset, the profiles are written to an emptyDir
+	// volume, which is deleted with the pods.
+	// +optional
+	OutputClaimName string `json:"outputClaimName,omitempty"`
+}
+
+// Artifacts configures the sidecar that uploads the logs of the containers of
+// a pod and the results of the launcher when the pod terminates. The sidecar
+// reads the logs from the /var/log/pods directory of the node.
+type Artifacts struct {
+	// Destination is the URL of the bucket and prefix, as s3://bucket/prefix
+	// or gs://bucket/prefix. The files of each pod are uploaded to
+	// <destination>/<namespace>/<mpijob>/<pod>.
+	Destination string `json:"destination"`
+
+	// ResultsPath is the directory of the launcher container whose files are
+	// uploaded with the logs. It is mounted from a volume shared with the
+	// sidecar.
+	// +optional
+	ResultsPath string `json:"resultsPath,omitempty"`
+
+	// Workers injects the sidecar in the workers too. Defaults to false.
+	// +optional
+	Workers *bool `json:"workers,omitempty"`
+
+	// CredentialsSecretName is the Secret with the credentials of the
+	// sidecar, like AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which are
+	// set as environment variables. If unset, the sidecar uses the
+	// credentials of the service account of the pod.
+	// +optional
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+}
+
+// CoreDumps mounts a volume for the core files in the launcher and the
+// workers. The kernel writes the core files to the path of the core_pattern
+// of the nodes, like /cores/core.%h.%e.%p, in the mount namespace of the
+// crashing process, so the path must be the directory of the core_pattern.
+// The core files are kept in a PersistentVolumeClaim, or uploaded to object
+// storage by a sidecar when the pods terminate.
+type CoreDumps struct {
+	// Path is the directory of the core_pattern of the nodes, where the
+	// volume of the core files is mounted. Defaults to /cores.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// ClaimName is the PersistentVolumeClaim keeping the core files. It must
+	// support the ReadWriteMany access mode when the pods run on several
+	// nodes. Exactly one of claimName and destination must be set.
+	// +optional
+	ClaimName string `json:"claimName,omitempty"`
+
+	// Destination is the URL of the bucket and prefix, as s3://bucket/prefix
+	// or gs://bucket/prefix, to which the sidecar uploads the core files of
+	// each pod, to <destination>/<namespace>/<mpijob>/<pod>/cores.
+	// +optional
+	Destination string `json:"destination,omitempty"`
+
+	// CredentialsSecretName is the Secret with the credentials of the
+	// sidecar, like AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which are
+	// set as environment variables. If unset, the sidecar uses the
+	// credentials of the service account of the pod.
+	// +optional
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+}
+
+// Hooks are batch/v1 Jobs run by the operator around the MPIJob. Their
+// results are reflected in the PreRunHookCompleted and PostRunHookCompleted
+// conditions.
+type Hooks struct {
+	// PreRun is run before the launcher is created. The MPIJob fails if the
+	// hook fails.
+	// +optional
+	PreRun *Hook `json:"preRun,omitempty"`
+
+	// PostRun is run once the MPIJob succeeded or failed. Its failure doesn't
+	// change the result of the MPIJob.
+	// +optional
+	PostRun *Hook `json:"postRun,omitempty"`
+}
+
+// Hook is a container, or a Job template, run in a batch/v1 Job. Exactly one
+// of container and jobTemplate must be set.
+type Hook struct {
+	// Container runs the hook. Its pod has the volumes, the service account
+	// and the image pull secrets of the launcher. Its schema is left out of
+	// the CRD to keep it small, and the operator validates it.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Container *v1.Container `json:"container,omitempty"`
+
+	// JobTemplate is the template of the Job of the hook, for hooks needing
+	// more than one container or their own volumes and service account. The
+	// operator sets the name, the namespace and the owner of the Job, and
+	// restartPolicy defaults to Never. Its schema is left out of the CRD to
+	// keep it small, and the operator validates it.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	JobTemplate *batchv1.JobTemplateSpec `json:"jobTemplate,omitempty"`
+
+	// BackoffLimit is the number of retries of the hook before it fails.
+	// Defaults to 0. It takes precedence over the backoffLimit of jobTemplate.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// ActiveDeadlineSeconds is the duration after which the hook fails. It
+	// takes precedence over the activeDeadlineSeconds of jobTemplate.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// LauncherJobTemplate customizes the launcher Job. Its suspend and
+// backoffLimit are set from the runPolicy of the MPIJob.
+type LauncherJobTemplate struct {
+	// Labels are set in the launcher Job. The labels set by the operator take
+	// precedence.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are set in the launcher Job.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// PodFailurePolicy of the launcher Job, like ignoring the failures of the
+	// launcher caused by disruptions when counting them against the
+	// backoffLimit. Requires the Never restartPolicy of the launcher.
+	// +optional
+	PodFailurePolicy *batchv1.PodFailurePolicy `json:"podFailurePolicy,omitempty"`
+}
+
+// ServiceTemplate customizes the headless Service of the MPIJob. Its
+// clusterIP and selector are set by the operator.
+type ServiceTemplate struct {
+	// Labels are set in the Service. The labels set by the operator take
+	// precedence.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are set in the Service.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// PublishNotReadyAddresses resolves the hostnames of the pods before they
+	// are ready, like for the workers without readiness probes of
+	// applications that contact each other while they start. Defaults to
+	// false.
+	// +optional
+	PublishNotReadyAddresses *bool `json:"publishNotReadyAddresses,omitempty"`
+
+	// Ports of the Service, like the ports of the metrics exporters of the
+	// workers scraped through the Service.
+	// +optional
+	// +listType=atomic
+	Ports []v1.ServicePort `json:"ports,omitempty"`
+}
+
+// WorkerOverride replaces fields of the worker pod template for the workers
+// with an index between StartIndex and EndIndex.
+type WorkerOverride struct {
+	// StartIndex is the index of the first worker of the range.
+	// +kubebuilder:validation:Minimum:=0
+	StartIndex int32 `json:"startIndex"`
+
+	// EndIndex is the index of the last worker of the range, included.
+	// Defaults to StartIndex.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	EndIndex *int32 `json:"endIndex,omitempty"`
+
+	// Resources are merged into the resources of the first container, taking
+	// precedence for each resource name.
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Env is merged into the environment variables of the first container,
+	// replacing the variables with the same name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Env []v1.EnvVar `json:"env,omitempty"`
+
+	// NodeSelector is merged into the node selector of the worker pod
+	// template, taking precedence.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// WorkerGroup is a group of workers with their own pod template.
+type WorkerGroup struct {
+	// Name of the group, set in the training.kubeflow.org/worker-group label
+	// of its workers.
+	Name string `json:"name"`
+
+	// Replicas is the number of workers of the group.
+	// +kubebuilder:validation:Minimum:=1
+	Replicas int32 `json:"replicas"`
+
+	// SlotsPerWorker is the number of slots of each worker of the group in
+	// the hostfile. Defaults to the SlotsPerWorker of the MPIJob.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	SlotsPerWorker *int32 `json:"slotsPerWorker,omitempty"`
+
+	// Template is the pod template of the workers of the group. Its schema is
+	// left out of the CRD to keep it small, and the operator validates it.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template v1.PodTemplateSpec `json:"template"`
+}
+
+// WorkerNaming is the pattern of the names of the workers:
+// <prefix>[<job hash>-]<index>.
+type WorkerNaming struct {
+	// Prefix starts the names of the workers. The names must be unique in
+	// the namespace, so the prefix should include the name of the MPIJob,
+	// unless IncludeJobHash is true. Defaults to <mpijob>-worker-.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// IndexWidth pads the indexes of the workers with zeros up to the width,
+	// like <mpijob>-worker-007 with 3. Defaults to 0, which doesn't pad them.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=10
+	// +optional
+	IndexWidth *int32 `json:"indexWidth,omitempty"`
+
+	// IncludeJobHash adds 8 hexadecimal digits hashing the UID of the
+	// MPIJob after the prefix, so that the names differ between MPIJobs,
+	// including the ones recreated with the same name. Defaults to false.
+	// +optional
+	IncludeJobHash *bool `json:"includeJobHash,omitempty"`
+}
+
+// ClusterAutoscaler configures the MPIJob for the Cluster Autoscaler. The
+// launcher and the workers are annotated as not safe to evict, so that a
+// scale-down doesn't interrupt the MPIJob.
+type ClusterAutoscaler struct {
+	// ProvisioningClassName requests the nodes of all the workers at once
+	// with a ProvisioningRequest of this class, like
+	// best-effort-atscale.autoscaling.x-k8s.io. The workers have a scheduling
+	// gate until the ProvisioningRequest is provisioned, so that they are
+	// scheduled together instead of one by one as the nodes are created.
+	// Requires the operator to manage ProvisioningRequests.
+	// +optional
+	ProvisioningClassName string `json:"provisioningClassName,omitempty"`
+}
+
+// MultiCluster splits the workers in pools placed on other clusters through
+// virtual nodes, like the ones of Admiralty or virtual-kubelet, which run the
+// pods in the remote clusters and report their IPs. The pod networks of the
+// clusters must be routable from each other. The hostfile lists the workers
+// of the pools by pod IP, since their hostnames don't resolve in the remote
+// clusters, so the launcher is created once all the workers are ready.
+// Only supported with Open MPI.
+type MultiCluster struct {
+	// WorkerPools are assigned the workers in order: the first pool gets the
+	// first workers, and so on. The remaining workers run in the cluster of
+	// the MPIJob.
+	// +kubebuilder:validation:MinItems:=1
+	// +listType=map
+	// +listMapKey=name
+	WorkerPools []WorkerPool `json:"workerPools"`
+}
+
+// WorkerPool is a group of workers placed on the same cluster.
+type WorkerPool struct {
+	// Name of the pool, set in the training.kubeflow.org/worker-pool label of
+	// its workers.
+	Name string `json:"name"`
+
+	// Replicas is the number of workers of the pool.
+	// +kubebuilder:validation:Minimum:=1
+	Replicas int32 `json:"replicas"`
+
+	// NodeSelector selects the virtual nodes of the cluster. It is merged
+	// into the node selector of the worker pod template, taking precedence.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are added to the workers of the pool, like the toleration
+	// of the taint of virtual-kubelet nodes.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// Annotations are set in the workers of the pool, taking precedence over
+	// the annotations of the worker pod template, like the
+	// multicluster.admiralty.io/elect annotation.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type TCPInterfaceSelection string
+
+const (
+	// TCPInterfaceSelectionNone keeps the interfaces selected by the MPI
+	// implementation.
+	TCPInterfaceSelectionNone TCPInterfaceSelection = "None"
+	// TCPInterfaceSelectionAuto selects the interfaces of the pod network.
+	TCPInterfaceSelectionAuto TCPInterfaceSelection = "Auto"
+)
+
+type ServiceMeshProvider string
+
+const (
+	ServiceMeshIstio   ServiceMeshProvider = "Istio"
+	ServiceMeshLinkerd ServiceMeshProvider = "Linkerd"
+)
+
+// ServiceMesh is the service mesh injecting sidecars in the pods of the
+// MPIJob. The containers of the launcher and the workers start once the
+// sidecar is ready.
+type ServiceMesh struct {
+	// Provider is the service mesh.
+	// Options are "Istio" and "Linkerd".
+	// +kubebuilder:validation:Enum:=Istio;Linkerd
+	Provider ServiceMeshProvider `json:"provider"`
+
+	// NativeSidecar requests the sidecar as a native sidecar, an init
+	// container that Kubernetes stops when the launcher container exits.
+	// Requires Kubernetes 1.29 and a mesh supporting it.
+	// Otherwise, the launcher container calls the shutdown endpoint of the
+	// sidecar once its command exits, which requires the command of the
+	// launcher container to be set and curl or wget in its image.
+	// Defaults to false.
+	// +optional
+	NativeSidecar *bool `json:"nativeSidecar,omitempty"`
+}
+
+// Network configures the secondary networks of the MPIJob, like SR-IOV or
+// InfiniBand networks. The workers, and the launcher if it runs as a worker,
+// are attached to them with the k8s.v1.cni.cncf.io/networks annotation. The
+// hostnames of the hostfile still resolve to the pod network, which carries
+// the SSH connections, while the interface selection of the MPI
+// implementation, NCCL and UCX restricts the traffic of the ranks to the
+// secondary interfaces.
+type Network struct {
+	// Attachments are the NetworkAttachmentDefinitions the pods are
+	// attached to.
+	// +kubebuilder:validation:MinItems:=1
+	Attachments []NetworkAttachment `json:"attachments"`
+}
+
+// NetworkAttachment is an attachment to a NetworkAttachmentDefinition.
+type NetworkAttachment struct {
+	// Name of the NetworkAttachmentDefinition.
+	Name string `json:"name"`
+
+	// Namespace of the NetworkAttachmentDefinition.
+	// Defaults to the namespace of the MPIJob.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Interface is the name of the interface in the pods.
+	// Defaults to net<n>, where n is the position of the attachment, starting
+	// at 1, as named by Multus.
+	// +optional
+	Interface string `json:"interface,omitempty"`
+}
+
+type BenchmarkType string
+
+const (
+	// BenchmarkOSUBandwidth measures the point-to-point bandwidth between
+	// two workers with osu_bw.
+	BenchmarkOSUBandwidth BenchmarkType = "OSUBandwidth"
+	// BenchmarkOSULatency measures the point-to-point latency between two
+	// workers with osu_latency.
+	BenchmarkOSULatency BenchmarkType = "OSULatency"
+	// BenchmarkNCCLAllReduce measures the all-reduce bus bandwidth across
+	// every slot of the workers with the NCCL tests.
+	BenchmarkNCCLAllReduce BenchmarkType = "NCCLAllReduce"
+)
+
+// Benchmark selects a network benchmark run by the launcher.
+// The launcher and worker containers without an image use the benchmark
+// images maintained with the operator.
+type Benchmark struct {
+	// Type is the benchmark.
+	// Options are "OSUBandwidth", "OSULatency" and "NCCLAllReduce".
+	// +kubebuilder:validation:Enum:=OSUBandwidth;OSULatency;NCCLAllReduce
+	Type BenchmarkType `json:"type"`
+
+	// Args replace the default arguments of the benchmark.
+	// +optional
+	Args []string `json:"args,omitempty"`
+}
+
+// Diagnostics is a short collective benchmark that the launcher runs with
+// mpirun across the scheduled workers before its own command. If the
+// benchmark fails, or the bandwidth it reports is below the threshold, the
+// MPIJob fails without running the launcher command.
+type Diagnostics struct {
+	// Enabled runs the diagnostics before the launcher command.
+	// Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Image runs the diagnostics. It must contain the MPI implementation and
+	// the benchmark. Defaults to the image of the launcher.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Command is the benchmark that mpirun starts with one process per slot.
+	// Defaults to the NCCL all-reduce test:
+	// ["all_reduce_perf", "-b", "8", "-e", "128M", "-f", "2", "-g", "1"].
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// MinBusBandwidthGBps fails the MPIJob if the average bus bandwidth
+	// reported by the benchmark, in GB/s, is lower. The benchmark must print
+	// it as "Avg bus bandwidth : <value>", like the NCCL tests do.
+	// If unset, only the exit code of the benchmark is checked.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MinBusBandwidthGBps *int32 `json:"minBusBandwidthGBps,omitempty"`
 }
 
 // MPIReplicaType is the type for MPIReplica.
@@ -230,6 +1014,12 @@ type JobStatus struct {
 	// +listMapKey=type
 	Conditions []JobCondition `json:"conditions,omitempty"`
 
+	// The state of the job: the type of the condition that finished it or,
+	// otherwise, of the first of the Suspended, Restarting, Running and Created
+	// conditions that is true.
+	// +optional
+	State JobConditionType `json:"state,omitempty"`
+
 	// replicaStatuses is map of ReplicaType and ReplicaStatus,
 	// specifies the status of each replica.
 	// +optional
@@ -247,6 +1037,65 @@ type JobStatus struct {
 	// +optional
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 
+	// Represents the time between the StartTime and the CompletionTime of the
+	// job, once it completed.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// The number of times the launcher restarted, counting its failed pods and
+	// the restarts of their containers.
+	// +optional
+	LauncherRestartCount int32 `json:"launcherRestartCount,omitempty"`
+
+	// The number of times the operator restarted the launcher and the workers
+	// together, like for node drains or stalled MPI processes.
+	// +optional
+	RestartCount int32 `json:"restartCount,omitempty"`
+
+	// The class of the failure of the job, once it failed: Infrastructure when
+	// it was caused by the cluster, like lost nodes, evictions, image pulls or
+	// containers killed for running out of memory, which are worth retrying,
+	// or Application otherwise, like a non-zero exit code of mpirun.
+	// +optional
+	FailureReasonClass FailureReasonClass `json:"failureReasonClass,omitempty"`
+
+	// The progress of the application, like "epoch 12/100" or "34%", which the
+	// launcher reports in the training.kubeflow.org/progress annotation of its
+	// pod.
+	// +optional
+	Progress string `json:"progress,omitempty"`
+
+	// The usage of the GPUs of the pods of the job, once it finished, as
+	// reported by the DCGM exporter. It is only set when the operator runs
+	// with --gpu-metrics-prometheus-url.
+	// +optional
+	GPUUsage *GPUUsage `json:"gpuUsage,omitempty"`
+
+	// The MPI processes killed by a signal that dumps core, as reported by
+	// mpirun in the logs of the launcher. It is only set when
+	// spec.coreDumps is set.
+	// +optional
+	// +listType=atomic
+	CoreDumps []CoreDump `json:"coreDumps,omitempty"`
+
+	// The outcome of the command of the last launcher pod that terminated, as
+	// reported by the launch wrapper. It is only set when spec.launchWrapper
+	// is set.
+	// +optional
+	LaunchResult *LaunchResult `json:"launchResult,omitempty"`
+
+	// The nodes on which the GPU pre-check of a worker failed, sorted by
+	// name. The workers aren't scheduled on them anymore. It is only set
+	// when spec.gpuPreCheck is set.
+	// +optional
+	// +listType=set
+	GPUPreCheckFailedNodes []string `json:"gpuPreCheckFailedNodes,omitempty"`
+
+	// The resources used by the pods of the job, once it finished, as
+	// computed from the requests of their containers and the time they ran.
+	// +optional
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty"`
+
 	// Represents last time when the job was reconciled. It is not guaranteed to
 	// be set in happens-before order across separate operations.
 	// It is represented in RFC3339 form and is in UTC.
@@ -254,12 +1103,94 @@ type JobStatus struct {
 	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
 }
 
+// GPUUsage summarizes the usage of the GPUs of a finished job.
+type GPUUsage struct {
+	// The number of GPUs that the pods of the job used.
+	GPUs int32 `json:"gpus"`
+
+	// The utilization of the GPUs, in percent, averaged over the GPUs and the
+	// duration of the job.
+	AverageUtilization int32 `json:"averageUtilization"`
+
+	// The highest utilization of any of the GPUs, in percent.
+	PeakUtilization int32 `json:"peakUtilization"`
+
+	// The energy consumed by the GPUs, in joules, estimated from their average
+	// power usage and the duration of the job.
+	// +optional
+	EnergyJoules int64 `json:"energyJoules,omitempty"`
+}
+
+// ResourceUsage summarizes the resources used by the launcher and the workers
+// of a finished job. It only accounts for the pods that still existed when
+// the job finished.
+type ResourceUsage struct {
+	// The CPUs requested by the containers of the pods, or their limits when
+	// they don't request CPUs, multiplied by the seconds the pods ran.
+	CPUSeconds int64 `json:"cpuSeconds"`
+
+	// The GPUs, that is the resources named like <vendor>/gpu, requested by
+	// the containers of the pods multiplied by the seconds the pods ran.
+	GPUSeconds int64 `json:"gpuSeconds"`
+
+	// The highest number of workers that ran at the same time.
+	PeakWorkers int32 `json:"peakWorkers"`
+
+	// The seconds between the start time and the completion time of the job.
+	WallClockSeconds int64 `json:"wallClockSeconds"`
+}
+
+type LaunchResultReason string
+
+const (
+	// LaunchResultSucceeded is the reason of a command that exited with 0.
+	LaunchResultSucceeded LaunchResultReason = "Succeeded"
+	// LaunchResultFailed is the reason of a command that exited with a
+	// non-zero exit code in its last attempt.
+	LaunchResultFailed LaunchResultReason = "Failed"
+	// LaunchResultTimedOut is the reason of a command whose last attempt
+	// exceeded spec.launchWrapper.attemptTimeoutSeconds.
+	LaunchResultTimedOut LaunchResultReason = "TimedOut"
+)
+
+// LaunchResult is the outcome of the command of a launcher pod run by the
+// launch wrapper.
+type LaunchResult struct {
+	// The number of attempts of the command.
+	Attempts int32 `json:"attempts"`
+
+	// Why the last attempt finished: Succeeded, Failed or TimedOut.
+	Reason LaunchResultReason `json:"reason"`
+
+	// The exit code of the last attempt.
+	ExitCode int32 `json:"exitCode"`
+}
+
+// CoreDump is an MPI process killed by a signal that dumps core.
+type CoreDump struct {
+	// The rank of the process.
+	Rank int32 `json:"rank"`
+
+	// The host of the process, like <mpijob>-worker-0, which is the %h of the
+	// name of its core file.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// The signal that killed the process, like SIGSEGV.
+	Signal string `json:"signal"`
+}
+
 // ReplicaStatus represents the current observed state of the replica.
 type ReplicaStatus struct {
 	// The number of actively running pods.
 	// +optional
 	Active int32 `json:"active,omitempty"`
 
+	// The number of running pods which have a Ready condition, that is, whose
+	// readiness probes, like the probes of sshd, passed.
+	// +optional
+	Ready int32 `json:"ready,omitempty"`
+
 	// The number of pods which reached phase succeeded.
 	// +optional
 	Succeeded int32 `json:"succeeded,omitempty"`
@@ -277,6 +1208,11 @@ type ReplicaStatus struct {
 	// selector matches no objects.
 	// +optional
 	Selector string `json:"selector,omitempty"`
+
+	// The nodes hosting the scheduled pods of the replica type, sorted by
+	// name.
+	// +optional
+	Nodes []string `json:"nodes,omitempty"`
 }
 
 // JobCondition describes the state of the job at a certain point.
@@ -319,6 +1255,11 @@ const (
 	// The training is running without error.
 	JobRunning JobConditionType = "Running"
 
+	// JobPodsReady means the launcher and all the workers of this job
+	// are running and ready, unlike JobRunning, which is set once the
+	// launcher started.
+	JobPodsReady JobConditionType = "PodsReady"
+
 	// JobRestarting means one or more sub-resources (e.g. services/pods) of this job
 	// reached phase failed but maybe restarted according to it's restart policy
 	// which specified by user in v1.PodTemplateSpec.
@@ -337,6 +1278,31 @@ const (
 	// reached phase failed with no restarting.
 	// The training has failed its execution.
 	JobFailed JobConditionType = "Failed"
+
+	// JobQueueFull means the Volcano queue of the job doesn't have the
+	// capacity to admit its PodGroup.
+	JobQueueFull JobConditionType = "QueueFull"
+
+	// JobPreRunHookCompleted means the pre-run hook of the job succeeded.
+	// It is false while the hook runs and once it failed.
+	JobPreRunHookCompleted JobConditionType = "PreRunHookCompleted"
+
+	// JobPostRunHookCompleted means the post-run hook of the job succeeded.
+	// It is false while the hook runs and once it failed.
+	JobPostRunHookCompleted JobConditionType = "PostRunHookCompleted"
+
+	// JobStalled means the watchdog of spec.watchdog detected MPI processes
+	// making no progress. It is false once they make progress again.
+	JobStalled JobConditionType = "Stalled"
+)
+
+// FailureReasonClass describes whether the failure of a job was caused by the
+// infrastructure or by the application.
+type FailureReasonClass string
+
+const (
+	FailureReasonClassInfrastructure FailureReasonClass = "Infrastructure"
+	FailureReasonClassApplication    FailureReasonClass = "Application"
 )
 
 // Following is merge from common.v1
@@ -27,565 +27,2177 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobCondition":     schema_pkg_apis_kubeflow_v2beta1_JobCondition(ref),
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobStatus":        schema_pkg_apis_kubeflow_v2beta1_JobStatus(ref),
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJob":           schema_pkg_apis_kubeflow_v2beta1_MPIJob(ref),
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobList":       schema_pkg_apis_kubeflow_v2beta1_MPIJobList(ref),
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobSpec":       schema_pkg_apis_kubeflow_v2beta1_MPIJobSpec(ref),
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaSpec":      schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref),
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus":    schema_pkg_apis_kubeflow_v2beta1_ReplicaStatus(ref),
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.RunPolicy":        schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref),
-		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy": schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                               schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                           schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                            schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                        schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                            schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                           schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                              schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                          schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                          schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                               schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":               schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                               schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                             schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                              schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                          schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                           schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":               schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                       schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                   schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                          schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                          schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":               schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                   schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                               schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                            schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                     schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                              schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                             schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                         schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                  schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":              schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                  schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                           schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                          schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                              schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":              schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                 schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                            schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                          schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                  schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                  schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                           schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                               schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                      schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                   schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                              schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                               schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                          schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                             schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                    schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                     schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                        schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Artifacts":           schema_pkg_apis_kubeflow_v2beta1_Artifacts(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Benchmark":           schema_pkg_apis_kubeflow_v2beta1_Benchmark(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ClusterAutoscaler":   schema_pkg_apis_kubeflow_v2beta1_ClusterAutoscaler(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.CoreDump":            schema_pkg_apis_kubeflow_v2beta1_CoreDump(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.CoreDumps":           schema_pkg_apis_kubeflow_v2beta1_CoreDumps(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Diagnostics":         schema_pkg_apis_kubeflow_v2beta1_Diagnostics(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.GPUPreCheck":         schema_pkg_apis_kubeflow_v2beta1_GPUPreCheck(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.GPUUsage":            schema_pkg_apis_kubeflow_v2beta1_GPUUsage(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Hook":                schema_pkg_apis_kubeflow_v2beta1_Hook(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Hooks":               schema_pkg_apis_kubeflow_v2beta1_Hooks(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobCondition":        schema_pkg_apis_kubeflow_v2beta1_JobCondition(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobStatus":           schema_pkg_apis_kubeflow_v2beta1_JobStatus(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LaunchResult":        schema_pkg_apis_kubeflow_v2beta1_LaunchResult(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LaunchWrapper":       schema_pkg_apis_kubeflow_v2beta1_LaunchWrapper(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LauncherJobTemplate": schema_pkg_apis_kubeflow_v2beta1_LauncherJobTemplate(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJob":              schema_pkg_apis_kubeflow_v2beta1_MPIJob(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobDefaults":      schema_pkg_apis_kubeflow_v2beta1_MPIJobDefaults(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobDefaultsList":  schema_pkg_apis_kubeflow_v2beta1_MPIJobDefaultsList(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobDefaultsSpec":  schema_pkg_apis_kubeflow_v2beta1_MPIJobDefaultsSpec(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobList":          schema_pkg_apis_kubeflow_v2beta1_MPIJobList(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobSpec":          schema_pkg_apis_kubeflow_v2beta1_MPIJobSpec(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MultiCluster":        schema_pkg_apis_kubeflow_v2beta1_MultiCluster(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Network":             schema_pkg_apis_kubeflow_v2beta1_Network(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.NetworkAttachment":   schema_pkg_apis_kubeflow_v2beta1_NetworkAttachment(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Profiling":           schema_pkg_apis_kubeflow_v2beta1_Profiling(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaDefaults":     schema_pkg_apis_kubeflow_v2beta1_ReplicaDefaults(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaSpec":         schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus":       schema_pkg_apis_kubeflow_v2beta1_ReplicaStatus(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ResourceUsage":       schema_pkg_apis_kubeflow_v2beta1_ResourceUsage(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.RunPolicy":           schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy":    schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ServiceMesh":         schema_pkg_apis_kubeflow_v2beta1_ServiceMesh(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ServiceTemplate":     schema_pkg_apis_kubeflow_v2beta1_ServiceTemplate(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Watchdog":            schema_pkg_apis_kubeflow_v2beta1_Watchdog(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerGroup":         schema_pkg_apis_kubeflow_v2beta1_WorkerGroup(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerNaming":        schema_pkg_apis_kubeflow_v2beta1_WorkerNaming(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerOverride":      schema_pkg_apis_kubeflow_v2beta1_WorkerOverride(ref),
+		"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerPool":          schema_pkg_apis_kubeflow_v2beta1_WorkerPool(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                  schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                              schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                               schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                           schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                               schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                              schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                 schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                             schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                             schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                  schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                  schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                  schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                 schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                             schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                              schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                  schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                          schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                      schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                             schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                             schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                  schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                      schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                  schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                               schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                        schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                 schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                            schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                     schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                 schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                     schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                              schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                             schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                 schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                 schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                    schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                               schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                             schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                     schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                     schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                              schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                  schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                         schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                      schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                 schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                  schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                             schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                   schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                       schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                        schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                           schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_JobCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_Artifacts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JobCondition describes the state of the job at a certain point.",
+				Description: "Artifacts configures the sidecar that uploads the logs of the containers of a pod and the results of the launcher when the pod terminates. The sidecar reads the logs from the /var/log/pods directory of the node.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"destination": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type of job condition.",
+							Description: "Destination is the URL of the bucket and prefix, as s3://bucket/prefix or gs://bucket/prefix. The files of each pod are uploaded to <destination>/<namespace>/<mpijob>/<pod>.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"resultsPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "ResultsPath is the directory of the launcher container whose files are uploaded with the logs. It is mounted from a volume shared with the sidecar.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Type:        []string{"string"},
+							Description: "Workers injects the sidecar in the workers too. Defaults to false.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"credentialsSecretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human-readable message indicating details about the transition.",
+							Description: "CredentialsSecretName is the Secret with the credentials of the sidecar, like AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which are set as environment variables. If unset, the sidecar uses the credentials of the service account of the pod.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The last time this condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
 				},
-				Required: []string{"type", "status"},
+				Required: []string{"destination"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_JobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_Benchmark(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JobStatus represents the current observed state of the training Job.",
+				Description: "Benchmark selects a network benchmark run by the launcher. The launcher and worker containers without an image use the benchmark images maintained with the operator.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type": "map",
-							},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the benchmark. Options are \"OSUBandwidth\", \"OSULatency\" and \"NCCLAllReduce\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions is a list of current observed job conditions.",
+							Description: "Args replace the default arguments of the benchmark.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobCondition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"replicaStatuses": {
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_ClusterAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAutoscaler configures the MPIJob for the Cluster Autoscaler. The launcher and the workers are annotated as not safe to evict, so that a scale-down doesn't interrupt the MPIJob.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provisioningClassName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "replicaStatuses is map of ReplicaType and ReplicaStatus, specifies the status of each replica.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus"),
-									},
-								},
-							},
+							Description: "ProvisioningClassName requests the nodes of all the workers at once with a ProvisioningRequest of this class, like best-effort-atscale.autoscaling.x-k8s.io. The workers have a scheduling gate until the ProvisioningRequest is provisioned, so that they are scheduled together instead of one by one as the nodes are created. Requires the operator to manage ProvisioningRequests.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"startTime": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_CoreDump(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDump is an MPI process killed by a signal that dumps core.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rank": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents time when the job was acknowledged by the job controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "The rank of the process.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"completionTime": {
+					"host": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents time when the job was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "The host of the process, like <mpijob>-worker-0, which is the %h of the name of its core file.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReconcileTime": {
+					"signal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents last time when the job was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "The signal that killed the process, like SIGSEGV.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"rank", "signal"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobCondition", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_MPIJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_CoreDumps(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "CoreDumps mounts a volume for the core files in the launcher and the workers. The kernel writes the core files to the path of the core_pattern of the nodes, like /cores/core.%h.%e.%p, in the mount namespace of the crashing process, so the path must be the directory of the core_pattern. The core files are kept in a PersistentVolumeClaim, or uploaded to object storage by a sidecar when the pods terminate.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Path is the directory of the core_pattern of the nodes, where the volume of the core files is mounted. Defaults to /cores.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"claimName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ClaimName is the PersistentVolumeClaim keeping the core files. It must support the ReadWriteMany access mode when the pods run on several nodes. Exactly one of claimName and destination must be set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"destination": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobSpec"),
+							Description: "Destination is the URL of the bucket and prefix, as s3://bucket/prefix or gs://bucket/prefix, to which the sidecar uploads the core files of each pod, to <destination>/<namespace>/<mpijob>/<pod>/cores.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"credentialsSecretName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobStatus"),
+							Description: "CredentialsSecretName is the Secret with the credentials of the sidecar, like AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which are set as environment variables. If unset, the sidecar uses the credentials of the service account of the pod.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobStatus", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_MPIJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_Diagnostics(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Diagnostics is a short collective benchmark that the launcher runs with mpirun across the scheduled workers before its own command. If the benchmark fails, or the bandwidth it reports is below the threshold, the MPIJob fails without running the launcher command.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
+							Description: "Enabled runs the diagnostics before the launcher command. Defaults to false.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Image runs the diagnostics. It must contain the MPI implementation and the benchmark. Defaults to the image of the launcher.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Command is the benchmark that mpirun starts with one process per slot. Defaults to the NCCL all-reduce test: [\"all_reduce_perf\", \"-b\", \"8\", \"-e\", \"128M\", \"-f\", \"2\", \"-g\", \"1\"].",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJob"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"minBusBandwidthGBps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinBusBandwidthGBps fails the MPIJob if the average bus bandwidth reported by the benchmark, in GB/s, is lower. The benchmark must print it as \"Avg bus bandwidth : <value>\", like the NCCL tests do. If unset, only the exit code of the benchmark is checked.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"metadata", "items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_MPIJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_GPUPreCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GPUPreCheck is a health check of the GPUs of the workers, like the DCGM diagnostics, that runs in an init container with the GPUs of the worker.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"slotsPerWorker": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the number of slots per worker used in hostfile. Defaults to 1.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"runLauncherAsWorker": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RunLauncherAsWorker indicates whether to run worker process in launcher Defaults to false.",
-							Type:        []string{"boolean"},
+							Description: "Image runs the check. Defaults to the DCGM image configured in the operator.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"runPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RunPolicy encapsulates various runtime policies of the job.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.RunPolicy"),
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"mpiReplicaSpecs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MPIReplicaSpecs contains maps from `MPIReplicaType` to `ReplicaSpec` that specify the MPI replicas to run.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Command is the check, which fails with a non-zero exit code. Defaults to the quick DCGM diagnostics: [\"/bin/sh\", \"-c\", \"nv-hostengine && dcgmi diag -r 1\"].",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaSpec"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"sshAuthMountPath": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SSHAuthMountPath is the directory where SSH keys are mounted. Defaults to \"/root/.ssh\".",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"launcherCreationPolicy": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. Defaults to AtStartup.",
+							Description: "ResourceName is the extended resource of the GPUs. Only the workers requesting it run the check. Defaults to nvidia.com/gpu.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"mpiImplementation": {
+					"maxFailedNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MPIImplementation is the MPI implementation. Options are \"OpenMPI\" (default), \"Intel\" and \"MPICH\".",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxFailedNodes is the number of nodes on which the check can fail before the MPIJob fails. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"mpiReplicaSpecs"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaSpec", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.RunPolicy"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_GPUUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReplicaSpec is a description of the replica",
+				Description: "GPUUsage summarizes the usage of the GPUs of a finished job.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"gpus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1.",
+							Description: "The number of GPUs that the pods of the job used.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"template": {
+					"averageUtilization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template is the object that describes the pod that will be created for this replica. RestartPolicy in PodTemplateSpec will be overide by RestartPolicy in ReplicaSpec",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.PodTemplateSpec"),
+							Description: "The utilization of the GPUs, in percent, averaged over the GPUs and the duration of the job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"restartPolicy": {
+					"peakUtilization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Restart policy for all replicas within the job. One of Always, OnFailure, Never and ExitCode. Default to Never.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The highest utilization of any of the GPUs, in percent.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"energyJoules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The energy consumed by the GPUs, in joules, estimated from their average power usage and the duration of the job.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"gpus", "averageUtilization", "peakUtilization"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.PodTemplateSpec"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_ReplicaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_Hook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReplicaStatus represents the current observed state of the replica.",
+				Description: "Hook is a container, or a Job template, run in a batch/v1 Job. Exactly one of container and jobTemplate must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"active": {
+					"container": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of actively running pods.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Container runs the hook. Its pod has the volumes, the service account and the image pull secrets of the launcher. Its schema is left out of the CRD to keep it small, and the operator validates it.",
+							Ref:         ref("k8s.io/api/core/v1.Container"),
 						},
 					},
-					"succeeded": {
+					"jobTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of pods which reached phase succeeded.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "JobTemplate is the template of the Job of the hook, for hooks needing more than one container or their own volumes and service account. The operator sets the name, the namespace and the owner of the Job, and restartPolicy defaults to Never. Its schema is left out of the CRD to keep it small, and the operator validates it.",
+							Ref:         ref("k8s.io/api/batch/v1.JobTemplateSpec"),
 						},
 					},
-					"failed": {
+					"backoffLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of pods which reached phase failed.",
+							Description: "BackoffLimit is the number of retries of the hook before it fails. Defaults to 0. It takes precedence over the backoffLimit of jobTemplate.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"labelSelector": {
+					"activeDeadlineSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated: Use selector instead",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Description: "ActiveDeadlineSeconds is the duration after which the hook fails. It takes precedence over the activeDeadlineSeconds of jobTemplate.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"selector": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/batch/v1.JobTemplateSpec", "k8s.io/api/core/v1.Container"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_Hooks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Hooks are batch/v1 Jobs run by the operator around the MPIJob. Their results are reflected in the PreRunHookCompleted and PostRunHookCompleted conditions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"preRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreRun is run before the launcher is created. The MPIJob fails if the hook fails.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Hook"),
+						},
+					},
+					"postRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRun is run once the MPIJob succeeded or failed. Its failure doesn't change the result of the MPIJob.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Hook"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Hook"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_JobCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JobCondition describes the state of the job at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type of job condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human-readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time this condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_JobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JobStatus represents the current observed state of the training Job.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "conditions is a list of current observed job conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobCondition"),
+									},
+								},
+							},
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The state of the job: the type of the condition that finished it or, otherwise, of the first of the Suspended, Restarting, Running and Created conditions that is true.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicaStatuses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicaStatuses is map of ReplicaType and ReplicaStatus, specifies the status of each replica.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus"),
+									},
+								},
+							},
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the job was acknowledged by the job controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the job was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the time between the StartTime and the CompletionTime of the job, once it completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"launcherRestartCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of times the launcher restarted, counting its failed pods and the restarts of their containers.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of times the operator restarted the launcher and the workers together, like for node drains or stalled MPI processes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failureReasonClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The class of the failure of the job, once it failed: Infrastructure when it was caused by the cluster, like lost nodes, evictions, image pulls or containers killed for running out of memory, which are worth retrying, or Application otherwise, like a non-zero exit code of mpirun.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The progress of the application, like \"epoch 12/100\" or \"34%\", which the launcher reports in the training.kubeflow.org/progress annotation of its pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gpuUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The usage of the GPUs of the pods of the job, once it finished, as reported by the DCGM exporter. It is only set when the operator runs with --gpu-metrics-prometheus-url.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.GPUUsage"),
+						},
+					},
+					"coreDumps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The MPI processes killed by a signal that dumps core, as reported by mpirun in the logs of the launcher. It is only set when spec.coreDumps is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.CoreDump"),
+									},
+								},
+							},
+						},
+					},
+					"launchResult": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The outcome of the command of the last launcher pod that terminated, as reported by the launch wrapper. It is only set when spec.launchWrapper is set.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LaunchResult"),
+						},
+					},
+					"gpuPreCheckFailedNodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The nodes on which the GPU pre-check of a worker failed, sorted by name. The workers aren't scheduled on them anymore. It is only set when spec.gpuPreCheck is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resourceUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The resources used by the pods of the job, once it finished, as computed from the requests of their containers and the time they ran.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ResourceUsage"),
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents last time when the job was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.CoreDump", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.GPUUsage", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobCondition", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LaunchResult", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaStatus", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ResourceUsage", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_LaunchResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LaunchResult is the outcome of the command of a launcher pod run by the launch wrapper.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of attempts of the command.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Why the last attempt finished: Succeeded, Failed or TimedOut.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exitCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The exit code of the last attempt.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"attempts", "reason", "exitCode"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_LaunchWrapper(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LaunchWrapper retries the command of the launcher, like mpirun, in the same launcher pod, so that a transient failure doesn't recreate the workers. The command of the launcher container must be set, and its image must have /bin/sh.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttempts is the number of times the command is run in a launcher pod before it fails. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"attemptTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttemptTimeoutSeconds is how long an attempt can run before it is terminated and fails. If unset, the attempts have no timeout.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryDelaySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryDelaySeconds is how long to wait between attempts. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryOnExitCodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOnExitCodes are the exit codes of the command that are retried. The exit code 124 is the one of the attempts that timed out. If empty, any non-zero exit code is retried.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_LauncherJobTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LauncherJobTemplate customizes the launcher Job. Its suspend and backoffLimit are set from the runPolicy of the MPIJob.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are set in the launcher Job. The labels set by the operator take precedence.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are set in the launcher Job.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"podFailurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodFailurePolicy of the launcher Job, like ignoring the failures of the launcher caused by disruptions when counting them against the backoffLimit. Requires the Never restartPolicy of the launcher.",
+							Ref:         ref("k8s.io/api/batch/v1.PodFailurePolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/batch/v1.PodFailurePolicy"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_MPIJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.JobStatus", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_MPIJobDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MPIJobDefaults are the defaults of the MPIJobs of its namespace, which the operator merges into the MPIJobs when it syncs them. The values set in an MPIJob take precedence. With several MPIJobDefaults in a namespace, they are merged in the order of their names, so that the first one setting a field takes precedence.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobDefaultsSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobDefaultsSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_MPIJobDefaultsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobDefaults"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJobDefaults", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_MPIJobDefaultsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"slotsPerWorker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SlotsPerWorker is the default of spec.slotsPerWorker.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cleanPodPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanPodPolicy is the default of spec.runPolicy.cleanPodPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFinished is the default of spec.runPolicy.ttlSecondsAfterFinished.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"launcher": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Launcher are the defaults of the launcher pod.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaDefaults"),
+						},
+					},
+					"worker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Worker are the defaults of the worker pods.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaDefaults"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaDefaults"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_MPIJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJob"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MPIJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_MPIJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"slotsPerWorker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the number of slots per worker used in hostfile. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"slotsPerWorkerDeviceClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SlotsPerWorkerDeviceClass derives the slots per worker from the devices requested by the ResourceClaimTemplates of the worker pod template, with one slot per device of this DeviceClass, like gpu.nvidia.com. It takes precedence over slotsPerWorker, which is used if the devices can't be counted. Requires the operator to watch ResourceClaimTemplates.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runLauncherAsWorker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunLauncherAsWorker indicates whether to run worker process in launcher Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunPolicy encapsulates various runtime policies of the job.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.RunPolicy"),
+						},
+					},
+					"mpiReplicaSpecs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MPIReplicaSpecs contains maps from `MPIReplicaType` to `ReplicaSpec` that specify the MPI replicas to run.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaSpec"),
+									},
+								},
+							},
+						},
+					},
+					"sshAuthMountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHAuthMountPath is the directory where SSH keys are mounted. Defaults to \"/root/.ssh\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"launcherCreationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. If WaitForWorkersScheduled, the launcher is created only after all workers are scheduled to nodes. Defaults to AtStartup.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mpiImplementation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MPIImplementation is the MPI implementation. Options are \"OpenMPI\" (default), \"Intel\" and \"MPICH\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"diagnostics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diagnostics configures a sanity test of the interconnect, run across the workers before the launcher command.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Diagnostics"),
+						},
+					},
+					"benchmark": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Benchmark turns the MPIJob into a network benchmark: the launcher runs the benchmark instead of its command, and the results are stored in the <name>-benchmark ConfigMap when the MPIJob succeeds.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Benchmark"),
+						},
+					},
+					"network": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Network attaches the workers to secondary networks with Multus, and selects their interfaces for the MPI, NCCL and UCX traffic.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Network"),
+						},
+					},
+					"tcpInterfaceSelection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TCPInterfaceSelection selects the interfaces of the TCP traffic of MPI. With Auto, the launcher restricts the traffic of mpirun and of the ranks to the interface of the pod network, or the ranks to the interfaces of spec.network, instead of the defaults of the MPI implementation, which can pick bridges like docker0 on multi-homed nodes. Defaults to None, which keeps the defaults.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceMesh": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceMesh configures the pods for the sidecars injected by a service mesh, so that mpirun waits for them and the launcher Job completes when the launcher command exits.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ServiceMesh"),
+						},
+					},
+					"multiCluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MultiCluster places workers on other clusters, while the launcher stays in the cluster of the MPIJob. This is an alpha feature.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MultiCluster"),
+						},
+					},
+					"clusterAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAutoscaler configures the pods for the Cluster Autoscaler, which provisions the nodes of the MPIJob.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ClusterAutoscaler"),
+						},
+					},
+					"workerOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerOverrides replace fields of the worker pod template for ranges of worker indexes, like more memory for worker-0 when it aggregates the I/O of the other workers. When ranges overlap, the last override takes precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerOverride"),
+									},
+								},
+							},
+						},
+					},
+					"workerGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerGroups are workers with their own pod template, replica count and slots, like GPU workers for a simulation and CPU workers for its analysis, in the same MPI run. The workers of the groups follow the Worker replicas in the hostfile, in the order of the groups, and share the restart policy of the Worker replicas.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerGroup"),
+									},
+								},
+							},
+						},
+					},
+					"workerNaming": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerNaming customizes the names of the workers, which are also their hostnames in the Service of the MPIJob and in the hostfile. The workers are named <mpijob>-worker-<index> by default. It can't change once the MPIJob started, since the workers aren't renamed.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerNaming"),
+						},
+					},
+					"launcherJob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LauncherJob customizes the batch/v1 Job generated for the launcher, for the systems, like Kueue or cost tools, that read its metadata.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LauncherJobTemplate"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are run by the operator before the launcher is created and after the MPIJob finishes, like staging data or uploading results.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Hooks"),
+						},
+					},
+					"artifacts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Artifacts injects a sidecar that uploads the logs of the launcher, and optionally of the workers, and a results directory to object storage, so that they outlive the pods.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Artifacts"),
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service customizes the headless Service generated for the launcher and the workers, which resolves their hostnames.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ServiceTemplate"),
+						},
+					},
+					"profiling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profiling wraps the MPI processes started by the launcher command with a profiler, which writes the profiles to a volume mounted in the launcher and the workers.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Profiling"),
+						},
+					},
+					"coreDumps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CoreDumps collects the core files of the MPI processes that crash, and records their ranks in the status.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.CoreDumps"),
+						},
+					},
+					"watchdog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Watchdog injects a sidecar in the workers that detects MPI processes making no progress, and marks the MPIJob as Stalled.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Watchdog"),
+						},
+					},
+					"launchWrapper": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LaunchWrapper runs the command of the launcher with an entrypoint of the operator that bounds the duration of each attempt, retries it in the launcher pod, and reports the outcome in the status.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LaunchWrapper"),
+						},
+					},
+					"gpuPreCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GPUPreCheck checks the GPUs of each worker in an init container before the worker starts, and recreates the workers whose check failed on other nodes, before the launcher runs.",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.GPUPreCheck"),
+						},
+					},
+				},
+				Required: []string{"mpiReplicaSpecs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Artifacts", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Benchmark", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ClusterAutoscaler", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.CoreDumps", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Diagnostics", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.GPUPreCheck", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Hooks", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LaunchWrapper", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.LauncherJobTemplate", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.MultiCluster", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Network", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Profiling", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ReplicaSpec", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.RunPolicy", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ServiceMesh", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.ServiceTemplate", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.Watchdog", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerGroup", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerNaming", "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerOverride"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_MultiCluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MultiCluster splits the workers in pools placed on other clusters through virtual nodes, like the ones of Admiralty or virtual-kubelet, which run the pods in the remote clusters and report their IPs. The pod networks of the clusters must be routable from each other. The hostfile lists the workers of the pools by pod IP, since their hostnames don't resolve in the remote clusters, so the launcher is created once all the workers are ready. Only supported with Open MPI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workerPools": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPools are assigned the workers in order: the first pool gets the first workers, and so on. The remaining workers run in the cluster of the MPIJob.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerPool"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"workerPools"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.WorkerPool"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_Network(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Network configures the secondary networks of the MPIJob, like SR-IOV or InfiniBand networks. The workers, and the launcher if it runs as a worker, are attached to them with the k8s.v1.cni.cncf.io/networks annotation. The hostnames of the hostfile still resolve to the pod network, which carries the SSH connections, while the interface selection of the MPI implementation, NCCL and UCX restricts the traffic of the ranks to the secondary interfaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attachments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attachments are the NetworkAttachmentDefinitions the pods are attached to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.NetworkAttachment"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"attachments"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.NetworkAttachment"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_NetworkAttachment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkAttachment is an attachment to a NetworkAttachmentDefinition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the NetworkAttachmentDefinition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the NetworkAttachmentDefinition. Defaults to the namespace of the MPIJob.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interface": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interface is the name of the interface in the pods. Defaults to net<n>, where n is the position of the attachment, starting at 1, as named by Multus.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_Profiling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Profiling inserts a profiler before the program of the first mpirun or mpiexec of the launcher command, so that it wraps every MPI process. The profiles are written to /profiles, whose path is also set in the MPI_PROFILES_DIR environment variable.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tool is the profiler. Options are \"None\" (default), \"Nsys\", \"HPCToolkit\" and \"Custom\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the profiler of the Custom tool, which is followed by the program of the MPI processes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are appended to the command of the profiler, like [\"--trace=cuda,nvtx,mpi\"] for Nsys.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image contains the profiler in its /profiler directory, which an init container copies to /opt/mpi-profiler in the launcher and the workers. The Nsys and HPCToolkit tools then run from /opt/mpi-profiler/bin. If unset, the profiler must be installed in the images of the launcher and the workers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outputClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputClaimName is the PersistentVolumeClaim mounted at /profiles. It must support the ReadWriteMany access mode when the pods run on several nodes. If unset, the profiles are written to an emptyDir volume, which is deleted with the pods.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_ReplicaDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicaDefaults are the defaults of the pods of a replica type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the image of the first container of the pods, if it has none.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources are the requests and limits of the first container of the pods, for the resources that it doesn't request or limit.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations are added to the pods, unless they have a toleration with the same key and effect.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_ReplicaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicaSpec is a description of the replica",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the object that describes the pod that will be created for this replica. RestartPolicy in PodTemplateSpec will be overide by RestartPolicy in ReplicaSpec",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.PodTemplateSpec"),
+						},
+					},
+					"restartPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Restart policy for all replicas within the job. One of Always, OnFailure, Never and ExitCode. Default to Never.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.PodTemplateSpec"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_ReplicaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicaStatus represents the current observed state of the replica.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of actively running pods.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of running pods which have a Ready condition, that is, whose readiness probes, like the probes of sshd, passed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of pods which reached phase succeeded.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of pods which reached phase failed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated: Use selector instead",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. An empty selector matches all objects. A null selector matches no objects.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The nodes hosting the scheduled pods of the replica type, sorted by name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_ResourceUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceUsage summarizes the resources used by the launcher and the workers of a finished job. It only accounts for the pods that still existed when the job finished.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpuSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The CPUs requested by the containers of the pods, or their limits when they don't request CPUs, multiplied by the seconds the pods ran.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"gpuSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The GPUs, that is the resources named like <vendor>/gpu, requested by the containers of the pods multiplied by the seconds the pods ran.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"peakWorkers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The highest number of workers that ran at the same time.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"wallClockSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The seconds between the start time and the completion time of the job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"cpuSeconds", "gpuSeconds", "peakWorkers", "wallClockSeconds"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cleanPodPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanPodPolicy defines the policy to kill pods after the job completes. Default to Running.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFinished is the TTL to clean up jobs. It may take extra ReconcilePeriod seconds for the cleanup, since reconcile gets called periodically. Default to infinite.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the duration in seconds relative to the startTime that the job may be active before the system tries to terminate it; value must be positive integer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional number of retries before marking this job failed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"schedulingPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulingPolicy defines the policy related to scheduling, e.g. gang-scheduling",
+							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "suspend specifies whether the MPIJob controller should create Pods or not. If a MPIJob is created with suspend set to true, no Pods are created by the MPIJob controller. If a MPIJob is suspended after creation (i.e. the flag goes from false to true), the MPIJob controller will delete all active Pods and PodGroups associated with this MPIJob. Also, it will suspend the Launcher Job. Users must design their workload to gracefully handle this. Suspending a Job will reset the StartTime field of the MPIJob.\n\nDefaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"managedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedBy is used to indicate the controller or entity that manages a MPIJob. The value must be either empty, 'kubeflow.org/mpi-operator' or 'kueue.x-k8s.io/multikueue'. The mpi-operator reconciles a MPIJob which doesn't have this field at all or the field value is the reserved string 'kubeflow.org/mpi-operator', but delegates reconciling the MPIJob with 'kueue.x-k8s.io/multikueue' to the Kueue. The field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"terminationGracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationGracePeriodSeconds overrides the terminationGracePeriodSeconds of the launcher and worker pods. It bounds the time that their preStop hooks and processes have to finish when the pods are deleted on cleanup, suspension or restart.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SchedulingPolicy encapsulates various scheduling policies of the distributed training job, for example `minAvailable` for gang-scheduling. Now, it supports only for volcano and scheduler-plugins.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minAvailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinAvailable defines the minimal number of member to run the PodGroup. If the gang-scheduling isn't empty, input is passed to `.spec.minMember` in PodGroup. Note that, when using this field, you need to make sure the application supports resizing (e.g., Elastic Horovod).\n\nIf not set, it defaults to the number of workers.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"queue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Queue defines the queue name to allocate resource for PodGroup. If the gang-scheduling is set to the volcano, input is passed to `.spec.queue` in PodGroup for the volcano, and if it is set to the scheduler-plugins, input isn't passed to PodGroup.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinResources defines the minimal resources of members to run the PodGroup. If the gang-scheduling isn't empty, input is passed to `.spec.minResources` in PodGroup for scheduler-plugins.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"priorityClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClass defines the PodGroup's PriorityClass. If the gang-scheduling is set to the volcano, input is passed to `.spec.priorityClassName` in PodGroup for volcano, and if it is set to the scheduler-plugins, input isn't passed to PodGroup for scheduler-plugins.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scheduleTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulerTimeoutSeconds defines the maximal time of members to wait before run the PodGroup. If the gang-scheduling is set to the scheduler-plugins, input is passed to `.spec.scheduleTimeoutSeconds` in PodGroup for the scheduler-plugins, and if it is set to the volcano, input isn't passed to PodGroup.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_ServiceMesh(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceMesh is the service mesh injecting sidecars in the pods of the MPIJob. The containers of the launcher and the workers start once the sidecar is ready.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. An empty selector matches all objects. A null selector matches no objects.",
+							Description: "Provider is the service mesh. Options are \"Istio\" and \"Linkerd\".",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"nativeSidecar": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NativeSidecar requests the sidecar as a native sidecar, an init container that Kubernetes stops when the launcher container exits. Requires Kubernetes 1.29 and a mesh supporting it. Otherwise, the launcher container calls the shutdown endpoint of the sidecar once its command exits, which requires the command of the launcher container to be set and curl or wget in its image. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"provider"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_RunPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_ServiceTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RunPolicy encapsulates various runtime policies of the distributed training job, for example how to clean up resources and how long the job can stay active.",
+				Description: "ServiceTemplate customizes the headless Service of the MPIJob. Its clusterIP and selector are set by the operator.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cleanPodPolicy": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanPodPolicy defines the policy to kill pods after the job completes. Default to Running.",
-							Type:        []string{"string"},
+							Description: "Labels are set in the Service. The labels set by the operator take precedence.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are set in the Service.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"publishNotReadyAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublishNotReadyAddresses resolves the hostnames of the pods before they are ready, like for the workers without readiness probes of applications that contact each other while they start. Defaults to false.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"ttlSecondsAfterFinished": {
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "TTLSecondsAfterFinished is the TTL to clean up jobs. It may take extra ReconcilePeriod seconds for the cleanup, since reconcile gets called periodically. Default to infinite.",
+							Description: "Ports of the Service, like the ports of the metrics exporters of the workers scraped through the Service.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.ServicePort"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ServicePort"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_Watchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Watchdog detects the MPI processes of a pod that make no progress, like ranks blocked in a collective that never completes. The MPI processes are stalled when no file of the heartbeat directory was modified for the timeout or, without heartbeat directory, when they didn't use any CPU for the timeout. As NCCL busy-waits, a heartbeat is needed to detect hung collectives on GPUs.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is how long the MPI processes can make no progress before they are stalled. Defaults to 1800.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"activeDeadlineSeconds": {
+					"heartbeatDir": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the duration in seconds relative to the startTime that the job may be active before the system tries to terminate it; value must be positive integer.",
+							Description: "HeartbeatDir is a directory, mounted in the launcher and workers, in which the MPI processes modify files as they make progress, like $HEARTBEAT_DIR/rank-$OMPI_COMM_WORLD_RANK after each step. The watchdog only starts once a file is written.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is what the operator does once the MPI processes are stalled. Options are \"Event\" (default), which sets the Stalled condition and records an event, and \"Restart\", which also restarts the launcher and the workers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_WorkerGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerGroup is a group of workers with their own pod template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the group, set in the training.kubeflow.org/worker-group label of its workers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of workers of the group.",
+							Default:     0,
 							Type:        []string{"integer"},
-							Format:      "int64",
+							Format:      "int32",
 						},
 					},
-					"backoffLimit": {
+					"slotsPerWorker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional number of retries before marking this job failed.",
+							Description: "SlotsPerWorker is the number of slots of each worker of the group in the hostfile. Defaults to the SlotsPerWorker of the MPIJob.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"schedulingPolicy": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulingPolicy defines the policy related to scheduling, e.g. gang-scheduling",
-							Ref:         ref("github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy"),
+							Description: "Template is the pod template of the workers of the group. Its schema is left out of the CRD to keep it small, and the operator validates it.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.PodTemplateSpec"),
 						},
 					},
-					"suspend": {
+				},
+				Required: []string{"name", "replicas", "template"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.PodTemplateSpec"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_WorkerNaming(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerNaming is the pattern of the names of the workers: <prefix>[<job hash>-]<index>.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "suspend specifies whether the MPIJob controller should create Pods or not. If a MPIJob is created with suspend set to true, no Pods are created by the MPIJob controller. If a MPIJob is suspended after creation (i.e. the flag goes from false to true), the MPIJob controller will delete all active Pods and PodGroups associated with this MPIJob. Also, it will suspend the Launcher Job. Users must design their workload to gracefully handle this. Suspending a Job will reset the StartTime field of the MPIJob.\n\nDefaults to false.",
-							Type:        []string{"boolean"},
+							Description: "Prefix starts the names of the workers. The names must be unique in the namespace, so the prefix should include the name of the MPIJob, unless IncludeJobHash is true. Defaults to <mpijob>-worker-.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"managedBy": {
+					"indexWidth": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedBy is used to indicate the controller or entity that manages a MPIJob. The value must be either empty, 'kubeflow.org/mpi-operator' or 'kueue.x-k8s.io/multikueue'. The mpi-operator reconciles a MPIJob which doesn't have this field at all or the field value is the reserved string 'kubeflow.org/mpi-operator', but delegates reconciling the MPIJob with 'kueue.x-k8s.io/multikueue' to the Kueue. The field is immutable.",
-							Type:        []string{"string"},
+							Description: "IndexWidth pads the indexes of the workers with zeros up to the width, like <mpijob>-worker-007 with 3. Defaults to 0, which doesn't pad them.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"includeJobHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncludeJobHash adds 8 hexadecimal digits hashing the UID of the MPIJob after the prefix, so that the names differ between MPIJobs, including the ones recreated with the same name. Defaults to false.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1.SchedulingPolicy"},
 	}
 }
 
-func schema_pkg_apis_kubeflow_v2beta1_SchedulingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_kubeflow_v2beta1_WorkerOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SchedulingPolicy encapsulates various scheduling policies of the distributed training job, for example `minAvailable` for gang-scheduling. Now, it supports only for volcano and scheduler-plugins.",
+				Description: "WorkerOverride replaces fields of the worker pod template for the workers with an index between StartIndex and EndIndex.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"minAvailable": {
+					"startIndex": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinAvailable defines the minimal number of member to run the PodGroup. If the gang-scheduling isn't empty, input is passed to `.spec.minMember` in PodGroup. Note that, when using this field, you need to make sure the application supports resizing (e.g., Elastic Horovod).\n\nIf not set, it defaults to the number of workers.",
+							Description: "StartIndex is the index of the first worker of the range.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"queue": {
+					"endIndex": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Queue defines the queue name to allocate resource for PodGroup. If the gang-scheduling is set to the volcano, input is passed to `.spec.queue` in PodGroup for the volcano, and if it is set to the scheduler-plugins, input isn't passed to PodGroup.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "EndIndex is the index of the last worker of the range, included. Defaults to StartIndex.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"minResources": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinResources defines the minimal resources of members to run the PodGroup. If the gang-scheduling isn't empty, input is passed to `.spec.minResources` in PodGroup for scheduler-plugins.",
+							Description: "Resources are merged into the resources of the first container, taking precedence for each resource name.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env is merged into the environment variables of the first container, replacing the variables with the same name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is merged into the node selector of the worker pod template, taking precedence.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"priorityClass": {
+				},
+				Required: []string{"startIndex"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_pkg_apis_kubeflow_v2beta1_WorkerPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPool is a group of workers placed on the same cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PriorityClass defines the PodGroup's PriorityClass. If the gang-scheduling is set to the volcano, input is passed to `.spec.priorityClassName` in PodGroup for volcano, and if it is set to the scheduler-plugins, input isn't passed to PodGroup for scheduler-plugins.",
+							Description: "Name of the pool, set in the training.kubeflow.org/worker-pool label of its workers.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"scheduleTimeoutSeconds": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulerTimeoutSeconds defines the maximal time of members to wait before run the PodGroup. If the gang-scheduling is set to the scheduler-plugins, input is passed to `.spec.scheduleTimeoutSeconds` in PodGroup for the scheduler-plugins, and if it is set to the volcano, input isn't passed to PodGroup.",
+							Description: "Replicas is the number of workers of the pool.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the virtual nodes of the cluster. It is merged into the node selector of the worker pod template, taking precedence.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations are added to the workers of the pool, like the toleration of the taint of virtual-kubelet nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are set in the workers of the pool, taking precedence over the annotations of the worker pod template, like the multicluster.admiralty.io/elect annotation.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"name", "replicas"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/api/core/v1.Toleration"},
 	}
 }
 
@@ -16,9 +16,12 @@ package validation
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -41,6 +44,47 @@ var (
 		string(kubeflow.RestartPolicyNever),
 		string(kubeflow.RestartPolicyOnFailure))
 
+	validBenchmarkTypes = sets.NewString(
+		string(kubeflow.BenchmarkOSUBandwidth),
+		string(kubeflow.BenchmarkOSULatency),
+		string(kubeflow.BenchmarkNCCLAllReduce),
+	)
+
+	validServiceMeshProviders = sets.NewString(
+		string(kubeflow.ServiceMeshIstio),
+		string(kubeflow.ServiceMeshLinkerd),
+	)
+
+	validProfilingTools = sets.NewString(
+		string(kubeflow.ProfilingToolNone),
+		string(kubeflow.ProfilingToolNsys),
+		string(kubeflow.ProfilingToolHPCToolkit),
+		string(kubeflow.ProfilingToolCustom),
+	)
+
+	validWatchdogActions = sets.NewString(
+		string(kubeflow.WatchdogActionEvent),
+		string(kubeflow.WatchdogActionRestart),
+	)
+
+	validTCPInterfaceSelections = sets.NewString(
+		string(kubeflow.TCPInterfaceSelectionNone),
+		string(kubeflow.TCPInterfaceSelectionAuto),
+	)
+
+	skipAnnotations = []string{
+		kubeflow.SkipDiagnosticsAnnotation,
+		kubeflow.SkipPodDefaultsAnnotation,
+		kubeflow.SkipPodDisruptionBudgetAnnotation,
+		kubeflow.SkipNodeDrainsAnnotation,
+	}
+
+	imageAnnotations = []string{
+		kubeflow.ArtifactUploaderImageAnnotation,
+		kubeflow.WatchdogImageAnnotation,
+		kubeflow.LaunchWrapperImageAnnotation,
+	}
+
 	validManagedBy = sets.NewString(
 		string(kubeflow.MultiKueueController),
 		string(kubeflow.KubeflowJobController))
@@ -48,6 +92,7 @@ var (
 
 func ValidateMPIJob(job *kubeflow.MPIJob) field.ErrorList {
 	errs := validateMPIJobName(job)
+	errs = append(errs, validateOverrideAnnotations(job.Annotations, field.NewPath("metadata", "annotations"))...)
 	errs = append(errs, validateMPIJobSpec(&job.Spec, field.NewPath("spec"))...)
 	return errs
 }
@@ -59,14 +104,47 @@ func validateMPIJobName(job *kubeflow.MPIJob) field.ErrorList {
 		if workerSpec.Replicas != nil && *workerSpec.Replicas > 0 {
 			replicas = *workerSpec.Replicas
 		}
+		for _, group := range job.Spec.WorkerGroups {
+			replicas += max(group.Replicas, 0)
+		}
 	}
-	maximumPodHostname := fmt.Sprintf("%s-worker-%d", job.Name, replicas-1)
+	path := field.NewPath("metadata").Child("name")
+	value := job.ObjectMeta.Name
+	if naming := job.Spec.WorkerNaming; naming != nil {
+		if naming.IndexWidth != nil && (*naming.IndexWidth < 0 || *naming.IndexWidth > 10) {
+			return append(allErrs, field.Invalid(field.NewPath("spec", "workerNaming", "indexWidth"), *naming.IndexWidth, "must be between 0 and 10"))
+		}
+		if naming.Prefix != "" {
+			path = field.NewPath("spec", "workerNaming", "prefix")
+			value = naming.Prefix
+		}
+	}
+	maximumPodHostname := kubeflow.WorkerName(job, int(replicas-1))
 	if errs := apimachineryvalidation.IsDNS1035Label(maximumPodHostname); len(errs) > 0 {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata").Child("name"), job.ObjectMeta.Name, fmt.Sprintf("will not able to create pod and service with invalid DNS label %q: %s", maximumPodHostname, strings.Join(errs, ", "))))
+		allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("will not able to create pod and service with invalid DNS label %q: %s", maximumPodHostname, strings.Join(errs, ", "))))
 	}
 	return allErrs
 }
 
+// validateOverrideAnnotations validates the annotations overriding the
+// configuration of the operator, which the controller would otherwise ignore.
+func validateOverrideAnnotations(annotations map[string]string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for _, key := range skipAnnotations {
+		if value, ok := annotations[key]; ok {
+			if _, err := strconv.ParseBool(value); err != nil {
+				errs = append(errs, field.Invalid(path.Key(key), value, "must be true or false"))
+			}
+		}
+	}
+	for _, key := range imageAnnotations {
+		if value, ok := annotations[key]; ok && (value == "" || strings.ContainsAny(value, " \t\n")) {
+			errs = append(errs, field.Invalid(path.Key(key), value, "must be an image reference"))
+		}
+	}
+	return errs
+}
+
 func validateMPIJobSpec(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
 	errs := validateMPIReplicaSpecs(spec.MPIReplicaSpecs, path.Child("mpiReplicaSpecs"))
 	if spec.SlotsPerWorker == nil {
@@ -74,6 +152,11 @@ func validateMPIJobSpec(spec *kubeflow.MPIJobSpec, path *field.Path) field.Error
 	} else {
 		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*spec.SlotsPerWorker), path.Child("slotsPerWorker"))...)
 	}
+	if spec.SlotsPerWorkerDeviceClass != "" {
+		for _, msg := range apimachineryvalidation.IsDNS1123Subdomain(spec.SlotsPerWorkerDeviceClass) {
+			errs = append(errs, field.Invalid(path.Child("slotsPerWorkerDeviceClass"), spec.SlotsPerWorkerDeviceClass, msg))
+		}
+	}
 	errs = append(errs, validateRunPolicy(&spec.RunPolicy, path.Child("runPolicy"))...)
 	if spec.SSHAuthMountPath == "" {
 		errs = append(errs, field.Required(path.Child("sshAuthMountPath"), "must have a mount path for SSH credentials"))
@@ -81,6 +164,531 @@ func validateMPIJobSpec(spec *kubeflow.MPIJobSpec, path *field.Path) field.Error
 	if !validMPIImplementations.Has(string(spec.MPIImplementation)) {
 		errs = append(errs, field.NotSupported(path.Child("mpiImplementation"), spec.MPIImplementation, validMPIImplementations.List()))
 	}
+	if spec.Diagnostics != nil {
+		errs = append(errs, validateDiagnostics(spec, path.Child("diagnostics"))...)
+	}
+	if spec.Benchmark != nil {
+		errs = append(errs, validateBenchmark(spec, path.Child("benchmark"))...)
+	}
+	if spec.Network != nil {
+		errs = append(errs, validateNetwork(spec, path)...)
+	}
+	if spec.TCPInterfaceSelection != "" && !validTCPInterfaceSelections.Has(string(spec.TCPInterfaceSelection)) {
+		errs = append(errs, field.NotSupported(path.Child("tcpInterfaceSelection"), spec.TCPInterfaceSelection, validTCPInterfaceSelections.List()))
+	}
+	if spec.ServiceMesh != nil {
+		errs = append(errs, validateServiceMesh(spec, path)...)
+	}
+	if spec.MultiCluster != nil {
+		errs = append(errs, validateMultiCluster(spec, path)...)
+	}
+	if len(spec.WorkerGroups) > 0 {
+		errs = append(errs, validateWorkerGroups(spec, path)...)
+	}
+	if len(spec.WorkerOverrides) > 0 {
+		errs = append(errs, validateWorkerOverrides(spec, path)...)
+	}
+	if spec.LauncherJob != nil {
+		errs = append(errs, validateLauncherJob(spec, path)...)
+	}
+	if spec.Hooks != nil {
+		errs = append(errs, validateHook(spec.Hooks.PreRun, path.Child("hooks", "preRun"))...)
+		errs = append(errs, validateHook(spec.Hooks.PostRun, path.Child("hooks", "postRun"))...)
+	}
+	if spec.Artifacts != nil {
+		errs = append(errs, validateArtifacts(spec.Artifacts, path.Child("artifacts"))...)
+	}
+	if spec.Service != nil {
+		errs = append(errs, validateService(spec.Service, path.Child("service"))...)
+	}
+	if spec.Profiling != nil {
+		errs = append(errs, validateProfiling(spec.Profiling, path.Child("profiling"))...)
+	}
+	if spec.CoreDumps != nil {
+		errs = append(errs, validateCoreDumps(spec.CoreDumps, path.Child("coreDumps"))...)
+	}
+	if spec.Watchdog != nil {
+		errs = append(errs, validateWatchdog(spec.Watchdog, path.Child("watchdog"))...)
+	}
+	if spec.LaunchWrapper != nil {
+		errs = append(errs, validateLaunchWrapper(spec, path)...)
+	}
+	if spec.GPUPreCheck != nil {
+		errs = append(errs, validateGPUPreCheck(spec.GPUPreCheck, path.Child("gpuPreCheck"))...)
+	}
+	if spec.ClusterAutoscaler != nil && spec.ClusterAutoscaler.ProvisioningClassName != "" {
+		className := spec.ClusterAutoscaler.ProvisioningClassName
+		for _, msg := range apimachineryvalidation.IsDNS1123Subdomain(className) {
+			errs = append(errs, field.Invalid(path.Child("clusterAutoscaler", "provisioningClassName"), className, msg))
+		}
+	}
+	return errs
+}
+
+func validateServiceMesh(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	mesh := spec.ServiceMesh
+	if !validServiceMeshProviders.Has(string(mesh.Provider)) {
+		errs = append(errs, field.NotSupported(path.Child("serviceMesh", "provider"), mesh.Provider, validServiceMeshProviders.List()))
+	}
+	// The shutdown endpoint of the sidecar is called after the command of the
+	// launcher container, which can't wrap the entrypoint of the image.
+	if mesh.NativeSidecar == nil || !*mesh.NativeSidecar {
+		if launcher := spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher]; launcher != nil && len(launcher.Template.Spec.Containers) > 0 &&
+			len(launcher.Template.Spec.Containers[0].Command) == 0 && spec.Benchmark == nil {
+			commandPath := path.Child("mpiReplicaSpecs").Key(string(kubeflow.MPIReplicaTypeLauncher)).Child("template", "spec", "containers").Index(0).Child("command")
+			errs = append(errs, field.Required(commandPath, "must be set to shut down the service mesh sidecar, unless serviceMesh.nativeSidecar is true"))
+		}
+	}
+	return errs
+}
+
+func validateMultiCluster(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	poolsPath := path.Child("multiCluster", "workerPools")
+	if len(spec.MultiCluster.WorkerPools) == 0 {
+		errs = append(errs, field.Required(poolsPath, "must have at least one worker pool"))
+	}
+	names := sets.NewString()
+	var replicas int32
+	for i, pool := range spec.MultiCluster.WorkerPools {
+		poolPath := poolsPath.Index(i)
+		for _, msg := range apimachineryvalidation.IsDNS1123Label(pool.Name) {
+			errs = append(errs, field.Invalid(poolPath.Child("name"), pool.Name, msg))
+		}
+		if names.Has(pool.Name) {
+			errs = append(errs, field.Duplicate(poolPath.Child("name"), pool.Name))
+		}
+		names.Insert(pool.Name)
+		if pool.Replicas < 1 {
+			errs = append(errs, field.Invalid(poolPath.Child("replicas"), pool.Replicas, "must be greater than or equal to 1"))
+		}
+		replicas += pool.Replicas
+		errs = append(errs, metav1validation.ValidateLabels(pool.NodeSelector, poolPath.Child("nodeSelector"))...)
+		errs = append(errs, apivalidation.ValidateAnnotations(pool.Annotations, poolPath.Child("annotations"))...)
+	}
+	if worker := spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]; worker == nil {
+		errs = append(errs, field.Required(path.Child("mpiReplicaSpecs").Key(string(kubeflow.MPIReplicaTypeWorker)), "must have workers to place in the worker pools"))
+	} else if worker.Replicas != nil && replicas > *worker.Replicas {
+		errs = append(errs, field.Invalid(poolsPath, replicas, fmt.Sprintf("must have at most the %d replicas of the workers", *worker.Replicas)))
+	}
+	// Only Open MPI connects the workers back to the launcher by IP, instead
+	// of resolving its hostname.
+	if spec.MPIImplementation != kubeflow.MPIImplementationOpenMPI {
+		errs = append(errs, field.NotSupported(path.Child("mpiImplementation"), spec.MPIImplementation, []string{string(kubeflow.MPIImplementationOpenMPI)}))
+	}
+	// The hostfile has the IPs of the workers once they are ready.
+	if spec.LauncherCreationPolicy != kubeflow.LauncherCreationPolicyWaitForWorkersReady {
+		errs = append(errs, field.NotSupported(path.Child("launcherCreationPolicy"), spec.LauncherCreationPolicy, []string{string(kubeflow.LauncherCreationPolicyWaitForWorkersReady)}))
+	}
+	return errs
+}
+
+func validateWorkerGroups(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	groupsPath := path.Child("workerGroups")
+	if spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker] == nil {
+		errs = append(errs, field.Required(path.Child("mpiReplicaSpecs").Key(string(kubeflow.MPIReplicaTypeWorker)), "must have workers to set the restart policy of the worker groups"))
+	}
+	names := sets.NewString()
+	for i, group := range spec.WorkerGroups {
+		groupPath := groupsPath.Index(i)
+		for _, msg := range apimachineryvalidation.IsDNS1123Label(group.Name) {
+			errs = append(errs, field.Invalid(groupPath.Child("name"), group.Name, msg))
+		}
+		if names.Has(group.Name) {
+			errs = append(errs, field.Duplicate(groupPath.Child("name"), group.Name))
+		}
+		names.Insert(group.Name)
+		if group.Replicas < 1 {
+			errs = append(errs, field.Invalid(groupPath.Child("replicas"), group.Replicas, "must be greater than or equal to 1"))
+		}
+		if group.SlotsPerWorker != nil && *group.SlotsPerWorker < 1 {
+			errs = append(errs, field.Invalid(groupPath.Child("slotsPerWorker"), *group.SlotsPerWorker, "must be greater than or equal to 1"))
+		}
+		errs = append(errs, validatePodTemplate(&group.Template, groupPath.Child("template"))...)
+		errs = append(errs, validateResourceClaims(&group.Template.Spec, groupPath.Child("template", "spec"))...)
+	}
+	// A ProvisioningRequest provisions the nodes of a single pod template.
+	if spec.ClusterAutoscaler != nil && spec.ClusterAutoscaler.ProvisioningClassName != "" {
+		errs = append(errs, field.Forbidden(path.Child("clusterAutoscaler", "provisioningClassName"), "must not be set with worker groups"))
+	}
+	return errs
+}
+
+// validatePodTemplate validates the pod templates left out of the schema of
+// the CRD, which the API server doesn't validate.
+func validatePodTemplate(tmpl *corev1.PodTemplateSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, metav1validation.ValidateLabels(tmpl.Labels, path.Child("metadata", "labels"))...)
+	errs = append(errs, apivalidation.ValidateAnnotations(tmpl.Annotations, path.Child("metadata", "annotations"))...)
+	specPath := path.Child("spec")
+	if len(tmpl.Spec.Containers) == 0 {
+		errs = append(errs, field.Required(specPath.Child("containers"), "must define at least one container"))
+	}
+	names := sets.NewString()
+	validateNames := func(containers []corev1.Container, containersPath *field.Path) {
+		for i, container := range containers {
+			namePath := containersPath.Index(i).Child("name")
+			for _, msg := range apimachineryvalidation.IsDNS1123Label(container.Name) {
+				errs = append(errs, field.Invalid(namePath, container.Name, msg))
+			}
+			if names.Has(container.Name) {
+				errs = append(errs, field.Duplicate(namePath, container.Name))
+			}
+			names.Insert(container.Name)
+		}
+	}
+	validateNames(tmpl.Spec.InitContainers, specPath.Child("initContainers"))
+	validateNames(tmpl.Spec.Containers, specPath.Child("containers"))
+	return errs
+}
+
+func validateWorkerOverrides(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	overridesPath := path.Child("workerOverrides")
+	worker := spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+	if worker == nil {
+		errs = append(errs, field.Required(path.Child("mpiReplicaSpecs").Key(string(kubeflow.MPIReplicaTypeWorker)), "must have workers to override"))
+	}
+	// The workers of the groups follow the Worker replicas.
+	var replicas int32
+	if worker != nil && worker.Replicas != nil {
+		replicas = *worker.Replicas
+		for _, group := range spec.WorkerGroups {
+			replicas += group.Replicas
+		}
+	}
+	for i, override := range spec.WorkerOverrides {
+		overridePath := overridesPath.Index(i)
+		if override.StartIndex < 0 {
+			errs = append(errs, field.Invalid(overridePath.Child("startIndex"), override.StartIndex, "must be greater than or equal to 0"))
+		}
+		end := override.StartIndex
+		if override.EndIndex != nil {
+			end = *override.EndIndex
+			if end < override.StartIndex {
+				errs = append(errs, field.Invalid(overridePath.Child("endIndex"), end, "must be greater than or equal to startIndex"))
+			}
+		}
+		if worker != nil && worker.Replicas != nil && end >= replicas {
+			errs = append(errs, field.Invalid(overridePath, end, fmt.Sprintf("must only include the indexes of the %d workers", replicas)))
+		}
+		errs = append(errs, metav1validation.ValidateLabels(override.NodeSelector, overridePath.Child("nodeSelector"))...)
+		names := sets.NewString()
+		for j, env := range override.Env {
+			envPath := overridePath.Child("env").Index(j)
+			for _, msg := range apimachineryvalidation.IsEnvVarName(env.Name) {
+				errs = append(errs, field.Invalid(envPath.Child("name"), env.Name, msg))
+			}
+			if names.Has(env.Name) {
+				errs = append(errs, field.Duplicate(envPath.Child("name"), env.Name))
+			}
+			names.Insert(env.Name)
+		}
+	}
+	return errs
+}
+
+func validateLauncherJob(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	jobPath := path.Child("launcherJob")
+	errs = append(errs, metav1validation.ValidateLabels(spec.LauncherJob.Labels, jobPath.Child("labels"))...)
+	errs = append(errs, apivalidation.ValidateAnnotations(spec.LauncherJob.Annotations, jobPath.Child("annotations"))...)
+	policy := spec.LauncherJob.PodFailurePolicy
+	if policy == nil {
+		return errs
+	}
+	policyPath := jobPath.Child("podFailurePolicy")
+	if len(policy.Rules) == 0 {
+		errs = append(errs, field.Required(policyPath.Child("rules"), "must have at least one rule"))
+	}
+	if launcher := spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher]; launcher != nil && launcher.RestartPolicy != kubeflow.RestartPolicyNever {
+		errs = append(errs, field.Invalid(path.Child("mpiReplicaSpecs").Key(string(kubeflow.MPIReplicaTypeLauncher)).Child("restartPolicy"), launcher.RestartPolicy, fmt.Sprintf("must be %s to use a podFailurePolicy", kubeflow.RestartPolicyNever)))
+	}
+	return errs
+}
+
+func validateHook(hook *kubeflow.Hook, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if hook == nil {
+		return errs
+	}
+	switch {
+	case hook.Container == nil && hook.JobTemplate == nil:
+		errs = append(errs, field.Required(path, "must have a container or a jobTemplate"))
+	case hook.Container != nil && hook.JobTemplate != nil:
+		errs = append(errs, field.Forbidden(path.Child("jobTemplate"), "can't be set with container"))
+	case hook.Container != nil:
+		containerPath := path.Child("container")
+		for _, msg := range apimachineryvalidation.IsDNS1123Label(hook.Container.Name) {
+			errs = append(errs, field.Invalid(containerPath.Child("name"), hook.Container.Name, msg))
+		}
+		if hook.Container.Image == "" {
+			errs = append(errs, field.Required(containerPath.Child("image"), "must have an image"))
+		}
+	default:
+		jobPath := path.Child("jobTemplate")
+		errs = append(errs, metav1validation.ValidateLabels(hook.JobTemplate.Labels, jobPath.Child("metadata", "labels"))...)
+		errs = append(errs, apivalidation.ValidateAnnotations(hook.JobTemplate.Annotations, jobPath.Child("metadata", "annotations"))...)
+		errs = append(errs, validatePodTemplate(&hook.JobTemplate.Spec.Template, jobPath.Child("spec", "template"))...)
+		if backoffLimit := hook.JobTemplate.Spec.BackoffLimit; backoffLimit != nil {
+			errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*backoffLimit), jobPath.Child("spec", "backoffLimit"))...)
+		}
+		if policy := hook.JobTemplate.Spec.Template.Spec.RestartPolicy; policy == corev1.RestartPolicyAlways {
+			errs = append(errs, field.NotSupported(jobPath.Child("spec", "template", "spec", "restartPolicy"), policy, []corev1.RestartPolicy{corev1.RestartPolicyNever, corev1.RestartPolicyOnFailure}))
+		}
+	}
+	if hook.BackoffLimit != nil {
+		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*hook.BackoffLimit), path.Child("backoffLimit"))...)
+	}
+	if hook.ActiveDeadlineSeconds != nil && *hook.ActiveDeadlineSeconds <= 0 {
+		errs = append(errs, field.Invalid(path.Child("activeDeadlineSeconds"), *hook.ActiveDeadlineSeconds, "must be greater than 0"))
+	}
+	return errs
+}
+
+func validateService(svc *kubeflow.ServiceTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	errs = append(errs, metav1validation.ValidateLabels(svc.Labels, path.Child("labels"))...)
+	errs = append(errs, apivalidation.ValidateAnnotations(svc.Annotations, path.Child("annotations"))...)
+	names := sets.New[string]()
+	for i, port := range svc.Ports {
+		portPath := path.Child("ports").Index(i)
+		switch {
+		case port.Name == "" && len(svc.Ports) > 1:
+			errs = append(errs, field.Required(portPath.Child("name"), "must be set when there are multiple ports"))
+		case port.Name != "":
+			for _, msg := range apimachineryvalidation.IsDNS1123Label(port.Name) {
+				errs = append(errs, field.Invalid(portPath.Child("name"), port.Name, msg))
+			}
+			if names.Has(port.Name) {
+				errs = append(errs, field.Duplicate(portPath.Child("name"), port.Name))
+			}
+			names.Insert(port.Name)
+		}
+		for _, msg := range apimachineryvalidation.IsValidPortNum(int(port.Port)) {
+			errs = append(errs, field.Invalid(portPath.Child("port"), port.Port, msg))
+		}
+	}
+	return errs
+}
+
+func validateArtifacts(artifacts *kubeflow.Artifacts, path *field.Path) field.ErrorList {
+	errs := validateDestination(artifacts.Destination, path.Child("destination"))
+	if artifacts.ResultsPath != "" && !strings.HasPrefix(artifacts.ResultsPath, "/") {
+		errs = append(errs, field.Invalid(path.Child("resultsPath"), artifacts.ResultsPath, "must be an absolute path"))
+	}
+	if name := artifacts.CredentialsSecretName; name != "" {
+		for _, msg := range apimachineryvalidation.IsDNS1123Subdomain(name) {
+			errs = append(errs, field.Invalid(path.Child("credentialsSecretName"), name, msg))
+		}
+	}
+	return errs
+}
+
+func validateProfiling(profiling *kubeflow.Profiling, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if profiling.Tool != "" && !validProfilingTools.Has(string(profiling.Tool)) {
+		errs = append(errs, field.NotSupported(path.Child("tool"), profiling.Tool, validProfilingTools.List()))
+	}
+	if profiling.Tool == kubeflow.ProfilingToolCustom && len(profiling.Command) == 0 {
+		errs = append(errs, field.Required(path.Child("command"), "must have a command for the Custom tool"))
+	} else if profiling.Tool != kubeflow.ProfilingToolCustom && len(profiling.Command) > 0 {
+		errs = append(errs, field.Forbidden(path.Child("command"), "is only supported with the Custom tool"))
+	}
+	if name := profiling.OutputClaimName; name != "" {
+		for _, msg := range apimachineryvalidation.IsDNS1123Subdomain(name) {
+			errs = append(errs, field.Invalid(path.Child("outputClaimName"), name, msg))
+		}
+	}
+	return errs
+}
+
+func validateCoreDumps(coreDumps *kubeflow.CoreDumps, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if coreDumps.Path != "" && !strings.HasPrefix(coreDumps.Path, "/") {
+		errs = append(errs, field.Invalid(path.Child("path"), coreDumps.Path, "must be an absolute path"))
+	}
+	switch {
+	case coreDumps.ClaimName == "" && coreDumps.Destination == "":
+		errs = append(errs, field.Required(path, "must have a claimName or a destination"))
+	case coreDumps.ClaimName != "" && coreDumps.Destination != "":
+		errs = append(errs, field.Forbidden(path.Child("destination"), "can't be set with claimName"))
+	case coreDumps.Destination != "":
+		errs = append(errs, validateDestination(coreDumps.Destination, path.Child("destination"))...)
+	}
+	if name := coreDumps.ClaimName; name != "" {
+		for _, msg := range apimachineryvalidation.IsDNS1123Subdomain(name) {
+			errs = append(errs, field.Invalid(path.Child("claimName"), name, msg))
+		}
+	}
+	if name := coreDumps.CredentialsSecretName; name != "" {
+		for _, msg := range apimachineryvalidation.IsDNS1123Subdomain(name) {
+			errs = append(errs, field.Invalid(path.Child("credentialsSecretName"), name, msg))
+		}
+	}
+	return errs
+}
+
+func validateGPUPreCheck(check *kubeflow.GPUPreCheck, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if check.ResourceName != "" {
+		for _, msg := range apimachineryvalidation.IsQualifiedName(string(check.ResourceName)) {
+			errs = append(errs, field.Invalid(path.Child("resourceName"), check.ResourceName, msg))
+		}
+	}
+	if check.MaxFailedNodes != nil {
+		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*check.MaxFailedNodes), path.Child("maxFailedNodes"))...)
+	}
+	return errs
+}
+
+func validateWatchdog(watchdog *kubeflow.Watchdog, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if watchdog.TimeoutSeconds != nil && *watchdog.TimeoutSeconds < 60 {
+		errs = append(errs, field.Invalid(path.Child("timeoutSeconds"), *watchdog.TimeoutSeconds, "must be greater than or equal to 60"))
+	}
+	if watchdog.HeartbeatDir != "" && !strings.HasPrefix(watchdog.HeartbeatDir, "/") {
+		errs = append(errs, field.Invalid(path.Child("heartbeatDir"), watchdog.HeartbeatDir, "must be an absolute path"))
+	}
+	if watchdog.Action != "" && !validWatchdogActions.Has(string(watchdog.Action)) {
+		errs = append(errs, field.NotSupported(path.Child("action"), watchdog.Action, validWatchdogActions.List()))
+	}
+	return errs
+}
+
+func validateLaunchWrapper(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	wrapper := spec.LaunchWrapper
+	wrapperPath := path.Child("launchWrapper")
+	if wrapper.MaxAttempts != nil && *wrapper.MaxAttempts < 1 {
+		errs = append(errs, field.Invalid(wrapperPath.Child("maxAttempts"), *wrapper.MaxAttempts, "must be greater than or equal to 1"))
+	}
+	if wrapper.AttemptTimeoutSeconds != nil && *wrapper.AttemptTimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(wrapperPath.Child("attemptTimeoutSeconds"), *wrapper.AttemptTimeoutSeconds, "must be greater than or equal to 1"))
+	}
+	if wrapper.RetryDelaySeconds != nil && *wrapper.RetryDelaySeconds < 0 {
+		errs = append(errs, field.Invalid(wrapperPath.Child("retryDelaySeconds"), *wrapper.RetryDelaySeconds, "must be greater than or equal to 0"))
+	}
+	for i, code := range wrapper.RetryOnExitCodes {
+		if code < 1 || code > 255 {
+			errs = append(errs, field.Invalid(wrapperPath.Child("retryOnExitCodes").Index(i), code, "must be between 1 and 255"))
+		}
+	}
+	// The image entrypoint, which the wrapper would replace, isn't known.
+	launcher := spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher]
+	if launcher != nil && len(launcher.Template.Spec.Containers) > 0 && len(launcher.Template.Spec.Containers[0].Command) == 0 {
+		errs = append(errs, field.Required(path.Child("mpiReplicaSpecs").Key(string(kubeflow.MPIReplicaTypeLauncher)).Child("template", "spec", "containers").Index(0).Child("command"), "must be set when launchWrapper is set"))
+	}
+	return errs
+}
+
+// validateDestination validates the URL of a bucket and prefix in object
+// storage, as s3://bucket/prefix or gs://bucket/prefix.
+func validateDestination(destination string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	bucket, found := strings.CutPrefix(destination, "s3://")
+	if !found {
+		bucket, found = strings.CutPrefix(destination, "gs://")
+	}
+	if !found {
+		errs = append(errs, field.Invalid(path, destination, "must start with s3:// or gs://"))
+	} else if bucket, _, _ = strings.Cut(bucket, "/"); bucket == "" {
+		errs = append(errs, field.Invalid(path, destination, "must have a bucket"))
+	}
+	return errs
+}
+
+// maxInterfaceNameLength is the maximum length of the name of a Linux network
+// interface.
+const maxInterfaceNameLength = 15
+
+func validateNetwork(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	attachmentsPath := path.Child("network", "attachments")
+	if len(spec.Network.Attachments) == 0 {
+		errs = append(errs, field.Required(attachmentsPath, "must have at least one attachment"))
+	}
+	interfaces := sets.NewString()
+	for i, attachment := range spec.Network.Attachments {
+		attachmentPath := attachmentsPath.Index(i)
+		for _, msg := range apimachineryvalidation.IsDNS1123Subdomain(attachment.Name) {
+			errs = append(errs, field.Invalid(attachmentPath.Child("name"), attachment.Name, msg))
+		}
+		if attachment.Namespace != "" {
+			for _, msg := range apimachineryvalidation.IsDNS1123Label(attachment.Namespace) {
+				errs = append(errs, field.Invalid(attachmentPath.Child("namespace"), attachment.Namespace, msg))
+			}
+		}
+		iface := attachment.Interface
+		if iface == "" {
+			errs = append(errs, field.Required(attachmentPath.Child("interface"), "must have an interface name"))
+		} else if len(iface) > maxInterfaceNameLength || strings.ContainsAny(iface, "/@,: \t\n") {
+			errs = append(errs, field.Invalid(attachmentPath.Child("interface"), iface, fmt.Sprintf("must be a network interface name of at most %d characters", maxInterfaceNameLength)))
+		} else if interfaces.Has(iface) {
+			errs = append(errs, field.Duplicate(attachmentPath.Child("interface"), iface))
+		}
+		interfaces.Insert(iface)
+	}
+	// The attachments would replace the networks of the pod template.
+	replicaTypes := []kubeflow.MPIReplicaType{kubeflow.MPIReplicaTypeWorker}
+	if spec.RunLauncherAsWorker != nil && *spec.RunLauncherAsWorker {
+		replicaTypes = append(replicaTypes, kubeflow.MPIReplicaTypeLauncher)
+	}
+	for _, rType := range replicaTypes {
+		if replica := spec.MPIReplicaSpecs[rType]; replica != nil {
+			if _, ok := replica.Template.Annotations[kubeflow.NetworksAnnotation]; ok {
+				annotationPath := path.Child("mpiReplicaSpecs").Key(string(rType)).Child("template", "metadata", "annotations").Key(kubeflow.NetworksAnnotation)
+				errs = append(errs, field.Forbidden(annotationPath, "can't be set with network.attachments"))
+			}
+		}
+	}
+	return errs
+}
+
+func validateBenchmark(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	benchmark := spec.Benchmark
+	if !validBenchmarkTypes.Has(string(benchmark.Type)) {
+		errs = append(errs, field.NotSupported(path.Child("type"), benchmark.Type, validBenchmarkTypes.List()))
+	}
+	var workers int32
+	if worker := spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]; worker != nil && worker.Replicas != nil {
+		workers = *worker.Replicas
+	}
+	switch benchmark.Type {
+	case kubeflow.BenchmarkOSUBandwidth, kubeflow.BenchmarkOSULatency:
+		// The point-to-point benchmarks measure the link between exactly two
+		// processes on different hosts.
+		if workers != 2 {
+			errs = append(errs, field.Invalid(path.Child("type"), benchmark.Type, "requires exactly 2 workers"))
+		}
+		if spec.SlotsPerWorker != nil && *spec.SlotsPerWorker != 1 {
+			errs = append(errs, field.Invalid(path.Child("type"), benchmark.Type, "requires 1 slot per worker"))
+		}
+		if spec.RunLauncherAsWorker != nil && *spec.RunLauncherAsWorker {
+			errs = append(errs, field.Invalid(path.Child("type"), benchmark.Type, "can't run with runLauncherAsWorker"))
+		}
+	case kubeflow.BenchmarkNCCLAllReduce:
+		if workers == 0 {
+			errs = append(errs, field.Invalid(path.Child("type"), benchmark.Type, "requires workers"))
+		}
+	}
+	return errs
+}
+
+func validateDiagnostics(spec *kubeflow.MPIJobSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	diagnostics := spec.Diagnostics
+	if diagnostics.MinBusBandwidthGBps != nil && *diagnostics.MinBusBandwidthGBps <= 0 {
+		errs = append(errs, field.Invalid(path.Child("minBusBandwidthGBps"), *diagnostics.MinBusBandwidthGBps, "must be greater than or equal to 1"))
+	}
+	if diagnostics.Enabled != nil && *diagnostics.Enabled &&
+		spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker] == nil &&
+		(spec.RunLauncherAsWorker == nil || !*spec.RunLauncherAsWorker) {
+		errs = append(errs, field.Invalid(path.Child("enabled"), *diagnostics.Enabled, "requires workers or runLauncherAsWorker"))
+	}
 	return errs
 }
 
@@ -101,6 +709,9 @@ func validateRunPolicy(policy *kubeflow.RunPolicy, path *field.Path) field.Error
 	if policy.BackoffLimit != nil {
 		errs = append(errs, apivalidation.ValidateNonnegativeField(int64(*policy.BackoffLimit), path.Child("backoffLimit"))...)
 	}
+	if policy.TerminationGracePeriodSeconds != nil {
+		errs = append(errs, apivalidation.ValidateNonnegativeField(*policy.TerminationGracePeriodSeconds, path.Child("terminationGracePeriodSeconds"))...)
+	}
 	if policy.ManagedBy != nil {
 		if !validManagedBy.Has(*policy.ManagedBy) {
 			errs = append(errs, field.NotSupported(path.Child("managedBy"), *policy.ManagedBy, validManagedBy.List()))
@@ -156,5 +767,28 @@ func validateReplicaSpec(spec *kubeflow.ReplicaSpec, path *field.Path) field.Err
 	if len(spec.Template.Spec.Containers) == 0 {
 		errs = append(errs, field.Required(path.Child("template", "spec", "containers"), "must define at least one container"))
 	}
+	errs = append(errs, validateResourceClaims(&spec.Template.Spec, path.Child("template", "spec"))...)
+	return errs
+}
+
+// validateResourceClaims checks that the claims used by the containers are
+// claims of the pod, which are created for each pod from their templates.
+func validateResourceClaims(spec *corev1.PodSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	podClaims := sets.NewString()
+	for _, claim := range spec.ResourceClaims {
+		podClaims.Insert(claim.Name)
+	}
+	validateContainers := func(containers []corev1.Container, path *field.Path) {
+		for i, c := range containers {
+			for j, claim := range c.Resources.Claims {
+				if !podClaims.Has(claim.Name) {
+					errs = append(errs, field.NotFound(path.Index(i).Child("resources", "claims").Index(j).Child("name"), claim.Name))
+				}
+			}
+		}
+	}
+	validateContainers(spec.InitContainers, path.Child("initContainers"))
+	validateContainers(spec.Containers, path.Child("containers"))
 	return errs
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -189,14 +190,16 @@ func TestValidateMPIJob(t *testing.T) {
 				Spec: kubeflow.MPIJobSpec{
 					SlotsPerWorker: ptr.To[int32](2),
 					RunPolicy: kubeflow.RunPolicy{
-						CleanPodPolicy:          ptr.To[kubeflow.CleanPodPolicy]("unknown"),
-						TTLSecondsAfterFinished: ptr.To[int32](-1),
-						ActiveDeadlineSeconds:   ptr.To[int64](-1),
-						BackoffLimit:            ptr.To[int32](-1),
-						ManagedBy:               ptr.To("invalid.com/controller"),
+						CleanPodPolicy:                ptr.To[kubeflow.CleanPodPolicy]("unknown"),
+						TTLSecondsAfterFinished:       ptr.To[int32](-1),
+						ActiveDeadlineSeconds:         ptr.To[int64](-1),
+						BackoffLimit:                  ptr.To[int32](-1),
+						ManagedBy:                     ptr.To("invalid.com/controller"),
+						TerminationGracePeriodSeconds: ptr.To[int64](-1),
 					},
-					SSHAuthMountPath:  "/root/.ssh",
-					MPIImplementation: kubeflow.MPIImplementation("Unknown"),
+					SSHAuthMountPath:      "/root/.ssh",
+					MPIImplementation:     kubeflow.MPIImplementation("Unknown"),
+					TCPInterfaceSelection: kubeflow.TCPInterfaceSelection("Manual"),
 					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
 						kubeflow.MPIReplicaTypeLauncher: {
 							Replicas:      ptr.To[int32](1),
@@ -240,6 +243,10 @@ func TestValidateMPIJob(t *testing.T) {
 					Type:  field.ErrorTypeInvalid,
 					Field: "spec.runPolicy.backoffLimit",
 				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.runPolicy.terminationGracePeriodSeconds",
+				},
 				{
 					Type:  field.ErrorTypeNotSupported,
 					Field: "spec.runPolicy.managedBy",
@@ -248,6 +255,10 @@ func TestValidateMPIJob(t *testing.T) {
 					Type:  field.ErrorTypeNotSupported,
 					Field: "spec.mpiImplementation",
 				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.tcpInterfaceSelection",
+				},
 			},
 		},
 		"empty replica specs": {
@@ -400,6 +411,1127 @@ func TestValidateMPIJob(t *testing.T) {
 				Field: "metadata.name",
 			}},
 		},
+		"invalid worker naming prefix": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					WorkerNaming: &kubeflow.WorkerNaming{
+						Prefix:         "Foo_",
+						IncludeJobHash: ptr.To(true),
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{{
+				Type:  field.ErrorTypeInvalid,
+				Field: "spec.workerNaming.prefix",
+			}},
+		},
+		"invalid worker naming index width": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					WorkerNaming: &kubeflow.WorkerNaming{
+						IndexWidth: ptr.To[int32](11),
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{{
+				Type:  field.ErrorTypeInvalid,
+				Field: "spec.workerNaming.indexWidth",
+			}},
+		},
+		"invalid diagnostics": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Diagnostics: &kubeflow.Diagnostics{
+						Enabled:             ptr.To(true),
+						MinBusBandwidthGBps: ptr.To[int32](0),
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.diagnostics.minBusBandwidthGBps",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.diagnostics.enabled",
+				},
+			},
+		},
+		"invalid benchmark": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Benchmark: &kubeflow.Benchmark{
+						Type: kubeflow.BenchmarkOSUBandwidth,
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](4),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.benchmark.type",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.benchmark.type",
+				},
+			},
+		},
+		"invalid network": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Network: &kubeflow.Network{
+						Attachments: []kubeflow.NetworkAttachment{
+							{Name: "Bad_Name", Interface: "net1"},
+							{Name: "sriov", Namespace: "networks", Interface: "net1"},
+							{Name: "sriov", Interface: "interface-name-too-long"},
+							{Name: "sriov"},
+						},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](2),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: map[string]string{
+										kubeflow.NetworksAnnotation: "sriov",
+									},
+								},
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.network.attachments[0].name",
+				},
+				{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "spec.network.attachments[1].interface",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.network.attachments[2].interface",
+				},
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.network.attachments[3].interface",
+				},
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.mpiReplicaSpecs[Worker].template.metadata.annotations[k8s.v1.cni.cncf.io/networks]",
+				},
+			},
+		},
+		"invalid service mesh": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					ServiceMesh: &kubeflow.ServiceMesh{
+						Provider: "Consul",
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{Args: []string{"mpirun", "pi"}}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.serviceMesh.provider",
+				},
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.mpiReplicaSpecs[Launcher].template.spec.containers[0].command",
+				},
+			},
+		},
+		"invalid resource claims and provisioning class": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker:            ptr.To[int32](2),
+					SlotsPerWorkerDeviceClass: "GPU_class",
+					ClusterAutoscaler: &kubeflow.ClusterAutoscaler{
+						ProvisioningClassName: "Best_Effort",
+					},
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](2),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									ResourceClaims: []corev1.PodResourceClaim{
+										{Name: "gpus", ResourceClaimTemplateName: ptr.To("gpus")},
+									},
+									InitContainers: []corev1.Container{{
+										Resources: corev1.ResourceRequirements{
+											Claims: []corev1.ResourceClaim{{Name: "nics"}},
+										},
+									}},
+									Containers: []corev1.Container{{
+										Resources: corev1.ResourceRequirements{
+											Claims: []corev1.ResourceClaim{{Name: "gpus"}, {Name: "nics"}},
+										},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeNotFound,
+					Field: "spec.mpiReplicaSpecs[Worker].template.spec.initContainers[0].resources.claims[0].name",
+				},
+				{
+					Type:  field.ErrorTypeNotFound,
+					Field: "spec.mpiReplicaSpecs[Worker].template.spec.containers[0].resources.claims[1].name",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.slotsPerWorkerDeviceClass",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.clusterAutoscaler.provisioningClassName",
+				},
+			},
+		},
+		"invalid worker overrides": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					WorkerOverrides: []kubeflow.WorkerOverride{
+						{StartIndex: 0, NodeSelector: map[string]string{"bad key": "io"}},
+						{StartIndex: 1, EndIndex: ptr.To[int32](0)},
+						{StartIndex: 1, EndIndex: ptr.To[int32](2)},
+						{StartIndex: 0, Env: []corev1.EnvVar{{Name: "FOO"}, {Name: "FOO"}, {Name: "1BAR"}}},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](2),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerOverrides[0].nodeSelector",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerOverrides[1].endIndex",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerOverrides[2]",
+				},
+				{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "spec.workerOverrides[3].env[1].name",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerOverrides[3].env[2].name",
+				},
+			},
+		},
+		"invalid worker groups": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					ClusterAutoscaler: &kubeflow.ClusterAutoscaler{ProvisioningClassName: "queued-provisioning.gke.io"},
+					WorkerGroups: []kubeflow.WorkerGroup{
+						{
+							Name:     "GPU",
+							Replicas: 2,
+							Template: corev1.PodTemplateSpec{
+								ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"gpu type": "a100"}},
+								Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "worker"}, {Name: "worker"}}},
+							},
+						},
+						{Name: "cpu", Replicas: 0, SlotsPerWorker: ptr.To[int32](0)},
+						{Name: "cpu", Replicas: 1, Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "worker"}}}}},
+					},
+					WorkerOverrides: []kubeflow.WorkerOverride{
+						{StartIndex: 3, EndIndex: ptr.To[int32](4)},
+						{StartIndex: 5},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](2),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerGroups[0].name",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerGroups[0].template.metadata.labels",
+				},
+				{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "spec.workerGroups[0].template.spec.containers[1].name",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerGroups[1].replicas",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerGroups[1].slotsPerWorker",
+				},
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.workerGroups[1].template.spec.containers",
+				},
+				{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "spec.workerGroups[2].name",
+				},
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.clusterAutoscaler.provisioningClassName",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.workerOverrides[1]",
+				},
+			},
+		},
+		"invalid launcher job": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					LauncherJob: &kubeflow.LauncherJobTemplate{
+						Labels:           map[string]string{"bad key": "io"},
+						Annotations:      map[string]string{"bad key": "io"},
+						PodFailurePolicy: &batchv1.PodFailurePolicy{},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyOnFailure,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.launcherJob.labels",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.launcherJob.annotations",
+				},
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.launcherJob.podFailurePolicy.rules",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.mpiReplicaSpecs[Launcher].restartPolicy",
+				},
+			},
+		},
+		"invalid hooks": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Hooks: &kubeflow.Hooks{
+						PreRun: &kubeflow.Hook{
+							Container:    &corev1.Container{Name: "Stage_Data"},
+							BackoffLimit: ptr.To[int32](-1),
+						},
+						PostRun: &kubeflow.Hook{
+							Container:             &corev1.Container{Name: "upload", Image: "upload:latest"},
+							ActiveDeadlineSeconds: ptr.To[int64](0),
+						},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.hooks.preRun.container.name",
+				},
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.hooks.preRun.container.image",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.hooks.preRun.backoffLimit",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.hooks.postRun.activeDeadlineSeconds",
+				},
+			},
+		},
+		"invalid hook job templates": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Hooks: &kubeflow.Hooks{
+						PreRun: &kubeflow.Hook{
+							Container:   &corev1.Container{Name: "stage", Image: "stage:latest"},
+							JobTemplate: &batchv1.JobTemplateSpec{},
+						},
+						PostRun: &kubeflow.Hook{
+							JobTemplate: &batchv1.JobTemplateSpec{
+								ObjectMeta: metav1.ObjectMeta{
+									Labels: map[string]string{"team/a/b": "upload"},
+								},
+								Spec: batchv1.JobSpec{
+									BackoffLimit: ptr.To[int32](-1),
+									Template: corev1.PodTemplateSpec{
+										Spec: corev1.PodSpec{
+											RestartPolicy: corev1.RestartPolicyAlways,
+										},
+									},
+								},
+							},
+						},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.hooks.preRun.jobTemplate",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.hooks.postRun.jobTemplate.metadata.labels",
+				},
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.hooks.postRun.jobTemplate.spec.template.spec.containers",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.hooks.postRun.jobTemplate.spec.backoffLimit",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.hooks.postRun.jobTemplate.spec.template.spec.restartPolicy",
+				},
+			},
+		},
+		"hook without container": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Hooks: &kubeflow.Hooks{
+						PreRun: &kubeflow.Hook{
+							BackoffLimit: ptr.To[int32](1),
+						},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.hooks.preRun",
+				},
+			},
+		},
+		"invalid artifacts": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Artifacts: &kubeflow.Artifacts{
+						Destination:           "s3:///results",
+						ResultsPath:           "results",
+						CredentialsSecretName: "S3_Credentials",
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.artifacts.destination",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.artifacts.resultsPath",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.artifacts.credentialsSecretName",
+				},
+			},
+		},
+		"invalid profiling": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Profiling: &kubeflow.Profiling{
+						Tool:            kubeflow.ProfilingToolCustom,
+						OutputClaimName: "Profiles",
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.profiling.command",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.profiling.outputClaimName",
+				},
+			},
+		},
+		"invalid core dumps": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					CoreDumps: &kubeflow.CoreDumps{
+						Path:        "cores",
+						ClaimName:   "cores",
+						Destination: "s3://cores",
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.coreDumps.path",
+				},
+				{
+					Type:  field.ErrorTypeForbidden,
+					Field: "spec.coreDumps.destination",
+				},
+			},
+		},
+		"invalid watchdog": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Watchdog: &kubeflow.Watchdog{
+						TimeoutSeconds: ptr.To[int32](10),
+						HeartbeatDir:   "heartbeat",
+						Action:         "Kill",
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.watchdog.timeoutSeconds",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.watchdog.heartbeatDir",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.watchdog.action",
+				},
+			},
+		},
+		"invalid GPU pre-check": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					GPUPreCheck: &kubeflow.GPUPreCheck{
+						ResourceName:   "nvidia.com/gpu devices",
+						MaxFailedNodes: ptr.To[int32](-1),
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.gpuPreCheck.resourceName",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.gpuPreCheck.maxFailedNodes",
+				},
+			},
+		},
+		"invalid launch wrapper": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					LaunchWrapper: &kubeflow.LaunchWrapper{
+						MaxAttempts:           ptr.To[int32](0),
+						AttemptTimeoutSeconds: ptr.To[int32](0),
+						RetryDelaySeconds:     ptr.To[int32](-1),
+						RetryOnExitCodes:      []int32{1, 256},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.launchWrapper.maxAttempts",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.launchWrapper.attemptTimeoutSeconds",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.launchWrapper.retryDelaySeconds",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.launchWrapper.retryOnExitCodes[1]",
+				},
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.mpiReplicaSpecs[Launcher].template.spec.containers[0].command",
+				},
+			},
+		},
+		"invalid override annotations": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+					Annotations: map[string]string{
+						kubeflow.SkipDiagnosticsAnnotation:       "yes",
+						kubeflow.SkipNodeDrainsAnnotation:        "true",
+						kubeflow.ArtifactUploaderImageAnnotation: "",
+					},
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[training.kubeflow.org/skip-diagnostics]",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[training.kubeflow.org/artifact-uploader-image]",
+				},
+			},
+		},
+		"invalid service": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:  "/home/mpiuser/.ssh",
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					Service: &kubeflow.ServiceTemplate{
+						Labels: map[string]string{"team": "-ml"},
+						Ports: []corev1.ServicePort{
+							{Name: "metrics", Port: 9090},
+							{Name: "metrics", Port: 0},
+							{Port: 8080},
+						},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.service.labels",
+				},
+				{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "spec.service.ports[1].name",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.service.ports[1].port",
+				},
+				{
+					Type:  field.ErrorTypeRequired,
+					Field: "spec.service.ports[2].name",
+				},
+			},
+		},
+		"invalid multi-cluster": {
+			job: kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker: ptr.To[int32](2),
+					RunPolicy: kubeflow.RunPolicy{
+						CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyRunning),
+					},
+					SSHAuthMountPath:       "/home/mpiuser/.ssh",
+					MPIImplementation:      kubeflow.MPIImplementationIntel,
+					LauncherCreationPolicy: kubeflow.LauncherCreationPolicyAtStartup,
+					MultiCluster: &kubeflow.MultiCluster{
+						WorkerPools: []kubeflow.WorkerPool{
+							{Name: "east", Replicas: 2},
+							{Name: "east", NodeSelector: map[string]string{"bad key": "east"}},
+						},
+					},
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas:      ptr.To[int32](1),
+							RestartPolicy: kubeflow.RestartPolicyNever,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrs: field.ErrorList{
+				{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "spec.multiCluster.workerPools[1].name",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.multiCluster.workerPools[1].replicas",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.multiCluster.workerPools[1].nodeSelector",
+				},
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.multiCluster.workerPools",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.mpiImplementation",
+				},
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.launcherCreationPolicy",
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
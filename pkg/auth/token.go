@@ -0,0 +1,34 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth holds the helpers shared by the HTTP servers of the operator,
+// like the dashboard and the gateway, that authenticate their clients with a
+// static token.
+package auth
+
+import (
+	"os"
+	"strings"
+)
+
+// ReadToken reads the token from the file, typically mounted from a Secret,
+// without the surrounding whitespace. The error names the file, and the
+// callers say which token it is.
+func ReadToken(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
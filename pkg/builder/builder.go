@@ -0,0 +1,294 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package builder constructs v2beta1 MPIJobs for the platforms that submit
+// them from Go, without assembling the nested replica specs by hand:
+//
+//	mpiJob, err := builder.NewMPIJob("default", "pi").
+//		SlotsPerWorker(1).
+//		Launcher(builder.Container("launcher", "mpioperator/mpi-pi:openmpi", "mpirun", "-n", "2", "/home/mpiuser/pi")).
+//		Workers(2, builder.Container("worker", "mpioperator/mpi-pi:openmpi", "/usr/sbin/sshd", "-De")).
+//		Env("OMP_NUM_THREADS", "1").
+//		Build()
+package builder
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/validation"
+)
+
+// MPIJobBuilder builds an MPIJob. Its methods modify the builder and return
+// it, so that they can be chained.
+type MPIJobBuilder struct {
+	job *kubeflow.MPIJob
+	env []corev1.EnvVar
+}
+
+// ReplicaOption modifies the replica spec of the launcher or the workers.
+type ReplicaOption func(*kubeflow.ReplicaSpec)
+
+// NewMPIJob starts building an MPIJob with the given namespace and name.
+func NewMPIJob(namespace, name string) *MPIJobBuilder {
+	return &MPIJobBuilder{
+		job: &kubeflow.MPIJob{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: kubeflow.SchemeGroupVersion.String(),
+				Kind:       kubeflow.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: kubeflow.MPIJobSpec{
+				MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{},
+			},
+		},
+	}
+}
+
+// Label sets a label of the MPIJob.
+func (b *MPIJobBuilder) Label(key, value string) *MPIJobBuilder {
+	if b.job.Labels == nil {
+		b.job.Labels = map[string]string{}
+	}
+	b.job.Labels[key] = value
+	return b
+}
+
+// Annotation sets an annotation of the MPIJob.
+func (b *MPIJobBuilder) Annotation(key, value string) *MPIJobBuilder {
+	if b.job.Annotations == nil {
+		b.job.Annotations = map[string]string{}
+	}
+	b.job.Annotations[key] = value
+	return b
+}
+
+// SlotsPerWorker sets the number of MPI processes of each worker.
+func (b *MPIJobBuilder) SlotsPerWorker(slots int32) *MPIJobBuilder {
+	b.job.Spec.SlotsPerWorker = ptr.To(slots)
+	return b
+}
+
+// MPIImplementation sets the MPI implementation that the hostfile and the
+// environment variables are generated for.
+func (b *MPIJobBuilder) MPIImplementation(impl kubeflow.MPIImplementation) *MPIJobBuilder {
+	b.job.Spec.MPIImplementation = impl
+	return b
+}
+
+// SSHAuthMountPath sets where the SSH credentials are mounted, which must be
+// the .ssh directory of the user running the MPI processes.
+func (b *MPIJobBuilder) SSHAuthMountPath(path string) *MPIJobBuilder {
+	b.job.Spec.SSHAuthMountPath = path
+	return b
+}
+
+// RunLauncherAsWorker makes the launcher run MPI processes too.
+func (b *MPIJobBuilder) RunLauncherAsWorker() *MPIJobBuilder {
+	b.job.Spec.RunLauncherAsWorker = ptr.To(true)
+	return b
+}
+
+// Launcher sets the launcher, which runs the given container.
+func (b *MPIJobBuilder) Launcher(container corev1.Container, opts ...ReplicaOption) *MPIJobBuilder {
+	b.job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher] = newReplicaSpec(1, container, opts)
+	return b
+}
+
+// Workers sets the given number of workers, which run the given container.
+func (b *MPIJobBuilder) Workers(replicas int32, container corev1.Container, opts ...ReplicaOption) *MPIJobBuilder {
+	b.job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker] = newReplicaSpec(replicas, container, opts)
+	return b
+}
+
+func newReplicaSpec(replicas int32, container corev1.Container, opts []ReplicaOption) *kubeflow.ReplicaSpec {
+	spec := &kubeflow.ReplicaSpec{
+		Replicas: ptr.To(replicas),
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{container},
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(spec)
+	}
+	return spec
+}
+
+// Env sets an environment variable in all the containers of the launcher and
+// the workers, unless they already set it.
+func (b *MPIJobBuilder) Env(name, value string) *MPIJobBuilder {
+	b.env = append(b.env, corev1.EnvVar{Name: name, Value: value})
+	return b
+}
+
+// CleanPodPolicy sets which pods are deleted when the MPIJob finishes.
+func (b *MPIJobBuilder) CleanPodPolicy(policy kubeflow.CleanPodPolicy) *MPIJobBuilder {
+	b.job.Spec.RunPolicy.CleanPodPolicy = ptr.To(policy)
+	return b
+}
+
+// BackoffLimit sets the number of retries of the launcher before the MPIJob
+// fails.
+func (b *MPIJobBuilder) BackoffLimit(limit int32) *MPIJobBuilder {
+	b.job.Spec.RunPolicy.BackoffLimit = ptr.To(limit)
+	return b
+}
+
+// ActiveDeadline sets how long the MPIJob can run before it is failed.
+func (b *MPIJobBuilder) ActiveDeadline(d time.Duration) *MPIJobBuilder {
+	b.job.Spec.RunPolicy.ActiveDeadlineSeconds = ptr.To(int64(d.Seconds()))
+	return b
+}
+
+// TTLAfterFinished sets how long the finished MPIJob is kept before it is
+// deleted.
+func (b *MPIJobBuilder) TTLAfterFinished(d time.Duration) *MPIJobBuilder {
+	b.job.Spec.RunPolicy.TTLSecondsAfterFinished = ptr.To(int32(d.Seconds()))
+	return b
+}
+
+// Suspend creates the MPIJob suspended, so that its pods aren't created until
+// it is resumed.
+func (b *MPIJobBuilder) Suspend() *MPIJobBuilder {
+	b.job.Spec.RunPolicy.Suspend = ptr.To(true)
+	return b
+}
+
+// Queue sets the queue of the gang scheduler that the MPIJob is submitted to.
+func (b *MPIJobBuilder) Queue(queue string) *MPIJobBuilder {
+	if b.job.Spec.RunPolicy.SchedulingPolicy == nil {
+		b.job.Spec.RunPolicy.SchedulingPolicy = &kubeflow.SchedulingPolicy{}
+	}
+	b.job.Spec.RunPolicy.SchedulingPolicy.Queue = queue
+	return b
+}
+
+// Build returns the MPIJob, or the validation errors that the API server
+// would reject it with. The MPIJob isn't defaulted, so that the API server
+// sets the defaults of its version.
+func (b *MPIJobBuilder) Build() (*kubeflow.MPIJob, error) {
+	job := b.job.DeepCopy()
+	for _, spec := range job.Spec.MPIReplicaSpecs {
+		if spec == nil {
+			continue
+		}
+		for i := range spec.Template.Spec.Containers {
+			addEnv(&spec.Template.Spec.Containers[i], b.env)
+		}
+	}
+	defaulted := job.DeepCopy()
+	kubeflow.SetDefaults_MPIJob(defaulted)
+	if errs := validation.ValidateMPIJob(defaulted); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return job, nil
+}
+
+func addEnv(container *corev1.Container, env []corev1.EnvVar) {
+	for _, e := range env {
+		if !hasEnv(container, e.Name) {
+			container.Env = append(container.Env, e)
+		}
+	}
+}
+
+func hasEnv(container *corev1.Container, name string) bool {
+	for _, e := range container.Env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Container returns a container with the given name and image, running the
+// given command.
+func Container(name, image string, command ...string) corev1.Container {
+	return corev1.Container{
+		Name:    name,
+		Image:   image,
+		Command: command,
+	}
+}
+
+// WithResources sets the requests and limits of the first container, like
+// the CPUs, the memory and the GPUs of each worker.
+func WithResources(requests, limits corev1.ResourceList) ReplicaOption {
+	return func(spec *kubeflow.ReplicaSpec) {
+		resources := &spec.Template.Spec.Containers[0].Resources
+		resources.Requests = requests
+		resources.Limits = limits
+	}
+}
+
+// WithGPUs requests the given number of NVIDIA GPUs for the first container.
+func WithGPUs(gpus int64) ReplicaOption {
+	return func(spec *kubeflow.ReplicaSpec) {
+		resources := &spec.Template.Spec.Containers[0].Resources
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Limits["nvidia.com/gpu"] = *resource.NewQuantity(gpus, resource.DecimalSI)
+	}
+}
+
+// WithRestartPolicy sets the restart policy of the pods.
+func WithRestartPolicy(policy kubeflow.RestartPolicy) ReplicaOption {
+	return func(spec *kubeflow.ReplicaSpec) {
+		spec.RestartPolicy = policy
+	}
+}
+
+// WithNodeSelector sets the node selector of the pods.
+func WithNodeSelector(selector map[string]string) ReplicaOption {
+	return func(spec *kubeflow.ReplicaSpec) {
+		spec.Template.Spec.NodeSelector = selector
+	}
+}
+
+// WithTolerations adds tolerations to the pods.
+func WithTolerations(tolerations ...corev1.Toleration) ReplicaOption {
+	return func(spec *kubeflow.ReplicaSpec) {
+		spec.Template.Spec.Tolerations = append(spec.Template.Spec.Tolerations, tolerations...)
+	}
+}
+
+// WithServiceAccountName sets the service account of the pods.
+func WithServiceAccountName(name string) ReplicaOption {
+	return func(spec *kubeflow.ReplicaSpec) {
+		spec.Template.Spec.ServiceAccountName = name
+	}
+}
+
+// WithPodLabels sets labels of the pods.
+func WithPodLabels(labels map[string]string) ReplicaOption {
+	return func(spec *kubeflow.ReplicaSpec) {
+		if spec.Template.Labels == nil {
+			spec.Template.Labels = map[string]string{}
+		}
+		for k, v := range labels {
+			spec.Template.Labels[k] = v
+		}
+	}
+}
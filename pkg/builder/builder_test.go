@@ -0,0 +1,143 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestBuild(t *testing.T) {
+	mpiJob, err := NewMPIJob("default", "pi").
+		Label("team", "ml").
+		SlotsPerWorker(2).
+		Launcher(Container("launcher", "pi", "mpirun", "/pi")).
+		Workers(2, Container("worker", "pi", "/usr/sbin/sshd", "-De"), WithGPUs(1), WithNodeSelector(map[string]string{"pool": "gpu"})).
+		Env("OMP_NUM_THREADS", "1").
+		CleanPodPolicy(kubeflow.CleanPodPolicyRunning).
+		TTLAfterFinished(time.Minute).
+		Queue("research").
+		Build()
+	if err != nil {
+		t.Fatalf("Build(): %v", err)
+	}
+	env := []corev1.EnvVar{{Name: "OMP_NUM_THREADS", Value: "1"}}
+	want := &kubeflow.MPIJob{
+		TypeMeta: metav1.TypeMeta{APIVersion: "kubeflow.org/v2beta1", Kind: "MPIJob"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "pi",
+			Labels:    map[string]string{"team": "ml"},
+		},
+		Spec: kubeflow.MPIJobSpec{
+			SlotsPerWorker: ptr.To[int32](2),
+			RunPolicy: kubeflow.RunPolicy{
+				CleanPodPolicy:          ptr.To(kubeflow.CleanPodPolicyRunning),
+				TTLSecondsAfterFinished: ptr.To[int32](60),
+				SchedulingPolicy:        &kubeflow.SchedulingPolicy{Queue: "research"},
+			},
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Replicas: ptr.To[int32](1),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name:    "launcher",
+								Image:   "pi",
+								Command: []string{"mpirun", "/pi"},
+								Env:     env,
+							}},
+						},
+					},
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Replicas: ptr.To[int32](2),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							NodeSelector: map[string]string{"pool": "gpu"},
+							Containers: []corev1.Container{{
+								Name:    "worker",
+								Image:   "pi",
+								Command: []string{"/usr/sbin/sshd", "-De"},
+								Env:     env,
+								Resources: corev1.ResourceRequirements{
+									Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, mpiJob); diff != "" {
+		t.Errorf("Unexpected MPIJob (-want,+got):\n%s", diff)
+	}
+}
+
+func TestBuildKeepsContainerEnv(t *testing.T) {
+	launcher := Container("launcher", "pi", "mpirun")
+	launcher.Env = []corev1.EnvVar{{Name: "OMP_NUM_THREADS", Value: "4"}}
+	mpiJob, err := NewMPIJob("default", "pi").
+		Launcher(launcher).
+		Env("OMP_NUM_THREADS", "1").
+		Build()
+	if err != nil {
+		t.Fatalf("Build(): %v", err)
+	}
+	got := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.Containers[0].Env
+	if diff := cmp.Diff(launcher.Env, got); diff != "" {
+		t.Errorf("Unexpected env of the launcher (-want,+got):\n%s", diff)
+	}
+}
+
+func TestBuildInvalid(t *testing.T) {
+	cases := map[string]struct {
+		builder *MPIJobBuilder
+		wantErr string
+	}{
+		"no launcher": {
+			builder: NewMPIJob("default", "pi").Workers(2, Container("worker", "pi")),
+			wantErr: "spec.mpiReplicaSpecs[Launcher]",
+		},
+		"no workers": {
+			builder: NewMPIJob("default", "pi").
+				Launcher(Container("launcher", "pi")).
+				Workers(0, Container("worker", "pi")),
+			wantErr: "spec.mpiReplicaSpecs[Worker].replicas",
+		},
+		"invalid name": {
+			builder: NewMPIJob("default", "Pi").Launcher(Container("launcher", "pi")),
+			wantErr: "metadata.name",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.builder.Build()
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Build() returned %v, want an error containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -24,11 +24,22 @@ import (
 // JobStatusApplyConfiguration represents a declarative configuration of the JobStatus type for use
 // with apply.
 type JobStatusApplyConfiguration struct {
-	Conditions        []JobConditionApplyConfiguration                                  `json:"conditions,omitempty"`
-	ReplicaStatuses   map[kubeflowv2beta1.MPIReplicaType]*kubeflowv2beta1.ReplicaStatus `json:"replicaStatuses,omitempty"`
-	StartTime         *v1.Time                                                          `json:"startTime,omitempty"`
-	CompletionTime    *v1.Time                                                          `json:"completionTime,omitempty"`
-	LastReconcileTime *v1.Time                                                          `json:"lastReconcileTime,omitempty"`
+	Conditions             []JobConditionApplyConfiguration                                  `json:"conditions,omitempty"`
+	State                  *kubeflowv2beta1.JobConditionType                                 `json:"state,omitempty"`
+	ReplicaStatuses        map[kubeflowv2beta1.MPIReplicaType]*kubeflowv2beta1.ReplicaStatus `json:"replicaStatuses,omitempty"`
+	StartTime              *v1.Time                                                          `json:"startTime,omitempty"`
+	CompletionTime         *v1.Time                                                          `json:"completionTime,omitempty"`
+	Duration               *v1.Duration                                                      `json:"duration,omitempty"`
+	LauncherRestartCount   *int32                                                            `json:"launcherRestartCount,omitempty"`
+	RestartCount           *int32                                                            `json:"restartCount,omitempty"`
+	FailureReasonClass     *kubeflowv2beta1.FailureReasonClass                               `json:"failureReasonClass,omitempty"`
+	Progress               *string                                                           `json:"progress,omitempty"`
+	GPUUsage               *GPUUsageApplyConfiguration                                       `json:"gpuUsage,omitempty"`
+	CoreDumps              []CoreDumpApplyConfiguration                                      `json:"coreDumps,omitempty"`
+	LaunchResult           *LaunchResultApplyConfiguration                                   `json:"launchResult,omitempty"`
+	GPUPreCheckFailedNodes []string                                                          `json:"gpuPreCheckFailedNodes,omitempty"`
+	ResourceUsage          *ResourceUsageApplyConfiguration                                  `json:"resourceUsage,omitempty"`
+	LastReconcileTime      *v1.Time                                                          `json:"lastReconcileTime,omitempty"`
 }
 
 // JobStatusApplyConfiguration constructs a declarative configuration of the JobStatus type for use with
@@ -50,6 +61,14 @@ func (b *JobStatusApplyConfiguration) WithConditions(values ...*JobConditionAppl
 	return b
 }
 
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithState(value kubeflowv2beta1.JobConditionType) *JobStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
 // WithReplicaStatuses puts the entries into the ReplicaStatuses field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the ReplicaStatuses field,
@@ -80,6 +99,93 @@ func (b *JobStatusApplyConfiguration) WithCompletionTime(value v1.Time) *JobStat
 	return b
 }
 
+// WithDuration sets the Duration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Duration field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithDuration(value v1.Duration) *JobStatusApplyConfiguration {
+	b.Duration = &value
+	return b
+}
+
+// WithLauncherRestartCount sets the LauncherRestartCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LauncherRestartCount field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithLauncherRestartCount(value int32) *JobStatusApplyConfiguration {
+	b.LauncherRestartCount = &value
+	return b
+}
+
+// WithRestartCount sets the RestartCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartCount field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithRestartCount(value int32) *JobStatusApplyConfiguration {
+	b.RestartCount = &value
+	return b
+}
+
+// WithFailureReasonClass sets the FailureReasonClass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailureReasonClass field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithFailureReasonClass(value kubeflowv2beta1.FailureReasonClass) *JobStatusApplyConfiguration {
+	b.FailureReasonClass = &value
+	return b
+}
+
+// WithProgress sets the Progress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Progress field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithProgress(value string) *JobStatusApplyConfiguration {
+	b.Progress = &value
+	return b
+}
+
+// WithGPUUsage sets the GPUUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GPUUsage field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithGPUUsage(value *GPUUsageApplyConfiguration) *JobStatusApplyConfiguration {
+	b.GPUUsage = value
+	return b
+}
+
+// WithCoreDumps adds the given value to the CoreDumps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CoreDumps field.
+func (b *JobStatusApplyConfiguration) WithCoreDumps(values ...*CoreDumpApplyConfiguration) *JobStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCoreDumps")
+		}
+		b.CoreDumps = append(b.CoreDumps, *values[i])
+	}
+	return b
+}
+
+// WithLaunchResult sets the LaunchResult field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LaunchResult field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithLaunchResult(value *LaunchResultApplyConfiguration) *JobStatusApplyConfiguration {
+	b.LaunchResult = value
+	return b
+}
+
+// WithGPUPreCheckFailedNodes adds the given value to the GPUPreCheckFailedNodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the GPUPreCheckFailedNodes field.
+func (b *JobStatusApplyConfiguration) WithGPUPreCheckFailedNodes(values ...string) *JobStatusApplyConfiguration {
+	for i := range values {
+		b.GPUPreCheckFailedNodes = append(b.GPUPreCheckFailedNodes, values[i])
+	}
+	return b
+}
+
+// WithResourceUsage sets the ResourceUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceUsage field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithResourceUsage(value *ResourceUsageApplyConfiguration) *JobStatusApplyConfiguration {
+	b.ResourceUsage = value
+	return b
+}
+
 // WithLastReconcileTime sets the LastReconcileTime field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the LastReconcileTime field is set to the value of the last call.
@@ -17,8 +17,11 @@
 package v2beta1
 
 import (
+	kubeflowv2beta1 "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	internal "github.com/kubeflow/mpi-operator/pkg/client/applyconfiguration/internal"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -42,6 +45,42 @@ func MPIJob(name, namespace string) *MPIJobApplyConfiguration {
 	return b
 }
 
+// ExtractMPIJob extracts the applied configuration owned by fieldManager from
+// mPIJob. If no managedFields are found in mPIJob for fieldManager, a
+// MPIJobApplyConfiguration is returned with only the Name, Namespace (if applicable),
+// APIVersion and Kind populated. It is possible that no managed fields were found for because other
+// field managers have taken ownership of all the fields previously owned by fieldManager, or because
+// the fieldManager never owned fields any fields.
+// mPIJob must be a unmodified MPIJob API object that was retrieved from the Kubernetes API.
+// ExtractMPIJob provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+// Experimental!
+func ExtractMPIJob(mPIJob *kubeflowv2beta1.MPIJob, fieldManager string) (*MPIJobApplyConfiguration, error) {
+	return extractMPIJob(mPIJob, fieldManager, "")
+}
+
+// ExtractMPIJobStatus is the same as ExtractMPIJob except
+// that it extracts the status subresource applied configuration.
+// Experimental!
+func ExtractMPIJobStatus(mPIJob *kubeflowv2beta1.MPIJob, fieldManager string) (*MPIJobApplyConfiguration, error) {
+	return extractMPIJob(mPIJob, fieldManager, "status")
+}
+
+func extractMPIJob(mPIJob *kubeflowv2beta1.MPIJob, fieldManager string, subresource string) (*MPIJobApplyConfiguration, error) {
+	b := &MPIJobApplyConfiguration{}
+	err := managedfields.ExtractInto(mPIJob, internal.Parser().Type("com.github.kubeflow.mpi-operator.pkg.apis.kubeflow.v2beta1.MPIJob"), fieldManager, b, subresource)
+	if err != nil {
+		return nil, err
+	}
+	b.WithName(mPIJob.Name)
+	b.WithNamespace(mPIJob.Namespace)
+
+	b.WithKind("MPIJob")
+	b.WithAPIVersion("kubeflow.org/v2beta1")
+	return b, nil
+}
+
 // WithKind sets the Kind field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Kind field is set to the value of the last call.
@@ -23,13 +23,33 @@ import (
 // MPIJobSpecApplyConfiguration represents a declarative configuration of the MPIJobSpec type for use
 // with apply.
 type MPIJobSpecApplyConfiguration struct {
-	SlotsPerWorker         *int32                                                          `json:"slotsPerWorker,omitempty"`
-	RunLauncherAsWorker    *bool                                                           `json:"runLauncherAsWorker,omitempty"`
-	RunPolicy              *RunPolicyApplyConfiguration                                    `json:"runPolicy,omitempty"`
-	MPIReplicaSpecs        map[kubeflowv2beta1.MPIReplicaType]*kubeflowv2beta1.ReplicaSpec `json:"mpiReplicaSpecs,omitempty"`
-	SSHAuthMountPath       *string                                                         `json:"sshAuthMountPath,omitempty"`
-	LauncherCreationPolicy *kubeflowv2beta1.LauncherCreationPolicy                         `json:"launcherCreationPolicy,omitempty"`
-	MPIImplementation      *kubeflowv2beta1.MPIImplementation                              `json:"mpiImplementation,omitempty"`
+	SlotsPerWorker            *int32                                                          `json:"slotsPerWorker,omitempty"`
+	SlotsPerWorkerDeviceClass *string                                                         `json:"slotsPerWorkerDeviceClass,omitempty"`
+	RunLauncherAsWorker       *bool                                                           `json:"runLauncherAsWorker,omitempty"`
+	RunPolicy                 *RunPolicyApplyConfiguration                                    `json:"runPolicy,omitempty"`
+	MPIReplicaSpecs           map[kubeflowv2beta1.MPIReplicaType]*kubeflowv2beta1.ReplicaSpec `json:"mpiReplicaSpecs,omitempty"`
+	SSHAuthMountPath          *string                                                         `json:"sshAuthMountPath,omitempty"`
+	LauncherCreationPolicy    *kubeflowv2beta1.LauncherCreationPolicy                         `json:"launcherCreationPolicy,omitempty"`
+	MPIImplementation         *kubeflowv2beta1.MPIImplementation                              `json:"mpiImplementation,omitempty"`
+	Diagnostics               *DiagnosticsApplyConfiguration                                  `json:"diagnostics,omitempty"`
+	Benchmark                 *BenchmarkApplyConfiguration                                    `json:"benchmark,omitempty"`
+	Network                   *NetworkApplyConfiguration                                      `json:"network,omitempty"`
+	TCPInterfaceSelection     *kubeflowv2beta1.TCPInterfaceSelection                          `json:"tcpInterfaceSelection,omitempty"`
+	ServiceMesh               *ServiceMeshApplyConfiguration                                  `json:"serviceMesh,omitempty"`
+	MultiCluster              *MultiClusterApplyConfiguration                                 `json:"multiCluster,omitempty"`
+	ClusterAutoscaler         *ClusterAutoscalerApplyConfiguration                            `json:"clusterAutoscaler,omitempty"`
+	WorkerOverrides           []WorkerOverrideApplyConfiguration                              `json:"workerOverrides,omitempty"`
+	WorkerGroups              []WorkerGroupApplyConfiguration                                 `json:"workerGroups,omitempty"`
+	WorkerNaming              *WorkerNamingApplyConfiguration                                 `json:"workerNaming,omitempty"`
+	LauncherJob               *LauncherJobTemplateApplyConfiguration                          `json:"launcherJob,omitempty"`
+	Hooks                     *HooksApplyConfiguration                                        `json:"hooks,omitempty"`
+	Artifacts                 *ArtifactsApplyConfiguration                                    `json:"artifacts,omitempty"`
+	Service                   *ServiceTemplateApplyConfiguration                              `json:"service,omitempty"`
+	Profiling                 *ProfilingApplyConfiguration                                    `json:"profiling,omitempty"`
+	CoreDumps                 *CoreDumpsApplyConfiguration                                    `json:"coreDumps,omitempty"`
+	Watchdog                  *WatchdogApplyConfiguration                                     `json:"watchdog,omitempty"`
+	LaunchWrapper             *LaunchWrapperApplyConfiguration                                `json:"launchWrapper,omitempty"`
+	GPUPreCheck               *GPUPreCheckApplyConfiguration                                  `json:"gpuPreCheck,omitempty"`
 }
 
 // MPIJobSpecApplyConfiguration constructs a declarative configuration of the MPIJobSpec type for use with
@@ -46,6 +66,14 @@ func (b *MPIJobSpecApplyConfiguration) WithSlotsPerWorker(value int32) *MPIJobSp
 	return b
 }
 
+// WithSlotsPerWorkerDeviceClass sets the SlotsPerWorkerDeviceClass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SlotsPerWorkerDeviceClass field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithSlotsPerWorkerDeviceClass(value string) *MPIJobSpecApplyConfiguration {
+	b.SlotsPerWorkerDeviceClass = &value
+	return b
+}
+
 // WithRunLauncherAsWorker sets the RunLauncherAsWorker field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RunLauncherAsWorker field is set to the value of the last call.
@@ -99,3 +127,165 @@ func (b *MPIJobSpecApplyConfiguration) WithMPIImplementation(value kubeflowv2bet
 	b.MPIImplementation = &value
 	return b
 }
+
+// WithDiagnostics sets the Diagnostics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Diagnostics field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithDiagnostics(value *DiagnosticsApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.Diagnostics = value
+	return b
+}
+
+// WithBenchmark sets the Benchmark field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Benchmark field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithBenchmark(value *BenchmarkApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.Benchmark = value
+	return b
+}
+
+// WithNetwork sets the Network field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Network field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithNetwork(value *NetworkApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.Network = value
+	return b
+}
+
+// WithTCPInterfaceSelection sets the TCPInterfaceSelection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TCPInterfaceSelection field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithTCPInterfaceSelection(value kubeflowv2beta1.TCPInterfaceSelection) *MPIJobSpecApplyConfiguration {
+	b.TCPInterfaceSelection = &value
+	return b
+}
+
+// WithServiceMesh sets the ServiceMesh field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceMesh field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithServiceMesh(value *ServiceMeshApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.ServiceMesh = value
+	return b
+}
+
+// WithMultiCluster sets the MultiCluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MultiCluster field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithMultiCluster(value *MultiClusterApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.MultiCluster = value
+	return b
+}
+
+// WithClusterAutoscaler sets the ClusterAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterAutoscaler field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithClusterAutoscaler(value *ClusterAutoscalerApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.ClusterAutoscaler = value
+	return b
+}
+
+// WithWorkerOverrides adds the given value to the WorkerOverrides field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkerOverrides field.
+func (b *MPIJobSpecApplyConfiguration) WithWorkerOverrides(values ...*WorkerOverrideApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkerOverrides")
+		}
+		b.WorkerOverrides = append(b.WorkerOverrides, *values[i])
+	}
+	return b
+}
+
+// WithWorkerGroups adds the given value to the WorkerGroups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkerGroups field.
+func (b *MPIJobSpecApplyConfiguration) WithWorkerGroups(values ...*WorkerGroupApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkerGroups")
+		}
+		b.WorkerGroups = append(b.WorkerGroups, *values[i])
+	}
+	return b
+}
+
+// WithWorkerNaming sets the WorkerNaming field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerNaming field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithWorkerNaming(value *WorkerNamingApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.WorkerNaming = value
+	return b
+}
+
+// WithLauncherJob sets the LauncherJob field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LauncherJob field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithLauncherJob(value *LauncherJobTemplateApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.LauncherJob = value
+	return b
+}
+
+// WithHooks sets the Hooks field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hooks field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithHooks(value *HooksApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.Hooks = value
+	return b
+}
+
+// WithArtifacts sets the Artifacts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Artifacts field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithArtifacts(value *ArtifactsApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.Artifacts = value
+	return b
+}
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithService(value *ServiceTemplateApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.Service = value
+	return b
+}
+
+// WithProfiling sets the Profiling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Profiling field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithProfiling(value *ProfilingApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.Profiling = value
+	return b
+}
+
+// WithCoreDumps sets the CoreDumps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CoreDumps field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithCoreDumps(value *CoreDumpsApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.CoreDumps = value
+	return b
+}
+
+// WithWatchdog sets the Watchdog field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Watchdog field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithWatchdog(value *WatchdogApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.Watchdog = value
+	return b
+}
+
+// WithLaunchWrapper sets the LaunchWrapper field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LaunchWrapper field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithLaunchWrapper(value *LaunchWrapperApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.LaunchWrapper = value
+	return b
+}
+
+// WithGPUPreCheck sets the GPUPreCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GPUPreCheck field is set to the value of the last call.
+func (b *MPIJobSpecApplyConfiguration) WithGPUPreCheck(value *GPUPreCheckApplyConfiguration) *MPIJobSpecApplyConfiguration {
+	b.GPUPreCheck = value
+	return b
+}
@@ -24,10 +24,12 @@ import (
 // with apply.
 type ReplicaStatusApplyConfiguration struct {
 	Active        *int32                              `json:"active,omitempty"`
+	Ready         *int32                              `json:"ready,omitempty"`
 	Succeeded     *int32                              `json:"succeeded,omitempty"`
 	Failed        *int32                              `json:"failed,omitempty"`
 	LabelSelector *v1.LabelSelectorApplyConfiguration `json:"labelSelector,omitempty"`
 	Selector      *string                             `json:"selector,omitempty"`
+	Nodes         []string                            `json:"nodes,omitempty"`
 }
 
 // ReplicaStatusApplyConfiguration constructs a declarative configuration of the ReplicaStatus type for use with
@@ -44,6 +46,14 @@ func (b *ReplicaStatusApplyConfiguration) WithActive(value int32) *ReplicaStatus
 	return b
 }
 
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *ReplicaStatusApplyConfiguration) WithReady(value int32) *ReplicaStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
 // WithSucceeded sets the Succeeded field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Succeeded field is set to the value of the last call.
@@ -75,3 +85,13 @@ func (b *ReplicaStatusApplyConfiguration) WithSelector(value string) *ReplicaSta
 	b.Selector = &value
 	return b
 }
+
+// WithNodes adds the given value to the Nodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Nodes field.
+func (b *ReplicaStatusApplyConfiguration) WithNodes(values ...string) *ReplicaStatusApplyConfiguration {
+	for i := range values {
+		b.Nodes = append(b.Nodes, values[i])
+	}
+	return b
+}
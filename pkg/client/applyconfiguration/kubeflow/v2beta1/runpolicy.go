@@ -23,13 +23,14 @@ import (
 // RunPolicyApplyConfiguration represents a declarative configuration of the RunPolicy type for use
 // with apply.
 type RunPolicyApplyConfiguration struct {
-	CleanPodPolicy          *v2beta1.CleanPodPolicy             `json:"cleanPodPolicy,omitempty"`
-	TTLSecondsAfterFinished *int32                              `json:"ttlSecondsAfterFinished,omitempty"`
-	ActiveDeadlineSeconds   *int64                              `json:"activeDeadlineSeconds,omitempty"`
-	BackoffLimit            *int32                              `json:"backoffLimit,omitempty"`
-	SchedulingPolicy        *SchedulingPolicyApplyConfiguration `json:"schedulingPolicy,omitempty"`
-	Suspend                 *bool                               `json:"suspend,omitempty"`
-	ManagedBy               *string                             `json:"managedBy,omitempty"`
+	CleanPodPolicy                *v2beta1.CleanPodPolicy             `json:"cleanPodPolicy,omitempty"`
+	TTLSecondsAfterFinished       *int32                              `json:"ttlSecondsAfterFinished,omitempty"`
+	ActiveDeadlineSeconds         *int64                              `json:"activeDeadlineSeconds,omitempty"`
+	BackoffLimit                  *int32                              `json:"backoffLimit,omitempty"`
+	SchedulingPolicy              *SchedulingPolicyApplyConfiguration `json:"schedulingPolicy,omitempty"`
+	Suspend                       *bool                               `json:"suspend,omitempty"`
+	ManagedBy                     *string                             `json:"managedBy,omitempty"`
+	TerminationGracePeriodSeconds *int64                              `json:"terminationGracePeriodSeconds,omitempty"`
 }
 
 // RunPolicyApplyConfiguration constructs a declarative configuration of the RunPolicy type for use with
@@ -93,3 +94,11 @@ func (b *RunPolicyApplyConfiguration) WithManagedBy(value string) *RunPolicyAppl
 	b.ManagedBy = &value
 	return b
 }
+
+// WithTerminationGracePeriodSeconds sets the TerminationGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TerminationGracePeriodSeconds field is set to the value of the last call.
+func (b *RunPolicyApplyConfiguration) WithTerminationGracePeriodSeconds(value int64) *RunPolicyApplyConfiguration {
+	b.TerminationGracePeriodSeconds = &value
+	return b
+}
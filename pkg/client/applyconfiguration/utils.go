@@ -30,22 +30,78 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=kubeflow.org, Version=v2beta1
+	case v2beta1.SchemeGroupVersion.WithKind("Artifacts"):
+		return &kubeflowv2beta1.ArtifactsApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("Benchmark"):
+		return &kubeflowv2beta1.BenchmarkApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("ClusterAutoscaler"):
+		return &kubeflowv2beta1.ClusterAutoscalerApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("CoreDump"):
+		return &kubeflowv2beta1.CoreDumpApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("CoreDumps"):
+		return &kubeflowv2beta1.CoreDumpsApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("Diagnostics"):
+		return &kubeflowv2beta1.DiagnosticsApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("GPUPreCheck"):
+		return &kubeflowv2beta1.GPUPreCheckApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("GPUUsage"):
+		return &kubeflowv2beta1.GPUUsageApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("Hook"):
+		return &kubeflowv2beta1.HookApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("Hooks"):
+		return &kubeflowv2beta1.HooksApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("JobCondition"):
 		return &kubeflowv2beta1.JobConditionApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("JobStatus"):
 		return &kubeflowv2beta1.JobStatusApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("LauncherJobTemplate"):
+		return &kubeflowv2beta1.LauncherJobTemplateApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("LaunchResult"):
+		return &kubeflowv2beta1.LaunchResultApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("LaunchWrapper"):
+		return &kubeflowv2beta1.LaunchWrapperApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("MPIJob"):
 		return &kubeflowv2beta1.MPIJobApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("MPIJobDefaults"):
+		return &kubeflowv2beta1.MPIJobDefaultsApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("MPIJobDefaultsSpec"):
+		return &kubeflowv2beta1.MPIJobDefaultsSpecApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("MPIJobSpec"):
 		return &kubeflowv2beta1.MPIJobSpecApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("MultiCluster"):
+		return &kubeflowv2beta1.MultiClusterApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("Network"):
+		return &kubeflowv2beta1.NetworkApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("NetworkAttachment"):
+		return &kubeflowv2beta1.NetworkAttachmentApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("Profiling"):
+		return &kubeflowv2beta1.ProfilingApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("ReplicaDefaults"):
+		return &kubeflowv2beta1.ReplicaDefaultsApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("ReplicaSpec"):
 		return &kubeflowv2beta1.ReplicaSpecApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("ReplicaStatus"):
 		return &kubeflowv2beta1.ReplicaStatusApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("ResourceUsage"):
+		return &kubeflowv2beta1.ResourceUsageApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("RunPolicy"):
 		return &kubeflowv2beta1.RunPolicyApplyConfiguration{}
 	case v2beta1.SchemeGroupVersion.WithKind("SchedulingPolicy"):
 		return &kubeflowv2beta1.SchedulingPolicyApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("ServiceMesh"):
+		return &kubeflowv2beta1.ServiceMeshApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("ServiceTemplate"):
+		return &kubeflowv2beta1.ServiceTemplateApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("Watchdog"):
+		return &kubeflowv2beta1.WatchdogApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("WorkerGroup"):
+		return &kubeflowv2beta1.WorkerGroupApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("WorkerNaming"):
+		return &kubeflowv2beta1.WorkerNamingApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("WorkerOverride"):
+		return &kubeflowv2beta1.WorkerOverrideApplyConfiguration{}
+	case v2beta1.SchemeGroupVersion.WithKind("WorkerPool"):
+		return &kubeflowv2beta1.WorkerPoolApplyConfiguration{}
 
 	}
 	return nil
@@ -30,6 +30,10 @@ func (c *FakeKubeflowV2beta1) MPIJobs(namespace string) v2beta1.MPIJobInterface
 	return &FakeMPIJobs{c, namespace}
 }
 
+func (c *FakeKubeflowV2beta1) MPIJobDefaults(namespace string) v2beta1.MPIJobDefaultsInterface {
+	return &FakeMPIJobDefaults{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeKubeflowV2beta1) RESTClient() rest.Interface {
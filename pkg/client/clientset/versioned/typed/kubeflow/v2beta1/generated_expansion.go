@@ -17,3 +17,5 @@
 package v2beta1
 
 type MPIJobExpansion interface{}
+
+type MPIJobDefaultsExpansion interface{}
@@ -27,6 +27,7 @@ import (
 type KubeflowV2beta1Interface interface {
 	RESTClient() rest.Interface
 	MPIJobsGetter
+	MPIJobDefaultsGetter
 }
 
 // KubeflowV2beta1Client is used to interact with features provided by the kubeflow.org group.
@@ -38,6 +39,10 @@ func (c *KubeflowV2beta1Client) MPIJobs(namespace string) MPIJobInterface {
 	return newMPIJobs(c, namespace)
 }
 
+func (c *KubeflowV2beta1Client) MPIJobDefaults(namespace string) MPIJobDefaultsInterface {
+	return newMPIJobDefaults(c, namespace)
+}
+
 // NewForConfig creates a new KubeflowV2beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -24,6 +24,8 @@ import (
 type Interface interface {
 	// MPIJobs returns a MPIJobInformer.
 	MPIJobs() MPIJobInformer
+	// MPIJobDefaults returns a MPIJobDefaultsInformer.
+	MPIJobDefaults() MPIJobDefaultsInformer
 }
 
 type version struct {
@@ -41,3 +43,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) MPIJobs() MPIJobInformer {
 	return &mPIJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// MPIJobDefaults returns a MPIJobDefaultsInformer.
+func (v *version) MPIJobDefaults() MPIJobDefaultsInformer {
+	return &mPIJobDefaultsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
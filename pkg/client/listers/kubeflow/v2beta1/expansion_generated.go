@@ -23,3 +23,11 @@ type MPIJobListerExpansion interface{}
 // MPIJobNamespaceListerExpansion allows custom methods to be added to
 // MPIJobNamespaceLister.
 type MPIJobNamespaceListerExpansion interface{}
+
+// MPIJobDefaultsListerExpansion allows custom methods to be added to
+// MPIJobDefaultsLister.
+type MPIJobDefaultsListerExpansion interface{}
+
+// MPIJobDefaultsNamespaceListerExpansion allows custom methods to be added to
+// MPIJobDefaultsNamespaceLister.
+type MPIJobDefaultsNamespaceListerExpansion interface{}
@@ -0,0 +1,133 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cloudevents emits CloudEvents for the lifecycle transitions of
+// MPIJobs, so that event-driven systems can react to them without watching
+// the Kubernetes API.
+package cloudevents
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	SpecVersion = "1.0"
+
+	// Types of the events.
+	TypeCreated   = "org.kubeflow.mpijob.created"
+	TypeStarted   = "org.kubeflow.mpijob.started"
+	TypeSucceeded = "org.kubeflow.mpijob.succeeded"
+	TypeFailed    = "org.kubeflow.mpijob.failed"
+	TypeRestarted = "org.kubeflow.mpijob.restarted"
+)
+
+// Event is a CloudEvent with the data of an MPIJob.
+type Event struct {
+	// ID is unique for each transition of an MPIJob.
+	ID string
+	// Source is the collection of MPIJobs of the namespace, like
+	// /apis/kubeflow.org/v2beta1/namespaces/default/mpijobs.
+	Source string
+	Type   string
+	// Subject is the name of the MPIJob.
+	Subject string
+	Time    time.Time
+	Data    Data
+}
+
+// Data is the payload of the events.
+type Data struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+	// Reason and Message are those of the condition of the transition.
+	Reason  string             `json:"reason,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Status  kubeflow.JobStatus `json:"status"`
+}
+
+// conditionTypes maps the conditions to the events emitted when they become
+// true.
+var conditionTypes = []struct {
+	condition kubeflow.JobConditionType
+	eventType string
+}{
+	{kubeflow.JobCreated, TypeCreated},
+	{kubeflow.JobRunning, TypeStarted},
+	{kubeflow.JobSucceeded, TypeSucceeded},
+	{kubeflow.JobFailed, TypeFailed},
+}
+
+// Transitions returns the events of the transitions of the MPIJob from the old
+// status to its current status. A restart is a new failure of the launcher
+// that doesn't fail the MPIJob.
+func Transitions(old *kubeflow.JobStatus, job *kubeflow.MPIJob, now time.Time) []Event {
+	var events []Event
+	newEvent := func(eventType, idSuffix, reason, message string, at time.Time) Event {
+		if at.IsZero() {
+			at = now
+		}
+		return Event{
+			ID:      fmt.Sprintf("%s-%s", job.UID, idSuffix),
+			Source:  fmt.Sprintf("/apis/%s/namespaces/%s/mpijobs", kubeflow.SchemeGroupVersion.String(), job.Namespace),
+			Type:    eventType,
+			Subject: job.Name,
+			Time:    at,
+			Data: Data{
+				Namespace: job.Namespace,
+				Name:      job.Name,
+				UID:       string(job.UID),
+				Reason:    reason,
+				Message:   message,
+				Status:    job.Status,
+			},
+		}
+	}
+	for _, ct := range conditionTypes {
+		cond := trueCondition(&job.Status, ct.condition)
+		if cond == nil || trueCondition(old, ct.condition) != nil {
+			continue
+		}
+		at := cond.LastTransitionTime.Time
+		idSuffix := fmt.Sprintf("%s-%d", ct.condition, at.Unix())
+		events = append(events, newEvent(ct.eventType, idSuffix, cond.Reason, cond.Message, at))
+	}
+	if failed, oldFailed := launcherFailures(&job.Status), launcherFailures(old); failed > oldFailed && trueCondition(&job.Status, kubeflow.JobFailed) == nil {
+		idSuffix := fmt.Sprintf("restarted-%d", failed)
+		msg := fmt.Sprintf("Launcher failed %d times", failed)
+		events = append(events, newEvent(TypeRestarted, idSuffix, "LauncherFailed", msg, now))
+	}
+	return events
+}
+
+func trueCondition(status *kubeflow.JobStatus, condType kubeflow.JobConditionType) *kubeflow.JobCondition {
+	for i := range status.Conditions {
+		if c := &status.Conditions[i]; c.Type == condType && c.Status == corev1.ConditionTrue {
+			return c
+		}
+	}
+	return nil
+}
+
+func launcherFailures(status *kubeflow.JobStatus) int32 {
+	if s := status.ReplicaStatuses[kubeflow.MPIReplicaTypeLauncher]; s != nil {
+		return s.Failed
+	}
+	return 0
+}
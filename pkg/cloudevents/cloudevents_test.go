@@ -0,0 +1,208 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+var testTime = time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+func condition(condType kubeflow.JobConditionType, status corev1.ConditionStatus) kubeflow.JobCondition {
+	return kubeflow.JobCondition{
+		Type:               condType,
+		Status:             status,
+		Reason:             string(condType) + "Reason",
+		LastTransitionTime: metav1.NewTime(testTime),
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	cases := map[string]struct {
+		old       kubeflow.JobStatus
+		status    kubeflow.JobStatus
+		wantTypes []string
+		wantIDs   []string
+	}{
+		"created": {
+			status: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{condition(kubeflow.JobCreated, corev1.ConditionTrue)},
+			},
+			wantTypes: []string{TypeCreated},
+			wantIDs:   []string{"uid-Created-1735725600"},
+		},
+		"started": {
+			old: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{condition(kubeflow.JobCreated, corev1.ConditionTrue)},
+			},
+			status: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{
+					condition(kubeflow.JobCreated, corev1.ConditionTrue),
+					condition(kubeflow.JobRunning, corev1.ConditionTrue),
+				},
+			},
+			wantTypes: []string{TypeStarted},
+			wantIDs:   []string{"uid-Running-1735725600"},
+		},
+		"restarted": {
+			old: kubeflow.JobStatus{
+				ReplicaStatuses: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+					kubeflow.MPIReplicaTypeLauncher: {Failed: 1},
+				},
+			},
+			status: kubeflow.JobStatus{
+				ReplicaStatuses: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+					kubeflow.MPIReplicaTypeLauncher: {Failed: 2},
+				},
+			},
+			wantTypes: []string{TypeRestarted},
+			wantIDs:   []string{"uid-restarted-2"},
+		},
+		"failed": {
+			old: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{condition(kubeflow.JobRunning, corev1.ConditionTrue)},
+			},
+			status: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{
+					condition(kubeflow.JobRunning, corev1.ConditionFalse),
+					condition(kubeflow.JobFailed, corev1.ConditionTrue),
+				},
+				ReplicaStatuses: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+					kubeflow.MPIReplicaTypeLauncher: {Failed: 1},
+				},
+			},
+			wantTypes: []string{TypeFailed},
+			wantIDs:   []string{"uid-Failed-1735725600"},
+		},
+		"no transition": {
+			old: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{condition(kubeflow.JobRunning, corev1.ConditionTrue)},
+			},
+			status: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{condition(kubeflow.JobRunning, corev1.ConditionTrue)},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job := &kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "default", UID: "uid"},
+				Status:     tc.status,
+			}
+			var gotTypes, gotIDs []string
+			for _, e := range Transitions(&tc.old, job, testTime) {
+				gotTypes = append(gotTypes, e.Type)
+				gotIDs = append(gotIDs, e.ID)
+				if e.Source != "/apis/kubeflow.org/v2beta1/namespaces/default/mpijobs" || e.Subject != "pi" {
+					t.Errorf("Unexpected source %s and subject %s", e.Source, e.Subject)
+				}
+			}
+			if diff := cmp.Diff(tc.wantTypes, gotTypes); diff != "" {
+				t.Errorf("Unexpected event types (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantIDs, gotIDs); diff != "" {
+				t.Errorf("Unexpected event IDs (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPSink(t *testing.T) {
+	var gotHeaders http.Header
+	var gotData Data
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &gotData); err != nil {
+			t.Errorf("Decoding body: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	e := Event{
+		ID:      "uid-Created-1",
+		Source:  "/apis/kubeflow.org/v2beta1/namespaces/default/mpijobs",
+		Type:    TypeCreated,
+		Subject: "pi",
+		Time:    testTime,
+		Data:    Data{Namespace: "default", Name: "pi", UID: "uid"},
+	}
+	if err := NewHTTPSink(server.URL).Send(context.Background(), e); err != nil {
+		t.Fatalf("Send() failed: %v", err)
+	}
+	wantHeaders := map[string]string{
+		"Content-Type":   "application/json",
+		"Ce-Specversion": "1.0",
+		"Ce-Id":          "uid-Created-1",
+		"Ce-Source":      "/apis/kubeflow.org/v2beta1/namespaces/default/mpijobs",
+		"Ce-Type":        TypeCreated,
+		"Ce-Subject":     "pi",
+		"Ce-Time":        "2025-01-01T10:00:00Z",
+	}
+	for name, want := range wantHeaders {
+		if got := gotHeaders.Get(name); got != want {
+			t.Errorf("Got header %s=%q, want %q", name, got, want)
+		}
+	}
+	if diff := cmp.Diff(e.Data, gotData); diff != "" {
+		t.Errorf("Unexpected data (-want,+got):\n%s", diff)
+	}
+}
+
+type recordingSink struct {
+	events chan Event
+}
+
+func (s *recordingSink) Send(_ context.Context, e Event) error {
+	s.events <- e
+	return nil
+}
+
+func TestQueue(t *testing.T) {
+	sink := &recordingSink{events: make(chan Event, 2)}
+	q := NewQueue(sink, 1)
+	if err := q.Send(context.Background(), Event{ID: "1"}); err != nil {
+		t.Fatalf("Send() failed: %v", err)
+	}
+	if err := q.Send(context.Background(), Event{ID: "2"}); err == nil {
+		t.Errorf("Send() succeeded with a full queue")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go q.Run(ctx)
+	select {
+	case e := <-sink.events:
+		if e.ID != "1" {
+			t.Errorf("Sent event %s, want 1", e.ID)
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("Queued event wasn't sent")
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"k8s.io/klog"
+)
+
+const (
+	sendTimeout = 10 * time.Second
+
+	// DefaultQueueSize is the number of events buffered by a Queue.
+	DefaultQueueSize = 1024
+)
+
+// Sink receives CloudEvents.
+type Sink interface {
+	Send(ctx context.Context, e Event) error
+}
+
+// HTTPSink sends each event in an HTTP POST request, in the binary content
+// mode of the HTTP protocol binding: the attributes are ce- headers and the
+// body is the data encoded as JSON. This is what Knative Eventing brokers and
+// Argo Events webhook sources expect.
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPSink(url string) *HTTPSink {
+	return &HTTPSink{
+		url:    url,
+		client: &http.Client{Timeout: sendTimeout},
+	}
+}
+
+func (s *HTTPSink) Send(ctx context.Context, e Event) error {
+	body, err := json.Marshal(e.Data)
+	if err != nil {
+		return fmt.Errorf("encoding event data: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Ce-Specversion", SpecVersion)
+	req.Header.Set("Ce-Id", e.ID)
+	req.Header.Set("Ce-Source", e.Source)
+	req.Header.Set("Ce-Type", e.Type)
+	req.Header.Set("Ce-Subject", e.Subject)
+	req.Header.Set("Ce-Time", e.Time.UTC().Format(time.RFC3339Nano))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending event: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sending event: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// Queue buffers the events sent to a Sink, so that a slow sink doesn't delay
+// the reconciliation of MPIJobs. Events are dropped if the buffer is full.
+type Queue struct {
+	sink   Sink
+	events chan Event
+}
+
+// NewQueue returns a Queue of the given size. Run must be called to send the
+// events.
+func NewQueue(sink Sink, size int) *Queue {
+	return &Queue{
+		sink:   sink,
+		events: make(chan Event, size),
+	}
+}
+
+// Send queues the event. It never blocks.
+func (q *Queue) Send(_ context.Context, e Event) error {
+	select {
+	case q.events <- e:
+		return nil
+	default:
+		return fmt.Errorf("event queue is full, dropping event %s", e.ID)
+	}
+}
+
+// Run sends the queued events until the context is done.
+func (q *Queue) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-q.events:
+			if err := q.sink.Send(ctx, e); err != nil {
+				klog.Errorf("Failed to send CloudEvent %s of type %s for MPIJob %s: %v", e.ID, e.Type, e.Subject, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"strconv"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// hasOverride returns whether the Skip annotation of the MPIJob is set to
+// true. MPIJobs with other values than true and false aren't synced.
+func hasOverride(mpiJob *kubeflow.MPIJob, annotation string) bool {
+	skip, _ := strconv.ParseBool(mpiJob.Annotations[annotation])
+	return skip
+}
+
+// overriddenImage returns the image set in the annotation of the MPIJob,
+// or else the image configured in the operator, or else the default image.
+func overriddenImage(mpiJob *kubeflow.MPIJob, annotation, image, defaultImage string) string {
+	if override := mpiJob.Annotations[annotation]; override != "" {
+		return override
+	}
+	if image != "" {
+		return image
+	}
+	return defaultImage
+}
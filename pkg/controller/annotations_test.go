@@ -0,0 +1,68 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestOverriddenImage(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		image       string
+		wantImage   string
+	}{
+		"default": {
+			wantImage: DefaultWatchdogImage,
+		},
+		"operator": {
+			image:     "registry.example.com/watchdog:v1",
+			wantImage: "registry.example.com/watchdog:v1",
+		},
+		"annotation": {
+			annotations: map[string]string{kubeflow.WatchdogImageAnnotation: "registry.example.com/watchdog:debug"},
+			image:       "registry.example.com/watchdog:v1",
+			wantImage:   "registry.example.com/watchdog:debug",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := &kubeflow.MPIJob{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			if got := overriddenImage(mpiJob, kubeflow.WatchdogImageAnnotation, tc.image, DefaultWatchdogImage); got != tc.wantImage {
+				t.Errorf("overriddenImage() returned %q, want %q", got, tc.wantImage)
+			}
+		})
+	}
+}
+
+func TestSkipDiagnostics(t *testing.T) {
+	mpiJob := &kubeflow.MPIJob{
+		Spec: kubeflow.MPIJobSpec{
+			Diagnostics: &kubeflow.Diagnostics{Enabled: ptr.To(true)},
+		},
+	}
+	if !isDiagnosticsEnabled(mpiJob) {
+		t.Errorf("Diagnostics disabled without the %s annotation", kubeflow.SkipDiagnosticsAnnotation)
+	}
+	mpiJob.Annotations = map[string]string{kubeflow.SkipDiagnosticsAnnotation: "true"}
+	if isDiagnosticsEnabled(mpiJob) {
+		t.Errorf("Diagnostics enabled with the %s annotation", kubeflow.SkipDiagnosticsAnnotation)
+	}
+}
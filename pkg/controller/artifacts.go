@@ -0,0 +1,138 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// DefaultArtifactUploaderImage is the image of the sidecar uploading the
+	// artifacts, built from build/artifact-uploader.
+	DefaultArtifactUploaderImage = "mpioperator/artifact-uploader:latest"
+
+	artifactUploaderContainerName = "artifact-uploader"
+	artifactLogsVolumeName        = "mpi-job-logs"
+	artifactResultsVolumeName     = "mpi-job-results"
+	artifactLogsMountPath         = "/mnt/logs"
+	artifactResultsMountPath      = "/mnt/results"
+	podLogsHostPath               = "/var/log/pods"
+
+	artifactDestinationEnv = "ARTIFACTS_DESTINATION"
+	artifactLogsDirEnv     = "ARTIFACTS_LOGS_DIR"
+	artifactResultsDirEnv  = "ARTIFACTS_RESULTS_DIR"
+)
+
+// isArtifactUploadEnabled returns whether the sidecar uploading the artifacts
+// is injected in the launcher, or in the workers.
+func isArtifactUploadEnabled(mpiJob *kubeflow.MPIJob, isLauncher bool) bool {
+	artifacts := mpiJob.Spec.Artifacts
+	if artifacts == nil {
+		return false
+	}
+	return isLauncher || ptr.Deref(artifacts.Workers, false)
+}
+
+// setupArtifactUpload injects the sidecar uploading the logs of the pod, and
+// the results of the launcher, to spec.artifacts.destination. The sidecar is
+// an init container with the Always restart policy, so that it is stopped
+// once the other containers exit and it uploads the artifacts before exiting.
+func (c *MPIJobController) setupArtifactUpload(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, container *corev1.Container, isLauncher bool) {
+	if !isArtifactUploadEnabled(mpiJob, isLauncher) {
+		return
+	}
+	artifacts := mpiJob.Spec.Artifacts
+	sidecar := c.newArtifactUploader(artifactUploaderContainerName, mpiJob, isLauncher, artifacts.Destination, artifacts.CredentialsSecretName)
+	sidecar.Env = append(sidecar.Env, corev1.EnvVar{Name: artifactLogsDirEnv, Value: artifactLogsMountPath})
+	sidecar.VolumeMounts = append(sidecar.VolumeMounts, corev1.VolumeMount{
+		Name:      artifactLogsVolumeName,
+		MountPath: artifactLogsMountPath,
+		ReadOnly:  true,
+		// The kubelet writes the logs of the containers of the pod to
+		// /var/log/pods/<namespace>_<name>_<uid>.
+		SubPathExpr: "$(POD_NAMESPACE)_$(POD_NAME)_$(POD_UID)",
+	})
+	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
+		Name: artifactLogsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: podLogsHostPath,
+				Type: ptr.To(corev1.HostPathDirectory),
+			},
+		},
+	})
+	if isLauncher && artifacts.ResultsPath != "" {
+		podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
+			Name: artifactResultsVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      artifactResultsVolumeName,
+			MountPath: artifacts.ResultsPath,
+		})
+		sidecar.VolumeMounts = append(sidecar.VolumeMounts, corev1.VolumeMount{
+			Name:      artifactResultsVolumeName,
+			MountPath: artifactResultsMountPath,
+			ReadOnly:  true,
+		})
+		sidecar.Env = append(sidecar.Env, corev1.EnvVar{Name: artifactResultsDirEnv, Value: artifactResultsMountPath})
+	}
+	// The sidecar starts before the other init containers, so that their logs
+	// are uploaded too.
+	podTemplate.Spec.InitContainers = append([]corev1.Container{sidecar}, podTemplate.Spec.InitContainers...)
+}
+
+// newArtifactUploader returns a sidecar uploading files of the pod to the
+// destination when the pod terminates. The caller mounts the files and sets
+// the environment variables with their directories.
+func (c *MPIJobController) newArtifactUploader(name string, mpiJob *kubeflow.MPIJob, isLauncher bool, destination, credentialsSecretName string) corev1.Container {
+	role := worker
+	if isLauncher {
+		role = launcher
+	}
+	sidecar := corev1.Container{
+		Name:          name,
+		Image:         overriddenImage(mpiJob, kubeflow.ArtifactUploaderImageAnnotation, c.ArtifactUploaderImage, DefaultArtifactUploaderImage),
+		RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+		Env: []corev1.EnvVar{
+			{Name: "POD_NAME", ValueFrom: fieldRef("metadata.name")},
+			{Name: "POD_NAMESPACE", ValueFrom: fieldRef("metadata.namespace")},
+			{Name: "POD_UID", ValueFrom: fieldRef("metadata.uid")},
+			{Name: "K_MPI_JOB_NAME", Value: mpiJob.Name},
+			{Name: "K_MPI_JOB_NAMESPACE", Value: mpiJob.Namespace},
+			{Name: "K_MPI_JOB_ROLE", Value: role},
+			{Name: artifactDestinationEnv, Value: destination},
+		},
+	}
+	if credentialsSecretName != "" {
+		sidecar.EnvFrom = []corev1.EnvFromSource{{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: credentialsSecretName},
+			},
+		}}
+	}
+	return sidecar
+}
+
+func fieldRef(path string) *corev1.EnvVarSource {
+	return &corev1.EnvVarSource{
+		FieldRef: &corev1.ObjectFieldSelector{FieldPath: path},
+	}
+}
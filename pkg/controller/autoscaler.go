@@ -0,0 +1,263 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// safeToEvictAnnotation keeps the Cluster Autoscaler from scaling down
+	// the nodes of the pods.
+	safeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+
+	// Annotations of the pods provisioned by a ProvisioningRequest, which the
+	// Cluster Autoscaler doesn't scale up for individually.
+	consumeProvisioningRequestAnnotation = "autoscaling.x-k8s.io/consume-provisioning-request"
+	provisioningClassNameAnnotation      = "autoscaling.x-k8s.io/provisioning-class-name"
+
+	// provisioningRequestGate is the scheduling gate of the workers until
+	// their ProvisioningRequest is provisioned.
+	provisioningRequestGate = "kubeflow.org/provisioning-request"
+
+	provisioningRequestProvisioned = "Provisioned"
+	provisioningRequestFailed      = "Failed"
+
+	provisioningRequestReason       = "ProvisioningRequest"
+	provisioningRequestFailedReason = "ProvisioningRequestFailed"
+)
+
+// ProvisioningRequestResource is the resource of the ProvisioningRequests of
+// the Cluster Autoscaler.
+var ProvisioningRequestResource = schema.GroupVersionResource{
+	Group:    "autoscaling.x-k8s.io",
+	Version:  "v1",
+	Resource: "provisioningrequests",
+}
+
+// ProvisioningRequests manages the ProvisioningRequests of the workers of
+// MPIJobs with spec.clusterAutoscaler.provisioningClassName.
+type ProvisioningRequests struct {
+	client   dynamic.Interface
+	informer informers.GenericInformer
+}
+
+// NewProvisioningRequests returns the ProvisioningRequests created with the
+// client and read from the informer of ProvisioningRequestResource.
+func NewProvisioningRequests(client dynamic.Interface, informer informers.GenericInformer) *ProvisioningRequests {
+	return &ProvisioningRequests{client: client, informer: informer}
+}
+
+// EnableProvisioningRequests makes the controller request the nodes of the
+// workers of MPIJobs with ProvisioningRequests. It must be called before
+// the informer of the ProvisioningRequests is started.
+func (c *MPIJobController) EnableProvisioningRequests(p *ProvisioningRequests) error {
+	if _, err := p.informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleObject,
+		UpdateFunc: c.handleObjectUpdate,
+		DeleteFunc: c.handleObject,
+	}); err != nil {
+		return fmt.Errorf("adding ProvisioningRequest event handler: %w", err)
+	}
+	c.provisioningRequests = p
+	return nil
+}
+
+func provisioningClassName(mpiJob *kubeflow.MPIJob) string {
+	if mpiJob.Spec.ClusterAutoscaler == nil || workerReplicas(mpiJob) == 0 {
+		return ""
+	}
+	return mpiJob.Spec.ClusterAutoscaler.ProvisioningClassName
+}
+
+func provisioningRequestName(mpiJob *kubeflow.MPIJob) string {
+	return mpiJob.Name + workerSuffix
+}
+
+// setupClusterAutoscaler annotates the pods of the template as not safe to
+// evict and, for workers with a ProvisioningRequest, gates them until it is
+// provisioned. The annotations set in the template take precedence.
+func (c *MPIJobController) setupClusterAutoscaler(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, isLauncher bool) {
+	if mpiJob.Spec.ClusterAutoscaler == nil {
+		return
+	}
+	if podTemplate.Annotations == nil {
+		podTemplate.Annotations = make(map[string]string)
+	}
+	if _, ok := podTemplate.Annotations[safeToEvictAnnotation]; !ok {
+		podTemplate.Annotations[safeToEvictAnnotation] = "false"
+	}
+	className := provisioningClassName(mpiJob)
+	if isLauncher || className == "" || c.provisioningRequests == nil {
+		return
+	}
+	podTemplate.Annotations[consumeProvisioningRequestAnnotation] = provisioningRequestName(mpiJob)
+	podTemplate.Annotations[provisioningClassNameAnnotation] = className
+	podTemplate.Spec.SchedulingGates = append(podTemplate.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: provisioningRequestGate})
+}
+
+// syncProvisioningRequest creates the ProvisioningRequest of the workers, and
+// removes the scheduling gate of the workers once it is provisioned.
+func (c *MPIJobController) syncProvisioningRequest(mpiJob *kubeflow.MPIJob, workers []*corev1.Pod) error {
+	className := provisioningClassName(mpiJob)
+	if className == "" {
+		return nil
+	}
+	if c.provisioningRequests == nil {
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, provisioningRequestReason, "The operator doesn't manage ProvisioningRequests, ignoring provisioning class %s", className)
+		return nil
+	}
+	name := provisioningRequestName(mpiJob)
+	obj, err := c.provisioningRequests.informer.Lister().ByNamespace(mpiJob.Namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return c.createProvisioningRequest(mpiJob, className)
+	}
+	if err != nil {
+		return fmt.Errorf("getting ProvisioningRequest: %w", err)
+	}
+	pr, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unexpected type %T of ProvisioningRequest %s", obj, name)
+	}
+	if !metav1.IsControlledBy(pr, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, pr.GetName(), pr.GetKind())
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return fmt.Errorf("%s", msg)
+	}
+	if status, message := provisioningRequestCondition(pr, provisioningRequestFailed); status == metav1.ConditionTrue {
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, provisioningRequestFailedReason, "ProvisioningRequest %s failed: %s", name, message)
+		return nil
+	}
+	if status, _ := provisioningRequestCondition(pr, provisioningRequestProvisioned); status != metav1.ConditionTrue {
+		klog.V(4).Infof("Waiting for ProvisioningRequest %s/%s to be provisioned", mpiJob.Namespace, name)
+		return nil
+	}
+	for _, pod := range workers {
+		if err := c.removeProvisioningRequestGate(pod); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createProvisioningRequest creates the ProvisioningRequest of the workers,
+// and the PodTemplate it refers to.
+func (c *MPIJobController) createProvisioningRequest(mpiJob *kubeflow.MPIJob, className string) error {
+	name := provisioningRequestName(mpiJob)
+	worker := c.newWorker(mpiJob, 0)
+	template := &corev1.PodTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: mpiJob.Namespace,
+			Labels: map[string]string{
+				"app": mpiJob.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind),
+			},
+		},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      worker.Labels,
+				Annotations: worker.Annotations,
+			},
+			Spec: worker.Spec,
+		},
+	}
+	template.Template.Spec.SchedulingGates = nil
+	if _, err := c.kubeClient.CoreV1().PodTemplates(mpiJob.Namespace).Create(context.TODO(), template, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating PodTemplate of the ProvisioningRequest: %w", err)
+	}
+
+	pr := &unstructured.Unstructured{}
+	pr.SetAPIVersion(ProvisioningRequestResource.GroupVersion().String())
+	pr.SetKind("ProvisioningRequest")
+	pr.SetName(name)
+	pr.SetNamespace(mpiJob.Namespace)
+	pr.SetLabels(map[string]string{"app": mpiJob.Name})
+	pr.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind)})
+	pr.Object["spec"] = map[string]interface{}{
+		"provisioningClassName": className,
+		"podSets": []interface{}{
+			map[string]interface{}{
+				"podTemplateRef": map[string]interface{}{"name": name},
+				"count":          int64(workerReplicas(mpiJob)),
+			},
+		},
+	}
+	if _, err := c.provisioningRequests.client.Resource(ProvisioningRequestResource).Namespace(mpiJob.Namespace).Create(context.TODO(), pr, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating ProvisioningRequest: %w", err)
+	}
+	c.recorder.Eventf(mpiJob, corev1.EventTypeNormal, provisioningRequestReason, "Created ProvisioningRequest %s for %d workers", name, workerReplicas(mpiJob))
+	return nil
+}
+
+// provisioningRequestCondition returns the status and the message of a
+// condition of the ProvisioningRequest.
+func provisioningRequestCondition(pr *unstructured.Unstructured, conditionType string) (metav1.ConditionStatus, string) {
+	conditions, _, _ := unstructured.NestedSlice(pr.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != conditionType {
+			continue
+		}
+		status, _ := condition["status"].(string)
+		message, _ := condition["message"].(string)
+		return metav1.ConditionStatus(status), message
+	}
+	return metav1.ConditionUnknown, ""
+}
+
+func (c *MPIJobController) removeProvisioningRequestGate(pod *corev1.Pod) error {
+	gates := make([]corev1.PodSchedulingGate, 0, len(pod.Spec.SchedulingGates))
+	for _, gate := range pod.Spec.SchedulingGates {
+		if gate.Name != provisioningRequestGate {
+			gates = append(gates, gate)
+		}
+	}
+	if len(gates) == len(pod.Spec.SchedulingGates) {
+		return nil
+	}
+	pod = pod.DeepCopy()
+	pod.Spec.SchedulingGates = gates
+	if _, err := c.kubeClient.CoreV1().Pods(pod.Namespace).Update(context.TODO(), pod, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("removing the scheduling gate of worker %s: %w", pod.Name, err)
+	}
+	return nil
+}
+
+// countScheduledWorkerPods returns the number of workers scheduled to nodes.
+func countScheduledWorkerPods(workers []*corev1.Pod) int {
+	scheduled := 0
+	for _, pod := range workers {
+		if pod.Spec.NodeName != "" {
+			scheduled++
+		}
+	}
+	return scheduled
+}
@@ -0,0 +1,283 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
+)
+
+func newProvisioningRequestsForTest(t *testing.T, objects ...*unstructured.Unstructured) (*ProvisioningRequests, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{ProvisioningRequestResource: "ProvisioningRequestList"})
+	informer := dynamicinformer.NewDynamicSharedInformerFactory(client, 0).ForResource(ProvisioningRequestResource)
+	for _, obj := range objects {
+		if err := informer.Informer().GetIndexer().Add(obj); err != nil {
+			t.Fatalf("Adding ProvisioningRequest: %v", err)
+		}
+	}
+	return NewProvisioningRequests(client, informer), client
+}
+
+func newClusterAutoscalerMPIJob() *kubeflow.MPIJob {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.UID = "uid"
+	mpiJob.Spec.ClusterAutoscaler = &kubeflow.ClusterAutoscaler{
+		ProvisioningClassName: "best-effort-atscale.autoscaling.x-k8s.io",
+	}
+	scheme.Scheme.Default(mpiJob)
+	return mpiJob
+}
+
+func TestNewClusterAutoscalerPods(t *testing.T) {
+	cases := map[string]struct {
+		provisioningClass       string
+		enabled                 bool
+		wantWorkerAnnotations   map[string]string
+		wantWorkerGates         []corev1.PodSchedulingGate
+		wantLauncherAnnotations map[string]string
+	}{
+		"without provisioning class": {
+			enabled: true,
+			wantWorkerAnnotations: map[string]string{
+				safeToEvictAnnotation: "false",
+			},
+			wantLauncherAnnotations: map[string]string{
+				safeToEvictAnnotation: "true",
+			},
+		},
+		"provisioning class": {
+			provisioningClass: "best-effort-atscale.autoscaling.x-k8s.io",
+			enabled:           true,
+			wantWorkerAnnotations: map[string]string{
+				safeToEvictAnnotation:                "false",
+				consumeProvisioningRequestAnnotation: "test-worker",
+				provisioningClassNameAnnotation:      "best-effort-atscale.autoscaling.x-k8s.io",
+			},
+			wantWorkerGates: []corev1.PodSchedulingGate{{Name: provisioningRequestGate}},
+			wantLauncherAnnotations: map[string]string{
+				safeToEvictAnnotation: "true",
+			},
+		},
+		"provisioning requests disabled": {
+			provisioningClass: "best-effort-atscale.autoscaling.x-k8s.io",
+			wantWorkerAnnotations: map[string]string{
+				safeToEvictAnnotation: "false",
+			},
+			wantLauncherAnnotations: map[string]string{
+				safeToEvictAnnotation: "true",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newClusterAutoscalerMPIJob()
+			mpiJob.Spec.ClusterAutoscaler.ProvisioningClassName = tc.provisioningClass
+			// The annotations of the pod templates take precedence.
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Annotations = map[string]string{
+				safeToEvictAnnotation: "true",
+			}
+			c := &MPIJobController{recorder: &record.FakeRecorder{}}
+			if tc.enabled {
+				c.provisioningRequests, _ = newProvisioningRequestsForTest(t)
+			}
+
+			worker := c.newWorker(mpiJob, 0)
+			if diff := cmp.Diff(tc.wantWorkerAnnotations, worker.Annotations); diff != "" {
+				t.Errorf("Unexpected worker annotations (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantWorkerGates, worker.Spec.SchedulingGates); diff != "" {
+				t.Errorf("Unexpected worker scheduling gates (-want,+got):\n%s", diff)
+			}
+			launcher := c.newLauncherJob(mpiJob).Spec.Template
+			if diff := cmp.Diff(tc.wantLauncherAnnotations, launcher.Annotations); diff != "" {
+				t.Errorf("Unexpected launcher annotations (-want,+got):\n%s", diff)
+			}
+			if len(launcher.Spec.SchedulingGates) > 0 {
+				t.Errorf("Unexpected launcher scheduling gates %v", launcher.Spec.SchedulingGates)
+			}
+		})
+	}
+}
+
+func newProvisioningRequest(mpiJob *kubeflow.MPIJob, conditions ...interface{}) *unstructured.Unstructured {
+	pr := &unstructured.Unstructured{}
+	pr.SetAPIVersion(ProvisioningRequestResource.GroupVersion().String())
+	pr.SetKind("ProvisioningRequest")
+	pr.SetName(provisioningRequestName(mpiJob))
+	pr.SetNamespace(mpiJob.Namespace)
+	pr.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind)})
+	pr.Object["status"] = map[string]interface{}{"conditions": conditions}
+	return pr
+}
+
+func TestSyncProvisioningRequest(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		mpiJob := newClusterAutoscalerMPIJob()
+		kubeClient := k8sfake.NewSimpleClientset()
+		provisioningRequests, dynamicClient := newProvisioningRequestsForTest(t)
+		c := &MPIJobController{recorder: record.NewFakeRecorder(1), kubeClient: kubeClient, provisioningRequests: provisioningRequests}
+		if err := c.syncProvisioningRequest(mpiJob, nil); err != nil {
+			t.Fatalf("syncProvisioningRequest(): %v", err)
+		}
+		template, err := kubeClient.CoreV1().PodTemplates(mpiJob.Namespace).Get(context.Background(), "test-worker", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Getting PodTemplate: %v", err)
+		}
+		if len(template.Template.Spec.SchedulingGates) > 0 {
+			t.Errorf("Unexpected scheduling gates in the PodTemplate: %v", template.Template.Spec.SchedulingGates)
+		}
+		pr, err := dynamicClient.Resource(ProvisioningRequestResource).Namespace(mpiJob.Namespace).Get(context.Background(), "test-worker", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Getting ProvisioningRequest: %v", err)
+		}
+		wantSpec := map[string]interface{}{
+			"provisioningClassName": "best-effort-atscale.autoscaling.x-k8s.io",
+			"podSets": []interface{}{
+				map[string]interface{}{
+					"podTemplateRef": map[string]interface{}{"name": "test-worker"},
+					"count":          int64(2),
+				},
+			},
+		}
+		if diff := cmp.Diff(wantSpec, pr.Object["spec"]); diff != "" {
+			t.Errorf("Unexpected ProvisioningRequest spec (-want,+got):\n%s", diff)
+		}
+		if !metav1.IsControlledBy(pr, mpiJob) {
+			t.Errorf("ProvisioningRequest isn't controlled by the MPIJob")
+		}
+	})
+
+	cases := map[string]struct {
+		conditions      []interface{}
+		wantGates       []corev1.PodSchedulingGate
+		wantWarningSent bool
+	}{
+		"provisioned": {
+			conditions: []interface{}{
+				map[string]interface{}{"type": "Provisioned", "status": "True"},
+			},
+			wantGates: []corev1.PodSchedulingGate{{Name: "other"}},
+		},
+		"pending": {
+			conditions: []interface{}{
+				map[string]interface{}{"type": "Accepted", "status": "True"},
+				map[string]interface{}{"type": "Provisioned", "status": "False"},
+			},
+			wantGates: []corev1.PodSchedulingGate{{Name: "other"}, {Name: provisioningRequestGate}},
+		},
+		"failed": {
+			conditions: []interface{}{
+				map[string]interface{}{"type": "Failed", "status": "True", "message": "out of quota"},
+			},
+			wantGates:       []corev1.PodSchedulingGate{{Name: "other"}, {Name: provisioningRequestGate}},
+			wantWarningSent: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newClusterAutoscalerMPIJob()
+			worker := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-worker-0", Namespace: mpiJob.Namespace},
+				Spec: corev1.PodSpec{
+					SchedulingGates: []corev1.PodSchedulingGate{{Name: "other"}, {Name: provisioningRequestGate}},
+				},
+			}
+			kubeClient := k8sfake.NewSimpleClientset(worker)
+			provisioningRequests, _ := newProvisioningRequestsForTest(t, newProvisioningRequest(mpiJob, tc.conditions...))
+			recorder := record.NewFakeRecorder(1)
+			c := &MPIJobController{recorder: recorder, kubeClient: kubeClient, provisioningRequests: provisioningRequests}
+			if err := c.syncProvisioningRequest(mpiJob, []*corev1.Pod{worker}); err != nil {
+				t.Fatalf("syncProvisioningRequest(): %v", err)
+			}
+			got, err := kubeClient.CoreV1().Pods(mpiJob.Namespace).Get(context.Background(), worker.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting worker: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantGates, got.Spec.SchedulingGates); diff != "" {
+				t.Errorf("Unexpected scheduling gates (-want,+got):\n%s", diff)
+			}
+			if gotWarningSent := len(recorder.Events) > 0; gotWarningSent != tc.wantWarningSent {
+				t.Errorf("Unexpected warning sent %t, want %t", gotWarningSent, tc.wantWarningSent)
+			}
+		})
+	}
+}
+
+func TestLauncherCanBeCreated(t *testing.T) {
+	scheduled := &corev1.Pod{Spec: corev1.PodSpec{NodeName: "node"}}
+	ready := &corev1.Pod{
+		Spec: corev1.PodSpec{NodeName: "node"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	pending := &corev1.Pod{}
+	cases := map[string]struct {
+		policy  kubeflow.LauncherCreationPolicy
+		workers []*corev1.Pod
+		want    bool
+	}{
+		"at startup": {
+			policy:  kubeflow.LauncherCreationPolicyAtStartup,
+			workers: []*corev1.Pod{pending},
+			want:    true,
+		},
+		"workers ready": {
+			policy:  kubeflow.LauncherCreationPolicyWaitForWorkersReady,
+			workers: []*corev1.Pod{ready, ready},
+			want:    true,
+		},
+		"workers not ready": {
+			policy:  kubeflow.LauncherCreationPolicyWaitForWorkersReady,
+			workers: []*corev1.Pod{ready, scheduled},
+		},
+		"workers scheduled": {
+			policy:  kubeflow.LauncherCreationPolicyWaitForWorkersScheduled,
+			workers: []*corev1.Pod{ready, scheduled},
+			want:    true,
+		},
+		"workers not scheduled": {
+			policy:  kubeflow.LauncherCreationPolicyWaitForWorkersScheduled,
+			workers: []*corev1.Pod{scheduled, pending},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+			mpiJob.Spec.LauncherCreationPolicy = tc.policy
+			c := &MPIJobController{}
+			if got := c.launcherCanBeCreated(mpiJob, tc.workers); got != tc.want {
+				t.Errorf("launcherCanBeCreated() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
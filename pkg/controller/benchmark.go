@@ -0,0 +1,266 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	benchmarkSuffix       = "-benchmark"
+	benchmarkResultsKey   = "results.json"
+	benchmarkProcessesEnv = "MPI_BENCHMARK_PROCESSES"
+
+	mpiJobBenchmarkResultsReason = "BenchmarkResultsStored"
+
+	osuBenchmarksImage = "mpioperator/osu-benchmarks:latest"
+	ncclTestsImage     = "mpioperator/nccl-tests:latest"
+
+	// benchmarkScript runs the benchmark given as arguments with mpirun. The
+	// rows of results are written, with the spaces squeezed, to the
+	// termination message, which the controller parses once the launcher
+	// succeeds. The termination message is limited to 4096 bytes.
+	benchmarkScript = `out=$(mpirun -np "$` + benchmarkProcessesEnv + `" "$@" 2>&1)
+rc=$?
+printf '%s\n' "$out"
+printf '%s\n' "$out" | grep -E '^[[:space:]]*[0-9]|Avg bus bandwidth' | tr -s ' \t' ' ' | tail -c 4000 > /dev/termination-log
+exit $rc
+`
+)
+
+// benchmarkResults is the content of the results of a benchmark, stored in
+// the <name>-benchmark ConfigMap.
+type benchmarkResults struct {
+	Type kubeflow.BenchmarkType `json:"type"`
+	// Unit is the unit of the values of the results.
+	Unit    string            `json:"unit"`
+	Results []benchmarkResult `json:"results"`
+	// AvgBusBandwidthGBps is the average bus bandwidth reported by the NCCL
+	// tests.
+	AvgBusBandwidthGBps *float64 `json:"avgBusBandwidthGBps,omitempty"`
+}
+
+type benchmarkResult struct {
+	SizeBytes int64   `json:"sizeBytes"`
+	Value     float64 `json:"value"`
+}
+
+func benchmarkImage(benchmarkType kubeflow.BenchmarkType) string {
+	if benchmarkType == kubeflow.BenchmarkNCCLAllReduce {
+		return ncclTestsImage
+	}
+	return osuBenchmarksImage
+}
+
+func benchmarkUnit(benchmarkType kubeflow.BenchmarkType) string {
+	switch benchmarkType {
+	case kubeflow.BenchmarkOSUBandwidth:
+		return "MB/s"
+	case kubeflow.BenchmarkOSULatency:
+		return "us"
+	}
+	return "GB/s"
+}
+
+func defaultBenchmarkArgs(benchmarkType kubeflow.BenchmarkType) []string {
+	switch benchmarkType {
+	case kubeflow.BenchmarkOSUBandwidth:
+		return []string{"osu_bw"}
+	case kubeflow.BenchmarkOSULatency:
+		return []string{"osu_latency"}
+	}
+	// One GPU per process, from 8 bytes to 1GiB.
+	return []string{"all_reduce_perf", "-b", "8", "-e", "1G", "-f", "2", "-g", "1"}
+}
+
+// benchmarkProcesses returns the number of processes of the benchmark: one per
+// worker for the point-to-point benchmarks, one per slot otherwise.
+func benchmarkProcesses(mpiJob *kubeflow.MPIJob) int32 {
+	if mpiJob.Spec.Benchmark.Type == kubeflow.BenchmarkNCCLAllReduce {
+		return totalSlots(mpiJob)
+	}
+	return 2
+}
+
+// setBenchmarkImage sets the image of the benchmark in the container if it
+// doesn't have one.
+func setBenchmarkImage(mpiJob *kubeflow.MPIJob, container *corev1.Container) {
+	if mpiJob.Spec.Benchmark != nil && container.Image == "" {
+		container.Image = benchmarkImage(mpiJob.Spec.Benchmark.Type)
+	}
+}
+
+// setupBenchmarkOnLauncher replaces the command of the launcher container with
+// the benchmark.
+func setupBenchmarkOnLauncher(mpiJob *kubeflow.MPIJob, container *corev1.Container) {
+	benchmark := mpiJob.Spec.Benchmark
+	setBenchmarkImage(mpiJob, container)
+	args := benchmark.Args
+	if len(args) == 0 {
+		args = defaultBenchmarkArgs(benchmark.Type)
+	}
+	container.Command = append([]string{"sh", "-c", benchmarkScript, "benchmark"}, args...)
+	container.Args = nil
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  benchmarkProcessesEnv,
+		Value: strconv.Itoa(int(benchmarkProcesses(mpiJob))),
+	})
+	container.TerminationMessagePath = corev1.TerminationMessagePathDefault
+	container.TerminationMessagePolicy = corev1.TerminationMessageReadFile
+}
+
+// parseBenchmarkResults parses the rows of results written by benchmarkScript.
+// The OSU benchmarks report the size and the value, and the NCCL tests report
+// the bus bandwidth of the out-of-place operation in the 8th column, or the
+// 7th with versions that don't report the root.
+func parseBenchmarkResults(benchmarkType kubeflow.BenchmarkType, output string) (*benchmarkResults, error) {
+	results := &benchmarkResults{
+		Type:    benchmarkType,
+		Unit:    benchmarkUnit(benchmarkType),
+		Results: []benchmarkResult{},
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if _, avg, ok := strings.Cut(line, "Avg bus bandwidth"); ok {
+			avg = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(avg), ":"))
+			if v, err := strconv.ParseFloat(avg, 64); err == nil {
+				results.AvgBusBandwidthGBps = ptr.To(v)
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		valueField := fields[1]
+		if benchmarkType == kubeflow.BenchmarkNCCLAllReduce {
+			switch {
+			case len(fields) >= 13:
+				valueField = fields[7]
+			case len(fields) == 12:
+				valueField = fields[6]
+			default:
+				continue
+			}
+		}
+		value, err := strconv.ParseFloat(valueField, 64)
+		if err != nil {
+			continue
+		}
+		results.Results = append(results.Results, benchmarkResult{SizeBytes: size, Value: value})
+	}
+	if len(results.Results) == 0 {
+		return nil, errors.New("no results in the launcher termination message")
+	}
+	return results, nil
+}
+
+// benchmarkOutput returns the termination message of the launcher container
+// of the succeeded launcher pod.
+func benchmarkOutput(launcherPods []*corev1.Pod) (string, bool) {
+	for _, pod := range launcherPods {
+		if pod.Status.Phase != corev1.PodSucceeded || len(pod.Spec.Containers) == 0 {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == pod.Spec.Containers[0].Name && status.State.Terminated != nil {
+				return status.State.Terminated.Message, true
+			}
+		}
+	}
+	return "", false
+}
+
+func newBenchmarkConfigMap(mpiJob *kubeflow.MPIJob, results *benchmarkResults) (*corev1.ConfigMap, error) {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding benchmark results: %w", err)
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mpiJob.Name + benchmarkSuffix,
+			Namespace: mpiJob.Namespace,
+			Labels: map[string]string{
+				"app": mpiJob.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind),
+			},
+		},
+		Data: map[string]string{
+			benchmarkResultsKey: string(data),
+		},
+	}, nil
+}
+
+// storeBenchmarkResults stores the results of the benchmark of a succeeded
+// MPIJob in the <name>-benchmark ConfigMap. A launcher pod without results
+// is reported with an event and doesn't fail the MPIJob.
+func (c *MPIJobController) storeBenchmarkResults(mpiJob *kubeflow.MPIJob, launcherPods []*corev1.Pod) error {
+	output, ok := benchmarkOutput(launcherPods)
+	if !ok {
+		return nil
+	}
+	results, err := parseBenchmarkResults(mpiJob.Spec.Benchmark.Type, output)
+	if err != nil {
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, mpiJobBenchmarkResultsReason, "Failed to parse benchmark results: %v", err)
+		return nil
+	}
+	newCM, err := newBenchmarkConfigMap(mpiJob, results)
+	if err != nil {
+		return err
+	}
+	cm, err := c.configMapLister.ConfigMaps(mpiJob.Namespace).Get(newCM.Name)
+	if apierrors.IsNotFound(err) {
+		_, err = c.kubeClient.CoreV1().ConfigMaps(mpiJob.Namespace).Create(context.TODO(), newCM, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("creating benchmark results ConfigMap: %w", err)
+		}
+		c.recorder.Eventf(mpiJob, corev1.EventTypeNormal, mpiJobBenchmarkResultsReason, "Benchmark results stored in ConfigMap %s", newCM.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(cm, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, cm.Name, cm.Kind)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return errors.New(msg)
+	}
+	if !equality.Semantic.DeepEqual(cm.Data, newCM.Data) {
+		cm = cm.DeepCopy()
+		cm.Data = newCM.Data
+		if _, err := c.kubeClient.CoreV1().ConfigMaps(mpiJob.Namespace).Update(context.TODO(), cm, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("updating benchmark results ConfigMap: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	defaultCoreDumpsPath          = "/cores"
+	coreDumpsVolumeName           = "mpi-core-dumps"
+	coreDumpUploaderContainerName = "core-dump-uploader"
+	coreDumpsResultsName          = "cores"
+
+	artifactResultsNameEnv = "ARTIFACTS_RESULTS_NAME"
+
+	coreDumpedReason = "CoreDumped"
+)
+
+var (
+	// coreDumpSignals are the signals whose default action is to dump core.
+	coreDumpSignals = map[int]string{
+		3:  "SIGQUIT",
+		4:  "SIGILL",
+		5:  "SIGTRAP",
+		6:  "SIGABRT",
+		7:  "SIGBUS",
+		8:  "SIGFPE",
+		11: "SIGSEGV",
+		24: "SIGXCPU",
+		25: "SIGXFSZ",
+		31: "SIGSYS",
+	}
+
+	// openMPISignalRE matches the report of mpirun and prterun of Open MPI:
+	// "mpirun noticed that process rank 1 with PID 0 on node pi-worker-0
+	// exited on signal 11 (Segmentation fault)."
+	openMPISignalRE = regexp.MustCompile(`process\s+rank\s+(\d+)\s+with\s+PID\s+\d+\s+on\s+node\s+(\S+)\s+exited\s+on\s+signal\s+(\d+)`)
+	// hydraSignalRE matches the report of Hydra, the process manager of
+	// MPICH and Intel MPI:
+	// "=   RANK 1 PID 4567 RUNNING AT pi-worker-0
+	//  =   KILLED BY SIGNAL: 11 (Segmentation fault)".
+	hydraSignalRE = regexp.MustCompile(`RANK (\d+) PID \d+ RUNNING AT (\S+)\s+=\s+KILLED BY SIGNAL: (\d+)`)
+)
+
+func coreDumpsPath(coreDumps *kubeflow.CoreDumps) string {
+	if coreDumps.Path != "" {
+		return coreDumps.Path
+	}
+	return defaultCoreDumpsPath
+}
+
+// setupCoreDumps mounts the volume of the core files in the container and,
+// when spec.coreDumps.destination is set, injects the sidecar uploading them.
+// The launcher falls back to its logs for its termination message, so that
+// the ranks that dumped core can be read from the reports of mpirun.
+func (c *MPIJobController) setupCoreDumps(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, container *corev1.Container, isLauncher bool) {
+	coreDumps := mpiJob.Spec.CoreDumps
+	volume := corev1.Volume{
+		Name: coreDumpsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	if coreDumps.ClaimName != "" {
+		volume.VolumeSource = corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: coreDumps.ClaimName,
+			},
+		}
+	}
+	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, volume)
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      coreDumpsVolumeName,
+		MountPath: coreDumpsPath(coreDumps),
+	})
+	if isLauncher {
+		if container.TerminationMessagePath == "" {
+			container.TerminationMessagePath = corev1.TerminationMessagePathDefault
+		}
+		container.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+	}
+	if coreDumps.Destination == "" {
+		return
+	}
+	sidecar := c.newArtifactUploader(coreDumpUploaderContainerName, mpiJob, isLauncher, coreDumps.Destination, coreDumps.CredentialsSecretName)
+	sidecar.Env = append(sidecar.Env,
+		corev1.EnvVar{Name: artifactResultsDirEnv, Value: artifactResultsMountPath},
+		corev1.EnvVar{Name: artifactResultsNameEnv, Value: coreDumpsResultsName})
+	sidecar.VolumeMounts = append(sidecar.VolumeMounts, corev1.VolumeMount{
+		Name:      coreDumpsVolumeName,
+		MountPath: artifactResultsMountPath,
+		ReadOnly:  true,
+	})
+	podTemplate.Spec.InitContainers = append([]corev1.Container{sidecar}, podTemplate.Spec.InitContainers...)
+}
+
+// updateMPIJobCoreDumps adds the MPI processes that the launcher pods report
+// as killed by a signal that dumps core to the status of the MPIJob. The
+// reports are kept once the launcher pods are gone.
+func (c *MPIJobController) updateMPIJobCoreDumps(mpiJob *kubeflow.MPIJob, launcherPods []*corev1.Pod) {
+	for _, pod := range launcherPods {
+		for _, status := range pod.Status.ContainerStatuses {
+			for _, terminated := range []*corev1.ContainerStateTerminated{status.State.Terminated, status.LastTerminationState.Terminated} {
+				if terminated == nil {
+					continue
+				}
+				for _, dump := range parseCoreDumps(terminated.Message) {
+					if slices.Contains(mpiJob.Status.CoreDumps, dump) {
+						continue
+					}
+					mpiJob.Status.CoreDumps = append(mpiJob.Status.CoreDumps, dump)
+					c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, coreDumpedReason, "Rank %d on %s was killed by %s and dumped core", dump.Rank, dump.Host, dump.Signal)
+				}
+			}
+		}
+	}
+}
+
+// parseCoreDumps returns the MPI processes killed by a signal that dumps core
+// in the output of mpirun.
+func parseCoreDumps(output string) []kubeflow.CoreDump {
+	var dumps []kubeflow.CoreDump
+	for _, re := range []*regexp.Regexp{openMPISignalRE, hydraSignalRE} {
+		for _, match := range re.FindAllStringSubmatch(output, -1) {
+			rank, err := strconv.ParseInt(match[1], 10, 32)
+			if err != nil {
+				continue
+			}
+			signal, err := strconv.Atoi(match[3])
+			if err != nil {
+				continue
+			}
+			name, ok := coreDumpSignals[signal]
+			if !ok {
+				continue
+			}
+			// The hostfile has the FQDNs of the pods, while the core files
+			// are named after their hostnames.
+			host, _, _ := strings.Cut(match[2], ".")
+			dump := kubeflow.CoreDump{Rank: int32(rank), Host: host, Signal: name}
+			if !slices.Contains(dumps, dump) {
+				dumps = append(dumps, dump)
+			}
+		}
+	}
+	return dumps
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestParseCoreDumps(t *testing.T) {
+	cases := map[string]struct {
+		output    string
+		wantDumps []kubeflow.CoreDump
+	}{
+		"Open MPI": {
+			output: `--------------------------------------------------------------------------
+Primary job  terminated normally, but 1 process returned
+a non-zero exit code. Per user-direction, the job has been aborted.
+--------------------------------------------------------------------------
+--------------------------------------------------------------------------
+mpirun noticed that process rank 3 with PID 0 on node pi-worker-1 exited on signal 11 (Segmentation fault).
+--------------------------------------------------------------------------
+`,
+			wantDumps: []kubeflow.CoreDump{{Rank: 3, Host: "pi-worker-1", Signal: "SIGSEGV"}},
+		},
+		"Open MPI with the FQDN of the worker": {
+			output: `prterun noticed that process rank 0 with PID 1234 on node pi-worker-0.pi.default.svc exited on
+signal 6 (Aborted).`,
+			wantDumps: []kubeflow.CoreDump{{Rank: 0, Host: "pi-worker-0", Signal: "SIGABRT"}},
+		},
+		"Hydra": {
+			output: `===================================================================================
+=   BAD TERMINATION OF ONE OF YOUR APPLICATION PROCESSES
+=   RANK 1 PID 4567 RUNNING AT pi-worker-0
+=   KILLED BY SIGNAL: 7 (Bus error)
+===================================================================================
+===================================================================================
+=   BAD TERMINATION OF ONE OF YOUR APPLICATION PROCESSES
+=   RANK 2 PID 4568 RUNNING AT pi-worker-1
+=   KILLED BY SIGNAL: 9 (Killed)
+===================================================================================`,
+			wantDumps: []kubeflow.CoreDump{{Rank: 1, Host: "pi-worker-0", Signal: "SIGBUS"}},
+		},
+		"killed without core dump": {
+			output: "mpirun noticed that process rank 1 with PID 0 on node pi-worker-0 exited on signal 9 (Killed).",
+		},
+		"non-zero exit code": {
+			output: "mpirun detected that one or more processes exited with non-zero status",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantDumps, parseCoreDumps(tc.output)); diff != "" {
+				t.Errorf("Unexpected core dumps (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateMPIJobCoreDumps(t *testing.T) {
+	mpiJob := &kubeflow.MPIJob{
+		Spec: kubeflow.MPIJobSpec{
+			CoreDumps: &kubeflow.CoreDumps{ClaimName: "cores"},
+		},
+		Status: kubeflow.JobStatus{
+			CoreDumps: []kubeflow.CoreDump{{Rank: 3, Host: "pi-worker-1", Signal: "SIGSEGV"}},
+		},
+	}
+	launcherPods := []*corev1.Pod{{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						ExitCode: 139,
+						Message:  "mpirun noticed that process rank 0 with PID 0 on node pi-worker-0 exited on signal 11 (Segmentation fault).",
+					},
+				},
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						ExitCode: 139,
+						Message:  "mpirun noticed that process rank 3 with PID 0 on node pi-worker-1 exited on signal 11 (Segmentation fault).",
+					},
+				},
+			}},
+		},
+	}}
+	recorder := record.NewFakeRecorder(10)
+	c := &MPIJobController{recorder: recorder}
+
+	c.updateMPIJobCoreDumps(mpiJob, launcherPods)
+	want := []kubeflow.CoreDump{
+		{Rank: 3, Host: "pi-worker-1", Signal: "SIGSEGV"},
+		{Rank: 0, Host: "pi-worker-0", Signal: "SIGSEGV"},
+	}
+	if diff := cmp.Diff(want, mpiJob.Status.CoreDumps); diff != "" {
+		t.Errorf("Unexpected core dumps (-want,+got):\n%s", diff)
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("Recorded %d events, want 1", len(recorder.Events))
+	}
+}
+
+func TestSetupCoreDumps(t *testing.T) {
+	mpiJob := &kubeflow.MPIJob{
+		Spec: kubeflow.MPIJobSpec{
+			CoreDumps: &kubeflow.CoreDumps{
+				Path:        "/var/crash",
+				Destination: "s3://cores",
+			},
+		},
+	}
+	podTemplate := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "launcher"}},
+		},
+	}
+	c := &MPIJobController{}
+	c.setupCoreDumps(mpiJob, podTemplate, &podTemplate.Spec.Containers[0], true)
+
+	wantContainer := corev1.Container{
+		Name:                     "launcher",
+		VolumeMounts:             []corev1.VolumeMount{{Name: "mpi-core-dumps", MountPath: "/var/crash"}},
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+	if diff := cmp.Diff(wantContainer, podTemplate.Spec.Containers[0]); diff != "" {
+		t.Errorf("Unexpected launcher container (-want,+got):\n%s", diff)
+	}
+	if len(podTemplate.Spec.InitContainers) != 1 {
+		t.Fatalf("Got %d init containers, want the uploader", len(podTemplate.Spec.InitContainers))
+	}
+	uploader := podTemplate.Spec.InitContainers[0]
+	wantMounts := []corev1.VolumeMount{{Name: "mpi-core-dumps", MountPath: "/mnt/results", ReadOnly: true}}
+	if diff := cmp.Diff(wantMounts, uploader.VolumeMounts); diff != "" {
+		t.Errorf("Unexpected volume mounts of the uploader (-want,+got):\n%s", diff)
+	}
+	wantVolumes := []corev1.Volume{{
+		Name:         "mpi-core-dumps",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}}
+	if diff := cmp.Diff(wantVolumes, podTemplate.Spec.Volumes); diff != "" {
+		t.Errorf("Unexpected volumes (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	diagnosticsContainerName = "mpi-diagnostics"
+
+	diagnosticsProcessesEnv    = "MPI_DIAGNOSTICS_PROCESSES"
+	diagnosticsMinBandwidthEnv = "MPI_DIAGNOSTICS_MIN_BUS_BANDWIDTH_GBPS"
+
+	// diagnosticsScript runs the benchmark given as arguments with mpirun and
+	// checks the average bus bandwidth it reports. The reason of a failure is
+	// written to the termination message, which the controller copies to the
+	// MPIJob condition.
+	diagnosticsScript = `out=$(mpirun -np "$` + diagnosticsProcessesEnv + `" "$@" 2>&1)
+rc=$?
+printf '%s\n' "$out"
+fail() {
+  echo "$1" > /dev/termination-log
+  exit 1
+}
+if [ "$rc" -ne 0 ]; then
+  fail "benchmark exited with code $rc"
+fi
+min="$` + diagnosticsMinBandwidthEnv + `"
+if [ -n "$min" ]; then
+  bw=$(printf '%s\n' "$out" | sed -n 's/.*Avg bus bandwidth *: *\([0-9.][0-9.]*\).*/\1/p' | tail -n 1)
+  if [ -z "$bw" ]; then
+    fail "benchmark didn't report the average bus bandwidth"
+  fi
+  if awk -v bw="$bw" -v min="$min" 'BEGIN { exit !(bw < min) }'; then
+    fail "average bus bandwidth of $bw GB/s is below the threshold of $min GB/s"
+  fi
+fi
+`
+)
+
+// defaultDiagnosticsCommand is the NCCL all-reduce test, with one GPU per
+// process.
+var defaultDiagnosticsCommand = []string{"all_reduce_perf", "-b", "8", "-e", "128M", "-f", "2", "-g", "1"}
+
+func isDiagnosticsEnabled(mpiJob *kubeflow.MPIJob) bool {
+	return mpiJob.Spec.Diagnostics != nil && ptr.Deref(mpiJob.Spec.Diagnostics.Enabled, false) &&
+		!hasOverride(mpiJob, kubeflow.SkipDiagnosticsAnnotation)
+}
+
+// totalSlots returns the number of slots of the MPIJob, which is the number
+// of processes of the diagnostics and of the MPI ranks.
+func totalSlots(mpiJob *kubeflow.MPIJob) int32 {
+	var slots int32
+	for i := 0; i < int(workerReplicas(mpiJob)); i++ {
+		slots += workerSlots(mpiJob, i)
+	}
+	if runLauncherAsWorker(mpiJob) {
+		slots += ptr.Deref(mpiJob.Spec.SlotsPerWorker, 1)
+	}
+	return slots
+}
+
+// newDiagnosticsInitContainer returns the init container running the
+// diagnostics before the launcher container. It has the same environment and
+// volumes as the launcher container, so that mpirun finds the hostfile and the
+// SSH keys.
+func newDiagnosticsInitContainer(mpiJob *kubeflow.MPIJob, launcherContainer *corev1.Container) corev1.Container {
+	diagnostics := mpiJob.Spec.Diagnostics
+	container := corev1.Container{
+		Name:            diagnosticsContainerName,
+		Image:           launcherContainer.Image,
+		ImagePullPolicy: launcherContainer.ImagePullPolicy,
+		Env:             append([]corev1.EnvVar(nil), launcherContainer.Env...),
+		EnvFrom:         launcherContainer.EnvFrom,
+		VolumeMounts:    launcherContainer.VolumeMounts,
+		WorkingDir:      launcherContainer.WorkingDir,
+		SecurityContext: launcherContainer.SecurityContext,
+		Resources:       launcherContainer.Resources,
+
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+	if diagnostics.Image != "" {
+		container.Image = diagnostics.Image
+	}
+	command := diagnostics.Command
+	if len(command) == 0 {
+		command = defaultDiagnosticsCommand
+	}
+	container.Command = append([]string{"sh", "-c", diagnosticsScript, diagnosticsContainerName}, command...)
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  diagnosticsProcessesEnv,
+		Value: strconv.Itoa(int(totalSlots(mpiJob))),
+	})
+	if diagnostics.MinBusBandwidthGBps != nil {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  diagnosticsMinBandwidthEnv,
+			Value: strconv.Itoa(int(*diagnostics.MinBusBandwidthGBps)),
+		})
+	}
+	return container
+}
+
+// diagnosticsFailure returns the reason why the diagnostics failed in any of
+// the launcher pods.
+func diagnosticsFailure(launcherPods []*corev1.Pod) (string, bool) {
+	for _, pod := range launcherPods {
+		for _, status := range pod.Status.InitContainerStatuses {
+			if status.Name != diagnosticsContainerName {
+				continue
+			}
+			for _, state := range []corev1.ContainerState{status.State, status.LastTerminationState} {
+				if t := state.Terminated; t != nil && t.ExitCode != 0 {
+					if t.Message != "" {
+						return t.Message, true
+					}
+					return fmt.Sprintf("exited with code %d", t.ExitCode), true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// updateMPIJobDiagnosticsFailedStatus fails the MPIJob. The launcher Job is
+// deleted once the status is stored.
+func (c *MPIJobController) updateMPIJobDiagnosticsFailedStatus(mpiJob *kubeflow.MPIJob, reason string) {
+	msg := truncateMessage(fmt.Sprintf("MPIJob %s/%s failed the diagnostics: %s", mpiJob.Namespace, mpiJob.Name, reason))
+	c.recorder.Event(mpiJob, corev1.EventTypeWarning, kubeflow.DiagnosticsFailedReason, msg)
+	if mpiJob.Status.CompletionTime == nil {
+		now := metav1.NewTime(c.clock.Now())
+		mpiJob.Status.CompletionTime = &now
+	}
+	updateMPIJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.DiagnosticsFailedReason, msg, c.clock)
+	mpiJob.Status.FailureReasonClass = kubeflow.FailureReasonClassInfrastructure
+	mpiJobsFailureCount.Inc()
+}
+
+// deleteLauncherAfterFailedDiagnostics deletes the launcher Job of an MPIJob
+// that failed the diagnostics or the GPU pre-check, so that they aren't
+// retried and the launcher command never runs.
+func (c *MPIJobController) deleteLauncherAfterFailedDiagnostics(mpiJob *kubeflow.MPIJob) error {
+	cond := getCondition(mpiJob.Status, kubeflow.JobFailed)
+	if cond == nil || (cond.Reason != kubeflow.DiagnosticsFailedReason && cond.Reason != kubeflow.GPUPreCheckFailedReason) {
+		return nil
+	}
+	launcher, err := c.jobLister.Jobs(mpiJob.Namespace).Get(mpiJob.Name + launcherSuffix)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("obtaining launcher Job: %w", err)
+	}
+	if launcher.DeletionTimestamp != nil || !metav1.IsControlledBy(launcher, mpiJob) {
+		return nil
+	}
+	err = c.kubeClient.BatchV1().Jobs(launcher.Namespace).Delete(context.TODO(), launcher.Name, metav1.DeleteOptions{
+		PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting launcher Job after failed diagnostics: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	policyinformers "k8s.io/client-go/informers/policy/v1"
+	policylisters "k8s.io/client-go/listers/policy/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// podDisruptionBudgets reads the PodDisruptionBudgets of the workers.
+type podDisruptionBudgets struct {
+	lister policylisters.PodDisruptionBudgetLister
+	synced cache.InformerSynced
+}
+
+// EnablePodDisruptionBudgets makes the controller protect the workers of
+// running MPIJobs from voluntary disruptions, like node drains, with
+// PodDisruptionBudgets. It must be called before the informer of the
+// PodDisruptionBudgets is started.
+func (c *MPIJobController) EnablePodDisruptionBudgets(informer policyinformers.PodDisruptionBudgetInformer) error {
+	if _, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleObject,
+		UpdateFunc: c.handleObjectUpdate,
+		DeleteFunc: c.handleObject,
+	}); err != nil {
+		return fmt.Errorf("adding PodDisruptionBudget event handler: %w", err)
+	}
+	c.podDisruptionBudgets = &podDisruptionBudgets{
+		lister: informer.Lister(),
+		synced: informer.Informer().HasSynced,
+	}
+	return nil
+}
+
+func podDisruptionBudgetName(mpiJob *kubeflow.MPIJob) string {
+	return mpiJob.Name + workerSuffix
+}
+
+// needsPodDisruptionBudget returns whether the workers of the MPIJob are
+// running the MPI processes, so that evicting any of them fails the MPIJob.
+func needsPodDisruptionBudget(mpiJob *kubeflow.MPIJob) bool {
+	if workerReplicas(mpiJob) == 0 || isMPIJobSuspended(mpiJob) || isFinished(mpiJob.Status) ||
+		hasOverride(mpiJob, kubeflow.SkipPodDisruptionBudgetAnnotation) {
+		return false
+	}
+	return hasCondition(mpiJob.Status, kubeflow.JobRunning) || hasCondition(mpiJob.Status, kubeflow.JobRestarting)
+}
+
+// syncPodDisruptionBudget creates the PodDisruptionBudget of the workers,
+// with a maxUnavailable of 0, while the MPIJob is running and deletes it
+// otherwise.
+func (c *MPIJobController) syncPodDisruptionBudget(mpiJob *kubeflow.MPIJob) error {
+	if c.podDisruptionBudgets == nil {
+		return nil
+	}
+	name := podDisruptionBudgetName(mpiJob)
+	pdb, err := c.podDisruptionBudgets.lister.PodDisruptionBudgets(mpiJob.Namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		if !needsPodDisruptionBudget(mpiJob) {
+			return nil
+		}
+		klog.V(4).Infof("Creating the PodDisruptionBudget of the workers of %s/%s", mpiJob.Namespace, mpiJob.Name)
+		_, err = c.kubeClient.PolicyV1().PodDisruptionBudgets(mpiJob.Namespace).Create(context.TODO(), c.newPodDisruptionBudget(mpiJob), metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("creating PodDisruptionBudget: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting PodDisruptionBudget: %w", err)
+	}
+	if !metav1.IsControlledBy(pdb, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, pdb.Name, pdb.Kind)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return errors.New(msg)
+	}
+	if needsPodDisruptionBudget(mpiJob) || pdb.DeletionTimestamp != nil {
+		return nil
+	}
+	klog.V(4).Infof("Deleting the PodDisruptionBudget of the workers of %s/%s", mpiJob.Namespace, mpiJob.Name)
+	err = c.kubeClient.PolicyV1().PodDisruptionBudgets(mpiJob.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting PodDisruptionBudget: %w", err)
+	}
+	return nil
+}
+
+// newPodDisruptionBudget creates the PodDisruptionBudget selecting the workers
+// of the MPIJob, which doesn't allow any of them to be evicted.
+func (c *MPIJobController) newPodDisruptionBudget(mpiJob *kubeflow.MPIJob) *policyv1.PodDisruptionBudget {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podDisruptionBudgetName(mpiJob),
+			Namespace: mpiJob.Namespace,
+			Labels: map[string]string{
+				"app": mpiJob.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind),
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: defaultLabels(mpiJob.Name, worker),
+			},
+		},
+	}
+	c.propagateMetadata(mpiJob, &pdb.ObjectMeta)
+	return pdb
+}
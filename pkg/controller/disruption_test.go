@@ -0,0 +1,125 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
+)
+
+func TestSyncPodDisruptionBudget(t *testing.T) {
+	running := func(mpiJob *kubeflow.MPIJob) {
+		updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, kubeflow.JobRunningReason, "", clock.RealClock{})
+	}
+	cases := map[string]struct {
+		update  func(*kubeflow.MPIJob)
+		existed bool
+		wantPDB bool
+	}{
+		"created": {
+			update: func(*kubeflow.MPIJob) {},
+		},
+		"running": {
+			update:  running,
+			wantPDB: true,
+		},
+		"running with the PodDisruptionBudget": {
+			update:  running,
+			existed: true,
+			wantPDB: true,
+		},
+		"suspended": {
+			update: func(mpiJob *kubeflow.MPIJob) {
+				running(mpiJob)
+				mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
+			},
+			existed: true,
+		},
+		"succeeded": {
+			update: func(mpiJob *kubeflow.MPIJob) {
+				updateMPIJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, kubeflow.JobSucceededReason, "", clock.RealClock{})
+			},
+			existed: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+			scheme.Scheme.Default(mpiJob)
+			tc.update(mpiJob)
+			c := &MPIJobController{recorder: &record.FakeRecorder{}}
+			kubeClient := k8sfake.NewSimpleClientset()
+			c.kubeClient = kubeClient
+			informer := kubeinformers.NewSharedInformerFactory(kubeClient, 0).Policy().V1().PodDisruptionBudgets()
+			if err := c.EnablePodDisruptionBudgets(informer); err != nil {
+				t.Fatalf("Enabling the PodDisruptionBudgets: %v", err)
+			}
+			if tc.existed {
+				pdb, err := kubeClient.PolicyV1().PodDisruptionBudgets(mpiJob.Namespace).Create(context.TODO(), c.newPodDisruptionBudget(mpiJob), metav1.CreateOptions{})
+				if err != nil {
+					t.Fatalf("Creating the PodDisruptionBudget: %v", err)
+				}
+				if err := informer.Informer().GetIndexer().Add(pdb); err != nil {
+					t.Fatalf("Adding the PodDisruptionBudget: %v", err)
+				}
+			}
+
+			if err := c.syncPodDisruptionBudget(mpiJob); err != nil {
+				t.Fatalf("Syncing the PodDisruptionBudget: %v", err)
+			}
+			pdb, err := kubeClient.PolicyV1().PodDisruptionBudgets(mpiJob.Namespace).Get(context.TODO(), "test-worker", metav1.GetOptions{})
+			if !tc.wantPDB {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("Got PodDisruptionBudget %v, error %v, want none", pdb, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Getting the PodDisruptionBudget: %v", err)
+			}
+			wantSpec := policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+						kubeflow.JobNameLabel:      "test",
+						kubeflow.JobRoleLabel:      worker,
+					},
+				},
+			}
+			if diff := cmp.Diff(wantSpec, pdb.Spec); diff != "" {
+				t.Errorf("Unexpected spec of the PodDisruptionBudget (-want,+got):\n%s", diff)
+			}
+			if !metav1.IsControlledBy(pdb, mpiJob) {
+				t.Error("PodDisruptionBudget isn't controlled by the MPIJob")
+			}
+		})
+	}
+}
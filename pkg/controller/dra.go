@@ -0,0 +1,145 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
+	"k8s.io/apimachinery/pkg/api/resource"
+	resourceinformers "k8s.io/client-go/informers/resource/v1alpha3"
+	resourcelisters "k8s.io/client-go/listers/resource/v1alpha3"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const slotsPerWorkerDeviceClassReason = "SlotsPerWorkerDeviceClass"
+
+// DRAResources counts the devices requested through Dynamic Resource
+// Allocation by the ResourceClaimTemplates of the pod templates of MPIJobs.
+type DRAResources struct {
+	lister resourcelisters.ResourceClaimTemplateLister
+	synced cache.InformerSynced
+	// deviceClassResources maps DeviceClasses to the extended resources their
+	// devices are counted as in the minResources of PodGroups.
+	deviceClassResources map[string]corev1.ResourceName
+}
+
+// NewDRAResources returns the DRAResources reading the ResourceClaimTemplates
+// from the informer. The devices of the DeviceClasses of deviceClassResources,
+// like gpu.nvidia.com=nvidia.com/gpu, are counted as extended resources in the
+// minResources of PodGroups, so that gang schedulers reserve them for the
+// whole MPIJob. Devices of other DeviceClasses aren't counted, since the
+// schedulers check minResources against the allocatable resources of nodes.
+func NewDRAResources(informer resourceinformers.ResourceClaimTemplateInformer, deviceClassResources map[string]corev1.ResourceName) *DRAResources {
+	return &DRAResources{
+		lister:               informer.Lister(),
+		synced:               informer.Informer().HasSynced,
+		deviceClassResources: deviceClassResources,
+	}
+}
+
+// devices returns the number of devices per DeviceClass requested by the
+// claims of a pod that are generated from ResourceClaimTemplates. Existing
+// ResourceClaims are shared by the pods, and requests for all the devices of
+// a DeviceClass have no fixed count, so neither is counted.
+func (d *DRAResources) devices(namespace string, spec *corev1.PodSpec) (map[string]int64, error) {
+	devices := make(map[string]int64)
+	for _, claim := range spec.ResourceClaims {
+		if claim.ResourceClaimTemplateName == nil {
+			continue
+		}
+		template, err := d.lister.ResourceClaimTemplates(namespace).Get(*claim.ResourceClaimTemplateName)
+		if err != nil {
+			return nil, fmt.Errorf("getting ResourceClaimTemplate %s: %w", *claim.ResourceClaimTemplateName, err)
+		}
+		for _, req := range template.Spec.Spec.Devices.Requests {
+			if req.AllocationMode != "" && req.AllocationMode != resourcev1alpha3.DeviceAllocationModeExactCount {
+				continue
+			}
+			count := req.Count
+			if count == 0 {
+				// The default count of ExactCount requests.
+				count = 1
+			}
+			devices[req.DeviceClassName] += count
+		}
+	}
+	return devices, nil
+}
+
+// resources returns the extended resources of the devices requested by the
+// claims of a pod.
+func (d *DRAResources) resources(namespace string, spec *corev1.PodSpec) corev1.ResourceList {
+	if len(d.deviceClassResources) == 0 {
+		return nil
+	}
+	devices, err := d.devices(namespace, spec)
+	if err != nil {
+		klog.Warningf("Ignoring the devices of the claims in the minResources of the PodGroup: %v", err)
+		return nil
+	}
+	resources := corev1.ResourceList{}
+	for class, count := range devices {
+		if name, ok := d.deviceClassResources[class]; ok {
+			q := resources[name]
+			q.Add(*resource.NewQuantity(count, resource.DecimalSI))
+			resources[name] = q
+		}
+	}
+	return resources
+}
+
+// EnableDRA makes the controller count the devices requested by the claims of
+// the MPIJobs, to derive their slots and the minResources of their PodGroups.
+func (c *MPIJobController) EnableDRA(dra *DRAResources) {
+	c.draResources = dra
+	if c.PodGroupCtrl != nil {
+		c.PodGroupCtrl.setDRAResources(dra)
+	}
+}
+
+// resolveSlotsPerWorker sets the slots per worker of the MPIJob, in memory, to
+// the number of devices of spec.slotsPerWorkerDeviceClass requested by each
+// worker, or by the launcher if there are no workers.
+func (c *MPIJobController) resolveSlotsPerWorker(mpiJob *kubeflow.MPIJob) {
+	class := mpiJob.Spec.SlotsPerWorkerDeviceClass
+	if class == "" {
+		return
+	}
+	if c.draResources == nil {
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, slotsPerWorkerDeviceClassReason, "The operator doesn't watch ResourceClaimTemplates, using %d slots per worker", *mpiJob.Spec.SlotsPerWorker)
+		return
+	}
+	replica := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+	if replica == nil {
+		replica = mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher]
+	}
+	devices, err := c.draResources.devices(mpiJob.Namespace, &replica.Template.Spec)
+	if err != nil {
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, slotsPerWorkerDeviceClassReason, "Failed to count the devices of DeviceClass %s, using %d slots per worker: %v", class, *mpiJob.Spec.SlotsPerWorker, err)
+		return
+	}
+	count := devices[class]
+	if count == 0 {
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, slotsPerWorkerDeviceClassReason, "The claims of the workers request no devices of DeviceClass %s, using %d slots per worker", class, *mpiJob.Spec.SlotsPerWorker)
+		return
+	}
+	mpiJob.Spec.SlotsPerWorker = ptr.To(int32(count))
+}
@@ -0,0 +1,240 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	resourcev1alpha3 "k8s.io/api/resource/v1alpha3"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
+)
+
+func newResourceClaimTemplate(name string, requests ...resourcev1alpha3.DeviceRequest) *resourcev1alpha3.ResourceClaimTemplate {
+	return &resourcev1alpha3.ResourceClaimTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: resourcev1alpha3.ResourceClaimTemplateSpec{
+			Spec: resourcev1alpha3.ResourceClaimSpec{
+				Devices: resourcev1alpha3.DeviceClaim{Requests: requests},
+			},
+		},
+	}
+}
+
+func newDRAResources(t *testing.T, templates ...*resourcev1alpha3.ResourceClaimTemplate) *DRAResources {
+	t.Helper()
+	informer := kubeinformers.NewSharedInformerFactory(k8sfake.NewSimpleClientset(), 0).Resource().V1alpha3().ResourceClaimTemplates()
+	for _, template := range templates {
+		if err := informer.Informer().GetIndexer().Add(template); err != nil {
+			t.Fatalf("Adding ResourceClaimTemplate %s: %v", template.Name, err)
+		}
+	}
+	return NewDRAResources(informer, map[string]corev1.ResourceName{"gpu.nvidia.com": "nvidia.com/gpu"})
+}
+
+func testDRAResources(t *testing.T) *DRAResources {
+	return newDRAResources(t,
+		newResourceClaimTemplate("gpus",
+			resourcev1alpha3.DeviceRequest{Name: "gpus", DeviceClassName: "gpu.nvidia.com", AllocationMode: resourcev1alpha3.DeviceAllocationModeExactCount, Count: 4},
+			resourcev1alpha3.DeviceRequest{Name: "nic", DeviceClassName: "nic.example.com"},
+		),
+		newResourceClaimTemplate("all-nics",
+			resourcev1alpha3.DeviceRequest{Name: "nics", DeviceClassName: "nic.example.com", AllocationMode: resourcev1alpha3.DeviceAllocationModeAll},
+		),
+	)
+}
+
+func TestDRAResources(t *testing.T) {
+	cases := map[string]struct {
+		claims        []corev1.PodResourceClaim
+		wantDevices   map[string]int64
+		wantResources corev1.ResourceList
+		wantErr       bool
+	}{
+		"templates": {
+			claims: []corev1.PodResourceClaim{
+				{Name: "gpus", ResourceClaimTemplateName: ptr.To("gpus")},
+				{Name: "nics", ResourceClaimTemplateName: ptr.To("all-nics")},
+				{Name: "shared", ResourceClaimName: ptr.To("shared")},
+			},
+			wantDevices: map[string]int64{
+				"gpu.nvidia.com":  4,
+				"nic.example.com": 1,
+			},
+			wantResources: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("4"),
+			},
+		},
+		"no claims": {
+			wantDevices:   map[string]int64{},
+			wantResources: corev1.ResourceList{},
+		},
+		"missing template": {
+			claims: []corev1.PodResourceClaim{
+				{Name: "gpus", ResourceClaimTemplateName: ptr.To("missing")},
+			},
+			wantErr: true,
+		},
+	}
+	dra := testDRAResources(t)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec := &corev1.PodSpec{ResourceClaims: tc.claims}
+			devices, err := dra.devices(metav1.NamespaceDefault, spec)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("devices() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantDevices, devices); diff != "" {
+				t.Errorf("Unexpected devices (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantResources, dra.resources(metav1.NamespaceDefault, spec)); diff != "" {
+				t.Errorf("Unexpected resources (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveSlotsPerWorker(t *testing.T) {
+	gpuClaims := []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("gpus")}}
+	cases := map[string]struct {
+		deviceClass     string
+		workerClaims    []corev1.PodResourceClaim
+		launcherClaims  []corev1.PodResourceClaim
+		noWorkers       bool
+		disabled        bool
+		wantSlots       int32
+		wantWarningSent bool
+	}{
+		"without device class": {
+			workerClaims: gpuClaims,
+			wantSlots:    1,
+		},
+		"worker claims": {
+			deviceClass:  "gpu.nvidia.com",
+			workerClaims: gpuClaims,
+			wantSlots:    4,
+		},
+		"launcher claims without workers": {
+			deviceClass:    "gpu.nvidia.com",
+			launcherClaims: gpuClaims,
+			noWorkers:      true,
+			wantSlots:      4,
+		},
+		"no devices of the device class": {
+			deviceClass:     "nic.example.com",
+			workerClaims:    []corev1.PodResourceClaim{{Name: "nics", ResourceClaimTemplateName: ptr.To("all-nics")}},
+			wantSlots:       1,
+			wantWarningSent: true,
+		},
+		"missing template": {
+			deviceClass:     "gpu.nvidia.com",
+			workerClaims:    []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("missing")}},
+			wantSlots:       1,
+			wantWarningSent: true,
+		},
+		"disabled": {
+			deviceClass:     "gpu.nvidia.com",
+			workerClaims:    gpuClaims,
+			disabled:        true,
+			wantSlots:       1,
+			wantWarningSent: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			replicas := ptr.To[int32](2)
+			if tc.noWorkers {
+				replicas = nil
+			}
+			mpiJob := newMPIJob("test", replicas, nil, nil)
+			mpiJob.Spec.SlotsPerWorker = ptr.To[int32](1)
+			mpiJob.Spec.SlotsPerWorkerDeviceClass = tc.deviceClass
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.ResourceClaims = tc.launcherClaims
+			if !tc.noWorkers {
+				mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.ResourceClaims = tc.workerClaims
+			}
+			recorder := record.NewFakeRecorder(1)
+			c := &MPIJobController{recorder: recorder}
+			if !tc.disabled {
+				c.EnableDRA(testDRAResources(t))
+			}
+			c.resolveSlotsPerWorker(mpiJob)
+			if got := *mpiJob.Spec.SlotsPerWorker; got != tc.wantSlots {
+				t.Errorf("Unexpected slots per worker %d, want %d", got, tc.wantSlots)
+			}
+			if gotWarningSent := len(recorder.Events) > 0; gotWarningSent != tc.wantWarningSent {
+				t.Errorf("Unexpected warning sent %t, want %t", gotWarningSent, tc.wantWarningSent)
+			}
+		})
+	}
+}
+
+func TestCalPGMinResourceWithClaims(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Replicas = ptr.To[int32](1)
+	worker := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+	worker.Template.Spec.ResourceClaims = []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("gpus")}}
+	worker.Template.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("10"),
+	}
+	got := calPGMinResource(ptr.To[int32](3), mpiJob, nil, testDRAResources(t))
+	want := &corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("20"),
+		"nvidia.com/gpu":   resource.MustParse("8"),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected minResources (-want,+got):\n%s", diff)
+	}
+}
+
+func TestNewPodsWithResourceClaims(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	for _, rt := range []kubeflow.MPIReplicaType{kubeflow.MPIReplicaTypeLauncher, kubeflow.MPIReplicaTypeWorker} {
+		spec := &mpiJob.Spec.MPIReplicaSpecs[rt].Template.Spec
+		spec.ResourceClaims = []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("gpus")}}
+		spec.Containers[0].Resources.Claims = []corev1.ResourceClaim{{Name: "gpus"}}
+	}
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}}
+	wantClaims := []corev1.PodResourceClaim{{Name: "gpus", ResourceClaimTemplateName: ptr.To("gpus")}}
+	wantContainerClaims := []corev1.ResourceClaim{{Name: "gpus"}}
+
+	launcher := c.newLauncherJob(mpiJob).Spec.Template.Spec
+	if diff := cmp.Diff(wantClaims, launcher.ResourceClaims); diff != "" {
+		t.Errorf("Unexpected launcher claims (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantContainerClaims, launcher.Containers[0].Resources.Claims); diff != "" {
+		t.Errorf("Unexpected launcher container claims (-want,+got):\n%s", diff)
+	}
+	worker := c.newWorker(mpiJob, 0).Spec
+	if diff := cmp.Diff(wantClaims, worker.ResourceClaims); diff != "" {
+		t.Errorf("Unexpected worker claims (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantContainerClaims, worker.Containers[0].Resources.Claims); diff != "" {
+		t.Errorf("Unexpected worker container claims (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// nodeNameIndex indexes the pods by the node they are bound to.
+const nodeNameIndex = "spec.nodeName"
+
+// drainTaints are the taints of the nodes that are cordoned or about to be
+// removed, by kubectl drain, the Cluster Autoscaler or Karpenter.
+var drainTaints = sets.New(
+	corev1.TaintNodeUnschedulable,
+	corev1.TaintNodeOutOfService,
+	"ToBeDeletedByClusterAutoscaler",
+	"karpenter.sh/disrupted",
+)
+
+// nodeDrains reads the nodes of the pods of the MPIJobs.
+type nodeDrains struct {
+	lister     corelisters.NodeLister
+	synced     cache.InformerSynced
+	podIndexer cache.Indexer
+}
+
+// EnableNodeDrains makes the controller move the running MPIJobs off the nodes
+// that are drained: when a node of their pods is cordoned or tainted for
+// removal, or one of their pods is being evicted, the MPIJob is restarted on
+// other nodes, or suspended if its launcher has the Never restart policy. It
+// must be called before the informers of the nodes and the pods are started.
+func (c *MPIJobController) EnableNodeDrains(nodeInformer coreinformers.NodeInformer, podInformer coreinformers.PodInformer) error {
+	if err := podInformer.Informer().AddIndexers(cache.Indexers{nodeNameIndex: podNodeName}); err != nil {
+		return fmt.Errorf("adding pod node index: %w", err)
+	}
+	if _, err := nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.handleNode,
+		UpdateFunc: func(old, new interface{}) {
+			// Only the nodes that start draining are handled.
+			if oldNode, ok := old.(*corev1.Node); ok && !isNodeDraining(oldNode) {
+				c.handleNode(new)
+			}
+		},
+	}); err != nil {
+		return fmt.Errorf("adding Node event handler: %w", err)
+	}
+	c.nodeDrains = &nodeDrains{
+		lister:     nodeInformer.Lister(),
+		synced:     nodeInformer.Informer().HasSynced,
+		podIndexer: podInformer.Informer().GetIndexer(),
+	}
+	return nil
+}
+
+func podNodeName(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil, nil
+	}
+	return []string{pod.Spec.NodeName}, nil
+}
+
+// handleNode enqueues the MPIJobs with pods on the node, if it's draining.
+func (c *MPIJobController) handleNode(obj interface{}) {
+	node, ok := obj.(*corev1.Node)
+	if !ok || !isNodeDraining(node) {
+		return
+	}
+	pods, err := c.nodeDrains.podIndexer.ByIndex(nodeNameIndex, node.Name)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("obtaining pods of node %s: %w", node.Name, err))
+		return
+	}
+	for _, obj := range pods {
+		pod := obj.(*corev1.Pod)
+		if pod.Labels[kubeflow.OperatorNameLabel] != kubeflow.OperatorName {
+			continue
+		}
+		mpiJob, err := c.mpiJobLister.MPIJobs(pod.Namespace).Get(pod.Labels[kubeflow.JobNameLabel])
+		if err != nil {
+			continue
+		}
+		klog.V(4).Infof("Node %s of pod %s/%s is draining", node.Name, pod.Namespace, pod.Name)
+		c.enqueueMPIJob(mpiJob)
+	}
+}
+
+// isNodeDraining returns whether the node is cordoned or tainted for removal.
+func isNodeDraining(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return true
+	}
+	for _, taint := range node.Spec.Taints {
+		if drainTaints.Has(taint.Key) && taint.Effect != corev1.TaintEffectPreferNoSchedule {
+			return true
+		}
+	}
+	return false
+}
+
+// isPodDisrupted returns whether the pod is being evicted or deleted because
+// of its node.
+func isPodDisrupted(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.DisruptionTarget {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// isRestartingForNodeDrain returns whether the MPIJob was restarted by
+// syncNodeDrains and isn't running again yet.
+func isRestartingForNodeDrain(mpiJob *kubeflow.MPIJob) bool {
+	return isRestartingFor(mpiJob, kubeflow.NodeDrainReason)
+}
+
+// isRestartingFor returns whether the MPIJob was restarted by restartMPIJob
+// for the reason and isn't running again yet.
+func isRestartingFor(mpiJob *kubeflow.MPIJob, reason string) bool {
+	cond := getCondition(mpiJob.Status, kubeflow.JobRestarting)
+	return cond != nil && cond.Status == corev1.ConditionTrue && cond.Reason == reason
+}
+
+// arePodsTerminating returns whether the launcher Job or any of the pods is
+// being deleted.
+func arePodsTerminating(launcher *batchv1.Job, pods []*corev1.Pod) bool {
+	terminating := launcher != nil && launcher.DeletionTimestamp != nil
+	for _, pod := range pods {
+		terminating = terminating || pod.DeletionTimestamp != nil
+	}
+	return terminating
+}
+
+// drainingNodes returns the names of the draining nodes of the pods.
+func (c *MPIJobController) drainingNodes(pods []*corev1.Pod) []string {
+	nodes := sets.New[string]()
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if isPodDisrupted(pod) {
+			nodes.Insert(pod.Spec.NodeName)
+			continue
+		}
+		node, err := c.nodeDrains.lister.Get(pod.Spec.NodeName)
+		if err == nil && isNodeDraining(node) {
+			nodes.Insert(pod.Spec.NodeName)
+		}
+	}
+	return sets.List(nodes)
+}
+
+// syncNodeDrains restarts the MPIJob when any of its pods is on a draining
+// node, deleting the launcher and the workers with their grace period so
+// that they can checkpoint, or suspends it if its launcher has the Never
+// restart policy. It returns whether the MPIJob was restarted or suspended, or
+// is waiting for the pods of the previous restart to terminate, in which case
+// the rest of the sync is skipped.
+func (c *MPIJobController) syncNodeDrains(mpiJob *kubeflow.MPIJob, launcher *batchv1.Job) (bool, error) {
+	if c.nodeDrains == nil || hasOverride(mpiJob, kubeflow.SkipNodeDrainsAnnotation) {
+		return false, nil
+	}
+	selector := labels.SelectorFromSet(labels.Set{
+		kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+		kubeflow.JobNameLabel:      mpiJob.Name,
+	})
+	pods, err := c.podLister.Pods(mpiJob.Namespace).List(selector)
+	if err != nil {
+		return false, fmt.Errorf("obtaining pods: %w", err)
+	}
+	// The new pods could otherwise be created next to the old ones, or the
+	// new launcher connect to the old workers.
+	if isRestartingForNodeDrain(mpiJob) && arePodsTerminating(launcher, pods) {
+		klog.V(4).Infof("Waiting for the pods of %s/%s to terminate before restarting it.", mpiJob.Namespace, mpiJob.Name)
+		return true, nil
+	}
+	nodes := c.drainingNodes(pods)
+	if len(nodes) == 0 {
+		return false, nil
+	}
+
+	if spec := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher]; spec != nil && spec.RestartPolicy == kubeflow.RestartPolicyNever {
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, kubeflow.NodeDrainReason, "Suspending MPIJob %s/%s because nodes %s are draining", mpiJob.Namespace, mpiJob.Name, strings.Join(nodes, ", "))
+		patch := []byte(`{"spec":{"runPolicy":{"suspend":true}}}`)
+		_, err := c.kubeflowClient.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).Patch(context.TODO(), mpiJob.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return false, fmt.Errorf("suspending MPIJob: %w", err)
+		}
+		return true, nil
+	}
+
+	msg := fmt.Sprintf("MPIJob %s/%s is restarting because nodes %s are draining.", mpiJob.Namespace, mpiJob.Name, strings.Join(nodes, ", "))
+	return true, c.restartMPIJob(mpiJob, launcher, kubeflow.NodeDrainReason, msg)
+}
+
+// restartMPIJob deletes the launcher and the workers with their grace period,
+// so that they can checkpoint, and sets the Restarting condition with the
+// reason. The pods are created again once the old ones are gone.
+func (c *MPIJobController) restartMPIJob(mpiJob *kubeflow.MPIJob, launcher *batchv1.Job, reason, msg string) error {
+	klog.Info(msg)
+	if launcher != nil && launcher.DeletionTimestamp == nil {
+		// The foreground deletion keeps the launcher Job until its pods are
+		// gone, so that it isn't recreated next to them.
+		err := c.kubeClient.BatchV1().Jobs(launcher.Namespace).Delete(context.TODO(), launcher.Name, metav1.DeleteOptions{
+			PropagationPolicy: ptr.To(metav1.DeletePropagationForeground),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting launcher Job: %w", err)
+		}
+	}
+	if err := c.deleteWorkerPods(mpiJob); err != nil {
+		return err
+	}
+	initializeMPIJobStatuses(mpiJob, kubeflow.MPIReplicaTypeWorker)
+	mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Active = 0
+	mpiJob.Status.RestartCount++
+	updateMPIJobConditions(mpiJob, kubeflow.JobRestarting, corev1.ConditionTrue, reason, msg, c.clock)
+	c.recorder.Event(mpiJob, corev1.EventTypeWarning, reason, msg)
+	return c.updateStatusHandler(mpiJob)
+}
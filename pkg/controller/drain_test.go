@@ -0,0 +1,174 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
+)
+
+func TestSyncNodeDrains(t *testing.T) {
+	cordoned := func(node *corev1.Node) {
+		node.Spec.Unschedulable = true
+	}
+	cases := map[string]struct {
+		updateJob    func(*kubeflow.MPIJob)
+		updateNode   func(*corev1.Node)
+		updateWorker func(*corev1.Pod)
+		wantHandled  bool
+		wantRestart  bool
+		wantSuspend  bool
+	}{
+		"no draining node": {},
+		"cordoned": {
+			updateNode:  cordoned,
+			wantHandled: true,
+			wantRestart: true,
+		},
+		"tainted by the Cluster Autoscaler": {
+			updateNode: func(node *corev1.Node) {
+				node.Spec.Taints = []corev1.Taint{{Key: "ToBeDeletedByClusterAutoscaler", Effect: corev1.TaintEffectNoSchedule}}
+			},
+			wantHandled: true,
+			wantRestart: true,
+		},
+		"worker being evicted": {
+			updateWorker: func(pod *corev1.Pod) {
+				pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue}}
+			},
+			wantHandled: true,
+			wantRestart: true,
+		},
+		"launcher with the Never restart policy": {
+			updateJob: func(mpiJob *kubeflow.MPIJob) {
+				mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].RestartPolicy = kubeflow.RestartPolicyNever
+			},
+			updateNode:  cordoned,
+			wantHandled: true,
+			wantSuspend: true,
+		},
+		"waiting for the workers to terminate": {
+			updateJob: func(mpiJob *kubeflow.MPIJob) {
+				updateMPIJobConditions(mpiJob, kubeflow.JobRestarting, corev1.ConditionTrue, kubeflow.NodeDrainReason, "", clock.RealClock{})
+			},
+			updateWorker: func(pod *corev1.Pod) {
+				pod.DeletionTimestamp = ptr.To(metav1.Now())
+			},
+			wantHandled: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+			scheme.Scheme.Default(mpiJob)
+			updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, kubeflow.JobRunningReason, "", clock.RealClock{})
+			if tc.updateJob != nil {
+				tc.updateJob(mpiJob)
+			}
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}}
+			if tc.updateNode != nil {
+				tc.updateNode(node)
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            workerName(mpiJob, 0),
+					Namespace:       mpiJob.Namespace,
+					Labels:          defaultLabels(mpiJob.Name, worker),
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind)},
+				},
+				Spec:   corev1.PodSpec{NodeName: node.Name},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			}
+			if tc.updateWorker != nil {
+				tc.updateWorker(pod)
+			}
+			launcherJob := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            mpiJob.Name + launcherSuffix,
+					Namespace:       mpiJob.Namespace,
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind)},
+				},
+			}
+
+			kubeClient := k8sfake.NewSimpleClientset(pod, launcherJob)
+			kubeflowClient := fake.NewSimpleClientset(mpiJob)
+			factory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+			podInformer := factory.Core().V1().Pods()
+			nodeInformer := factory.Core().V1().Nodes()
+			var updated *kubeflow.MPIJob
+			c := &MPIJobController{
+				kubeClient:     kubeClient,
+				kubeflowClient: kubeflowClient,
+				podLister:      podInformer.Lister(),
+				recorder:       &record.FakeRecorder{},
+				clock:          clock.RealClock{},
+				updateStatusHandler: func(mpiJob *kubeflow.MPIJob) error {
+					updated = mpiJob
+					return nil
+				},
+			}
+			if err := c.EnableNodeDrains(nodeInformer, podInformer); err != nil {
+				t.Fatalf("Enabling the node drain handling: %v", err)
+			}
+			if err := podInformer.Informer().GetIndexer().Add(pod); err != nil {
+				t.Fatalf("Adding the worker: %v", err)
+			}
+			if err := nodeInformer.Informer().GetIndexer().Add(node); err != nil {
+				t.Fatalf("Adding the node: %v", err)
+			}
+
+			handled, err := c.syncNodeDrains(mpiJob, launcherJob)
+			if err != nil {
+				t.Fatalf("Syncing the node drains: %v", err)
+			}
+			if handled != tc.wantHandled {
+				t.Errorf("syncNodeDrains() returned %t, want %t", handled, tc.wantHandled)
+			}
+
+			_, err = kubeClient.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
+			if deleted := apierrors.IsNotFound(err); deleted != tc.wantRestart {
+				t.Errorf("Worker deleted: %t, want %t", deleted, tc.wantRestart)
+			}
+			_, err = kubeClient.BatchV1().Jobs(launcherJob.Namespace).Get(context.TODO(), launcherJob.Name, metav1.GetOptions{})
+			if deleted := apierrors.IsNotFound(err); deleted != tc.wantRestart {
+				t.Errorf("Launcher deleted: %t, want %t", deleted, tc.wantRestart)
+			}
+			if restarting := updated != nil && isRestartingForNodeDrain(updated); restarting != tc.wantRestart {
+				t.Errorf("Restarting condition set: %t, want %t", restarting, tc.wantRestart)
+			}
+			got, err := kubeflowClient.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).Get(context.TODO(), mpiJob.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting the MPIJob: %v", err)
+			}
+			if suspended := isMPIJobSuspended(got); suspended != tc.wantSuspend {
+				t.Errorf("MPIJob suspended: %t, want %t", suspended, tc.wantSuspend)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// inFlightSyncs tracks the keys being synced by the workers and when their
+// syncs started.
+type inFlightSyncs struct {
+	mu   sync.Mutex
+	keys map[string]time.Time
+}
+
+func (s *inFlightSyncs) start(key string, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.keys == nil {
+		s.keys = make(map[string]time.Time)
+	}
+	s.keys[key] = now
+}
+
+func (s *inFlightSyncs) finish(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, key)
+}
+
+func (s *inFlightSyncs) snapshot() map[string]time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return maps.Clone(s.keys)
+}
+
+// DumpState writes the keys waiting in the workqueue, the syncs in flight, the
+// pod expectations that the informer hasn't satisfied yet and the age of the
+// last MPIJob watch event, to diagnose an MPIJob that isn't reconciled. The keys waiting for their rate limiting or
+// delay aren't in the workqueue yet.
+func (c *MPIJobController) DumpState(w io.Writer) {
+	now := c.clock.Now()
+	var high, low []any
+	if c.queued != nil {
+		high, low = c.queued.snapshot()
+	}
+	fmt.Fprintf(w, "Workqueue: %d keys ready, %d of them housekeeping\n", len(high)+len(low), len(low))
+	for _, key := range high {
+		fmt.Fprintf(w, "  %v\n", key)
+	}
+	for _, key := range low {
+		fmt.Fprintf(w, "  %v (housekeeping)\n", key)
+	}
+
+	inFlight := c.inFlight.snapshot()
+	fmt.Fprintf(w, "Syncs in flight: %d\n", len(inFlight))
+	for _, key := range slices.Sorted(maps.Keys(inFlight)) {
+		fmt.Fprintf(w, "  %s for %v\n", key, now.Sub(inFlight[key]).Round(time.Millisecond))
+	}
+
+	var expectations []pendingPodsDump
+	if c.podExpectations != nil {
+		expectations = c.podExpectations.snapshot()
+	}
+	fmt.Fprintf(w, "Unsatisfied pod expectations: %d\n", len(expectations))
+	for _, e := range expectations {
+		fmt.Fprintf(w, "  %s: creations %v, deletions %v, updated %v ago\n", e.key, e.creations, e.deletions, now.Sub(e.since).Round(time.Second))
+	}
+
+	if watched := c.health.watched.Load(); watched != 0 {
+		fmt.Fprintf(w, "Last MPIJob watch event: %v ago\n", now.Sub(time.Unix(0, watched)).Round(time.Second))
+	} else {
+		fmt.Fprintln(w, "Last MPIJob watch event: none")
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestDumpState(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	housekeeping := map[any]bool{"default/finished": true}
+	c := &MPIJobController{
+		clock:           fakeClock,
+		queued:          newPriorityQueue(func(item any) bool { return housekeeping[item] }),
+		podExpectations: newPodExpectations(fakeClock),
+	}
+	c.queued.Push("default/finished")
+	c.queued.Push("default/new")
+	c.inFlight.start("default/stuck", fakeClock.Now())
+	c.inFlight.start("default/done", fakeClock.Now())
+	c.inFlight.finish("default/done")
+	c.podExpectations.expectCreation("default/scaling", "scaling-worker-1")
+	c.podExpectations.expectCreation("default/scaling", "scaling-worker-0")
+	c.podExpectations.expectCreation("default/observed", "observed-worker-0")
+	c.podExpectations.observeCreation("default/observed", "observed-worker-0")
+	c.observeMPIJobWatch()
+	fakeClock.Step(90 * time.Second)
+
+	var got strings.Builder
+	c.DumpState(&got)
+	want := `Workqueue: 2 keys ready, 1 of them housekeeping
+  default/new
+  default/finished (housekeeping)
+Syncs in flight: 1
+  default/stuck for 1m30s
+Unsatisfied pod expectations: 1
+  default/scaling: creations [scaling-worker-0 scaling-worker-1], deletions [], updated 1m30s ago
+Last MPIJob watch event: 1m30s ago
+`
+	if diff := cmp.Diff(want, got.String()); diff != "" {
+		t.Errorf("Unexpected state (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// expectationsTimeout is the time after which the pods that the informer
+// didn't observe are no longer expected, in case their events were missed.
+const expectationsTimeout = 5 * time.Minute
+
+// podExpectations tracks the worker pods created or deleted by the controller
+// that the informer hasn't observed yet, like the expectations of the Job and
+// ReplicaSet controllers. Until then, the pod lister is stale for the MPIJob,
+// and the sync would create the same pods again or delete them twice.
+type podExpectations struct {
+	mu      sync.Mutex
+	clock   clock.PassiveClock
+	pending map[string]*pendingPods
+}
+
+// pendingPods are the names of the pods of an MPIJob whose creation or
+// deletion the informer hasn't observed yet.
+type pendingPods struct {
+	creations sets.Set[string]
+	deletions sets.Set[string]
+	timestamp time.Time
+}
+
+func newPodExpectations(clock clock.PassiveClock) *podExpectations {
+	return &podExpectations{clock: clock, pending: make(map[string]*pendingPods)}
+}
+
+func (e *podExpectations) pendingFor(key string) *pendingPods {
+	p, ok := e.pending[key]
+	if !ok {
+		p = &pendingPods{creations: sets.New[string](), deletions: sets.New[string]()}
+		e.pending[key] = p
+	}
+	p.timestamp = e.clock.Now()
+	return p
+}
+
+// expectCreation records that the pod of the MPIJob is being created.
+func (e *podExpectations) expectCreation(key, pod string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pendingFor(key).creations.Insert(pod)
+}
+
+// expectDeletion records that the pod of the MPIJob is being deleted.
+func (e *podExpectations) expectDeletion(key, pod string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pendingFor(key).deletions.Insert(pod)
+}
+
+// observeCreation records that the informer observed the pod of the MPIJob,
+// or that its creation failed.
+func (e *podExpectations) observeCreation(key, pod string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if p, ok := e.pending[key]; ok {
+		p.creations.Delete(pod)
+	}
+}
+
+// observeDeletion records that the informer observed the deletion of the pod
+// of the MPIJob, or that the deletion failed.
+func (e *podExpectations) observeDeletion(key, pod string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if p, ok := e.pending[key]; ok {
+		p.deletions.Delete(pod)
+	}
+}
+
+// satisfied returns whether the informer observed all the pods created or
+// deleted for the MPIJob, or the expectations expired.
+func (e *podExpectations) satisfied(key string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	p, ok := e.pending[key]
+	if !ok {
+		return true
+	}
+	if p.creations.Len() == 0 && p.deletions.Len() == 0 {
+		return true
+	}
+	if e.clock.Since(p.timestamp) > expectationsTimeout {
+		delete(e.pending, key)
+		return true
+	}
+	return false
+}
+
+// forget removes the expectations of a deleted MPIJob.
+func (e *podExpectations) forget(key string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.pending, key)
+}
+
+// pendingPodsDump describes the pods of an MPIJob that the informer hasn't
+// observed yet, for the state dumps.
+type pendingPodsDump struct {
+	key       string
+	creations []string
+	deletions []string
+	since     time.Time
+}
+
+// snapshot returns the unsatisfied expectations, sorted by key.
+func (e *podExpectations) snapshot() []pendingPodsDump {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var dumps []pendingPodsDump
+	for key, p := range e.pending {
+		if p.creations.Len() == 0 && p.deletions.Len() == 0 {
+			continue
+		}
+		dumps = append(dumps, pendingPodsDump{
+			key:       key,
+			creations: sets.List(p.creations),
+			deletions: sets.List(p.deletions),
+			since:     p.timestamp,
+		})
+	}
+	slices.SortFunc(dumps, func(a, b pendingPodsDump) int {
+		return strings.Compare(a.key, b.key)
+	})
+	return dumps
+}
+
+// mpiJobKeyOfPod returns the key of the MPIJob controlling the pod, if any.
+// Only the worker pods are controlled by MPIJobs.
+func mpiJobKeyOfPod(pod *corev1.Pod) (string, bool) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil || ref.Kind != kubeflow.Kind || ref.APIVersion != kubeflow.SchemeGroupVersion.String() {
+		return "", false
+	}
+	return pod.Namespace + "/" + ref.Name, true
+}
+
+func podFromObject(obj interface{}) (*corev1.Pod, bool) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*corev1.Pod)
+	return pod, ok
+}
+
+// handlePodAdd observes the creation of the pod before handling it.
+func (c *MPIJobController) handlePodAdd(obj interface{}) {
+	if pod, ok := podFromObject(obj); ok {
+		if key, ok := mpiJobKeyOfPod(pod); ok {
+			c.podExpectations.observeCreation(key, pod.Name)
+			if pod.DeletionTimestamp != nil {
+				c.podExpectations.observeDeletion(key, pod.Name)
+			}
+		}
+	}
+	c.handleObject(obj)
+}
+
+// handlePodUpdate observes the deletion of the pod, which starts when its
+// deletion timestamp is set, before handling it.
+func (c *MPIJobController) handlePodUpdate(old, new interface{}) {
+	if pod, ok := podFromObject(new); ok && pod.DeletionTimestamp != nil {
+		if key, ok := mpiJobKeyOfPod(pod); ok {
+			c.podExpectations.observeDeletion(key, pod.Name)
+		}
+	}
+	c.handleObjectUpdate(old, new)
+}
+
+// handlePodDelete observes the deletion of the pod before handling it.
+func (c *MPIJobController) handlePodDelete(obj interface{}) {
+	if pod, ok := podFromObject(obj); ok {
+		if key, ok := mpiJobKeyOfPod(pod); ok {
+			c.podExpectations.observeDeletion(key, pod.Name)
+		}
+	}
+	c.handleObject(obj)
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+)
+
+func TestPodExpectations(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	e := newPodExpectations(fakeClock)
+	const key = "default/test"
+	if !e.satisfied(key) {
+		t.Errorf("Expectations of an unknown MPIJob aren't satisfied")
+	}
+
+	e.expectCreation(key, "test-worker-0")
+	e.expectCreation(key, "test-worker-1")
+	e.expectDeletion(key, "test-worker-2")
+	e.observeCreation(key, "test-worker-0")
+	e.observeDeletion(key, "test-worker-2")
+	if e.satisfied(key) {
+		t.Errorf("Expectations are satisfied before observing test-worker-1")
+	}
+	e.observeCreation(key, "test-worker-1")
+	if !e.satisfied(key) {
+		t.Errorf("Expectations aren't satisfied after observing all the pods")
+	}
+
+	e.expectDeletion(key, "test-worker-1")
+	fakeClock.Step(expectationsTimeout + time.Second)
+	if !e.satisfied(key) {
+		t.Errorf("Expectations didn't expire")
+	}
+
+	e.expectCreation(key, "test-worker-0")
+	e.forget(key)
+	if !e.satisfied(key) {
+		t.Errorf("Expectations of a deleted MPIJob aren't satisfied")
+	}
+}
+
+func TestGetOrCreateWorkerWithPendingExpectations(t *testing.T) {
+	f := newFixture(t, "")
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	f.setUpMPIJob(mpiJob)
+	c, _, _ := f.newController(clock.RealClock{})
+	// The informer doesn't observe the pods, like a slow informer.
+	c.podExpectations.expectCreation("default/test", "other")
+
+	workers, err := c.getOrCreateWorker(mpiJob)
+	if err != nil {
+		t.Fatalf("getOrCreateWorker(): %v", err)
+	}
+	if len(workers) != 0 || len(f.kubeClient.Actions()) != 0 {
+		t.Errorf("Unexpected pod creations with pending expectations: %v", f.kubeClient.Actions())
+	}
+
+	c.podExpectations.observeCreation("default/test", "other")
+	if _, err = c.getOrCreateWorker(mpiJob); err != nil {
+		t.Fatalf("getOrCreateWorker(): %v", err)
+	}
+	if got := len(f.kubeClient.Actions()); got != 2 {
+		t.Fatalf("Got %d pod creations, want 2", got)
+	}
+	if c.podExpectations.satisfied("default/test") {
+		t.Errorf("Expectations are satisfied before observing the created pods")
+	}
+	for i := 0; i < 2; i++ {
+		pod := c.newWorker(mpiJob, i)
+		c.handlePodAdd(pod)
+	}
+	if !c.podExpectations.satisfied("default/test") {
+		t.Errorf("Expectations aren't satisfied after observing the created pods")
+	}
+
+	// Deleting pods are observed from their updates.
+	e := newPodExpectations(clock.RealClock{})
+	c.podExpectations = e
+	pod := c.newWorker(mpiJob, 0)
+	e.expectDeletion("default/test", pod.Name)
+	deleting := pod.DeepCopy()
+	deleting.ResourceVersion = "2"
+	deleting.DeletionTimestamp = ptr.To(metav1.Now())
+	c.handlePodUpdate(pod, deleting)
+	if !e.satisfied("default/test") {
+		t.Errorf("Expectations aren't satisfied after observing the deletion timestamp")
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// DefaultGPUPreCheckImage is the image of the GPU pre-check, which
+	// contains the DCGM diagnostics.
+	DefaultGPUPreCheckImage = "nvcr.io/nvidia/cloud-native/dcgm:4.2.3-1-ubuntu22.04"
+
+	gpuPreCheckContainerName = "mpi-gpu-pre-check"
+	defaultGPUResourceName   = corev1.ResourceName("nvidia.com/gpu")
+	defaultMaxFailedNodes    = 3
+)
+
+// defaultGPUPreCheckCommand starts the DCGM host engine in the init container
+// and runs the quick diagnostics of the GPUs.
+var defaultGPUPreCheckCommand = []string{"/bin/sh", "-c", "nv-hostengine && dcgmi diag -r 1"}
+
+// setupGPUPreCheck adds the init container checking the GPUs of a worker that
+// requests them, before the other init containers. The init container gets
+// the GPUs of the worker, and the worker isn't scheduled on the nodes on which
+// the check already failed.
+func (c *MPIJobController) setupGPUPreCheck(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec) {
+	check := mpiJob.Spec.GPUPreCheck
+	resourceName := check.ResourceName
+	if resourceName == "" {
+		resourceName = defaultGPUResourceName
+	}
+	gpus := resource.Quantity{}
+	for _, container := range podTemplate.Spec.Containers {
+		if q, ok := container.Resources.Limits[resourceName]; ok {
+			gpus.Add(q)
+		} else if q, ok := container.Resources.Requests[resourceName]; ok {
+			gpus.Add(q)
+		}
+	}
+	if gpus.IsZero() {
+		return
+	}
+	image := check.Image
+	if image == "" {
+		image = c.GPUPreCheckImage
+	}
+	if image == "" {
+		image = DefaultGPUPreCheckImage
+	}
+	command := check.Command
+	if len(command) == 0 {
+		command = defaultGPUPreCheckCommand
+	}
+	container := corev1.Container{
+		Name:    gpuPreCheckContainerName,
+		Image:   image,
+		Command: command,
+		Resources: corev1.ResourceRequirements{
+			Limits:   corev1.ResourceList{resourceName: gpus},
+			Requests: corev1.ResourceList{resourceName: gpus},
+		},
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+	podTemplate.Spec.InitContainers = append([]corev1.Container{container}, podTemplate.Spec.InitContainers...)
+	excludeNodes(&podTemplate.Spec, mpiJob.Status.GPUPreCheckFailedNodes)
+}
+
+// excludeNodes adds the nodes to the ones the pod can't be scheduled on, to
+// each of the terms of its required node affinity.
+func excludeNodes(podSpec *corev1.PodSpec, nodes []string) {
+	if len(nodes) == 0 {
+		return
+	}
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      metav1.ObjectNameField,
+		Operator: corev1.NodeSelectorOpNotIn,
+		Values:   slices.Clone(nodes),
+	}
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.NodeAffinity == nil {
+		podSpec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	affinity := podSpec.Affinity.NodeAffinity
+	if affinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		affinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	selector := affinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(selector.NodeSelectorTerms) == 0 {
+		selector.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range selector.NodeSelectorTerms {
+		term := &selector.NodeSelectorTerms[i]
+		term.MatchFields = append(term.MatchFields, requirement)
+	}
+}
+
+// gpuPreCheckFailure returns the reason why the GPU pre-check of the worker
+// failed, if it did.
+func gpuPreCheckFailure(pod *corev1.Pod) (string, bool) {
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name != gpuPreCheckContainerName {
+			continue
+		}
+		for _, state := range []corev1.ContainerState{status.State, status.LastTerminationState} {
+			if t := state.Terminated; t != nil && t.ExitCode != 0 {
+				if t.Message != "" {
+					return t.Message, true
+				}
+				return fmt.Sprintf("exited with code %d", t.ExitCode), true
+			}
+		}
+	}
+	return "", false
+}
+
+// syncGPUPreCheck deletes the workers whose GPU pre-check failed, so that they
+// are recreated on other nodes, and records the nodes in the status. The
+// MPIJob fails once the check failed on more than
+// spec.gpuPreCheck.maxFailedNodes nodes. It returns whether the status was
+// updated.
+func (c *MPIJobController) syncGPUPreCheck(mpiJob *kubeflow.MPIJob, workers []*corev1.Pod) (bool, error) {
+	check := mpiJob.Spec.GPUPreCheck
+	if check == nil {
+		return false, nil
+	}
+	key := mpiJob.Namespace + "/" + mpiJob.Name
+	// The deleted workers stay in the lister until the informer observes
+	// their deletion.
+	if !c.podExpectations.satisfied(key) {
+		return false, nil
+	}
+	changed := false
+	for _, pod := range workers {
+		if pod == nil || pod.DeletionTimestamp != nil || pod.Spec.NodeName == "" {
+			continue
+		}
+		reason, failed := gpuPreCheckFailure(pod)
+		if !failed {
+			continue
+		}
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, kubeflow.GPUPreCheckFailedReason, "GPU pre-check of pod %s failed on node %s: %s", pod.Name, pod.Spec.NodeName, truncateMessage(reason))
+		if !slices.Contains(mpiJob.Status.GPUPreCheckFailedNodes, pod.Spec.NodeName) {
+			mpiJob.Status.GPUPreCheckFailedNodes = append(mpiJob.Status.GPUPreCheckFailedNodes, pod.Spec.NodeName)
+			slices.Sort(mpiJob.Status.GPUPreCheckFailedNodes)
+			changed = true
+		}
+		c.podExpectations.expectDeletion(key, pod.Name)
+		if err := c.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, podDeleteOptions(pod)); err != nil {
+			c.podExpectations.observeDeletion(key, pod.Name)
+			if !apierrors.IsNotFound(err) {
+				return false, fmt.Errorf("deleting worker after failed GPU pre-check: %w", err)
+			}
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+	if failedNodes := len(mpiJob.Status.GPUPreCheckFailedNodes); failedNodes > int(ptr.Deref(check.MaxFailedNodes, defaultMaxFailedNodes)) {
+		msg := truncateMessage(fmt.Sprintf("MPIJob %s/%s failed the GPU pre-check on %d nodes", mpiJob.Namespace, mpiJob.Name, failedNodes))
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, kubeflow.GPUPreCheckFailedReason, msg)
+		if mpiJob.Status.CompletionTime == nil {
+			now := metav1.NewTime(c.clock.Now())
+			mpiJob.Status.CompletionTime = &now
+		}
+		updateMPIJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.GPUPreCheckFailedReason, msg, c.clock)
+		mpiJob.Status.FailureReasonClass = kubeflow.FailureReasonClassInfrastructure
+		mpiJobsFailureCount.Inc()
+		// The launcher command didn't run, so the workers used the resources.
+		setResourceUsage(mpiJob, nil, workers)
+	}
+	return true, c.updateStatusHandler(mpiJob)
+}
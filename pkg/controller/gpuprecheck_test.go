@@ -0,0 +1,219 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestSetupGPUPreCheck(t *testing.T) {
+	mpiJob := &kubeflow.MPIJob{
+		Spec: kubeflow.MPIJobSpec{
+			GPUPreCheck: &kubeflow.GPUPreCheck{},
+		},
+		Status: kubeflow.JobStatus{
+			GPUPreCheckFailedNodes: []string{"gpu-node-3"},
+		},
+	}
+	podTemplate := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "setup"}},
+			Containers: []corev1.Container{{
+				Name: "worker",
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+				},
+			}},
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+							MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      "gpu.example.com/model",
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"h100"},
+							}},
+						}},
+					},
+				},
+			},
+		},
+	}
+	c := &MPIJobController{GPUPreCheckImage: "dcgm:test"}
+	c.setupGPUPreCheck(mpiJob, podTemplate)
+
+	want := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{
+					Name:    gpuPreCheckContainerName,
+					Image:   "dcgm:test",
+					Command: []string{"/bin/sh", "-c", "nv-hostengine && dcgmi diag -r 1"},
+					Resources: corev1.ResourceRequirements{
+						Limits:   corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+						Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+					},
+					TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				},
+				{Name: "setup"},
+			},
+			Containers: podTemplate.Spec.Containers,
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+							MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      "gpu.example.com/model",
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"h100"},
+							}},
+							MatchFields: []corev1.NodeSelectorRequirement{{
+								Key:      "metadata.name",
+								Operator: corev1.NodeSelectorOpNotIn,
+								Values:   []string{"gpu-node-3"},
+							}},
+						}},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, podTemplate); diff != "" {
+		t.Errorf("Unexpected pod template (-want,+got):\n%s", diff)
+	}
+
+	// The workers without GPUs aren't checked.
+	cpuTemplate := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "worker"}},
+		},
+	}
+	c.setupGPUPreCheck(mpiJob, cpuTemplate)
+	if len(cpuTemplate.Spec.InitContainers) != 0 || cpuTemplate.Spec.Affinity != nil {
+		t.Errorf("Unexpected GPU pre-check in a worker without GPUs: %v", cpuTemplate.Spec)
+	}
+}
+
+func TestSyncGPUPreCheck(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	failedWorker := func(mpiJob *kubeflow.MPIJob, index int, node string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      workerName(mpiJob, index),
+				Namespace: mpiJob.Namespace,
+			},
+			Spec: corev1.PodSpec{NodeName: node},
+			Status: corev1.PodStatus{
+				InitContainerStatuses: []corev1.ContainerStatus{{
+					Name: gpuPreCheckContainerName,
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 226, Message: "Fail - GPU 3 failed the memory test"},
+					},
+				}},
+			},
+		}
+	}
+	cases := map[string]struct {
+		failedNodes   []string
+		wantNodes     []string
+		wantHandled   bool
+		wantFailed    bool
+		wantEventsLen int
+	}{
+		"first failure": {
+			wantNodes:     []string{"node-a"},
+			wantHandled:   true,
+			wantEventsLen: 1,
+		},
+		"failure on a known node": {
+			failedNodes:   []string{"node-a"},
+			wantNodes:     []string{"node-a"},
+			wantEventsLen: 1,
+		},
+		"too many failed nodes": {
+			failedNodes:   []string{"node-b"},
+			wantNodes:     []string{"node-a", "node-b"},
+			wantHandled:   true,
+			wantFailed:    true,
+			wantEventsLen: 2,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+			mpiJob.Spec.GPUPreCheck = &kubeflow.GPUPreCheck{MaxFailedNodes: ptr.To[int32](1)}
+			mpiJob.Status.GPUPreCheckFailedNodes = tc.failedNodes
+			workers := []*corev1.Pod{
+				failedWorker(mpiJob, 0, "node-a"),
+				{ObjectMeta: metav1.ObjectMeta{Name: workerName(mpiJob, 1), Namespace: mpiJob.Namespace}},
+			}
+			kubeClient := k8sfake.NewSimpleClientset(workers[0], workers[1])
+			recorder := record.NewFakeRecorder(10)
+			var updated *kubeflow.MPIJob
+			c := &MPIJobController{
+				kubeClient:      kubeClient,
+				recorder:        recorder,
+				clock:           clocktesting.NewFakeClock(now),
+				podExpectations: newPodExpectations(clocktesting.NewFakeClock(now)),
+				updateStatusHandler: func(mpiJob *kubeflow.MPIJob) error {
+					updated = mpiJob
+					return nil
+				},
+			}
+
+			handled, err := c.syncGPUPreCheck(mpiJob, workers)
+			if err != nil {
+				t.Fatalf("Syncing the GPU pre-check: %v", err)
+			}
+			if handled != tc.wantHandled || (updated != nil) != tc.wantHandled {
+				t.Errorf("syncGPUPreCheck() returned %t, want %t", handled, tc.wantHandled)
+			}
+			if diff := cmp.Diff(tc.wantNodes, mpiJob.Status.GPUPreCheckFailedNodes); diff != "" {
+				t.Errorf("Unexpected failed nodes (-want,+got):\n%s", diff)
+			}
+			if got, want := <-recorder.Events, "Warning GPUPreCheckFailed GPU pre-check of pod test-worker-0 failed on node node-a: Fail - GPU 3 failed the memory test"; got != want {
+				t.Errorf("Got event %q, want %q", got, want)
+			}
+			if got := len(recorder.Events) + 1; got != tc.wantEventsLen {
+				t.Errorf("Got %d events, want %d", got, tc.wantEventsLen)
+			}
+			if _, err := kubeClient.CoreV1().Pods(mpiJob.Namespace).Get(context.TODO(), workers[0].Name, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+				t.Errorf("The worker that failed the GPU pre-check wasn't deleted: %v", err)
+			}
+			if _, err := kubeClient.CoreV1().Pods(mpiJob.Namespace).Get(context.TODO(), workers[1].Name, metav1.GetOptions{}); err != nil {
+				t.Errorf("Getting the healthy worker: %v", err)
+			}
+			cond := getCondition(mpiJob.Status, kubeflow.JobFailed)
+			if failed := cond != nil && cond.Reason == kubeflow.GPUPreCheckFailedReason; failed != tc.wantFailed {
+				t.Errorf("MPIJob failed: %t, want %t", failed, tc.wantFailed)
+			}
+		})
+	}
+}
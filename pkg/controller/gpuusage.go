@@ -0,0 +1,67 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// gpuUsageTimeout bounds the time spent querying the GPU usage of a
+	// finished MPIJob.
+	gpuUsageTimeout = 30 * time.Second
+
+	gpuUsageReason            = "GPUUsage"
+	gpuUsageQueryFailedReason = "GPUUsageQueryFailed"
+)
+
+// setGPUUsage sets the usage of the GPUs of the finished MPIJob, between its
+// start and completion times, in its status.
+func (c *MPIJobController) setGPUUsage(mpiJob *kubeflow.MPIJob) {
+	if c.GPUUsageSource == nil || mpiJob.Status.StartTime == nil {
+		return
+	}
+	end := c.clock.Now()
+	if mpiJob.Status.CompletionTime != nil {
+		end = mpiJob.Status.CompletionTime.Time
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gpuUsageTimeout)
+	defer cancel()
+	usage, err := c.GPUUsageSource.Usage(ctx, mpiJob.Namespace, mpiJob.Name, mpiJob.Status.StartTime.Time, end)
+	if err != nil {
+		klog.Errorf("Failed to query the GPU usage of MPIJob %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, gpuUsageQueryFailedReason, truncateMessage(fmt.Sprintf("Failed to query the GPU usage: %v", err)))
+		return
+	}
+	if usage == nil {
+		return
+	}
+	mpiJob.Status.GPUUsage = &kubeflow.GPUUsage{
+		GPUs:               int32(usage.GPUs),
+		AverageUtilization: int32(math.Round(usage.AverageUtilization)),
+		PeakUtilization:    int32(math.Round(usage.PeakUtilization)),
+		EnergyJoules:       int64(math.Round(usage.EnergyJoules)),
+	}
+	c.recorder.Eventf(mpiJob, corev1.EventTypeNormal, gpuUsageReason, "MPIJob used %d GPUs at an average utilization of %d%%, with a peak of %d%%, and an estimated %.1f kWh",
+		mpiJob.Status.GPUUsage.GPUs, mpiJob.Status.GPUUsage.AverageUtilization, mpiJob.Status.GPUUsage.PeakUtilization, usage.EnergyJoules/3.6e6)
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// health tracks the progress of the controller for its health checks. The
+// times are in Unix nanoseconds, 0 meaning that the event didn't happen yet.
+type health struct {
+	// started is when the controller started waiting for the informer caches.
+	started atomic.Int64
+	// synced is when the informer caches finished syncing.
+	synced atomic.Int64
+	// dequeued is when a worker last took a key from the workqueue, or when
+	// the workers started.
+	dequeued atomic.Int64
+	// watched is when the MPIJob informer last delivered an MPIJob from the
+	// API server, that is, not from a resync of its cache.
+	watched atomic.Int64
+}
+
+// observeMPIJobWatch records that the MPIJob informer delivered an MPIJob
+// from the API server.
+func (c *MPIJobController) observeMPIJobWatch() {
+	now := c.clock.Now()
+	c.health.watched.Store(now.UnixNano())
+	lastMPIJobWatchEventTime.Set(float64(now.Unix()))
+}
+
+// CheckCachesSynced fails if the informer caches haven't synced within
+// CacheSyncTimeout of the start of the controller. It has the signature of
+// the health checks of k8s.io/apiserver/pkg/server/healthz.
+//
+// The caches aren't checked once synced: the informers relist when their
+// watches fail, but a watch that stays open without delivering the changes
+// isn't detected, since the MPIJobs of an idle cluster don't change either.
+// The time of the last MPIJob delivered by the watch is exported in the
+// mpi_operator_mpijob_watch_event_timestamp_seconds metric and in the dumps
+// of DumpState instead, to alert on it along with the MPIJob activity.
+func (c *MPIJobController) CheckCachesSynced(*http.Request) error {
+	if c.CacheSyncTimeout == 0 || c.health.synced.Load() != 0 {
+		return nil
+	}
+	started := c.health.started.Load()
+	if started == 0 {
+		return nil
+	}
+	if waiting := c.clock.Since(time.Unix(0, started)); waiting > c.CacheSyncTimeout {
+		return fmt.Errorf("informer caches haven't synced after %v", waiting.Round(time.Second))
+	}
+	return nil
+}
+
+// CheckQueueProgress fails if the workqueue has keys but the workers haven't
+// taken any for QueueStallTimeout, like when all of them are stuck in a sync.
+func (c *MPIJobController) CheckQueueProgress(*http.Request) error {
+	dequeued := c.health.dequeued.Load()
+	if c.QueueStallTimeout == 0 || dequeued == 0 {
+		return nil
+	}
+	pending := c.queue.Len()
+	if pending == 0 {
+		return nil
+	}
+	if stalled := c.clock.Since(time.Unix(0, dequeued)); stalled > c.QueueStallTimeout {
+		return fmt.Errorf("no key was taken from the workqueue in %v, while %d are pending", stalled.Round(time.Second), pending)
+	}
+	return nil
+}
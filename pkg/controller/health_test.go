@@ -0,0 +1,72 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestCheckCachesSynced(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	c := &MPIJobController{clock: fakeClock, CacheSyncTimeout: time.Minute}
+	if err := c.CheckCachesSynced(nil); err != nil {
+		t.Errorf("Check failed before the controller started: %v", err)
+	}
+	c.health.started.Store(fakeClock.Now().UnixNano())
+	fakeClock.Step(30 * time.Second)
+	if err := c.CheckCachesSynced(nil); err != nil {
+		t.Errorf("Check failed while the caches are syncing: %v", err)
+	}
+	fakeClock.Step(time.Minute)
+	if err := c.CheckCachesSynced(nil); err == nil {
+		t.Error("Check succeeded after the caches didn't sync within the timeout")
+	}
+	c.health.synced.Store(fakeClock.Now().UnixNano())
+	if err := c.CheckCachesSynced(nil); err != nil {
+		t.Errorf("Check failed after the caches synced: %v", err)
+	}
+}
+
+func TestCheckQueueProgress(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	c := &MPIJobController{
+		clock:             fakeClock,
+		queue:             workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[any]()),
+		QueueStallTimeout: time.Minute,
+	}
+	defer c.queue.ShutDown()
+	c.health.dequeued.Store(fakeClock.Now().UnixNano())
+	fakeClock.Step(2 * time.Minute)
+	if err := c.CheckQueueProgress(nil); err != nil {
+		t.Errorf("Check failed with an empty workqueue: %v", err)
+	}
+	c.queue.Add("default/test")
+	if err := c.CheckQueueProgress(nil); err == nil {
+		t.Error("Check succeeded with a stalled workqueue")
+	}
+	c.health.dequeued.Store(fakeClock.Now().UnixNano())
+	if err := c.CheckQueueProgress(nil); err != nil {
+		t.Errorf("Check failed after a key was taken: %v", err)
+	}
+	c.QueueStallTimeout = 0
+	fakeClock.Step(2 * time.Minute)
+	if err := c.CheckQueueProgress(nil); err != nil {
+		t.Errorf("Check failed while disabled: %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	preRunHook  = "pre-run"
+	postRunHook = "post-run"
+)
+
+// syncPreRunHook runs the pre-run hook of the MPIJob, if any, and returns
+// whether the launcher can be created. When the hook failed, it only records
+// the PreRunHookCompleted condition: updateMPIJobStatus fails the MPIJob, so
+// that the failure goes through the same steps as any other.
+func (c *MPIJobController) syncPreRunHook(mpiJob *kubeflow.MPIJob) (bool, error) {
+	if mpiJob.Spec.Hooks == nil || mpiJob.Spec.Hooks.PreRun == nil {
+		return true, nil
+	}
+	if hasCondition(mpiJob.Status, kubeflow.JobPreRunHookCompleted) {
+		return true, nil
+	}
+	if isMPIJobSuspended(mpiJob) {
+		return false, nil
+	}
+	job, err := c.getOrCreateHookJob(mpiJob, mpiJob.Spec.Hooks.PreRun, preRunHook)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case isJobSucceeded(job):
+		msg := fmt.Sprintf("Pre-run hook of MPIJob %s/%s succeeded.", mpiJob.Namespace, mpiJob.Name)
+		updateMPIJobConditions(mpiJob, kubeflow.JobPreRunHookCompleted, corev1.ConditionTrue, kubeflow.HookSucceededReason, msg, c.clock)
+		return true, nil
+	case isJobFailed(job):
+		msg := truncateMessage(fmt.Sprintf("Pre-run hook of MPIJob %s/%s failed: %s", mpiJob.Namespace, mpiJob.Name, hookFailure(job)))
+		updateMPIJobConditions(mpiJob, kubeflow.JobPreRunHookCompleted, corev1.ConditionFalse, kubeflow.HookFailedReason, msg, c.clock)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, kubeflow.PreRunHookFailedReason, msg)
+		return false, nil
+	}
+	msg := fmt.Sprintf("Pre-run hook of MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJob, kubeflow.JobPreRunHookCompleted, corev1.ConditionFalse, kubeflow.HookRunningReason, msg, c.clock)
+	return false, nil
+}
+
+// updatePreRunHookFailedStatus fails the MPIJob whose pre-run hook failed.
+func (c *MPIJobController) updatePreRunHookFailedStatus(mpiJob *kubeflow.MPIJob) {
+	cond := getCondition(mpiJob.Status, kubeflow.JobPreRunHookCompleted)
+	if cond == nil || cond.Reason != kubeflow.HookFailedReason || isFinished(mpiJob.Status) {
+		return
+	}
+	updateMPIJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.PreRunHookFailedReason, cond.Message, c.clock)
+	mpiJobsFailureCount.Inc()
+}
+
+// syncPostRunHook runs the post-run hook of the finished MPIJob, if any, and
+// returns whether its PostRunHookCompleted condition changed.
+func (c *MPIJobController) syncPostRunHook(mpiJob *kubeflow.MPIJob) (bool, error) {
+	if mpiJob.Spec.Hooks == nil || mpiJob.Spec.Hooks.PostRun == nil {
+		return false, nil
+	}
+	// The Job of the hook might be gone once the condition records its result.
+	if cond := getCondition(mpiJob.Status, kubeflow.JobPostRunHookCompleted); cond != nil && cond.Reason != kubeflow.HookRunningReason {
+		return false, nil
+	}
+	job, err := c.getOrCreateHookJob(mpiJob, mpiJob.Spec.Hooks.PostRun, postRunHook)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case isJobSucceeded(job):
+		msg := fmt.Sprintf("Post-run hook of MPIJob %s/%s succeeded.", mpiJob.Namespace, mpiJob.Name)
+		return updateMPIJobConditions(mpiJob, kubeflow.JobPostRunHookCompleted, corev1.ConditionTrue, kubeflow.HookSucceededReason, msg, c.clock), nil
+	case isJobFailed(job):
+		msg := truncateMessage(fmt.Sprintf("Post-run hook of MPIJob %s/%s failed: %s", mpiJob.Namespace, mpiJob.Name, hookFailure(job)))
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, kubeflow.HookFailedReason, msg)
+		return updateMPIJobConditions(mpiJob, kubeflow.JobPostRunHookCompleted, corev1.ConditionFalse, kubeflow.HookFailedReason, msg, c.clock), nil
+	}
+	msg := fmt.Sprintf("Post-run hook of MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	return updateMPIJobConditions(mpiJob, kubeflow.JobPostRunHookCompleted, corev1.ConditionFalse, kubeflow.HookRunningReason, msg, c.clock), nil
+}
+
+// getOrCreateHookJob gets the Job of the hook controlled by this MPIJob, or
+// creates one if it doesn't exist.
+func (c *MPIJobController) getOrCreateHookJob(mpiJob *kubeflow.MPIJob, hook *kubeflow.Hook, role string) (*batchv1.Job, error) {
+	name := hookJobName(mpiJob, role)
+	job, err := c.jobLister.Jobs(mpiJob.Namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		klog.V(4).Infof("Creating the %s hook of %s/%s", role, mpiJob.Namespace, mpiJob.Name)
+		job, err = c.kubeClient.BatchV1().Jobs(mpiJob.Namespace).Create(context.TODO(), c.newHookJob(mpiJob, hook, role), metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting or creating the %s hook Job: %w", role, err)
+	}
+	if !metav1.IsControlledBy(job, mpiJob) {
+		msg := fmt.Sprintf(MessageResourceExists, job.Name, job.Kind)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return nil, errors.New(msg)
+	}
+	return job, nil
+}
+
+func hookJobName(mpiJob *kubeflow.MPIJob, role string) string {
+	return mpiJob.Name + "-" + role
+}
+
+// newHookJob creates the Job of the hook. A container runs with the volumes,
+// the service account and the image pull secrets of the launcher, while a Job
+// template is only given the name, the labels and the owner of the hook.
+func (c *MPIJobController) newHookJob(mpiJob *kubeflow.MPIJob, hook *kubeflow.Hook, role string) *batchv1.Job {
+	job := &batchv1.Job{}
+	if hook.JobTemplate != nil {
+		job.ObjectMeta = *hook.JobTemplate.ObjectMeta.DeepCopy()
+		job.Spec = *hook.JobTemplate.Spec.DeepCopy()
+		if job.Spec.Template.Spec.RestartPolicy == "" {
+			job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+		}
+	} else {
+		launcherSpec := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec
+		job.Spec.Template.Spec = corev1.PodSpec{
+			Containers:         []corev1.Container{*hook.Container.DeepCopy()},
+			RestartPolicy:      corev1.RestartPolicyNever,
+			ServiceAccountName: launcherSpec.ServiceAccountName,
+			ImagePullSecrets:   launcherSpec.ImagePullSecrets,
+		}
+		for _, volume := range launcherSpec.Volumes {
+			job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, *volume.DeepCopy())
+		}
+	}
+	podTemplate := &job.Spec.Template
+	if podTemplate.Labels == nil {
+		podTemplate.Labels = make(map[string]string)
+	}
+	for key, value := range defaultLabels(mpiJob.Name, role) {
+		podTemplate.Labels[key] = value
+	}
+	c.propagateMetadata(mpiJob, &podTemplate.ObjectMeta)
+	if c.PodDefaults != nil && !hasOverride(mpiJob, kubeflow.SkipPodDefaultsAnnotation) {
+		c.PodDefaults.Apply(podTemplate)
+	}
+
+	job.Name = hookJobName(mpiJob, role)
+	job.Namespace = mpiJob.Namespace
+	if job.Labels == nil {
+		job.Labels = make(map[string]string)
+	}
+	job.Labels["app"] = mpiJob.Name
+	job.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind),
+	}
+	if hook.BackoffLimit != nil || job.Spec.BackoffLimit == nil {
+		job.Spec.BackoffLimit = ptr.To(ptr.Deref(hook.BackoffLimit, 0))
+	}
+	if hook.ActiveDeadlineSeconds != nil {
+		job.Spec.ActiveDeadlineSeconds = hook.ActiveDeadlineSeconds
+	}
+	c.propagateMetadata(mpiJob, &job.ObjectMeta)
+	return job
+}
+
+// hookFailure returns the message, or else the reason, of the Failed
+// condition of the Job of a hook.
+func hookFailure(job *batchv1.Job) string {
+	cond := getJobCondition(job, batchv1.JobFailed)
+	if cond.Message == "" {
+		return cond.Reason
+	}
+	return cond.Message
+}
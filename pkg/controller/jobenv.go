@@ -0,0 +1,68 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	mpiJobNameEnv           = "MPIJOB_NAME"
+	mpiJobNamespaceEnv      = "MPIJOB_NAMESPACE"
+	mpiJobWorkerReplicasEnv = "MPIJOB_WORKER_REPLICAS"
+	mpiJobSlotsPerWorkerEnv = "MPIJOB_SLOTS_PER_WORKER"
+	mpiJobAttemptEnv        = "MPIJOB_ATTEMPT"
+	mpiJobWorkerIndexEnv    = "MPIJOB_WORKER_INDEX"
+)
+
+// setJobMetadataEnv adds the variables describing the MPIJob to the
+// containers and init containers of the pod, so that the applications and
+// the logging agents can tag their output without parsing the pod names. The
+// attempt counts the restarts of the MPIJob by the operator, starting at 1.
+// The worker index is only set in the workers, nil otherwise, whose slots are
+// those of their worker group. The variables
+// set in the pod template take precedence.
+func setJobMetadataEnv(mpiJob *kubeflow.MPIJob, podSpec *corev1.PodSpec, workerIndex *int) {
+	slots := ptr.Deref(mpiJob.Spec.SlotsPerWorker, 1)
+	if workerIndex != nil {
+		slots = workerSlots(mpiJob, *workerIndex)
+	}
+	env := []corev1.EnvVar{
+		{Name: mpiJobNameEnv, Value: mpiJob.Name},
+		{Name: mpiJobNamespaceEnv, Value: mpiJob.Namespace},
+		{Name: mpiJobWorkerReplicasEnv, Value: strconv.Itoa(int(workerReplicas(mpiJob)))},
+		{Name: mpiJobSlotsPerWorkerEnv, Value: strconv.Itoa(int(slots))},
+		{Name: mpiJobAttemptEnv, Value: strconv.Itoa(int(mpiJob.Status.RestartCount) + 1)},
+	}
+	if workerIndex != nil {
+		env = append(env, corev1.EnvVar{Name: mpiJobWorkerIndexEnv, Value: strconv.Itoa(*workerIndex)})
+	}
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			container := &containers[i]
+			for _, e := range env {
+				if !slices.ContainsFunc(container.Env, func(v corev1.EnvVar) bool { return v.Name == e.Name }) {
+					container.Env = append(container.Env, e)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestSetJobMetadataEnv(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](4), nil, nil)
+	mpiJob.Spec.SlotsPerWorker = ptr.To[int32](8)
+	mpiJob.Status.RestartCount = 2
+	podSpec := &corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "log-agent"}},
+		Containers: []corev1.Container{{
+			Name: "worker",
+			Env:  []corev1.EnvVar{{Name: "MPIJOB_NAME", Value: "training-run"}},
+		}},
+	}
+	setJobMetadataEnv(mpiJob, podSpec, ptr.To(3))
+
+	want := &corev1.PodSpec{
+		InitContainers: []corev1.Container{{
+			Name: "log-agent",
+			Env: []corev1.EnvVar{
+				{Name: "MPIJOB_NAME", Value: "test"},
+				{Name: "MPIJOB_NAMESPACE", Value: "default"},
+				{Name: "MPIJOB_WORKER_REPLICAS", Value: "4"},
+				{Name: "MPIJOB_SLOTS_PER_WORKER", Value: "8"},
+				{Name: "MPIJOB_ATTEMPT", Value: "3"},
+				{Name: "MPIJOB_WORKER_INDEX", Value: "3"},
+			},
+		}},
+		Containers: []corev1.Container{{
+			Name: "worker",
+			Env: []corev1.EnvVar{
+				{Name: "MPIJOB_NAME", Value: "training-run"},
+				{Name: "MPIJOB_NAMESPACE", Value: "default"},
+				{Name: "MPIJOB_WORKER_REPLICAS", Value: "4"},
+				{Name: "MPIJOB_SLOTS_PER_WORKER", Value: "8"},
+				{Name: "MPIJOB_ATTEMPT", Value: "3"},
+				{Name: "MPIJOB_WORKER_INDEX", Value: "3"},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, podSpec); diff != "" {
+		t.Errorf("Unexpected pod spec (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// DefaultLaunchWrapperImage is the image from which the launch wrapper is
+	// copied to the launcher pods, built from build/launch-wrapper.
+	DefaultLaunchWrapperImage = "mpioperator/launch-wrapper:latest"
+
+	launchWrapperInstallContainerName = "mpi-launch-wrapper-install"
+	launchWrapperVolumeName           = "mpi-launch-wrapper"
+	launchWrapperMountPath            = "/opt/mpi-launch-wrapper"
+	launchWrapperScript               = "launch-wrapper.sh"
+	launchWrapperMaxAttemptsEnv       = "LAUNCH_WRAPPER_MAX_ATTEMPTS"
+	launchWrapperAttemptTimeoutEnv    = "LAUNCH_WRAPPER_ATTEMPT_TIMEOUT_SECONDS"
+	launchWrapperRetryDelayEnv        = "LAUNCH_WRAPPER_RETRY_DELAY_SECONDS"
+	launchWrapperRetryOnExitCodesEnv  = "LAUNCH_WRAPPER_RETRY_ON_EXIT_CODES"
+	defaultLaunchRetryDelay           = 10
+	// launchReportPrefix starts the line of the termination message in which
+	// the launch wrapper reports the outcome of the command.
+	launchReportPrefix = "mpi-launch-wrapper: "
+
+	launchResultReason = "LaunchResult"
+)
+
+// setupLaunchWrapper copies the launch wrapper to the launcher pod with an
+// init container, and runs the command of the launcher container with it.
+// The launcher falls back to its logs for its termination message, in which
+// the wrapper reports the outcome of a failed command.
+func (c *MPIJobController) setupLaunchWrapper(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, container *corev1.Container) {
+	wrapper := mpiJob.Spec.LaunchWrapper
+	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
+		Name: launchWrapperVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+	mount := corev1.VolumeMount{
+		Name:      launchWrapperVolumeName,
+		MountPath: launchWrapperMountPath,
+	}
+	podTemplate.Spec.InitContainers = append(podTemplate.Spec.InitContainers, corev1.Container{
+		Name:         launchWrapperInstallContainerName,
+		Image:        overriddenImage(mpiJob, kubeflow.LaunchWrapperImageAnnotation, c.LaunchWrapperImage, DefaultLaunchWrapperImage),
+		Command:      []string{"cp", "/" + launchWrapperScript, launchWrapperMountPath},
+		VolumeMounts: []corev1.VolumeMount{mount},
+	})
+	mount.ReadOnly = true
+	container.VolumeMounts = append(container.VolumeMounts, mount)
+	container.Command = append([]string{"/bin/sh", path.Join(launchWrapperMountPath, launchWrapperScript)}, container.Command...)
+
+	container.Env = append(container.Env,
+		corev1.EnvVar{Name: launchWrapperMaxAttemptsEnv, Value: strconv.Itoa(int(ptr.Deref(wrapper.MaxAttempts, 1)))},
+		corev1.EnvVar{Name: launchWrapperRetryDelayEnv, Value: strconv.Itoa(int(ptr.Deref(wrapper.RetryDelaySeconds, defaultLaunchRetryDelay)))})
+	if wrapper.AttemptTimeoutSeconds != nil {
+		container.Env = append(container.Env, corev1.EnvVar{Name: launchWrapperAttemptTimeoutEnv, Value: strconv.Itoa(int(*wrapper.AttemptTimeoutSeconds))})
+	}
+	if len(wrapper.RetryOnExitCodes) > 0 {
+		codes := make([]string, len(wrapper.RetryOnExitCodes))
+		for i, code := range wrapper.RetryOnExitCodes {
+			codes[i] = strconv.Itoa(int(code))
+		}
+		container.Env = append(container.Env, corev1.EnvVar{Name: launchWrapperRetryOnExitCodesEnv, Value: strings.Join(codes, " ")})
+	}
+	if container.TerminationMessagePath == "" {
+		container.TerminationMessagePath = corev1.TerminationMessagePathDefault
+	}
+	container.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+}
+
+// updateMPIJobLaunchResult sets the outcome of the command of the last
+// launcher pod that terminated, as reported by the launch wrapper, in the
+// status of the MPIJob.
+func (c *MPIJobController) updateMPIJobLaunchResult(mpiJob *kubeflow.MPIJob, launcherPods []*corev1.Pod) {
+	var lastPod *corev1.Pod
+	var result *kubeflow.LaunchResult
+	for _, pod := range launcherPods {
+		if len(pod.Spec.Containers) == 0 || (lastPod != nil && pod.CreationTimestamp.Before(&lastPod.CreationTimestamp)) {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != pod.Spec.Containers[0].Name {
+				continue
+			}
+			terminated := status.State.Terminated
+			if terminated == nil {
+				terminated = status.LastTerminationState.Terminated
+			}
+			if terminated == nil {
+				continue
+			}
+			if r := parseLaunchResult(terminated.Message); r != nil {
+				lastPod, result = pod, r
+			}
+		}
+	}
+	if result == nil || ptr.Equal(mpiJob.Status.LaunchResult, result) {
+		return
+	}
+	mpiJob.Status.LaunchResult = result
+	eventType := corev1.EventTypeNormal
+	if result.Reason != kubeflow.LaunchResultSucceeded {
+		eventType = corev1.EventTypeWarning
+	}
+	c.recorder.Eventf(mpiJob, eventType, launchResultReason, "The launcher command of pod %s %s", lastPod.Name, launchResultMessage(result))
+}
+
+// launchResultMessage describes the outcome of the command of a launcher pod.
+func launchResultMessage(result *kubeflow.LaunchResult) string {
+	var outcome string
+	switch result.Reason {
+	case kubeflow.LaunchResultSucceeded:
+		outcome = "succeeded"
+	case kubeflow.LaunchResultTimedOut:
+		outcome = "timed out"
+	default:
+		outcome = fmt.Sprintf("failed with exit code %d", result.ExitCode)
+	}
+	attempts := "attempt"
+	if result.Attempts != 1 {
+		attempts += "s"
+	}
+	return fmt.Sprintf("%s after %d %s", outcome, result.Attempts, attempts)
+}
+
+// parseLaunchResult returns the last report of the launch wrapper in the
+// termination message of the launcher container, if any.
+func parseLaunchResult(message string) *kubeflow.LaunchResult {
+	lines := strings.Split(message, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		report, ok := strings.CutPrefix(strings.TrimSpace(lines[i]), launchReportPrefix)
+		// The wrapper also logs its retries with the prefix.
+		if !ok || !strings.HasPrefix(report, "{") {
+			continue
+		}
+		var result kubeflow.LaunchResult
+		if err := json.Unmarshal([]byte(report), &result); err != nil {
+			continue
+		}
+		return &result
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestSetupLaunchWrapper(t *testing.T) {
+	mpiJob := &kubeflow.MPIJob{
+		Spec: kubeflow.MPIJobSpec{
+			LaunchWrapper: &kubeflow.LaunchWrapper{
+				MaxAttempts:           ptr.To[int32](3),
+				AttemptTimeoutSeconds: ptr.To[int32](3600),
+				RetryOnExitCodes:      []int32{1, 124},
+			},
+		},
+	}
+	podTemplate := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    "launcher",
+				Command: []string{"mpirun", "-np", "4"},
+				Args:    []string{"python", "train.py"},
+			}},
+		},
+	}
+	c := &MPIJobController{LaunchWrapperImage: "launch-wrapper:test"}
+	c.setupLaunchWrapper(mpiJob, podTemplate, &podTemplate.Spec.Containers[0])
+
+	wantInit := []corev1.Container{{
+		Name:    launchWrapperInstallContainerName,
+		Image:   "launch-wrapper:test",
+		Command: []string{"cp", "/launch-wrapper.sh", "/opt/mpi-launch-wrapper"},
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      launchWrapperVolumeName,
+			MountPath: "/opt/mpi-launch-wrapper",
+		}},
+	}}
+	if diff := cmp.Diff(wantInit, podTemplate.Spec.InitContainers); diff != "" {
+		t.Errorf("Unexpected init containers (-want,+got):\n%s", diff)
+	}
+	wantContainer := corev1.Container{
+		Name:    "launcher",
+		Command: []string{"/bin/sh", "/opt/mpi-launch-wrapper/launch-wrapper.sh", "mpirun", "-np", "4"},
+		Args:    []string{"python", "train.py"},
+		Env: []corev1.EnvVar{
+			{Name: "LAUNCH_WRAPPER_MAX_ATTEMPTS", Value: "3"},
+			{Name: "LAUNCH_WRAPPER_RETRY_DELAY_SECONDS", Value: "10"},
+			{Name: "LAUNCH_WRAPPER_ATTEMPT_TIMEOUT_SECONDS", Value: "3600"},
+			{Name: "LAUNCH_WRAPPER_RETRY_ON_EXIT_CODES", Value: "1 124"},
+		},
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      launchWrapperVolumeName,
+			MountPath: "/opt/mpi-launch-wrapper",
+			ReadOnly:  true,
+		}},
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+	if diff := cmp.Diff(wantContainer, podTemplate.Spec.Containers[0]); diff != "" {
+		t.Errorf("Unexpected launcher container (-want,+got):\n%s", diff)
+	}
+}
+
+func TestParseLaunchResult(t *testing.T) {
+	cases := map[string]struct {
+		message string
+		want    *kubeflow.LaunchResult
+	}{
+		"no report": {
+			message: "mpirun noticed that process rank 0 exited on signal 9 (Killed).",
+		},
+		"report in the logs": {
+			message: `mpi-launch-wrapper: attempt 1 of 2 exited with code 1, retrying in 10s
+mpirun noticed that process rank 1 with PID 0 on node pi-worker-0 exited on signal 11 (Segmentation fault).
+mpi-launch-wrapper: {"attempts":2,"reason":"Failed","exitCode":139}
+`,
+			want: &kubeflow.LaunchResult{Attempts: 2, Reason: kubeflow.LaunchResultFailed, ExitCode: 139},
+		},
+		"report after the termination message of the command": {
+			message: "8 1.23\n16 2.34\nmpi-launch-wrapper: {\"attempts\":1,\"reason\":\"Succeeded\",\"exitCode\":0}\n",
+			want:    &kubeflow.LaunchResult{Attempts: 1, Reason: kubeflow.LaunchResultSucceeded},
+		},
+		"truncated report": {
+			message: `mpi-launch-wrapper: {"attempts":3,"reason":"Timed`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, parseLaunchResult(tc.message)); diff != "" {
+				t.Errorf("Unexpected launch result (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateMPIJobLaunchResult(t *testing.T) {
+	now := time.Now()
+	launcherPod := func(name string, created time.Time, message string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "launcher"}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name: "launcher",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 124, Message: message},
+					},
+				}},
+			},
+		}
+	}
+	pods := []*corev1.Pod{
+		launcherPod("test-launcher-b", now, `mpi-launch-wrapper: {"attempts":3,"reason":"TimedOut","exitCode":124}`),
+		launcherPod("test-launcher-a", now.Add(-time.Hour), `mpi-launch-wrapper: {"attempts":1,"reason":"Failed","exitCode":1}`),
+	}
+	mpiJob := &kubeflow.MPIJob{
+		Spec: kubeflow.MPIJobSpec{LaunchWrapper: &kubeflow.LaunchWrapper{}},
+	}
+	recorder := record.NewFakeRecorder(10)
+	c := &MPIJobController{recorder: recorder}
+	c.updateMPIJobLaunchResult(mpiJob, pods)
+
+	want := &kubeflow.LaunchResult{Attempts: 3, Reason: kubeflow.LaunchResultTimedOut, ExitCode: 124}
+	if diff := cmp.Diff(want, mpiJob.Status.LaunchResult); diff != "" {
+		t.Errorf("Unexpected launch result (-want,+got):\n%s", diff)
+	}
+	if got, want := <-recorder.Events, "Warning LaunchResult The launcher command of pod test-launcher-b timed out after 3 attempts"; got != want {
+		t.Errorf("Got event %q, want %q", got, want)
+	}
+
+	// The same report isn't recorded again.
+	c.updateMPIJobLaunchResult(mpiJob, pods)
+	if len(recorder.Events) != 0 {
+		t.Errorf("Unexpected event %q", <-recorder.Events)
+	}
+}
@@ -25,8 +25,12 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -65,6 +69,13 @@ import (
 	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
 	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions/kubeflow/v2beta1"
 	listers "github.com/kubeflow/mpi-operator/pkg/client/listers/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/cloudevents"
+	"github.com/kubeflow/mpi-operator/pkg/gpuusage"
+	"github.com/kubeflow/mpi-operator/pkg/history"
+	"github.com/kubeflow/mpi-operator/pkg/jobmetrics"
+	"github.com/kubeflow/mpi-operator/pkg/notification"
+	"github.com/kubeflow/mpi-operator/pkg/poddefaults"
+	"github.com/kubeflow/mpi-operator/pkg/supportbundle"
 )
 
 const (
@@ -108,12 +119,35 @@ const (
 	// policy is set in pod template.
 	podTemplateRestartPolicyReason = "SetPodTemplateRestartPolicy"
 
+	// launcherRestartedReason is the warning reason when the launcher
+	// restarted.
+	launcherRestartedReason = "LauncherRestarted"
+
 	// eventMessageLimit is the maximum size of an Event's message.
 	// From: k8s.io/kubernetes/pkg/apis/core/validation/events.go
 	eventMessageLimit = 1024
 
 	openMPISlotsEnv  = "OMPI_MCA_orte_set_default_slots"
 	intelMPISlotsEnv = "I_MPI_PERHOST"
+
+	// historyArchiveTimeout bounds the time spent archiving a finished MPIJob.
+	historyArchiveTimeout = 30 * time.Second
+
+	// supportBundleTimeout bounds the time spent collecting and storing the
+	// support bundle of a failed MPIJob.
+	supportBundleTimeout = 2 * time.Minute
+
+	// notificationTimeout bounds the time spent sending the notifications of
+	// a finished MPIJob.
+	notificationTimeout = 30 * time.Second
+
+	// metricsPushTimeout bounds the time spent pushing the final metrics of
+	// a finished MPIJob.
+	metricsPushTimeout = 30 * time.Second
+
+	// slowStartInitialBatchSize is the number of worker pods created by the
+	// first batch of a sync.
+	slowStartInitialBatchSize = 1
 )
 
 var (
@@ -133,6 +167,23 @@ var (
 		Name: "mpi_operator_job_info",
 		Help: "Information about MPIJob",
 	}, []string{"launcher", "namespace"})
+	syncPanicsCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "mpi_operator_sync_panics_total",
+		Help: "Counts number of syncs of MPIJobs that panicked",
+	})
+	lastMPIJobWatchEventTime = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "mpi_operator_mpijob_watch_event_timestamp_seconds",
+		Help: "Time of the last MPIJob delivered by the watch of the API server, excluding the resyncs of the informer",
+	})
+
+	// ManagedSecretsSelector selects the Secrets created by the operator, so
+	// that the Secret informer doesn't cache the other Secrets.
+	ManagedSecretsSelector = labels.Set{kubeflow.OperatorNameLabel: kubeflow.OperatorName}.String()
+
+	// UnfinishedPodsSelector selects the pods that aren't left by finished
+	// MPIJobs, so that the pod informer drops the pods released by the
+	// controller.
+	UnfinishedPodsSelector = "!" + kubeflow.JobFinishedLabel
 
 	sshVolumeItems = []corev1.KeyToPath{
 		{
@@ -222,6 +273,98 @@ type MPIJobController struct {
 	kubeflowClient clientset.Interface
 	// PodGroupCtrl is a client for PodGroups (volcano and scheduler-plugins).
 	PodGroupCtrl PodGroupControl
+	// HistoryBackend archives the records of finished MPIJobs, if set.
+	HistoryBackend history.Backend
+
+	// SupportBundleStore stores the support bundles of failed MPIJobs, if set.
+	SupportBundleStore supportbundle.Store
+
+	// Notifier sends the notifications of finished MPIJobs, if set.
+	Notifier notification.Notifier
+
+	// CloudEventSink receives the CloudEvents of the lifecycle transitions of
+	// the MPIJobs, if set. It must not block.
+	CloudEventSink cloudevents.Sink
+
+	// MetricsPusher pushes the final metrics of finished MPIJobs, if set.
+	MetricsPusher jobmetrics.Pusher
+
+	// GPUUsageSource reads the GPU usage of finished MPIJobs, if set.
+	GPUUsageSource gpuusage.Source
+
+	// PodDefaults are merged into the launcher and worker pods, if set.
+	PodDefaults *poddefaults.Config
+
+	// ArtifactUploaderImage is the image of the sidecar uploading the
+	// artifacts of the MPIJobs with spec.artifacts. Defaults to
+	// DefaultArtifactUploaderImage.
+	ArtifactUploaderImage string
+
+	// WatchdogImage is the image of the sidecar detecting the stalled MPI
+	// processes of the MPIJobs with spec.watchdog. Defaults to
+	// DefaultWatchdogImage.
+	WatchdogImage string
+
+	// LaunchWrapperImage is the image from which the launch wrapper is copied
+	// to the launcher pods of the MPIJobs with spec.launchWrapper. Defaults
+	// to DefaultLaunchWrapperImage.
+	LaunchWrapperImage string
+
+	// GPUPreCheckImage is the image of the GPU pre-check of the MPIJobs with
+	// spec.gpuPreCheck, unless they set one. Defaults to
+	// DefaultGPUPreCheckImage.
+	GPUPreCheckImage string
+
+	// PropagatedLabelPrefixes and PropagatedAnnotationPrefixes select the
+	// labels and annotations of the MPIJobs copied to their pods, launcher
+	// Job and Service, like the cost allocation labels of a team.
+	PropagatedLabelPrefixes      []string
+	PropagatedAnnotationPrefixes []string
+
+	// WorkerCreationParallelism is the maximum number of worker pods of an
+	// MPIJob created at the same time.
+	WorkerCreationParallelism int
+
+	// ReleaseFinishedPods labels the pods left by finished MPIJobs once they
+	// are cleaned up, so that the memory of the pod informer doesn't grow with
+	// the number of finished MPIJobs. The pod informer must select
+	// UnfinishedPodsSelector.
+	ReleaseFinishedPods bool
+
+	// StatusCoalescingWindow delays the syncs triggered by the events of the
+	// pods, so that the events of an MPIJob within the window result in a
+	// single sync and status update. 0 syncs on every event.
+	StatusCoalescingWindow time.Duration
+
+	// CacheSyncTimeout and QueueStallTimeout are how long the informer caches
+	// can take to sync and the workqueue can have pending keys without the
+	// workers taking any, before the health checks fail. 0 disables the check.
+	CacheSyncTimeout  time.Duration
+	QueueStallTimeout time.Duration
+
+	// health tracks the progress of the controller for the health checks.
+	health health
+
+	// draResources counts the devices of the claims of the MPIJobs, if set.
+	draResources *DRAResources
+
+	// provisioningRequests manages the ProvisioningRequests of the workers of
+	// the MPIJobs, if set.
+	provisioningRequests *ProvisioningRequests
+
+	// queueAdmission checks the capacity of the Volcano queues, if set.
+	queueAdmission *queueAdmission
+
+	// podDisruptionBudgets protects the workers of the running MPIJobs from
+	// voluntary disruptions, if set.
+	podDisruptionBudgets *podDisruptionBudgets
+
+	// nodeDrains moves the running MPIJobs off the draining nodes, if set.
+	nodeDrains *nodeDrains
+
+	// mpiJobDefaults merges the MPIJobDefaults of their namespace into the
+	// MPIJobs, if set.
+	mpiJobDefaults *mpiJobDefaults
 
 	configMapLister     corelisters.ConfigMapLister
 	configMapSynced     cache.InformerSynced
@@ -244,15 +387,36 @@ type MPIJobController struct {
 	// means we can ensure we only process a fixed amount of resources at a
 	// time, and makes it easy to ensure we are never processing the same item
 	// simultaneously in two different workers.
+	// The keys of finished MPIJobs are processed after the others.
 	queue workqueue.TypedRateLimitingInterface[any]
+	// queued is the storage of the keys ready in the queue, for the state
+	// dumps.
+	queued *priorityQueue
+	// inFlight tracks the keys being synced by the workers.
+	inFlight inFlightSyncs
+	// supportBundleQueue holds the keys of the failed MPIJobs whose support
+	// bundles are collected by a separate worker, so that the syncs don't
+	// wait for them.
+	supportBundleQueue workqueue.TypedInterface[string]
+	// pendingSupportBundles are the keys of the MPIJobs whose support bundles
+	// are queued or being collected, whose pods aren't cleaned up yet.
+	pendingSupportBundles pendingKeys
 	// recorder is an event recorder for recording Event resources to the
 	// Kubernetes API.
-	recorder record.EventRecorder
+	recorder         record.EventRecorder
+	eventBroadcaster record.EventBroadcaster
+	// eventClient records the Events once the controller runs.
+	eventClient kubernetes.Interface
+
+	// podExpectations tracks the worker pods created or deleted by the syncs
+	// that the pod informer hasn't observed yet.
+	podExpectations *podExpectations
 
 	// To allow injection of updateStatus for testing.
 	updateStatusHandler func(mpijob *kubeflow.MPIJob) error
 
-	// Clock for internal use of unit-testing
+	// clock is the source of the time of the controller and of its workqueue,
+	// so that unit tests can inject a fake clock.
 	clock clock.WithTicker
 }
 
@@ -276,7 +440,9 @@ func NewMPIJobController(
 		priorityClassInformer, mpiJobInformer, &clock.RealClock{}, namespace, gangSchedulingName, workqueueRateLimiter)
 }
 
-// NewMPIJobControllerWithClock returns a new MPIJob controller.
+// NewMPIJobControllerWithClock returns a new MPIJob controller using the
+// clock for the timestamps of the status, the time-based decisions and the
+// delayed requeues, so that tests can control them with a fake clock.
 func NewMPIJobControllerWithClock(
 	kubeClient kubernetes.Interface,
 	kubeflowClient clientset.Interface,
@@ -297,7 +463,6 @@ func NewMPIJobControllerWithClock(
 	klog.V(4).Info("Creating event broadcaster")
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(klog.Infof)
-	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
 	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: controllerAgentName})
 
 	// For the gang scheduling.
@@ -319,6 +484,7 @@ func NewMPIJobControllerWithClock(
 		podGroupSynced = podGroupCtrl.PodGroupSharedIndexInformer().HasSynced
 	}
 
+	queue, queued := newPriorityWorkqueue(workqueueRateLimiter, mpiJobInformer.Lister(), clock)
 	controller := &MPIJobController{
 		kubeClient:          kubeClient,
 		kubeflowClient:      kubeflowClient,
@@ -338,9 +504,16 @@ func NewMPIJobControllerWithClock(
 		priorityClassSynced: priorityClassSynced,
 		mpiJobLister:        mpiJobInformer.Lister(),
 		mpiJobSynced:        mpiJobInformer.Informer().HasSynced,
-		queue:               workqueue.NewTypedRateLimitingQueueWithConfig(workqueueRateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{Name: "MPIJob"}),
+		queue:               queue,
+		queued:              queued,
+		supportBundleQueue:  workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{Name: "SupportBundles"}),
 		recorder:            recorder,
+		eventBroadcaster:    eventBroadcaster,
+		eventClient:         kubeClient,
+		podExpectations:     newPodExpectations(clock),
 		clock:               clock,
+
+		WorkerCreationParallelism: options.DefaultWorkerCreationParallelism,
 	}
 
 	controller.updateStatusHandler = controller.doUpdateJobStatus
@@ -378,8 +551,15 @@ func NewMPIJobControllerWithClock(
 	if _, err := mpiJobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: controller.addMPIJob,
 		UpdateFunc: func(old, new interface{}) {
+			// The resyncs deliver the same object from the cache.
+			if old.(*kubeflow.MPIJob).ResourceVersion != new.(*kubeflow.MPIJob).ResourceVersion {
+				controller.observeMPIJobWatch()
+			}
 			controller.enqueueMPIJob(new)
 		},
+		DeleteFunc: func(interface{}) {
+			controller.observeMPIJobWatch()
+		},
 	}); err != nil {
 		return nil, err
 	}
@@ -419,9 +599,9 @@ func NewMPIJobControllerWithClock(
 		return nil, err
 	}
 	if _, err := podInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    controller.handleObject,
-		UpdateFunc: controller.handleObjectUpdate,
-		DeleteFunc: controller.handleObject,
+		AddFunc:    controller.handlePodAdd,
+		UpdateFunc: controller.handlePodUpdate,
+		DeleteFunc: controller.handlePodDelete,
 	}); err != nil {
 		return nil, err
 	}
@@ -451,12 +631,16 @@ func NewMPIJobControllerWithClock(
 func (c *MPIJobController) Run(threadiness int, stopCh <-chan struct{}) error {
 	defer runtime.HandleCrash()
 	defer c.queue.ShutDown()
+	defer c.supportBundleQueue.ShutDown()
 
 	// Start the informer factories to begin populating the informer caches.
 	klog.Info("Starting MPIJob controller")
+	c.eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: c.eventClient.CoreV1().Events("")})
+	defer c.eventBroadcaster.Shutdown()
 
 	// Wait for the caches to be synced before starting workers.
 	klog.Info("Waiting for informer caches to sync")
+	c.health.started.Store(c.clock.Now().UnixNano())
 	synced := []cache.InformerSynced{
 		c.configMapSynced,
 		c.secretSynced,
@@ -468,15 +652,38 @@ func (c *MPIJobController) Run(threadiness int, stopCh <-chan struct{}) error {
 	if c.PodGroupCtrl != nil {
 		synced = append(synced, c.podGroupSynced, c.priorityClassSynced)
 	}
+	if c.draResources != nil {
+		synced = append(synced, c.draResources.synced)
+	}
+	if c.provisioningRequests != nil {
+		synced = append(synced, c.provisioningRequests.informer.Informer().HasSynced)
+	}
+	if c.queueAdmission != nil {
+		synced = append(synced, c.queueAdmission.synced)
+	}
+	if c.podDisruptionBudgets != nil {
+		synced = append(synced, c.podDisruptionBudgets.synced)
+	}
+	if c.nodeDrains != nil {
+		synced = append(synced, c.nodeDrains.synced)
+	}
+	if c.mpiJobDefaults != nil {
+		synced = append(synced, c.mpiJobDefaults.synced)
+	}
 	if ok := cache.WaitForCacheSync(stopCh, synced...); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
+	c.health.synced.Store(c.clock.Now().UnixNano())
 
 	klog.Info("Starting workers")
+	c.health.dequeued.Store(c.clock.Now().UnixNano())
 	// Launch workers to process MPIJob resources.
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
 	}
+	if c.SupportBundleStore != nil {
+		go wait.Until(c.runSupportBundleWorker, time.Second, stopCh)
+	}
 
 	klog.Info("Started workers")
 	<-stopCh
@@ -493,6 +700,20 @@ func (c *MPIJobController) runWorker() {
 	}
 }
 
+// safeSyncHandler runs the syncHandler, recovering from its panics, so that a
+// sync panicking on a malformed MPIJob doesn't stop the reconciliation of the
+// other MPIJobs. The panics are returned as errors to retry the sync.
+func (c *MPIJobController) safeSyncHandler(key string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			syncPanicsCount.Inc()
+			klog.Errorf("Observed a panic syncing '%s': %v\n%s", key, r, debug.Stack())
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return c.syncHandler(key)
+}
+
 // processNextWorkItem will read a single work item off the work queue and
 // attempt to process it, by calling the syncHandler.
 func (c *MPIJobController) processNextWorkItem() bool {
@@ -501,6 +722,7 @@ func (c *MPIJobController) processNextWorkItem() bool {
 	if shutdown {
 		return false
 	}
+	c.health.dequeued.Store(c.clock.Now().UnixNano())
 
 	// We wrap this block in a func so we can defer c.queue.Done.
 	err := func(obj interface{}) error {
@@ -528,7 +750,9 @@ func (c *MPIJobController) processNextWorkItem() bool {
 		}
 		// Run the syncHandler, passing it the namespace/name string of the
 		// MPIJob resource to be synced.
-		if err := c.syncHandler(key); err != nil {
+		c.inFlight.start(key, c.clock.Now())
+		defer c.inFlight.finish(key)
+		if err := c.safeSyncHandler(key); err != nil {
 			c.queue.AddRateLimited(key)
 			return fmt.Errorf("error syncing '%s': %s", key, err.Error())
 		}
@@ -569,6 +793,7 @@ func (c *MPIJobController) syncHandler(key string) error {
 		// The MPIJob may no longer exist, in which case we stop processing.
 		if apierrors.IsNotFound(err) {
 			klog.V(4).Infof("MPIJob has been deleted: %v", key)
+			c.podExpectations.forget(key)
 			return nil
 		}
 		return fmt.Errorf("obtaining job: %w", err)
@@ -578,6 +803,10 @@ func (c *MPIJobController) syncHandler(key string) error {
 	// You can use DeepCopy() to make a deep copy of original object and modify this copy
 	// Or create a copy manually for better performance
 	mpiJob := sharedJob.DeepCopy()
+	if updated, err := c.applyMPIJobDefaults(mpiJob); updated || err != nil {
+		// The update of the MPIJob triggers another sync.
+		return err
+	}
 	// Set default for the new mpiJob.
 	scheme.Scheme.Default(mpiJob)
 
@@ -597,11 +826,12 @@ func (c *MPIJobController) syncHandler(key string) error {
 		// Do not requeue
 		return nil
 	}
+	c.resolveSlotsPerWorker(mpiJob)
 
 	if len(mpiJob.Status.Conditions) == 0 {
 		msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-		updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
-		c.recorder.Event(mpiJob, corev1.EventTypeNormal, "MPIJobCreated", msg)
+		updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, c.clock)
+		c.recorder.Event(mpiJob, corev1.EventTypeNormal, kubeflow.JobCreatedReason, msg)
 		mpiJobsCreatedCount.Inc()
 	}
 
@@ -609,10 +839,35 @@ func (c *MPIJobController) syncHandler(key string) error {
 	// retrying (it reached .spec.backoffLimit). If it's filled, we want to
 	// cleanup and stop retrying the MPIJob.
 	if isFinished(mpiJob.Status) && mpiJob.Status.CompletionTime != nil {
-		if isCleanUpPods(mpiJob.Spec.RunPolicy.CleanPodPolicy) {
+		if err := c.deleteLauncherAfterFailedDiagnostics(mpiJob); err != nil {
+			return err
+		}
+		postRunChanged, err := c.syncPostRunHook(mpiJob)
+		if err != nil {
+			return err
+		}
+		if err := c.syncPodDisruptionBudget(mpiJob); err != nil {
+			return err
+		}
+		cleanUp := isCleanUpPods(mpiJob.Spec.RunPolicy.CleanPodPolicy)
+		if cleanUp && c.pendingSupportBundles.has(key) {
+			// The MPIJob is synced again once its support bundle, with the
+			// logs of the workers, is stored.
+			klog.V(4).Infof("Waiting for the support bundle of %s before cleaning up its pods.", key)
+			cleanUp = false
+		}
+		if cleanUp {
 			if err := cleanUpWorkerPods(mpiJob, c); err != nil {
 				return err
 			}
+		}
+		if c.ReleaseFinishedPods {
+			if err := c.releaseFinishedPods(mpiJob); err != nil {
+				return err
+			}
+		}
+		c.podExpectations.forget(key)
+		if cleanUp || postRunChanged {
 			return c.updateStatusHandler(mpiJob)
 		}
 		return nil
@@ -620,7 +875,7 @@ func (c *MPIJobController) syncHandler(key string) error {
 
 	// first set StartTime.
 	if mpiJob.Status.StartTime == nil && !isMPIJobSuspended(mpiJob) {
-		now := metav1.Now()
+		now := metav1.NewTime(c.clock.Now())
 		mpiJob.Status.StartTime = &now
 	}
 
@@ -633,8 +888,20 @@ func (c *MPIJobController) syncHandler(key string) error {
 	var worker []*corev1.Pod
 	// We're done if the launcher either succeeded or failed.
 	done := launcher != nil && isJobFinished(launcher)
+	if !done && !isMPIJobSuspended(mpiJob) {
+		// The MPIJob is restarted or suspended instead of syncing its pods
+		// while they are on draining nodes.
+		if handled, err := c.syncNodeDrains(mpiJob, launcher); handled || err != nil {
+			return err
+		}
+		if handled, err := c.syncWatchdog(mpiJob, launcher); handled || err != nil {
+			return err
+		}
+	}
 	if !done {
-		_, err := c.getOrCreateService(mpiJob, newJobService(mpiJob))
+		svc := newJobService(mpiJob)
+		c.propagateMetadata(mpiJob, &svc.ObjectMeta)
+		_, err := c.getOrCreateService(mpiJob, svc)
 		if err != nil {
 			return fmt.Errorf("getting or creating Service to front workers: %w", err)
 		}
@@ -648,23 +915,49 @@ func (c *MPIJobController) syncHandler(key string) error {
 			return fmt.Errorf("creating SSH auth secret: %w", err)
 		}
 
+		held := false
 		if !isMPIJobSuspended(mpiJob) {
 			// Get the PodGroup for this MPIJob
 			if c.PodGroupCtrl != nil {
-				if podGroup, err := c.getOrCreatePodGroups(mpiJob); podGroup == nil || err != nil {
+				podGroup, err := c.getOrCreatePodGroups(mpiJob)
+				if podGroup == nil || err != nil {
 					return err
 				}
+				var changed bool
+				if held, changed = c.syncQueueCapacity(mpiJob, podGroup); changed {
+					// The update of the status requeues the MPIJob.
+					return c.updateStatusHandler(mpiJob)
+				}
+				if c.syncGangSchedulability(mpiJob, podGroup) {
+					return c.updateStatusHandler(mpiJob)
+				}
 			}
-			worker, err = c.getOrCreateWorker(mpiJob)
+			if held {
+				klog.V(4).Infof("Holding the pods of %s/%s until the queue has capacity.", mpiJob.Namespace, mpiJob.Name)
+			} else {
+				worker, err = c.getOrCreateWorker(mpiJob)
+				if err != nil {
+					return err
+				}
+				if err := c.syncProvisioningRequest(mpiJob, worker); err != nil {
+					return err
+				}
+				if handled, err := c.syncGPUPreCheck(mpiJob, worker); handled || err != nil {
+					return err
+				}
+			}
+		}
+		if launcher == nil && !held {
+			preRunDone, err := c.syncPreRunHook(mpiJob)
 			if err != nil {
 				return err
 			}
-		}
-		if launcher == nil {
-			if mpiJob.Spec.LauncherCreationPolicy == kubeflow.LauncherCreationPolicyAtStartup || c.countReadyWorkerPods(worker) == len(worker) {
+			if !preRunDone {
+				klog.V(4).Infof("Waiting for the pre-run hook of %s/%s.", mpiJob.Namespace, mpiJob.Name)
+			} else if c.launcherCanBeCreated(mpiJob, worker) {
 				launcher, err = c.kubeClient.BatchV1().Jobs(namespace).Create(context.TODO(), c.newLauncherJob(mpiJob), metav1.CreateOptions{})
 				if err != nil {
-					c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, mpiJobFailedReason, "launcher pod created failed: %v", err)
+					c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, kubeflow.JobFailedReason, "launcher pod created failed: %v", err)
 					return fmt.Errorf("creating launcher Pod: %w", err)
 				}
 			} else {
@@ -697,7 +990,7 @@ func (c *MPIJobController) syncHandler(key string) error {
 		return err
 	}
 
-	return nil
+	return c.syncPodDisruptionBudget(mpiJob)
 }
 
 func cleanUpWorkerPods(mpiJob *kubeflow.MPIJob, c *MPIJobController) error {
@@ -714,6 +1007,32 @@ func cleanUpWorkerPods(mpiJob *kubeflow.MPIJob, c *MPIJobController) error {
 	return nil
 }
 
+// releaseFinishedPods labels the pods left by the finished MPIJob with
+// JobFinishedLabel, so that the pod informer selecting UnfinishedPodsSelector
+// drops them from its cache.
+func (c *MPIJobController) releaseFinishedPods(mpiJob *kubeflow.MPIJob) error {
+	selector := labels.SelectorFromSet(labels.Set{
+		kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+		kubeflow.JobNameLabel:      mpiJob.Name,
+	})
+	pods, err := c.podLister.Pods(mpiJob.Namespace).List(selector)
+	if err != nil {
+		return fmt.Errorf("obtaining pods: %w", err)
+	}
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || pod.Labels[kubeflow.JobFinishedLabel] != "" {
+			continue
+		}
+		pod = pod.DeepCopy()
+		pod.Labels[kubeflow.JobFinishedLabel] = "true"
+		_, err := c.kubeClient.CoreV1().Pods(pod.Namespace).Update(context.TODO(), pod, metav1.UpdateOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("labeling pod %s of finished MPIJob: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
+
 // getLauncherJob gets the launcher Job controlled by this MPIJob.
 func (c *MPIJobController) getLauncherJob(mpiJob *kubeflow.MPIJob) (*batchv1.Job, error) {
 	launcher, err := c.jobLister.Jobs(mpiJob.Namespace).Get(mpiJob.Name + launcherSuffix)
@@ -817,14 +1136,23 @@ func (c *MPIJobController) getRunningWorkerPods(mpiJob *kubeflow.MPIJob) ([]*cor
 	return podList, nil
 }
 
+// launcherCanBeCreated returns whether the workers are in the state required
+// by the launcher creation policy of the MPIJob.
+func (c *MPIJobController) launcherCanBeCreated(mpiJob *kubeflow.MPIJob, workers []*corev1.Pod) bool {
+	switch mpiJob.Spec.LauncherCreationPolicy {
+	case kubeflow.LauncherCreationPolicyWaitForWorkersReady:
+		return c.countReadyWorkerPods(workers) == len(workers)
+	case kubeflow.LauncherCreationPolicyWaitForWorkersScheduled:
+		return countScheduledWorkerPods(workers) == len(workers)
+	}
+	return true
+}
+
 func (c *MPIJobController) countReadyWorkerPods(workers []*corev1.Pod) int {
 	ready := 0
 	for _, pod := range workers {
-		for _, c := range pod.Status.Conditions {
-			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
-				ready++
-				break
-			}
+		if isPodReady(pod) {
+			ready++
 		}
 	}
 	return ready
@@ -839,6 +1167,7 @@ func (c *MPIJobController) getOrCreateConfigMap(mpiJob *kubeflow.MPIJob) (*corev
 		return nil, err
 	}
 	updateDiscoverHostsInConfigMap(newCM, mpiJob, podList)
+	setWorkerPoolAddresses(newCM, mpiJob, podList)
 
 	cm, err := c.configMapLister.ConfigMaps(mpiJob.Namespace).Get(mpiJob.Name + configSuffix)
 	// If the ConfigMap doesn't exist, we'll create it.
@@ -884,10 +1213,11 @@ func (c *MPIJobController) getOrCreateService(job *kubeflow.MPIJob, newSvc *core
 		return nil, errors.New(msg)
 	}
 
-	// If the Service selector is changed, update it.
-	if !equality.Semantic.DeepEqual(svc.Spec.Selector, newSvc.Spec.Selector) {
+	// If the Service selector or publishNotReadyAddresses is changed, update it.
+	if !equality.Semantic.DeepEqual(svc.Spec.Selector, newSvc.Spec.Selector) || svc.Spec.PublishNotReadyAddresses != newSvc.Spec.PublishNotReadyAddresses {
 		svc = svc.DeepCopy()
 		svc.Spec.Selector = newSvc.Spec.Selector
+		svc.Spec.PublishNotReadyAddresses = newSvc.Spec.PublishNotReadyAddresses
 		return c.kubeClient.CoreV1().Services(svc.Namespace).Update(context.TODO(), svc, metav1.UpdateOptions{})
 	}
 
@@ -903,7 +1233,11 @@ func (c *MPIJobController) getOrCreateSSHAuthSecret(job *kubeflow.MPIJob) (*core
 		if err != nil {
 			return nil, err
 		}
-		return c.kubeClient.CoreV1().Secrets(job.Namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
+		created, err := c.kubeClient.CoreV1().Secrets(job.Namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			return c.labelSSHAuthSecret(job)
+		}
+		return created, err
 	}
 	if err != nil {
 		return nil, err
@@ -927,6 +1261,27 @@ func (c *MPIJobController) getOrCreateSSHAuthSecret(job *kubeflow.MPIJob) (*core
 	return secret, nil
 }
 
+// labelSSHAuthSecret adds the labels of the Secrets created by the operator to
+// the SSH auth Secret of the MPIJob. The Secrets created by older versions of
+// the operator don't have them, so the informer doesn't watch them.
+func (c *MPIJobController) labelSSHAuthSecret(job *kubeflow.MPIJob) (*corev1.Secret, error) {
+	secret, err := c.kubeClient.CoreV1().Secrets(job.Namespace).Get(context.TODO(), job.Name+sshAuthSecretSuffix, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !metav1.IsControlledBy(secret, job) {
+		msg := fmt.Sprintf(MessageResourceExists, secret.Name, secret.Kind)
+		c.recorder.Event(job, corev1.EventTypeWarning, ErrResourceExists, msg)
+		return nil, errors.New(msg)
+	}
+	if secret.Labels == nil {
+		secret.Labels = make(map[string]string)
+	}
+	secret.Labels[kubeflow.OperatorNameLabel] = kubeflow.OperatorName
+	secret.Labels[kubeflow.JobNameLabel] = job.Name
+	return c.kubeClient.CoreV1().Secrets(job.Namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+}
+
 func keysFromData(data map[string][]byte) []string {
 	keys := make([]string, 0, len(data))
 	for k := range data {
@@ -954,7 +1309,20 @@ func (c *MPIJobController) getOrCreateWorker(mpiJob *kubeflow.MPIJob) ([]*corev1
 	if err != nil {
 		return nil, err
 	}
-	if len(podFullList) > int(*worker.Replicas) {
+	key := mpiJob.Namespace + "/" + mpiJob.Name
+	// Until the informer observes the pods created or deleted by the previous
+	// syncs, the lister would make this sync create or delete them again.
+	if !c.podExpectations.satisfied(key) {
+		klog.V(4).Infof("Waiting for the informer to observe the worker pods of %s", key)
+		for i := 0; i < int(workerReplicas(mpiJob)); i++ {
+			if pod, err := c.podLister.Pods(mpiJob.Namespace).Get(workerName(mpiJob, i)); err == nil && metav1.IsControlledBy(pod, mpiJob) {
+				workerPods = append(workerPods, pod)
+			}
+		}
+		return workerPods, nil
+	}
+	replicas := int(workerReplicas(mpiJob))
+	if len(podFullList) > replicas {
 		for _, pod := range podFullList {
 			indexStr, ok := pod.Labels[kubeflow.ReplicaIndexLabel]
 			if !ok {
@@ -962,44 +1330,113 @@ func (c *MPIJobController) getOrCreateWorker(mpiJob *kubeflow.MPIJob) ([]*corev1
 			}
 			index, err := strconv.Atoi(indexStr)
 			if err == nil {
-				if index >= int(*worker.Replicas) {
-					err = c.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+				if index >= replicas && pod.DeletionTimestamp == nil {
+					c.podExpectations.expectDeletion(key, pod.Name)
+					err = c.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, podDeleteOptions(pod))
 					if err != nil {
-						return nil, err
+						c.podExpectations.observeDeletion(key, pod.Name)
+						if !apierrors.IsNotFound(err) {
+							return nil, err
+						}
 					}
 				}
 			}
 		}
 	}
 
-	for i := 0; i < int(*worker.Replicas); i++ {
+	pods := make([]*corev1.Pod, replicas)
+	var missing []int
+	for i := range pods {
 		pod, err := c.podLister.Pods(mpiJob.Namespace).Get(workerName(mpiJob, i))
-
 		// If the worker Pod doesn't exist, we'll create it.
 		if apierrors.IsNotFound(err) {
-			worker := c.newWorker(mpiJob, i)
-			pod, err = c.kubeClient.CoreV1().Pods(mpiJob.Namespace).Create(context.TODO(), worker, metav1.CreateOptions{})
+			missing = append(missing, i)
+			continue
 		}
-		// If an error occurs during Get/Create, we'll requeue the item so we
-		// can attempt processing again later. This could have been caused by a
-		// temporary network failure, or any other transient reason.
 		if err != nil {
-			c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, mpiJobFailedReason, "worker pod created failed: %v", err)
 			return nil, err
 		}
 		// If the worker is not controlled by this MPIJob resource, we should log
 		// a warning to the event recorder and return.
-		if pod != nil && !metav1.IsControlledBy(pod, mpiJob) {
+		if !metav1.IsControlledBy(pod, mpiJob) {
 			msg := fmt.Sprintf(MessageResourceExists, pod.Name, pod.Kind)
 			c.recorder.Event(mpiJob, corev1.EventTypeWarning, ErrResourceExists, msg)
 			return nil, errors.New(msg)
 		}
-		workerPods = append(workerPods, pod)
+		pods[i] = pod
+	}
+	// If an error occurs during Create, we'll requeue the item so we can
+	// attempt processing again later. This could have been caused by a
+	// temporary network failure, or any other transient reason.
+	if err := c.createWorkers(mpiJob, missing, pods); err != nil {
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, kubeflow.JobFailedReason, "worker pod created failed: %v", err)
+		return nil, err
+	}
+	for _, pod := range pods {
+		// A pod that already existed is left to the informer and to the
+		// expectations: its creation stays expected, so the next syncs don't
+		// create or delete workers until the informer observes it.
+		if pod != nil {
+			workerPods = append(workerPods, pod)
+		}
 	}
-
 	return workerPods, nil
 }
 
+// createWorkers creates the worker pods of the indexes in slow-start batches,
+// with at most WorkerCreationParallelism requests at a time, and sets the
+// created pods at their index of pods.
+func (c *MPIJobController) createWorkers(mpiJob *kubeflow.MPIJob, indexes []int, pods []*corev1.Pod) error {
+	key := mpiJob.Namespace + "/" + mpiJob.Name
+	_, err := slowStartBatch(len(indexes), slowStartInitialBatchSize, max(c.WorkerCreationParallelism, 1), func(piece int) error {
+		index := indexes[piece]
+		worker := c.newWorker(mpiJob, index)
+		c.podExpectations.expectCreation(key, worker.Name)
+		pod, err := c.kubeClient.CoreV1().Pods(mpiJob.Namespace).Create(context.TODO(), worker, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			// The informer will observe the pod, which is checked by the
+			// next sync.
+			return nil
+		}
+		if err != nil {
+			c.podExpectations.observeCreation(key, worker.Name)
+			return err
+		}
+		pods[index] = pod
+		return nil
+	})
+	return err
+}
+
+// slowStartBatch calls fn for the count pieces in batches that start with
+// initialBatchSize pieces and double in size while all the calls succeed,
+// like the Job controller. When the creations fail, for example because of a
+// quota or an admission webhook, only the first batch is sent instead of all
+// the requests. It returns the number of successful calls and the errors of
+// the batch that failed.
+func slowStartBatch(count, initialBatchSize, parallelism int, fn func(piece int) error) (int, error) {
+	successes, offset := 0, 0
+	for batchSize := min(count, initialBatchSize); batchSize > 0; batchSize = min(2*batchSize, count-offset) {
+		var (
+			mu   sync.Mutex
+			errs []error
+		)
+		workqueue.ParallelizeUntil(context.TODO(), parallelism, batchSize, func(piece int) {
+			if err := fn(offset + piece); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+		successes += batchSize - len(errs)
+		if len(errs) > 0 {
+			return successes, errors.Join(errs...)
+		}
+		offset += batchSize
+	}
+	return successes, nil
+}
+
 func isMPIJobSuspended(mpiJob *kubeflow.MPIJob) bool {
 	return ptr.Deref(mpiJob.Spec.RunPolicy.Suspend, false)
 }
@@ -1009,17 +1446,13 @@ func isJobSuspended(job *batchv1.Job) bool {
 }
 
 func (c *MPIJobController) deleteWorkerPods(mpiJob *kubeflow.MPIJob) error {
-	var (
-		workerPrefix       = mpiJob.Name + workerSuffix
-		i            int32 = 0
-	)
 	worker := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
 	if worker == nil {
 		return nil
 	}
 
-	for ; i < *worker.Replicas; i++ {
-		name := fmt.Sprintf("%s-%d", workerPrefix, i)
+	for i := 0; i < int(workerReplicas(mpiJob)); i++ {
+		name := workerName(mpiJob, i)
 		pod, err := c.podLister.Pods(mpiJob.Namespace).Get(name)
 
 		// If the worker Pod doesn't exist, no need to remove it.
@@ -1041,7 +1474,11 @@ func (c *MPIJobController) deleteWorkerPods(mpiJob *kubeflow.MPIJob) error {
 			// Keep the worker pod
 			continue
 		}
-		err = c.kubeClient.CoreV1().Pods(mpiJob.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+		// A pod that is already terminating keeps its grace period.
+		if pod != nil && pod.DeletionTimestamp != nil {
+			continue
+		}
+		err = c.kubeClient.CoreV1().Pods(mpiJob.Namespace).Delete(context.TODO(), name, podDeleteOptions(pod))
 		if err != nil && !apierrors.IsNotFound(err) {
 			klog.Errorf("Failed to delete pod[%s/%s]: %v", mpiJob.Namespace, name, err)
 			return err
@@ -1054,20 +1491,23 @@ func (c *MPIJobController) updateMPIJobStatus(mpiJob *kubeflow.MPIJob, launcher
 	oldStatus := mpiJob.Status.DeepCopy()
 	if isMPIJobSuspended(mpiJob) {
 		// it is suspended now
-		if updateMPIJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "MPIJob suspended") {
-			c.recorder.Event(mpiJob, corev1.EventTypeNormal, "MPIJobSuspended", "MPIJob suspended")
+		if updateMPIJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, kubeflow.JobSuspendedReason, "MPIJob suspended", c.clock) {
+			c.recorder.Event(mpiJob, corev1.EventTypeNormal, kubeflow.JobSuspendedReason, "MPIJob suspended")
 		}
 	} else if getCondition(mpiJob.Status, kubeflow.JobSuspended) != nil {
 		// it is not suspended now, consider resumed if the condition was set before
-		if updateMPIJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionFalse, mpiJobResumedReason, "MPIJob resumed") {
-			c.recorder.Event(mpiJob, corev1.EventTypeNormal, "MPIJobResumed", "MPIJob resumed")
+		if updateMPIJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionFalse, kubeflow.JobResumedReason, "MPIJob resumed", c.clock) {
+			c.recorder.Event(mpiJob, corev1.EventTypeNormal, kubeflow.JobResumedReason, "MPIJob resumed")
 			now := metav1.NewTime(c.clock.Now())
 			mpiJob.Status.StartTime = &now
 		}
 	}
 	launcherPodsCnt := 0
+	launcherReady := 0
+	var launcherPods []*corev1.Pod
 	if launcher != nil {
-		launcherPods, err := c.jobPods(launcher)
+		var err error
+		launcherPods, err = c.jobPods(launcher)
 		if err != nil {
 			return fmt.Errorf("checking launcher pods running: %w", err)
 		}
@@ -1077,25 +1517,48 @@ func (c *MPIJobController) updateMPIJobStatus(mpiJob *kubeflow.MPIJob, launcher
 		initializeMPIJobStatuses(mpiJob, kubeflow.MPIReplicaTypeLauncher)
 		launcherStatus := mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeLauncher]
 		launcherStatus.Failed = launcher.Status.Failed
+		c.updateLauncherRestartCount(mpiJob, launcher, launcherPods)
+		updateMPIJobProgress(mpiJob, launcherPods)
+		if mpiJob.Spec.CoreDumps != nil {
+			c.updateMPIJobCoreDumps(mpiJob, launcherPods)
+		}
+		if mpiJob.Spec.LaunchWrapper != nil {
+			c.updateMPIJobLaunchResult(mpiJob, launcherPods)
+		}
+		updateReplicaNodes(mpiJob, oldStatus, kubeflow.MPIReplicaTypeLauncher, launcherPods)
 		if isJobSucceeded(launcher) {
+			if mpiJob.Spec.Benchmark != nil && getCondition(mpiJob.Status, kubeflow.JobSucceeded) == nil {
+				if err := c.storeBenchmarkResults(mpiJob, launcherPods); err != nil {
+					return err
+				}
+			}
 			launcherStatus.Succeeded = 1
 			msg := fmt.Sprintf("MPIJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
-			c.recorder.Event(mpiJob, corev1.EventTypeNormal, mpiJobSucceededReason, msg)
+			c.recorder.Event(mpiJob, corev1.EventTypeNormal, kubeflow.JobSucceededReason, msg)
 			if mpiJob.Status.CompletionTime == nil {
 				mpiJob.Status.CompletionTime = launcher.Status.CompletionTime
 			}
-			updateMPIJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, mpiJobSucceededReason, msg)
+			updateMPIJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, kubeflow.JobSucceededReason, msg, c.clock)
 			mpiJobsSuccessCount.Inc()
 		} else if isJobFailed(launcher) {
 			c.updateMPIJobFailedStatus(mpiJob, launcher, launcherPods)
+		} else if reason, failed := diagnosticsFailure(launcherPods); failed && isDiagnosticsEnabled(mpiJob) {
+			c.updateMPIJobDiagnosticsFailedStatus(mpiJob, reason)
 		} else {
-			mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeLauncher].Active = int32(launcherPodsCnt)
+			launcherStatus.Active = int32(launcherPodsCnt)
+			for _, pod := range launcherPods {
+				if isPodRunning(pod) && isPodReady(pod) {
+					launcherReady++
+				}
+			}
+			launcherStatus.Ready = int32(launcherReady)
 		}
 		mpiJobInfoGauge.WithLabelValues(launcher.Name, mpiJob.Namespace).Set(1)
 	}
 
 	var (
 		running = 0
+		ready   = 0
 		evict   = 0
 	)
 
@@ -1113,67 +1576,162 @@ func (c *MPIJobController) updateMPIJobStatus(mpiJob *kubeflow.MPIJob, launcher
 		case corev1.PodRunning:
 			running += 1
 			mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Active += 1
+			if isPodReady(worker[i]) {
+				ready += 1
+				mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Ready += 1
+			}
 		}
 	}
+	updateReplicaNodes(mpiJob, oldStatus, kubeflow.MPIReplicaTypeWorker, worker)
 	if evict > 0 {
 		msg := fmt.Sprintf("%d/%d workers are evicted", evict, len(worker))
 		klog.Infof("MPIJob <%s/%s>: %v", mpiJob.Namespace, mpiJob.Name, msg)
-		updateMPIJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, mpiJobEvict, msg)
-		c.recorder.Event(mpiJob, corev1.EventTypeWarning, mpiJobEvict, msg)
+		updateMPIJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.JobEvictedReason, msg, c.clock)
+		mpiJob.Status.FailureReasonClass = kubeflow.FailureReasonClassInfrastructure
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, kubeflow.JobEvictedReason, msg)
 	}
 
 	if isMPIJobSuspended(mpiJob) {
 		msg := fmt.Sprintf("MPIJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
-		updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+		updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, kubeflow.JobSuspendedReason, msg, c.clock)
 	} else if launcher != nil && launcherPodsCnt >= 1 && running == len(worker) {
 		msg := fmt.Sprintf("MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
-		updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
-		c.recorder.Eventf(mpiJob, corev1.EventTypeNormal, "MPIJobRunning", "MPIJob %s/%s is running", mpiJob.Namespace, mpiJob.Name)
+		updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, kubeflow.JobRunningReason, msg, c.clock)
+		c.recorder.Eventf(mpiJob, corev1.EventTypeNormal, kubeflow.JobRunningReason, "MPIJob %s/%s is running", mpiJob.Namespace, mpiJob.Name)
+	}
+	updatePodsReadyCondition(mpiJob, launcherReady >= 1 && ready == len(worker) && ready == int(workerReplicas(mpiJob)), c.clock)
+	c.updatePreRunHookFailedStatus(mpiJob)
+
+	c.setCompletionTime(mpiJob)
+	if !isFinished(*oldStatus) && isFinished(mpiJob.Status) {
+		c.setGPUUsage(mpiJob)
+	}
+	// Like the duration, the resource usage is set by the first sync of the
+	// finished MPIJob, even when another step, like a failed hook, finished
+	// it before.
+	if isFinished(mpiJob.Status) && mpiJob.Status.ResourceUsage == nil {
+		setResourceUsage(mpiJob, launcherPods, worker)
 	}
 
 	// no need to update the mpijob if the status hasn't changed since last time.
 	if !reflect.DeepEqual(*oldStatus, mpiJob.Status) {
-		return c.updateStatusHandler(mpiJob)
+		persistedStatus := c.persistedStatus(mpiJob, oldStatus)
+		if err := c.updateStatusHandler(mpiJob); err != nil {
+			return err
+		}
+		c.emitCloudEvents(persistedStatus, mpiJob)
+		if !isFinished(*oldStatus) && isFinished(mpiJob.Status) {
+			c.archiveMPIJob(mpiJob)
+			c.notifyMPIJob(mpiJob)
+			c.pushJobMetrics(mpiJob, launcher)
+			if cond := getCondition(mpiJob.Status, kubeflow.JobFailed); cond != nil && cond.Status == corev1.ConditionTrue {
+				c.enqueueSupportBundle(mpiJob)
+			}
+		}
 	}
 	return nil
 }
 
+// setCompletionTime sets the completion time of the finished MPIJob, if the
+// condition that finished it didn't, and its duration.
+func (c *MPIJobController) setCompletionTime(mpiJob *kubeflow.MPIJob) {
+	if !isFinished(mpiJob.Status) {
+		return
+	}
+	if mpiJob.Status.CompletionTime == nil {
+		now := metav1.NewTime(c.clock.Now())
+		mpiJob.Status.CompletionTime = &now
+	}
+	if mpiJob.Status.StartTime != nil && mpiJob.Status.Duration == nil {
+		duration := max(mpiJob.Status.CompletionTime.Sub(mpiJob.Status.StartTime.Time), 0)
+		mpiJob.Status.Duration = &metav1.Duration{Duration: duration.Round(time.Second)}
+	}
+}
+
+// updateLauncherRestartCount sets the number of times the launcher restarted
+// after a failure of its pods or their containers, and records an event when
+// it restarted since the last sync.
+func (c *MPIJobController) updateLauncherRestartCount(mpiJob *kubeflow.MPIJob, launcher *batchv1.Job, launcherPods []*corev1.Pod) {
+	restarts := launcher.Status.Failed
+	if isJobFailed(launcher) && restarts > 0 {
+		// The last failure isn't followed by a restart.
+		restarts--
+	}
+	for _, pod := range launcherPods {
+		for _, status := range pod.Status.ContainerStatuses {
+			restarts += status.RestartCount
+		}
+	}
+	// The count doesn't decrease when the failed pods are deleted.
+	if restarts <= mpiJob.Status.LauncherRestartCount {
+		return
+	}
+	mpiJob.Status.LauncherRestartCount = restarts
+	c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, launcherRestartedReason, "Launcher of MPIJob %s/%s restarted, %d restarts so far", mpiJob.Namespace, mpiJob.Name, restarts)
+}
+
 func (c *MPIJobController) updateMPIJobFailedStatus(mpiJob *kubeflow.MPIJob, launcher *batchv1.Job, launcherPods []*corev1.Pod) {
 	jobFailedCond := getJobCondition(launcher, batchv1.JobFailed)
-	reason := jobFailedCond.Reason
-	if reason == "" {
-		reason = mpiJobFailedReason
+	var reason string
+	switch jobFailedCond.Reason {
+	case "":
+		reason = kubeflow.JobFailedReason
+	case batchv1.JobReasonBackoffLimitExceeded:
+		reason = kubeflow.BackoffLimitExceededReason
+	case batchv1.JobReasonDeadlineExceeded:
+		reason = kubeflow.DeadlineExceededReason
+	default:
+		reason = jobFailedCond.Reason
 	}
 	msg := jobFailedCond.Message
 	if msg == "" {
 		msg = fmt.Sprintf("MPIJob %s/%s has failed", mpiJob.Namespace, mpiJob.Name)
 	}
-	if reason == batchv1.JobReasonBackoffLimitExceeded {
-		// Concatenate the reason and message from the last failed Pod.
-		var lastFailedPod *corev1.Pod
-		for _, p := range launcherPods {
-			if isPodFailed(p) && (lastFailedPod == nil || lastFailedPod.CreationTimestamp.Before(&p.CreationTimestamp)) {
-				lastFailedPod = p
-			}
+	var lastFailedPod *corev1.Pod
+	for _, p := range launcherPods {
+		if isPodFailed(p) && (lastFailedPod == nil || lastFailedPod.CreationTimestamp.Before(&p.CreationTimestamp)) {
+			lastFailedPod = p
 		}
-		if lastFailedPod != nil {
+	}
+	if reason == kubeflow.BackoffLimitExceededReason {
+		// Concatenate the reason and message from the last failed Pod.
+		if lastFailedPod != nil && isPodOOMKilled(lastFailedPod) {
+			reason = kubeflow.LauncherOOMReason
+			msg += ": the launcher ran out of memory"
+		} else if lastFailedPod != nil {
 			reason += "/" + lastFailedPod.Status.Reason
 			msg += ": " + lastFailedPod.Status.Message
 			msg = truncateMessage(msg)
 		}
 	}
+	if result := mpiJob.Status.LaunchResult; result != nil && result.Reason != kubeflow.LaunchResultSucceeded {
+		msg = truncateMessage(msg + "; the launcher command " + launchResultMessage(result))
+	}
+	workers := c.listWorkers(mpiJob)
+	if failures := workerFailures(workers); failures != "" {
+		msg = truncateMessage(msg + "; failed workers: " + failures)
+		// The launcher failing after the workers is a consequence of their
+		// failure.
+		if reason == kubeflow.JobFailedReason || strings.HasPrefix(reason, kubeflow.BackoffLimitExceededReason) {
+			reason = kubeflow.WorkerFailedReason
+		}
+	}
+	if mpiJob.Status.FailureReasonClass == "" {
+		mpiJob.Status.FailureReasonClass = failureReasonClass(lastFailedPod, workers)
+	}
 	c.recorder.Event(mpiJob, corev1.EventTypeWarning, reason, msg)
 	if mpiJob.Status.CompletionTime == nil {
-		now := metav1.Now()
+		now := metav1.NewTime(c.clock.Now())
 		mpiJob.Status.CompletionTime = &now
 	}
-	updateMPIJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, reason, msg)
+	updateMPIJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, reason, msg, c.clock)
 	mpiJobsFailureCount.Inc()
 }
 
 // When a mpiJob is added, set the defaults and enqueue the current mpiJob.
 func (c *MPIJobController) addMPIJob(obj interface{}) {
 	mpiJob := obj.(*kubeflow.MPIJob)
+	c.observeMPIJobWatch()
 
 	// Set default for the new mpiJob.
 	scheme.Scheme.Default(mpiJob)
@@ -1193,6 +1751,24 @@ func (c *MPIJobController) enqueueMPIJob(obj interface{}) {
 	c.queue.AddRateLimited(key)
 }
 
+// RecordEventsWith records the Events with the client instead of the client of
+// the controller, so that they don't share its rate limits. It must be called
+// before the controller is run.
+func (c *MPIJobController) RecordEventsWith(client kubernetes.Interface) {
+	c.eventClient = client
+}
+
+// enqueueMPIJobAfter puts the key of the MPIJob on the work queue after the
+// delay, unless it's already waiting to be added earlier.
+func (c *MPIJobController) enqueueMPIJobAfter(obj interface{}, delay time.Duration) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	c.queue.AddAfter(key, delay)
+}
+
 // handleObject will take any resource implementing metav1.Object and attempt
 // to find the MPIJob resource that 'owns' it. It does this by looking at the
 // objects metadata.ownerReferences field for an appropriate OwnerReference.
@@ -1247,6 +1823,12 @@ func (c *MPIJobController) handleObject(obj interface{}) {
 		return
 	}
 
+	if _, isPod := object.(*corev1.Pod); isPod && c.StatusCoalescingWindow > 0 {
+		// The pods change often while the workers start, so their events
+		// are coalesced: the queue keeps a single delayed key per MPIJob.
+		c.enqueueMPIJobAfter(mpiJob, c.StatusCoalescingWindow)
+		return
+	}
 	c.enqueueMPIJob(mpiJob)
 }
 
@@ -1268,6 +1850,123 @@ func (c *MPIJobController) doUpdateJobStatus(mpiJob *kubeflow.MPIJob) error {
 	return err
 }
 
+// archiveMPIJob stores the record of the finished MPIJob in the history
+// backend. Failures are reported as events and don't fail the sync, since the
+// MPIJob status is already final.
+func (c *MPIJobController) archiveMPIJob(mpiJob *kubeflow.MPIJob) {
+	if c.HistoryBackend == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), historyArchiveTimeout)
+	defer cancel()
+	if err := c.HistoryBackend.Archive(ctx, history.NewRecord(mpiJob, c.clock.Now())); err != nil {
+		klog.Errorf("Failed to archive MPIJob %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, "HistoryArchiveFailed", "Failed to archive the MPIJob: %v", err)
+		return
+	}
+	klog.V(4).Infof("Archived MPIJob %s/%s", mpiJob.Namespace, mpiJob.Name)
+}
+
+// persistedStatus returns the status of the MPIJob in the informer cache,
+// which doesn't include the changes of the sync, like the Created condition.
+func (c *MPIJobController) persistedStatus(mpiJob *kubeflow.MPIJob, fallback *kubeflow.JobStatus) *kubeflow.JobStatus {
+	shared, err := c.mpiJobLister.MPIJobs(mpiJob.Namespace).Get(mpiJob.Name)
+	if err != nil {
+		return fallback
+	}
+	return &shared.Status
+}
+
+// emitCloudEvents sends the CloudEvents of the transitions from the old status
+// to the stored status of the MPIJob.
+func (c *MPIJobController) emitCloudEvents(old *kubeflow.JobStatus, mpiJob *kubeflow.MPIJob) {
+	if c.CloudEventSink == nil {
+		return
+	}
+	for _, e := range cloudevents.Transitions(old, mpiJob, c.clock.Now()) {
+		if err := c.CloudEventSink.Send(context.Background(), e); err != nil {
+			klog.Errorf("Failed to emit CloudEvent %s for MPIJob %s/%s: %v", e.Type, mpiJob.Namespace, mpiJob.Name, err)
+		}
+	}
+}
+
+// notifyMPIJob sends the notifications of the finished MPIJob.
+func (c *MPIJobController) notifyMPIJob(mpiJob *kubeflow.MPIJob) {
+	if c.Notifier == nil {
+		return
+	}
+	e, ok := notification.NewEvent(mpiJob)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+	defer cancel()
+	if err := c.Notifier.Notify(ctx, e); err != nil {
+		klog.Errorf("Failed to send notifications of MPIJob %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, "NotificationFailed", truncateMessage(fmt.Sprintf("Failed to send notifications: %v", err)))
+	}
+}
+
+// pushJobMetrics pushes the final metrics of the finished MPIJob, so that they
+// are recorded even if the MPIJob finished between two scrapes.
+func (c *MPIJobController) pushJobMetrics(mpiJob *kubeflow.MPIJob, launcher *batchv1.Job) {
+	if c.MetricsPusher == nil {
+		return
+	}
+	m := &jobmetrics.Metrics{
+		Namespace: mpiJob.Namespace,
+		Name:      mpiJob.Name,
+		State:     kubeflow.JobFailed,
+		Ranks:     totalSlots(mpiJob),
+	}
+	if cond := getCondition(mpiJob.Status, kubeflow.JobSucceeded); cond != nil && cond.Status == corev1.ConditionTrue {
+		m.State = kubeflow.JobSucceeded
+	}
+	m.Workers = workerReplicas(mpiJob)
+	if s := mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeLauncher]; s != nil {
+		m.LauncherFailures = s.Failed
+	}
+	if mpiJob.Status.StartTime != nil {
+		m.StartTime = &mpiJob.Status.StartTime.Time
+	}
+	if mpiJob.Status.CompletionTime != nil {
+		m.CompletionTime = &mpiJob.Status.CompletionTime.Time
+	}
+	m.GPUUsage = mpiJob.Status.GPUUsage
+	if launcher != nil {
+		if pods, err := c.jobPods(launcher); err != nil {
+			klog.Errorf("Failed to list launcher pods of MPIJob %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+		} else {
+			m.ExitCode = launcherExitCode(pods)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), metricsPushTimeout)
+	defer cancel()
+	if err := c.MetricsPusher.Push(ctx, m); err != nil {
+		klog.Errorf("Failed to push metrics of MPIJob %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, "MetricsPushFailed", truncateMessage(fmt.Sprintf("Failed to push metrics: %v", err)))
+	}
+}
+
+// launcherExitCode returns the exit code of the launcher container of the most
+// recent launcher pod in which it terminated.
+func launcherExitCode(pods []*corev1.Pod) *int32 {
+	var latest *corev1.Pod
+	var exitCode *int32
+	for _, pod := range pods {
+		if len(pod.Spec.Containers) == 0 || (latest != nil && pod.CreationTimestamp.Before(&latest.CreationTimestamp)) {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == pod.Spec.Containers[0].Name && status.State.Terminated != nil {
+				latest = pod
+				exitCode = ptr.To(status.State.Terminated.ExitCode)
+			}
+		}
+	}
+	return exitCode
+}
+
 // newConfigMap creates a new ConfigMap containing configurations for an MPIJob
 // resource. It also sets the appropriate OwnerReferences on the resource so
 // handleObject can discover the MPIJob resource that 'owns' it.
@@ -1289,6 +1988,7 @@ func newConfigMap(mpiJob *kubeflow.MPIJob, workerReplicas int32) *corev1.ConfigM
 
 	for i := 0; i < int(workerReplicas); i++ {
 		name := workerName(mpiJob, i)
+		slots := workerSlots(mpiJob, i)
 		switch mpiJob.Spec.MPIImplementation {
 		case kubeflow.MPIImplementationOpenMPI:
 			buffer.WriteString(fmt.Sprintf("%s.%s.%s.svc slots=%d\n", name, mpiJob.Name, mpiJob.Namespace, slots))
@@ -1343,7 +2043,36 @@ func newJobService(job *kubeflow.MPIJob) *corev1.Service {
 		kubeflow.OperatorNameLabel: kubeflow.OperatorName,
 		kubeflow.JobNameLabel:      job.Name,
 	}
-	return newService(job, job.Name, labels)
+	svc := newService(job, job.Name, labels)
+	setServiceTemplate(job, svc)
+	return svc
+}
+
+// setServiceTemplate sets the labels, annotations, ports and
+// publishNotReadyAddresses of spec.service in the Service. The labels set by
+// the operator are kept.
+func setServiceTemplate(job *kubeflow.MPIJob, svc *corev1.Service) {
+	tmpl := job.Spec.Service
+	if tmpl == nil {
+		return
+	}
+	for key, value := range tmpl.Labels {
+		if _, ok := svc.Labels[key]; !ok {
+			svc.Labels[key] = value
+		}
+	}
+	if len(tmpl.Annotations) > 0 {
+		if svc.Annotations == nil {
+			svc.Annotations = make(map[string]string, len(tmpl.Annotations))
+		}
+		for key, value := range tmpl.Annotations {
+			svc.Annotations[key] = value
+		}
+	}
+	svc.Spec.PublishNotReadyAddresses = ptr.Deref(tmpl.PublishNotReadyAddresses, false)
+	for _, port := range tmpl.Ports {
+		svc.Spec.Ports = append(svc.Spec.Ports, *port.DeepCopy())
+	}
 }
 
 func newService(job *kubeflow.MPIJob, name string, selector map[string]string) *corev1.Service {
@@ -1390,7 +2119,9 @@ func newSSHAuthSecret(job *kubeflow.MPIJob) (*corev1.Secret, error) {
 			Name:      job.Name + sshAuthSecretSuffix,
 			Namespace: job.Namespace,
 			Labels: map[string]string{
-				"app": job.Name,
+				"app":                      job.Name,
+				kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+				kubeflow.JobNameLabel:      job.Name,
 			},
 			OwnerReferences: []metav1.OwnerReference{
 				*metav1.NewControllerRef(job, kubeflow.SchemeGroupVersionKind),
@@ -1405,7 +2136,7 @@ func newSSHAuthSecret(job *kubeflow.MPIJob) (*corev1.Secret, error) {
 }
 
 func workerName(mpiJob *kubeflow.MPIJob, index int) string {
-	return fmt.Sprintf("%s%s-%d", mpiJob.Name, workerSuffix, index)
+	return kubeflow.WorkerName(mpiJob, index)
 }
 
 func runLauncherAsWorker(mpiJob *kubeflow.MPIJob) bool {
@@ -1427,7 +2158,7 @@ func workerReplicaIndexLabel(mpiJob *kubeflow.MPIJob, index int) string {
 func (c *MPIJobController) newWorker(mpiJob *kubeflow.MPIJob, index int) *corev1.Pod {
 	name := workerName(mpiJob, index)
 
-	podTemplate := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.DeepCopy()
+	podTemplate := workerTemplate(mpiJob, index)
 
 	// keep the labels which are set in PodTemplate
 	if len(podTemplate.Labels) == 0 {
@@ -1437,33 +2168,54 @@ func (c *MPIJobController) newWorker(mpiJob *kubeflow.MPIJob, index int) *corev1
 		podTemplate.Labels[key] = value
 	}
 	podTemplate.Labels[kubeflow.ReplicaIndexLabel] = workerReplicaIndexLabel(mpiJob, index)
+	setNetworkAttachments(mpiJob, podTemplate)
+	setServiceMeshAnnotations(mpiJob, podTemplate, false)
+	setWorkerPool(mpiJob, podTemplate, index)
+	setWorkerOverrides(mpiJob, podTemplate, index)
+	if mpiJob.Spec.GPUPreCheck != nil {
+		c.setupGPUPreCheck(mpiJob, podTemplate)
+	}
+	c.setupClusterAutoscaler(mpiJob, podTemplate, false)
 	podTemplate.Spec.Hostname = name
 	podTemplate.Spec.Subdomain = mpiJob.Name // Matches job' Service name.
-	if podTemplate.Spec.HostNetwork {
-		// Allows resolution of worker hostnames without needing to include the
-		// namespace or cluster domain.
-		podTemplate.Spec.DNSPolicy = corev1.DNSClusterFirstWithHostNet
-	}
+	setHostNetworkDNSPolicy(podTemplate)
 	// The Intel and MPICH implementations require workers to communicate with the launcher through its hostname.
 	searche := fmt.Sprintf("%s.%s.svc.cluster.local", mpiJob.Name, mpiJob.Namespace)
 	if podTemplate.Spec.DNSConfig == nil {
 		podTemplate.Spec.DNSConfig = &corev1.PodDNSConfig{Searches: []string{searche}}
-	} else {
+	} else if !slices.Contains(podTemplate.Spec.DNSConfig.Searches, searche) {
 		podTemplate.Spec.DNSConfig.Searches = append(podTemplate.Spec.DNSConfig.Searches, searche)
 	}
 	setRestartPolicy(podTemplate, mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker])
+	setTerminationGracePeriod(mpiJob, podTemplate)
 
 	container := &podTemplate.Spec.Containers[0]
+	setBenchmarkImage(mpiJob, container)
 	if len(container.Command) == 0 && len(container.Args) == 0 {
 		container.Command = []string{"/usr/sbin/sshd", "-De"}
 	}
 	container.Env = append(container.Env, workerEnvVars...)
 	c.setupSSHOnPod(&podTemplate.Spec, mpiJob)
+	if isProfilingEnabled(mpiJob) {
+		setupProfiling(mpiJob, podTemplate, container)
+	}
+	if mpiJob.Spec.CoreDumps != nil {
+		c.setupCoreDumps(mpiJob, podTemplate, container, false)
+	}
+	if mpiJob.Spec.Watchdog != nil {
+		c.setupWatchdog(mpiJob, podTemplate, container)
+	}
+	c.setupArtifactUpload(mpiJob, podTemplate, container, false)
 
 	// add SchedulerName to podSpec
 	if c.PodGroupCtrl != nil {
 		c.PodGroupCtrl.decoratePodTemplateSpec(podTemplate, mpiJob.Name)
 	}
+	c.propagateMetadata(mpiJob, &podTemplate.ObjectMeta)
+	if c.PodDefaults != nil && !hasOverride(mpiJob, kubeflow.SkipPodDefaultsAnnotation) {
+		c.PodDefaults.Apply(podTemplate)
+	}
+	setJobMetadataEnv(mpiJob, &podTemplate.Spec, &index)
 
 	return &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1501,9 +2253,35 @@ func (c *MPIJobController) newLauncherJob(mpiJob *kubeflow.MPIJob) *batchv1.Job
 	if isMPIJobSuspended(mpiJob) {
 		job.Spec.Suspend = ptr.To(true)
 	}
+	setLauncherJobTemplate(mpiJob, job)
+	c.propagateMetadata(mpiJob, &job.ObjectMeta)
 	return job
 }
 
+// setLauncherJobTemplate sets the labels, annotations and pod failure policy
+// of spec.launcherJob in the launcher Job. The labels set by the operator are
+// kept.
+func setLauncherJobTemplate(mpiJob *kubeflow.MPIJob, job *batchv1.Job) {
+	tmpl := mpiJob.Spec.LauncherJob
+	if tmpl == nil {
+		return
+	}
+	for key, value := range tmpl.Labels {
+		if _, ok := job.Labels[key]; !ok {
+			job.Labels[key] = value
+		}
+	}
+	if len(tmpl.Annotations) > 0 {
+		if job.Annotations == nil {
+			job.Annotations = make(map[string]string, len(tmpl.Annotations))
+		}
+		for key, value := range tmpl.Annotations {
+			job.Annotations[key] = value
+		}
+	}
+	job.Spec.PodFailurePolicy = tmpl.PodFailurePolicy.DeepCopy()
+}
+
 // newLauncherPodTemplate creates a new launcher Job for an MPIJob resource. It also sets
 // the appropriate OwnerReferences on the resource so handleObject can discover
 // the MPIJob resource that 'owns' it.
@@ -1524,14 +2302,13 @@ func (c *MPIJobController) newLauncherPodTemplate(mpiJob *kubeflow.MPIJob) corev
 	}
 	if runLauncherAsWorker(mpiJob) {
 		podTemplate.Labels[kubeflow.ReplicaIndexLabel] = "0"
+		setNetworkAttachments(mpiJob, podTemplate)
 	}
+	setServiceMeshAnnotations(mpiJob, podTemplate, true)
+	c.setupClusterAutoscaler(mpiJob, podTemplate, true)
 	podTemplate.Spec.Hostname = launcherName
 	podTemplate.Spec.Subdomain = mpiJob.Name // Matches job' Service name.
-	if podTemplate.Spec.HostNetwork {
-		// Allows resolution of worker hostnames without needing to include the
-		// namespace or cluster domain.
-		podTemplate.Spec.DNSPolicy = corev1.DNSClusterFirstWithHostNet
-	}
+	setHostNetworkDNSPolicy(podTemplate)
 	container := &podTemplate.Spec.Containers[0]
 	container.Env = append(container.Env, launcherEnvVars...)
 	slotsStr := strconv.Itoa(int(*mpiJob.Spec.SlotsPerWorker))
@@ -1568,6 +2345,7 @@ func (c *MPIJobController) newLauncherPodTemplate(mpiJob *kubeflow.MPIJob) corev
 		c.recorder.Event(mpiJob, corev1.EventTypeWarning, podTemplateRestartPolicyReason, errMsg)
 	}
 	setRestartPolicy(podTemplate, mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher])
+	setTerminationGracePeriod(mpiJob, podTemplate)
 
 	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes,
 		corev1.Volume{
@@ -1585,6 +2363,36 @@ func (c *MPIJobController) newLauncherPodTemplate(mpiJob *kubeflow.MPIJob) corev
 		Name:      configVolumeName,
 		MountPath: configMountPath,
 	})
+	setupNetworkOnLauncher(mpiJob, container)
+	setupTCPInterfacesOnLauncher(mpiJob, podTemplate, container)
+	setupMultiClusterOnLauncher(mpiJob, container)
+	if mpiJob.Spec.Benchmark != nil {
+		setupBenchmarkOnLauncher(mpiJob, container)
+	}
+	setupServiceMeshOnLauncher(mpiJob, container)
+	if isProfilingEnabled(mpiJob) {
+		c.setupProfilingOnLauncher(mpiJob, podTemplate, container)
+	}
+	if mpiJob.Spec.CoreDumps != nil {
+		c.setupCoreDumps(mpiJob, podTemplate, container, true)
+	}
+	// The wrapper runs the command once the other features modified it.
+	if mpiJob.Spec.LaunchWrapper != nil {
+		c.setupLaunchWrapper(mpiJob, podTemplate, container)
+	}
+	// The launcher only runs MPI processes when it also acts as a worker.
+	if mpiJob.Spec.Watchdog != nil && runLauncherAsWorker(mpiJob) {
+		c.setupWatchdog(mpiJob, podTemplate, container)
+	}
+	if isDiagnosticsEnabled(mpiJob) {
+		podTemplate.Spec.InitContainers = append(podTemplate.Spec.InitContainers, newDiagnosticsInitContainer(mpiJob, container))
+	}
+	c.setupArtifactUpload(mpiJob, podTemplate, container, true)
+	c.propagateMetadata(mpiJob, &podTemplate.ObjectMeta)
+	if c.PodDefaults != nil && !hasOverride(mpiJob, kubeflow.SkipPodDefaultsAnnotation) {
+		c.PodDefaults.Apply(podTemplate)
+	}
+	setJobMetadataEnv(mpiJob, &podTemplate.Spec, nil)
 
 	return corev1.PodTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1634,6 +2442,36 @@ func setRestartPolicy(podTemplateSpec *corev1.PodTemplateSpec, spec *kubeflow.Re
 	}
 }
 
+// setHostNetworkDNSPolicy sets the ClusterFirstWithHostNet dnsPolicy in the
+// pods with hostNetwork, unless their template sets a dnsPolicy, like None
+// with the nameservers of a node-local DNS cache.
+func setHostNetworkDNSPolicy(podTemplate *corev1.PodTemplateSpec) {
+	if podTemplate.Spec.HostNetwork && podTemplate.Spec.DNSPolicy == "" {
+		// Allows resolution of worker hostnames without needing to include the
+		// namespace or cluster domain.
+		podTemplate.Spec.DNSPolicy = corev1.DNSClusterFirstWithHostNet
+	}
+}
+
+// setTerminationGracePeriod sets the terminationGracePeriodSeconds of the
+// runPolicy, if any, in the pod template.
+func setTerminationGracePeriod(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec) {
+	if gracePeriod := mpiJob.Spec.RunPolicy.TerminationGracePeriodSeconds; gracePeriod != nil {
+		podTemplate.Spec.TerminationGracePeriodSeconds = ptr.To(*gracePeriod)
+	}
+}
+
+// podDeleteOptions returns the options to delete the pod with the grace period
+// of its spec, so that its preStop hooks and processes can finish the writes
+// in flight.
+func podDeleteOptions(pod *corev1.Pod) metav1.DeleteOptions {
+	var opts metav1.DeleteOptions
+	if pod != nil && pod.Spec.TerminationGracePeriodSeconds != nil {
+		opts.GracePeriodSeconds = ptr.To(*pod.Spec.TerminationGracePeriodSeconds)
+	}
+	return opts
+}
+
 func isJobFinished(j *batchv1.Job) bool {
 	return isJobSucceeded(j) || isJobFailed(j)
 }
@@ -1661,6 +2499,26 @@ func isPodRunning(p *corev1.Pod) bool {
 	return p.Status.Phase == corev1.PodRunning
 }
 
+// isPodOOMKilled returns whether a container of the pod was terminated for
+// running out of memory.
+func isPodOOMKilled(p *corev1.Pod) bool {
+	for _, status := range p.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.Reason == "OOMKilled" {
+			return true
+		}
+	}
+	return false
+}
+
+func isPodReady(p *corev1.Pod) bool {
+	for _, c := range p.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
 func isPodPending(p *corev1.Pod) bool {
 	return p.Status.Phase == corev1.PodPending
 }
@@ -1689,12 +2547,18 @@ func workerSelector(mpiJobName string) (labels.Selector, error) {
 	return labels.ValidatedSelectorFromSet(set)
 }
 
+// workerReplicas returns the number of workers of the MPIJob, including the
+// workers of its worker groups.
 func workerReplicas(job *kubeflow.MPIJob) int32 {
+	var replicas int32
 	workerSpec := job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
 	if workerSpec != nil && workerSpec.Replicas != nil {
-		return *workerSpec.Replicas
+		replicas = *workerSpec.Replicas
+	}
+	for _, group := range job.Spec.WorkerGroups {
+		replicas += group.Replicas
 	}
-	return 0
+	return replicas
 }
 
 func (c *MPIJobController) setupSSHOnPod(podSpec *corev1.PodSpec, job *kubeflow.MPIJob) {
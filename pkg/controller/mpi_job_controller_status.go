@@ -15,51 +15,113 @@
 package controller
 
 import (
+	"fmt"
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 
 	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
 )
 
-const (
-	// mpiJobCreatedReason is added in a mpijob when it is created.
-	mpiJobCreatedReason = "MPIJobCreated"
-	// mpiJobSucceededReason is added in a mpijob when it is succeeded.
-	mpiJobSucceededReason = "MPIJobSucceeded"
-	// mpiJobRunningReason is added in a mpijob when it is running.
-	mpiJobRunningReason = "MPIJobRunning"
-	// mpiJobSuspendedReason is added in a mpijob when it is suspended.
-	mpiJobSuspendedReason = "MPIJobSuspended"
-	// mpiJobResumedReason is added in a mpijob when it is resumed.
-	mpiJobResumedReason = "MPIJobResumed"
-	// mpiJobFailedReason is added in a mpijob when it is failed.
-	mpiJobFailedReason = "MPIJobFailed"
-	// mpiJobEvict
-	mpiJobEvict = "MPIJobEvicted"
-)
-
 // initializeMPIJobStatuses initializes the ReplicaStatuses for MPIJob.
 func initializeMPIJobStatuses(mpiJob *kubeflow.MPIJob, mtype kubeflow.MPIReplicaType) {
 	if mpiJob.Status.ReplicaStatuses == nil {
 		mpiJob.Status.ReplicaStatuses = make(map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus)
 	}
 
-	mpiJob.Status.ReplicaStatuses[mtype] = &kubeflow.ReplicaStatus{}
+	role := worker
+	if mtype == kubeflow.MPIReplicaTypeLauncher {
+		role = launcher
+	}
+	podLabels := defaultLabels(mpiJob.Name, role)
+	// The deprecated LabelSelector isn't set: the migration removes it.
+	mpiJob.Status.ReplicaStatuses[mtype] = &kubeflow.ReplicaStatus{
+		Selector: labels.SelectorFromSet(podLabels).String(),
+	}
 }
 
 // updateMPIJobConditions updates the conditions of the given mpiJob.
-func updateMPIJobConditions(mpiJob *kubeflow.MPIJob, conditionType kubeflow.JobConditionType, status v1.ConditionStatus, reason, message string) bool {
-	condition := newCondition(conditionType, status, reason, message)
-	return setCondition(&mpiJob.Status, condition)
+func updateMPIJobConditions(mpiJob *kubeflow.MPIJob, conditionType kubeflow.JobConditionType, status v1.ConditionStatus, reason, message string, clock clock.PassiveClock) bool {
+	condition := newCondition(conditionType, status, reason, message, clock)
+	if !setCondition(&mpiJob.Status, condition) {
+		return false
+	}
+	mpiJob.Status.State = kubeflow.StateOf(mpiJob.Status)
+	return true
+}
+
+// updatePodsReadyCondition sets the PodsReady condition of the given mpiJob
+// when the launcher and all the workers are ready. The condition becomes false
+// once a pod is no longer ready, and it is not added before the pods are ready.
+func updatePodsReadyCondition(mpiJob *kubeflow.MPIJob, podsReady bool, clock clock.PassiveClock) bool {
+	if podsReady {
+		msg := fmt.Sprintf("MPIJob %s/%s has all its pods ready.", mpiJob.Namespace, mpiJob.Name)
+		return updateMPIJobConditions(mpiJob, kubeflow.JobPodsReady, v1.ConditionTrue, kubeflow.JobPodsReadyReason, msg, clock)
+	}
+	if !hasCondition(mpiJob.Status, kubeflow.JobPodsReady) {
+		return false
+	}
+	msg := fmt.Sprintf("MPIJob %s/%s has pods that are not ready.", mpiJob.Namespace, mpiJob.Name)
+	return updateMPIJobConditions(mpiJob, kubeflow.JobPodsReady, v1.ConditionFalse, kubeflow.JobPodsNotReadyReason, msg, clock)
+}
+
+// updateReplicaNodes sets the nodes hosting the scheduled pods of the replica
+// type. The nodes of the previous status are kept once the pods are gone, so
+// that the nodes of finished jobs remain known.
+func updateReplicaNodes(mpiJob *kubeflow.MPIJob, oldStatus *kubeflow.JobStatus, mtype kubeflow.MPIReplicaType, pods []*v1.Pod) {
+	nodes := sets.New[string]()
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" {
+			nodes.Insert(pod.Spec.NodeName)
+		}
+	}
+	status := mpiJob.Status.ReplicaStatuses[mtype]
+	if nodes.Len() > 0 {
+		status.Nodes = sets.List(nodes)
+	} else if old := oldStatus.ReplicaStatuses[mtype]; old != nil {
+		status.Nodes = old.Nodes
+	}
+}
+
+// maxProgressLength is the length at which the progress reported by the
+// launcher is truncated.
+const maxProgressLength = 128
+
+// updateMPIJobProgress copies the progress reported in the annotation of the
+// latest launcher pod to the status of the given mpiJob. The progress is kept
+// once the launcher pods are gone.
+func updateMPIJobProgress(mpiJob *kubeflow.MPIJob, launcherPods []*v1.Pod) {
+	var latest *v1.Pod
+	for _, pod := range launcherPods {
+		if _, ok := pod.Annotations[kubeflow.ProgressAnnotation]; !ok {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			latest = pod
+		}
+	}
+	if latest == nil {
+		return
+	}
+	progress := strings.TrimSpace(latest.Annotations[kubeflow.ProgressAnnotation])
+	if len(progress) > maxProgressLength {
+		progress = progress[:maxProgressLength]
+	}
+	mpiJob.Status.Progress = progress
 }
 
 // newCondition creates a new mpiJob condition.
-func newCondition(conditionType kubeflow.JobConditionType, status v1.ConditionStatus, reason, message string) kubeflow.JobCondition {
+func newCondition(conditionType kubeflow.JobConditionType, status v1.ConditionStatus, reason, message string, clock clock.PassiveClock) kubeflow.JobCondition {
+	now := metav1.NewTime(clock.Now())
 	return kubeflow.JobCondition{
 		Type:               conditionType,
 		Status:             status,
-		LastUpdateTime:     metav1.Now(),
-		LastTransitionTime: metav1.Now(),
+		LastUpdateTime:     now,
+		LastTransitionTime: now,
 		Reason:             reason,
 		Message:            message,
 	}
@@ -15,21 +15,29 @@
 package controller
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kubeinformers "k8s.io/client-go/informers"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
@@ -48,6 +56,12 @@ import (
 	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
 	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
 	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions"
+	"github.com/kubeflow/mpi-operator/pkg/cloudevents"
+	"github.com/kubeflow/mpi-operator/pkg/gpuusage"
+	"github.com/kubeflow/mpi-operator/pkg/history"
+	"github.com/kubeflow/mpi-operator/pkg/jobmetrics"
+	"github.com/kubeflow/mpi-operator/pkg/notification"
+	"github.com/kubeflow/mpi-operator/pkg/poddefaults"
 )
 
 var (
@@ -87,6 +101,14 @@ type fixture struct {
 	objects     []runtime.Object
 
 	gangSchedulingName string
+
+	historyBackend history.Backend
+	notifier       notification.Notifier
+	cloudEventSink cloudevents.Sink
+	metricsPusher  jobmetrics.Pusher
+	gpuUsage       gpuusage.Source
+
+	releaseFinishedPods bool
 }
 
 func newFixture(t *testing.T, gangSchedulingName string) *fixture {
@@ -194,6 +216,14 @@ func (f *fixture) newController(clock clock.WithTicker) (*MPIJobController, info
 	c.podGroupSynced = alwaysReady
 	c.mpiJobSynced = alwaysReady
 	c.recorder = &record.FakeRecorder{}
+	c.HistoryBackend = f.historyBackend
+	c.Notifier = f.notifier
+	c.CloudEventSink = f.cloudEventSink
+	c.MetricsPusher = f.metricsPusher
+	c.GPUUsageSource = f.gpuUsage
+	c.ReleaseFinishedPods = f.releaseFinishedPods
+	// The actions are checked in order.
+	c.WorkerCreationParallelism = 1
 
 	for _, configMap := range f.configMapLister {
 		err = k8sI.Core().V1().ConfigMaps().Informer().GetIndexer().Add(configMap)
@@ -427,6 +457,14 @@ func (f *fixture) expectNoKubeActions() bool {
 }
 
 func (f *fixture) expectUpdateMPIJobStatusAction(mpiJob *kubeflow.MPIJob) {
+	// The replica statuses select the pods of their replicas.
+	for mtype, status := range mpiJob.Status.ReplicaStatuses {
+		role := worker
+		if mtype == kubeflow.MPIReplicaTypeLauncher {
+			role = launcher
+		}
+		status.Selector = fmt.Sprintf("%s=%s,%s=%s,%s=%s", kubeflow.JobNameLabel, mpiJob.Name, kubeflow.JobRoleLabel, role, kubeflow.OperatorNameLabel, kubeflow.OperatorName)
+	}
 	action := core.NewUpdateAction(schema.GroupVersionResource{Resource: "mpijobs"}, mpiJob.Namespace, mpiJob)
 	action.Subresource = "status"
 	f.actions = append(f.actions, action)
@@ -512,6 +550,29 @@ func TestDoNothingWithInvalidMPIJob(t *testing.T) {
 	f.run(getKey(mpiJob, t))
 }
 
+func TestRecoverFromSyncPanic(t *testing.T) {
+	f := newFixture(t, "")
+	mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+	f.setUpMPIJob(mpiJob)
+	c, _, _ := f.newController(clock.RealClock{})
+	c.updateStatusHandler = func(*kubeflow.MPIJob) error {
+		panic("malformed MPIJob")
+	}
+	key := getKey(mpiJob, t)
+	panics := testutil.ToFloat64(syncPanicsCount)
+
+	c.queue.Add(key)
+	if !c.processNextWorkItem() {
+		t.Fatal("Worker stopped after a panic")
+	}
+	if got := testutil.ToFloat64(syncPanicsCount) - panics; got != 1 {
+		t.Errorf("Counted %v panics, want 1", got)
+	}
+	if got := c.queue.NumRequeues(key); got != 1 {
+		t.Errorf("Requeued the key %d times, want 1", got)
+	}
+}
+
 func TestDoNothingWithMPIJobManagedExternally(t *testing.T) {
 	f := newFixture(t, "")
 	var replicas int32 = 1
@@ -554,7 +615,8 @@ func TestAllResourcesCreated(t *testing.T) {
 			}
 			f.expectCreateJobAction(fmjc.newLauncherJob(mpiJobCopy))
 
-			mpiJobCopy.Status.Conditions = []kubeflow.JobCondition{newCondition(kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, "MPIJob default/foo is created.")}
+			mpiJobCopy.Status.Conditions = []kubeflow.JobCondition{newCondition(kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, "MPIJob default/foo is created.", clock.RealClock{})}
+			mpiJobCopy.Status.State = kubeflow.JobCreated
 			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
 				kubeflow.MPIReplicaTypeLauncher: {},
 				kubeflow.MPIReplicaTypeWorker:   {},
@@ -587,8 +649,8 @@ func TestLauncherNotControlledByUs(t *testing.T) {
 func TestLauncherSucceeded(t *testing.T) {
 	f := newFixture(t, "")
 
-	startTime := metav1.Now()
 	completionTime := metav1.Now()
+	startTime := metav1.NewTime(completionTime.Add(-90 * time.Second))
 
 	mpiJob := newMPIJob("test", ptr.To[int32](64), &startTime, &completionTime)
 	f.setUpMPIJob(mpiJob)
@@ -613,14 +675,114 @@ func TestLauncherSucceeded(t *testing.T) {
 	}
 
 	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	mpiJobCopy.Status.Duration = &metav1.Duration{Duration: 90 * time.Second}
+	mpiJobCopy.Status.ResourceUsage = &kubeflow.ResourceUsage{WallClockSeconds: 90}
+	wantGPUUsage := &kubeflow.GPUUsage{
+		GPUs:               64,
+		AverageUtilization: 11,
+		PeakUtilization:    38,
+		EnergyJoules:       1800000,
+	}
+	mpiJobCopy.Status.GPUUsage = wantGPUUsage
 
 	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
 	msg = fmt.Sprintf("MPIJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobSucceeded, corev1.ConditionTrue, mpiJobSucceededReason, msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobSucceeded, corev1.ConditionTrue, kubeflow.JobSucceededReason, msg, clock.RealClock{})
 	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
 
+	historyBackend := &fakeHistoryBackend{}
+	f.historyBackend = historyBackend
+	notifier := &fakeNotifier{}
+	f.notifier = notifier
+	cloudEventSink := &fakeCloudEventSink{}
+	f.cloudEventSink = cloudEventSink
+	metricsPusher := &fakeMetricsPusher{}
+	f.metricsPusher = metricsPusher
+	f.gpuUsage = &fakeGPUUsageSource{usage: &gpuusage.Usage{
+		GPUs:               64,
+		AverageUtilization: 11.2,
+		PeakUtilization:    37.6,
+		EnergyJoules:       1800000,
+	}}
 	f.run(getKey(mpiJob, t))
+
+	var archived []string
+	for _, r := range historyBackend.records {
+		archived = append(archived, fmt.Sprintf("%s/%s %s", r.Namespace, r.Name, r.State))
+	}
+	if diff := cmp.Diff([]string{"default/test Succeeded"}, archived); diff != "" {
+		t.Errorf("Unexpected archived records (-want,+got):\n%s", diff)
+	}
+	var notified []string
+	for _, e := range notifier.events {
+		notified = append(notified, fmt.Sprintf("%s/%s %s", e.Namespace, e.Name, e.State))
+	}
+	if diff := cmp.Diff([]string{"default/test Succeeded"}, notified); diff != "" {
+		t.Errorf("Unexpected notifications (-want,+got):\n%s", diff)
+	}
+	var emitted []string
+	for _, e := range cloudEventSink.events {
+		emitted = append(emitted, e.Type+" "+e.Subject)
+	}
+	if diff := cmp.Diff([]string{cloudevents.TypeCreated + " test", cloudevents.TypeSucceeded + " test"}, emitted); diff != "" {
+		t.Errorf("Unexpected CloudEvents (-want,+got):\n%s", diff)
+	}
+	wantMetrics := []*jobmetrics.Metrics{{
+		Namespace:      "default",
+		Name:           "test",
+		State:          kubeflow.JobSucceeded,
+		Ranks:          64,
+		Workers:        64,
+		StartTime:      &startTime.Time,
+		CompletionTime: &completionTime.Time,
+		GPUUsage:       wantGPUUsage,
+	}}
+	if diff := cmp.Diff(wantMetrics, metricsPusher.metrics); diff != "" {
+		t.Errorf("Unexpected pushed metrics (-want,+got):\n%s", diff)
+	}
+}
+
+func TestLauncherExitCode(t *testing.T) {
+	now := time.Now()
+	pod := func(name string, created time.Time, exitCode *int32) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "launcher"}}},
+		}
+		status := corev1.ContainerStatus{Name: "launcher"}
+		if exitCode != nil {
+			status.State.Terminated = &corev1.ContainerStateTerminated{ExitCode: *exitCode}
+		} else {
+			status.State.Running = &corev1.ContainerStateRunning{}
+		}
+		p.Status.ContainerStatuses = []corev1.ContainerStatus{status}
+		return p
+	}
+	cases := map[string]struct {
+		pods []*corev1.Pod
+		want *int32
+	}{
+		"no pods": {},
+		"running": {
+			pods: []*corev1.Pod{pod("a", now, nil)},
+		},
+		"latest terminated pod": {
+			pods: []*corev1.Pod{
+				pod("b", now, ptr.To[int32](0)),
+				pod("a", now.Add(-time.Minute), ptr.To[int32](137)),
+				pod("c", now.Add(time.Minute), nil),
+			},
+			want: ptr.To[int32](0),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, launcherExitCode(tc.pods)); diff != "" {
+				t.Errorf("Unexpected exit code (-want,+got):\n%s", diff)
+			}
+		})
+	}
 }
 
 func TestLauncherFailed(t *testing.T) {
@@ -667,109 +829,1549 @@ func TestLauncherFailed(t *testing.T) {
 		kubeflow.MPIReplicaTypeWorker: {},
 	}
 	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	mpiJobCopy.Status.Duration = &metav1.Duration{}
+	mpiJobCopy.Status.ResourceUsage = &kubeflow.ResourceUsage{}
+	mpiJobCopy.Status.LauncherRestartCount = 1
+	mpiJobCopy.Status.FailureReasonClass = kubeflow.FailureReasonClassApplication
 
 	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
 	msg = "Job has reached the specified backoff limit: second message"
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, batchv1.JobReasonBackoffLimitExceeded+"/FailedReason2", msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.BackoffLimitExceededReason+"/FailedReason2", msg, clock.RealClock{})
 
 	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
 
 	f.run(getKey(mpiJob, t))
 }
 
-func TestConfigMapNotControlledByUs(t *testing.T) {
+func TestLauncherFailedWithWorkerFailures(t *testing.T) {
 	f := newFixture(t, "")
 	startTime := metav1.Now()
 	completionTime := metav1.Now()
 
-	var replicas int32 = 64
+	var replicas int32 = 5
 	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
 	f.setUpMPIJob(mpiJob)
-	f.setUpService(newJobService(mpiJob))
 
-	configMap := newConfigMap(mpiJob, replicas)
-	updateDiscoverHostsInConfigMap(configMap, mpiJob, nil)
-	configMap.OwnerReferences = nil
-	f.setUpConfigMap(configMap)
+	fmjc := f.newFakeMPIJobController()
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  batchv1.JobReasonBackoffLimitExceeded,
+		Message: "Job has reached the specified backoff limit",
+	})
+	launcher.Status.Failed = 1
+	f.setUpLauncher(launcher)
 
-	f.runExpectError(getKey(mpiJob, t))
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		if i > 0 {
+			worker.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name: "worker",
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						ExitCode: int32(i),
+						Message:  fmt.Sprintf("rank %d: %s", i, strings.Repeat("x", 200)),
+					},
+				},
+			}}
+		}
+		f.setUpPod(worker)
+	}
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Failed: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {},
+	}
+	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	mpiJobCopy.Status.Duration = &metav1.Duration{}
+	mpiJobCopy.Status.ResourceUsage = &kubeflow.ResourceUsage{}
+	mpiJobCopy.Status.FailureReasonClass = kubeflow.FailureReasonClassApplication
+
+	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+	var failures []string
+	for i := 1; i <= 3; i++ {
+		failure := fmt.Sprintf("rank %d: %s", i, strings.Repeat("x", 200))
+		failures = append(failures, fmt.Sprintf("test-worker-%d exited with code %d: %s...", i, i, failure[:125]))
+	}
+	msg = "Job has reached the specified backoff limit; failed workers: " + strings.Join(failures, "; ") + "; and 1 more"
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.WorkerFailedReason, msg, clock.RealClock{})
+
+	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
 }
 
-func TestWorkerServiceNotControlledByUs(t *testing.T) {
+func TestLauncherOOMKilled(t *testing.T) {
 	f := newFixture(t, "")
 	startTime := metav1.Now()
 	completionTime := metav1.Now()
 
-	var replicas int32 = 2
-	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
+	mpiJob := newMPIJob("test", ptr.To[int32](64), &startTime, &completionTime)
 	f.setUpMPIJob(mpiJob)
 
+	fmjc := f.newFakeMPIJobController()
 	mpiJobCopy := mpiJob.DeepCopy()
 	scheme.Scheme.Default(mpiJobCopy)
-	service := newJobService(mpiJobCopy)
-	service.OwnerReferences = nil
-	f.setUpService(service)
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  batchv1.JobReasonBackoffLimitExceeded,
+		Message: "Job has reached the specified backoff limit",
+	})
+	launcher.Status.Failed = 1
+	f.setUpLauncher(launcher)
 
-	f.runExpectError(getKey(mpiJob, t))
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodFailed
+	launcherPod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "launcher",
+		State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"},
+		},
+	}}
+	f.setUpPod(launcherPod)
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Failed: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {},
+	}
+	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	mpiJobCopy.Status.Duration = &metav1.Duration{}
+	mpiJobCopy.Status.ResourceUsage = &kubeflow.ResourceUsage{}
+	mpiJobCopy.Status.FailureReasonClass = kubeflow.FailureReasonClassInfrastructure
+
+	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+	msg = "Job has reached the specified backoff limit: the launcher ran out of memory"
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.LauncherOOMReason, msg, clock.RealClock{})
+
+	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
 }
 
-func TestLauncherServiceNotControlledByUs(t *testing.T) {
+func TestLauncherDiagnosticsFailed(t *testing.T) {
 	f := newFixture(t, "")
 	startTime := metav1.Now()
 	completionTime := metav1.Now()
 
-	var replicas int32 = 2
-	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
-	mpiJob.Spec.MPIImplementation = kubeflow.MPIImplementationIntel
+	mpiJob := newMPIJob("test", ptr.To[int32](2), &startTime, &completionTime)
+	mpiJob.Spec.Diagnostics = &kubeflow.Diagnostics{Enabled: ptr.To(true)}
 	f.setUpMPIJob(mpiJob)
 
 	mpiJobCopy := mpiJob.DeepCopy()
 	scheme.Scheme.Default(mpiJobCopy)
-	service := newJobService(mpiJobCopy)
-	service.OwnerReferences = nil
-	f.setUpService(service)
-	configMap := newConfigMap(mpiJobCopy, replicas)
+	f.setUpService(newJobService(mpiJobCopy))
 	secret, err := newSSHAuthSecret(mpiJobCopy)
 	if err != nil {
-		t.Fatalf("Creating SSH auth Secret: %v", err)
+		t.Fatalf("Creating SSH auth secret: %v", err)
 	}
 	f.setUpSecret(secret)
-	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
-	f.setUpConfigMap(configMap)
+
 	fmjc := f.newFakeMPIJobController()
-	for i := 0; i < int(replicas); i++ {
+	var workers []*corev1.Pod
+	for i := 0; i < 2; i++ {
 		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		workers = append(workers, worker)
 		f.setUpPod(worker)
 	}
+	configMap := newConfigMap(mpiJobCopy, 2)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, workers)
+	f.setUpConfigMap(configMap)
 
-	f.runExpectError(getKey(mpiJob, t))
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	f.setUpLauncher(launcher)
+
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Status.Phase = corev1.PodPending
+	launcherPod.Status.InitContainerStatuses = []corev1.ContainerStatus{{
+		Name: diagnosticsContainerName,
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{
+				ExitCode: 1,
+				Message:  "average bus bandwidth of 3.2 GB/s is below the threshold of 10 GB/s",
+			},
+		},
+	}}
+	f.setUpPod(launcherPod)
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {},
+		kubeflow.MPIReplicaTypeWorker:   {Active: 2},
+	}
+	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	mpiJobCopy.Status.Duration = &metav1.Duration{}
+	mpiJobCopy.Status.ResourceUsage = &kubeflow.ResourceUsage{}
+	mpiJobCopy.Status.FailureReasonClass = kubeflow.FailureReasonClassInfrastructure
+
+	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+	msg = fmt.Sprintf("MPIJob %s/%s failed the diagnostics: average bus bandwidth of 3.2 GB/s is below the threshold of 10 GB/s", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.DiagnosticsFailedReason, msg, clock.RealClock{})
+	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
 }
 
-func TestSecretNotControlledByUs(t *testing.T) {
+func TestDeleteLauncherAfterFailedDiagnostics(t *testing.T) {
 	f := newFixture(t, "")
 	startTime := metav1.Now()
 	completionTime := metav1.Now()
 
-	var replicas int32 = 64
-	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
+	mpiJob := newMPIJob("test", ptr.To[int32](2), &startTime, &completionTime)
+	mpiJob.Spec.RunPolicy.CleanPodPolicy = ptr.To(kubeflow.CleanPodPolicyNone)
+	mpiJob.Spec.Diagnostics = &kubeflow.Diagnostics{Enabled: ptr.To(true)}
+	updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, "", clock.RealClock{})
+	updateMPIJobConditions(mpiJob, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.DiagnosticsFailedReason, "", clock.RealClock{})
 	f.setUpMPIJob(mpiJob)
 
+	fmjc := f.newFakeMPIJobController()
 	mpiJobCopy := mpiJob.DeepCopy()
 	scheme.Scheme.Default(mpiJobCopy)
-	configMap := newConfigMap(mpiJobCopy, replicas)
-	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
-	f.setUpConfigMap(configMap)
-	f.setUpService(newJobService(mpiJobCopy))
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	f.setUpLauncher(launcher)
+
+	f.kubeActions = append(f.kubeActions, core.NewDeleteAction(schema.GroupVersionResource{Resource: "jobs", Group: "batch", Version: "v1"}, launcher.Namespace, launcher.Name))
 
+	f.run(getKey(mpiJob, t))
+}
+
+func TestPreRunHookRunning(t *testing.T) {
+	f := newFixture(t, "")
+	now := metav1.Now()
+	mpiJob := newMPIJob("foo", ptr.To[int32](2), &now, nil)
+	mpiJob.Spec.Hooks = &kubeflow.Hooks{
+		PreRun: &kubeflow.Hook{
+			Container: &corev1.Container{Name: "stage", Image: "stage:latest"},
+		},
+	}
+	f.setUpMPIJob(mpiJob)
+
+	fmjc := f.newFakeMPIJobController()
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.expectCreateServiceAction(newJobService(mpiJobCopy))
+	cfgMap := newConfigMap(mpiJobCopy, 2)
+	updateDiscoverHostsInConfigMap(cfgMap, mpiJob, nil)
+	f.expectCreateConfigMapAction(cfgMap)
 	secret, err := newSSHAuthSecret(mpiJobCopy)
 	if err != nil {
-		t.Fatalf("Creating SSH auth Secret: %v", err)
+		t.Fatalf("Failed creating secret")
+	}
+	f.expectCreateSecretAction(secret)
+	for i := 0; i < 2; i++ {
+		f.expectCreatePodAction(fmjc.newWorker(mpiJobCopy, i))
+	}
+	// The launcher waits for the pre-run hook.
+	f.expectCreateJobAction(fmjc.newHookJob(mpiJobCopy, mpiJobCopy.Spec.Hooks.PreRun, preRunHook))
+
+	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+	msg = fmt.Sprintf("Pre-run hook of MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobPreRunHookCompleted, corev1.ConditionFalse, kubeflow.HookRunningReason, msg, clock.RealClock{})
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeWorker: {},
+	}
+	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestPreRunHookFailed(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	mpiJob := newMPIJob("test", ptr.To[int32](2), &startTime, &completionTime)
+	mpiJob.Spec.Hooks = &kubeflow.Hooks{
+		PreRun: &kubeflow.Hook{
+			Container: &corev1.Container{Name: "stage", Image: "stage:latest"},
+		},
+	}
+	f.setUpMPIJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.setUpService(newJobService(mpiJobCopy))
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth secret: %v", err)
+	}
+	f.setUpSecret(secret)
+
+	fmjc := f.newFakeMPIJobController()
+	var workers []*corev1.Pod
+	for i := 0; i < 2; i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		worker.Status.Phase = corev1.PodRunning
+		workers = append(workers, worker)
+		f.setUpPod(worker)
+	}
+	configMap := newConfigMap(mpiJobCopy, 2)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, workers)
+	f.setUpConfigMap(configMap)
+
+	hookJob := fmjc.newHookJob(mpiJobCopy, mpiJobCopy.Spec.Hooks.PreRun, preRunHook)
+	hookJob.Status.Conditions = []batchv1.JobCondition{{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  batchv1.JobReasonBackoffLimitExceeded,
+		Message: "Job has reached the specified backoff limit",
+	}}
+	f.setUpLauncher(hookJob)
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeWorker: {Active: 2},
+	}
+	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	mpiJobCopy.Status.Duration = &metav1.Duration{}
+	mpiJobCopy.Status.ResourceUsage = &kubeflow.ResourceUsage{}
+
+	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+	msg = fmt.Sprintf("Pre-run hook of MPIJob %s/%s failed: Job has reached the specified backoff limit", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobPreRunHookCompleted, corev1.ConditionFalse, kubeflow.HookFailedReason, msg, clock.RealClock{})
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobFailed, corev1.ConditionTrue, kubeflow.PreRunHookFailedReason, msg, clock.RealClock{})
+	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
+
+	historyBackend := &fakeHistoryBackend{}
+	f.historyBackend = historyBackend
+	notifier := &fakeNotifier{}
+	f.notifier = notifier
+	f.run(getKey(mpiJob, t))
+
+	var archived []string
+	for _, r := range historyBackend.records {
+		archived = append(archived, fmt.Sprintf("%s/%s %s", r.Namespace, r.Name, r.State))
+	}
+	if diff := cmp.Diff([]string{"default/test Failed"}, archived); diff != "" {
+		t.Errorf("Unexpected archived records (-want,+got):\n%s", diff)
+	}
+	var notified []string
+	for _, e := range notifier.events {
+		notified = append(notified, fmt.Sprintf("%s/%s %s", e.Namespace, e.Name, e.State))
+	}
+	if diff := cmp.Diff([]string{"default/test Failed"}, notified); diff != "" {
+		t.Errorf("Unexpected notifications (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPostRunHookCreated(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	mpiJob := newMPIJob("test", ptr.To[int32](2), &startTime, &completionTime)
+	mpiJob.Spec.RunPolicy.CleanPodPolicy = ptr.To(kubeflow.CleanPodPolicyNone)
+	mpiJob.Spec.Hooks = &kubeflow.Hooks{
+		PostRun: &kubeflow.Hook{
+			Container: &corev1.Container{Name: "upload", Image: "upload:latest"},
+		},
+	}
+	updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, "", clock.RealClock{})
+	updateMPIJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, kubeflow.JobSucceededReason, "", clock.RealClock{})
+	f.setUpMPIJob(mpiJob)
+
+	fmjc := f.newFakeMPIJobController()
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	f.expectCreateJobAction(fmjc.newHookJob(mpiJobCopy, mpiJobCopy.Spec.Hooks.PostRun, postRunHook))
+
+	msg := fmt.Sprintf("Post-run hook of MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobPostRunHookCompleted, corev1.ConditionFalse, kubeflow.HookRunningReason, msg, clock.RealClock{})
+	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestNewHookJob(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.ServiceAccountName = "launcher"
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.Volumes = []corev1.Volume{{Name: "data"}}
+	ownerRefs := []metav1.OwnerReference{*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind)}
+	podLabels := map[string]string{
+		kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+		kubeflow.JobNameLabel:      "test",
+		kubeflow.JobRoleLabel:      preRunHook,
+	}
+	cases := map[string]struct {
+		hook kubeflow.Hook
+		want batchv1.Job
+	}{
+		"container": {
+			hook: kubeflow.Hook{
+				Container:             &corev1.Container{Name: "stage", Image: "stage:latest"},
+				ActiveDeadlineSeconds: ptr.To[int64](60),
+			},
+			want: batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "test-pre-run",
+					Namespace:       metav1.NamespaceDefault,
+					Labels:          map[string]string{"app": "test"},
+					OwnerReferences: ownerRefs,
+				},
+				Spec: batchv1.JobSpec{
+					BackoffLimit:          ptr.To[int32](0),
+					ActiveDeadlineSeconds: ptr.To[int64](60),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: podLabels,
+						},
+						Spec: corev1.PodSpec{
+							Containers:         []corev1.Container{{Name: "stage", Image: "stage:latest"}},
+							RestartPolicy:      corev1.RestartPolicyNever,
+							ServiceAccountName: "launcher",
+							Volumes:            []corev1.Volume{{Name: "data"}},
+						},
+					},
+				},
+			},
+		},
+		"job template": {
+			hook: kubeflow.Hook{
+				JobTemplate: &batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "ignored",
+						Labels:      map[string]string{"app": "stage", "team": "a"},
+						Annotations: map[string]string{"example.com/hook": "stage"},
+					},
+					Spec: batchv1.JobSpec{
+						BackoffLimit:          ptr.To[int32](3),
+						ActiveDeadlineSeconds: ptr.To[int64](600),
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{"team": "a"},
+							},
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{Name: "fetch", Image: "fetch:latest"},
+									{Name: "index", Image: "index:latest"},
+								},
+								ServiceAccountName: "stage",
+							},
+						},
+					},
+				},
+				ActiveDeadlineSeconds: ptr.To[int64](60),
+			},
+			want: batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "test-pre-run",
+					Namespace:       metav1.NamespaceDefault,
+					Labels:          map[string]string{"app": "test", "team": "a"},
+					Annotations:     map[string]string{"example.com/hook": "stage"},
+					OwnerReferences: ownerRefs,
+				},
+				Spec: batchv1.JobSpec{
+					BackoffLimit:          ptr.To[int32](3),
+					ActiveDeadlineSeconds: ptr.To[int64](60),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+								kubeflow.JobNameLabel:      "test",
+								kubeflow.JobRoleLabel:      preRunHook,
+								"team":                     "a",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{Name: "fetch", Image: "fetch:latest"},
+								{Name: "index", Image: "index:latest"},
+							},
+							RestartPolicy:      corev1.RestartPolicyNever,
+							ServiceAccountName: "stage",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &MPIJobController{recorder: &record.FakeRecorder{}}
+			got := c.newHookJob(mpiJob, &tc.hook, preRunHook)
+			if diff := cmp.Diff(&tc.want, got); diff != "" {
+				t.Errorf("Unexpected hook Job (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewLauncherJobDiagnostics(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.SlotsPerWorker = ptr.To[int32](4)
+	mpiJob.Spec.Diagnostics = &kubeflow.Diagnostics{
+		Enabled:             ptr.To(true),
+		Image:               "nccl-tests",
+		MinBusBandwidthGBps: ptr.To[int32](10),
+	}
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+	job := c.newLauncherJob(mpiJob)
+	initContainers := job.Spec.Template.Spec.InitContainers
+	if len(initContainers) != 1 {
+		t.Fatalf("Launcher has %d init containers, want 1", len(initContainers))
+	}
+	got := initContainers[0]
+	if got.Name != diagnosticsContainerName || got.Image != "nccl-tests" {
+		t.Errorf("Unexpected init container %s with image %s", got.Name, got.Image)
+	}
+	wantCommand := append([]string{"sh", "-c", diagnosticsScript, diagnosticsContainerName}, defaultDiagnosticsCommand...)
+	if diff := cmp.Diff(wantCommand, got.Command); diff != "" {
+		t.Errorf("Unexpected command (-want,+got):\n%s", diff)
+	}
+	env := make(map[string]string)
+	for _, e := range got.Env {
+		env[e.Name] = e.Value
+	}
+	wantEnv := map[string]string{diagnosticsProcessesEnv: "8", diagnosticsMinBandwidthEnv: "10"}
+	for name, value := range wantEnv {
+		if env[name] != value {
+			t.Errorf("Got %s=%q, want %q", name, env[name], value)
+		}
+	}
+	if diff := cmp.Diff(job.Spec.Template.Spec.Containers[0].VolumeMounts, got.VolumeMounts); diff != "" {
+		t.Errorf("Init container doesn't mount the launcher volumes (-want,+got):\n%s", diff)
+	}
+}
+
+func TestLauncherBenchmarkSucceeded(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	mpiJob := newMPIJob("test", ptr.To[int32](64), &startTime, &completionTime)
+	mpiJob.Spec.Benchmark = &kubeflow.Benchmark{Type: kubeflow.BenchmarkNCCLAllReduce}
+	f.setUpMPIJob(mpiJob)
+
+	fmjc := f.newFakeMPIJobController()
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	launcher := fmjc.newLauncherJob(mpiJobCopy)
+	launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
+		Type:   batchv1.JobComplete,
+		Status: corev1.ConditionTrue,
+	})
+	f.setUpLauncher(launcher)
+	launcherPod := mockJobPod(launcher)
+	launcherPod.Spec = launcher.Spec.Template.Spec
+	launcherPod.Status.Phase = corev1.PodSucceeded
+	launcherPod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: launcher.Spec.Template.Spec.Containers[0].Name,
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			Message: " 8 2 float sum -1 18.35 0.00 0.00 0 17.60 0.00 0.00 0\n 1048576 262144 float sum -1 52.10 20.13 35.22 0 51.80 20.24 35.43 0\n# Avg bus bandwidth : 17.61\n",
+		}},
+	}}
+	f.setUpPod(launcherPod)
+
+	results, err := newBenchmarkConfigMap(mpiJobCopy, &benchmarkResults{
+		Type: kubeflow.BenchmarkNCCLAllReduce,
+		Unit: "GB/s",
+		Results: []benchmarkResult{
+			{SizeBytes: 8, Value: 0},
+			{SizeBytes: 1048576, Value: 35.22},
+		},
+		AvgBusBandwidthGBps: ptr.To(17.61),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.expectCreateConfigMapAction(results)
+
+	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+		kubeflow.MPIReplicaTypeLauncher: {
+			Succeeded: 1,
+		},
+		kubeflow.MPIReplicaTypeWorker: {},
+	}
+	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+	mpiJobCopy.Status.Duration = &metav1.Duration{}
+	mpiJobCopy.Status.ResourceUsage = &kubeflow.ResourceUsage{}
+	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+	msg = fmt.Sprintf("MPIJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobSucceeded, corev1.ConditionTrue, kubeflow.JobSucceededReason, msg, clock.RealClock{})
+	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
+
+	f.run(getKey(mpiJob, t))
+}
+
+func TestNewBenchmarkPods(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.Benchmark = &kubeflow.Benchmark{Type: kubeflow.BenchmarkOSULatency}
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.Containers[0].Image = ""
+	mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.Containers[0].Image = ""
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+	launcher := c.newLauncherJob(mpiJob).Spec.Template.Spec.Containers[0]
+	if launcher.Image != osuBenchmarksImage {
+		t.Errorf("Launcher has image %s, want %s", launcher.Image, osuBenchmarksImage)
+	}
+	wantCommand := []string{"sh", "-c", benchmarkScript, "benchmark", "osu_latency"}
+	if diff := cmp.Diff(wantCommand, launcher.Command); diff != "" {
+		t.Errorf("Unexpected command (-want,+got):\n%s", diff)
+	}
+	if len(launcher.Args) != 0 {
+		t.Errorf("Launcher has args %v, want none", launcher.Args)
+	}
+	var processes string
+	for _, e := range launcher.Env {
+		if e.Name == benchmarkProcessesEnv {
+			processes = e.Value
+		}
+	}
+	if processes != "2" {
+		t.Errorf("Got %s=%q, want \"2\"", benchmarkProcessesEnv, processes)
+	}
+	if worker := c.newWorker(mpiJob, 0).Spec.Containers[0]; worker.Image != osuBenchmarksImage {
+		t.Errorf("Worker has image %s, want %s", worker.Image, osuBenchmarksImage)
+	}
+}
+
+func TestNewNetworkPods(t *testing.T) {
+	cases := map[string]struct {
+		implementation      kubeflow.MPIImplementation
+		runLauncherAsWorker bool
+		launcherEnv         []corev1.EnvVar
+		wantLauncherEnv     map[string]string
+		wantLauncherNetwork string
+	}{
+		"openmpi": {
+			implementation: kubeflow.MPIImplementationOpenMPI,
+			wantLauncherEnv: map[string]string{
+				ncclSocketIfnameEnv:    "net1,ib0",
+				ucxNetDevicesEnv:       "net1,ib0",
+				openMPITCPIfIncludeEnv: "net1,ib0",
+				openMPIEnvListEnv:      "NCCL_SOCKET_IFNAME;UCX_NET_DEVICES",
+			},
+		},
+		"openmpi with user settings": {
+			implementation:      kubeflow.MPIImplementationOpenMPI,
+			runLauncherAsWorker: true,
+			launcherEnv: []corev1.EnvVar{
+				{Name: ucxNetDevicesEnv, Value: "mlx5_0:1"},
+				{Name: openMPITCPIfExcludeEnv, Value: "lo"},
+				{Name: openMPIEnvListEnv, Value: "LD_LIBRARY_PATH"},
+			},
+			wantLauncherEnv: map[string]string{
+				ncclSocketIfnameEnv:    "net1,ib0",
+				ucxNetDevicesEnv:       "mlx5_0:1",
+				openMPITCPIfExcludeEnv: "lo",
+				openMPIEnvListEnv:      "LD_LIBRARY_PATH;NCCL_SOCKET_IFNAME;UCX_NET_DEVICES",
+			},
+			wantLauncherNetwork: "sriov-a@net1,networks/sriov-b@ib0",
+		},
+		"intel": {
+			implementation: kubeflow.MPIImplementationIntel,
+			wantLauncherEnv: map[string]string{
+				ncclSocketIfnameEnv:  "net1,ib0",
+				ucxNetDevicesEnv:     "net1,ib0",
+				libfabricTCPIfaceEnv: "net1",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+			mpiJob.Spec.MPIImplementation = tc.implementation
+			mpiJob.Spec.RunLauncherAsWorker = ptr.To(tc.runLauncherAsWorker)
+			mpiJob.Spec.Network = &kubeflow.Network{
+				Attachments: []kubeflow.NetworkAttachment{
+					{Name: "sriov-a"},
+					{Name: "sriov-b", Namespace: "networks", Interface: "ib0"},
+				},
+			}
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.Containers[0].Env = tc.launcherEnv
+			scheme.Scheme.Default(mpiJob)
+			c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+			launcher := c.newLauncherJob(mpiJob).Spec.Template
+			gotEnv := map[string]string{}
+			for _, e := range launcher.Spec.Containers[0].Env {
+				if _, ok := tc.wantLauncherEnv[e.Name]; ok {
+					if _, dup := gotEnv[e.Name]; dup {
+						t.Errorf("Launcher has variable %s twice", e.Name)
+					}
+					gotEnv[e.Name] = e.Value
+				}
+			}
+			if diff := cmp.Diff(tc.wantLauncherEnv, gotEnv); diff != "" {
+				t.Errorf("Unexpected launcher environment (-want,+got):\n%s", diff)
+			}
+			if got := launcher.Annotations[kubeflow.NetworksAnnotation]; got != tc.wantLauncherNetwork {
+				t.Errorf("Launcher has networks %q, want %q", got, tc.wantLauncherNetwork)
+			}
+			worker := c.newWorker(mpiJob, 0)
+			if got, want := worker.Annotations[kubeflow.NetworksAnnotation], "sriov-a@net1,networks/sriov-b@ib0"; got != want {
+				t.Errorf("Worker has networks %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNewTCPInterfacesLauncher(t *testing.T) {
+	allEnv := []string{
+		openMPIOOBIfIncludeEnv, openMPIOOBIfExcludeEnv, openMPITCPIfIncludeEnv, openMPITCPIfExcludeEnv,
+		intelMPIHydraIfaceEnv, mpichHydraIfaceEnv, libfabricTCPIfaceEnv,
+	}
+	cases := map[string]struct {
+		implementation  kubeflow.MPIImplementation
+		selection       kubeflow.TCPInterfaceSelection
+		network         *kubeflow.Network
+		hostNetwork     bool
+		launcherEnv     []corev1.EnvVar
+		wantLauncherEnv map[string]string
+	}{
+		"none": {
+			implementation:  kubeflow.MPIImplementationOpenMPI,
+			selection:       kubeflow.TCPInterfaceSelectionNone,
+			wantLauncherEnv: map[string]string{},
+		},
+		"openmpi": {
+			implementation: kubeflow.MPIImplementationOpenMPI,
+			selection:      kubeflow.TCPInterfaceSelectionAuto,
+			wantLauncherEnv: map[string]string{
+				openMPIOOBIfIncludeEnv: "eth0",
+				openMPITCPIfIncludeEnv: "eth0",
+			},
+		},
+		"openmpi with secondary networks": {
+			implementation: kubeflow.MPIImplementationOpenMPI,
+			selection:      kubeflow.TCPInterfaceSelectionAuto,
+			network: &kubeflow.Network{
+				Attachments: []kubeflow.NetworkAttachment{{Name: "sriov-a"}},
+			},
+			wantLauncherEnv: map[string]string{
+				openMPIOOBIfIncludeEnv: "eth0",
+				openMPITCPIfIncludeEnv: "net1",
+			},
+		},
+		"openmpi with host network": {
+			implementation: kubeflow.MPIImplementationOpenMPI,
+			selection:      kubeflow.TCPInterfaceSelectionAuto,
+			hostNetwork:    true,
+			wantLauncherEnv: map[string]string{
+				openMPIOOBIfExcludeEnv: "127.0.0.1/8,docker0,virbr0,cni0",
+				openMPITCPIfExcludeEnv: "127.0.0.1/8,docker0,virbr0,cni0",
+			},
+		},
+		"openmpi with user settings": {
+			implementation: kubeflow.MPIImplementationOpenMPI,
+			selection:      kubeflow.TCPInterfaceSelectionAuto,
+			launcherEnv: []corev1.EnvVar{
+				{Name: openMPIOOBIfExcludeEnv, Value: "lo"},
+				{Name: openMPITCPIfIncludeEnv, Value: "bond0"},
+			},
+			wantLauncherEnv: map[string]string{
+				openMPIOOBIfExcludeEnv: "lo",
+				openMPITCPIfIncludeEnv: "bond0",
+			},
+		},
+		"intel": {
+			implementation: kubeflow.MPIImplementationIntel,
+			selection:      kubeflow.TCPInterfaceSelectionAuto,
+			wantLauncherEnv: map[string]string{
+				intelMPIHydraIfaceEnv: "eth0",
+				libfabricTCPIfaceEnv:  "eth0",
+			},
+		},
+		"mpich": {
+			implementation: kubeflow.MPIImplementationMPICH,
+			selection:      kubeflow.TCPInterfaceSelectionAuto,
+			wantLauncherEnv: map[string]string{
+				mpichHydraIfaceEnv:   "eth0",
+				libfabricTCPIfaceEnv: "eth0",
+			},
+		},
+		"mpich with host network": {
+			implementation:  kubeflow.MPIImplementationMPICH,
+			selection:       kubeflow.TCPInterfaceSelectionAuto,
+			hostNetwork:     true,
+			wantLauncherEnv: map[string]string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+			mpiJob.Spec.MPIImplementation = tc.implementation
+			mpiJob.Spec.TCPInterfaceSelection = tc.selection
+			mpiJob.Spec.Network = tc.network
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.Spec.HostNetwork = tc.hostNetwork
+			mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec.Containers[0].Env = tc.launcherEnv
+			scheme.Scheme.Default(mpiJob)
+			c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+			launcher := c.newLauncherJob(mpiJob).Spec.Template
+			gotEnv := map[string]string{}
+			for _, e := range launcher.Spec.Containers[0].Env {
+				if slices.Contains(allEnv, e.Name) {
+					if _, dup := gotEnv[e.Name]; dup {
+						t.Errorf("Launcher has variable %s twice", e.Name)
+					}
+					gotEnv[e.Name] = e.Value
+				}
+			}
+			if diff := cmp.Diff(tc.wantLauncherEnv, gotEnv); diff != "" {
+				t.Errorf("Unexpected launcher environment (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewServiceMeshPods(t *testing.T) {
+	cases := map[string]struct {
+		mesh                    kubeflow.ServiceMesh
+		wantLauncherAnnotations map[string]string
+		wantWorkerAnnotations   map[string]string
+		wantLauncherCommand     []string
+		wantShutdownURL         string
+	}{
+		"istio": {
+			mesh: kubeflow.ServiceMesh{Provider: kubeflow.ServiceMeshIstio},
+			wantLauncherAnnotations: map[string]string{
+				"user":                     "annotation",
+				istioProxyConfigAnnotation: istioHoldProxyConfig,
+			},
+			wantWorkerAnnotations: map[string]string{
+				istioProxyConfigAnnotation: istioHoldProxyConfig,
+			},
+			wantLauncherCommand: []string{"sh", "-c", sidecarShutdownScript, "sidecar-shutdown", "mpirun", "-np", "2", "pi"},
+			wantShutdownURL:     istioShutdownURL,
+		},
+		"linkerd": {
+			mesh: kubeflow.ServiceMesh{Provider: kubeflow.ServiceMeshLinkerd},
+			wantLauncherAnnotations: map[string]string{
+				"user":                            "annotation",
+				linkerdProxyAwaitAnnotation:       "enabled",
+				linkerdShutdownEndpointAnnotation: "true",
+			},
+			wantWorkerAnnotations: map[string]string{
+				linkerdProxyAwaitAnnotation: "enabled",
+			},
+			wantLauncherCommand: []string{"sh", "-c", sidecarShutdownScript, "sidecar-shutdown", "mpirun", "-np", "2", "pi"},
+			wantShutdownURL:     linkerdShutdownURL,
+		},
+		"istio native sidecar": {
+			mesh: kubeflow.ServiceMesh{Provider: kubeflow.ServiceMeshIstio, NativeSidecar: ptr.To(true)},
+			wantLauncherAnnotations: map[string]string{
+				"user":                       "annotation",
+				istioProxyConfigAnnotation:   istioHoldProxyConfig,
+				istioNativeSidecarAnnotation: "true",
+			},
+			wantWorkerAnnotations: map[string]string{
+				istioProxyConfigAnnotation:   istioHoldProxyConfig,
+				istioNativeSidecarAnnotation: "true",
+			},
+			wantLauncherCommand: []string{"mpirun", "-np", "2", "pi"},
+		},
+		"linkerd native sidecar": {
+			mesh: kubeflow.ServiceMesh{Provider: kubeflow.ServiceMeshLinkerd, NativeSidecar: ptr.To(true)},
+			wantLauncherAnnotations: map[string]string{
+				"user":                         "annotation",
+				linkerdProxyAwaitAnnotation:    "enabled",
+				linkerdNativeSidecarAnnotation: "true",
+			},
+			wantWorkerAnnotations: map[string]string{
+				linkerdProxyAwaitAnnotation:    "enabled",
+				linkerdNativeSidecarAnnotation: "true",
+			},
+			wantLauncherCommand: []string{"mpirun", "-np", "2", "pi"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+			mpiJob.Spec.ServiceMesh = &tc.mesh
+			launcherTemplate := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template
+			launcherTemplate.Annotations = map[string]string{"user": "annotation"}
+			launcherTemplate.Spec.Containers[0].Command = []string{"mpirun"}
+			launcherTemplate.Spec.Containers[0].Args = []string{"-np", "2", "pi"}
+			scheme.Scheme.Default(mpiJob)
+			c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+			launcher := c.newLauncherJob(mpiJob).Spec.Template
+			if diff := cmp.Diff(tc.wantLauncherAnnotations, launcher.Annotations); diff != "" {
+				t.Errorf("Unexpected launcher annotations (-want,+got):\n%s", diff)
+			}
+			container := launcher.Spec.Containers[0]
+			if diff := cmp.Diff(tc.wantLauncherCommand, append(container.Command, container.Args...)); diff != "" {
+				t.Errorf("Unexpected launcher command (-want,+got):\n%s", diff)
+			}
+			var shutdownURL string
+			for _, e := range container.Env {
+				if e.Name == sidecarShutdownURLEnv {
+					shutdownURL = e.Value
+				}
+			}
+			if shutdownURL != tc.wantShutdownURL {
+				t.Errorf("Got %s=%q, want %q", sidecarShutdownURLEnv, shutdownURL, tc.wantShutdownURL)
+			}
+			worker := c.newWorker(mpiJob, 0)
+			if diff := cmp.Diff(tc.wantWorkerAnnotations, worker.Annotations); diff != "" {
+				t.Errorf("Unexpected worker annotations (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewMultiClusterPods(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](3), nil, nil)
+	mpiJob.Spec.SlotsPerWorker = ptr.To[int32](2)
+	mpiJob.Spec.MPIImplementation = kubeflow.MPIImplementationOpenMPI
+	mpiJob.Spec.LauncherCreationPolicy = kubeflow.LauncherCreationPolicyWaitForWorkersReady
+	mpiJob.Spec.MultiCluster = &kubeflow.MultiCluster{
+		WorkerPools: []kubeflow.WorkerPool{{
+			Name:         "east",
+			Replicas:     2,
+			NodeSelector: map[string]string{"type": "virtual-kubelet"},
+			Tolerations:  []corev1.Toleration{{Key: "virtual-kubelet.io/provider", Operator: corev1.TolerationOpExists}},
+			Annotations:  map[string]string{"multicluster.admiralty.io/elect": ""},
+		}},
+	}
+	workerTemplate := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template
+	workerTemplate.Spec.NodeSelector = map[string]string{"type": "gpu", "zone": "a"}
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+	var workers []*corev1.Pod
+	for i := 0; i < 3; i++ {
+		workers = append(workers, c.newWorker(mpiJob, i))
+	}
+	for _, i := range []int{0, 1} {
+		worker := workers[i]
+		if pool := worker.Labels[kubeflow.WorkerPoolLabel]; pool != "east" {
+			t.Errorf("Worker %d has pool %q, want east", i, pool)
+		}
+		if diff := cmp.Diff(map[string]string{"multicluster.admiralty.io/elect": ""}, worker.Annotations); diff != "" {
+			t.Errorf("Unexpected annotations of worker %d (-want,+got):\n%s", i, diff)
+		}
+		if diff := cmp.Diff(map[string]string{"type": "virtual-kubelet", "zone": "a"}, worker.Spec.NodeSelector); diff != "" {
+			t.Errorf("Unexpected node selector of worker %d (-want,+got):\n%s", i, diff)
+		}
+		if diff := cmp.Diff(mpiJob.Spec.MultiCluster.WorkerPools[0].Tolerations, worker.Spec.Tolerations); diff != "" {
+			t.Errorf("Unexpected tolerations of worker %d (-want,+got):\n%s", i, diff)
+		}
+	}
+	if pool, ok := workers[2].Labels[kubeflow.WorkerPoolLabel]; ok {
+		t.Errorf("Local worker has pool %q", pool)
+	}
+	if diff := cmp.Diff(map[string]string{"type": "gpu", "zone": "a"}, workers[2].Spec.NodeSelector); diff != "" {
+		t.Errorf("Unexpected node selector of the local worker (-want,+got):\n%s", diff)
+	}
+
+	launcher := c.newLauncherJob(mpiJob).Spec.Template.Spec.Containers[0]
+	if !hasEnv(&launcher, openMPINoTreeSpawnEnv) {
+		t.Errorf("Launcher is missing %s", openMPINoTreeSpawnEnv)
+	}
+
+	for i, worker := range workers {
+		worker.Status.Phase = corev1.PodRunning
+		worker.Status.PodIP = fmt.Sprintf("10.0.0.%d", i+1)
+	}
+	configMap := newConfigMap(mpiJob, 3)
+	updateDiscoverHostsInConfigMap(configMap, mpiJob, workers)
+	setWorkerPoolAddresses(configMap, mpiJob, workers)
+	want := map[string]string{
+		hostfileName:            "10.0.0.1 slots=2\n10.0.0.2 slots=2\ntest-worker-2.test.default.svc slots=2\n",
+		discoverHostsScriptName: "#!/bin/sh\necho 10.0.0.1\necho 10.0.0.2\necho test-worker-2.test.default.svc\n",
+	}
+	if diff := cmp.Diff(want, configMap.Data); diff != "" {
+		t.Errorf("Unexpected ConfigMap data (-want,+got):\n%s", diff)
+	}
+}
+
+func TestNewOverriddenWorkers(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](4), nil, nil)
+	mpiJob.Spec.WorkerOverrides = []kubeflow.WorkerOverride{
+		{
+			StartIndex: 0,
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Gi")},
+			},
+			Env:          []corev1.EnvVar{{Name: "ROLE", Value: "aggregator"}},
+			NodeSelector: map[string]string{"storage": "nvme"},
+		},
+		{
+			StartIndex: 1,
+			EndIndex:   ptr.To[int32](2),
+			Env:        []corev1.EnvVar{{Name: "ROLE", Value: "compute"}},
+		},
+		{
+			StartIndex: 2,
+			Env:        []corev1.EnvVar{{Name: "ROLE", Value: "spare"}},
+		},
+	}
+	workerTemplate := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template
+	workerTemplate.Spec.NodeSelector = map[string]string{"zone": "a"}
+	workerTemplate.Spec.Containers[0].Resources = corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("8"),
+			corev1.ResourceMemory: resource.MustParse("16Gi"),
+		},
+	}
+	workerTemplate.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "ROLE", Value: "worker"}}
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+	cases := []struct {
+		memory       string
+		role         string
+		nodeSelector map[string]string
+	}{
+		{memory: "64Gi", role: "aggregator", nodeSelector: map[string]string{"storage": "nvme", "zone": "a"}},
+		{memory: "16Gi", role: "compute", nodeSelector: map[string]string{"zone": "a"}},
+		{memory: "16Gi", role: "spare", nodeSelector: map[string]string{"zone": "a"}},
+		{memory: "16Gi", role: "worker", nodeSelector: map[string]string{"zone": "a"}},
+	}
+	for i, tc := range cases {
+		worker := c.newWorker(mpiJob, i)
+		container := worker.Spec.Containers[0]
+		if memory := container.Resources.Limits[corev1.ResourceMemory]; memory.Cmp(resource.MustParse(tc.memory)) != 0 {
+			t.Errorf("Worker %d has a memory limit of %s, want %s", i, memory.String(), tc.memory)
+		}
+		if cpu := container.Resources.Limits[corev1.ResourceCPU]; cpu.Cmp(resource.MustParse("8")) != 0 {
+			t.Errorf("Worker %d has a CPU limit of %s, want 8", i, cpu.String())
+		}
+		var roles []string
+		for _, env := range container.Env {
+			if env.Name == "ROLE" {
+				roles = append(roles, env.Value)
+			}
+		}
+		if diff := cmp.Diff([]string{tc.role}, roles); diff != "" {
+			t.Errorf("Unexpected ROLE of worker %d (-want,+got):\n%s", i, diff)
+		}
+		if diff := cmp.Diff(tc.nodeSelector, worker.Spec.NodeSelector); diff != "" {
+			t.Errorf("Unexpected node selector of worker %d (-want,+got):\n%s", i, diff)
+		}
+	}
+	if memory := workerTemplate.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory]; memory.Cmp(resource.MustParse("16Gi")) != 0 {
+		t.Errorf("Worker template was modified, memory limit is %s", memory.String())
+	}
+}
+
+func TestNewPodsWithDefaults(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+	workerTemplate := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template
+	workerTemplate.Spec.NodeSelector = map[string]string{"pool": "debug"}
+	scheme.Scheme.Default(mpiJob)
+	toleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "gpu", Effect: corev1.TaintEffectNoSchedule}
+	c := &MPIJobController{
+		recorder: &record.FakeRecorder{},
+		PodDefaults: &poddefaults.Config{
+			Labels:       map[string]string{"team": "ml", kubeflow.JobRoleLabel: "default"},
+			NodeSelector: map[string]string{"pool": "training"},
+			Tolerations:  []corev1.Toleration{toleration},
+		},
+	}
+
+	workerPod := c.newWorker(mpiJob, 0)
+	launcherTemplate := c.newLauncherJob(mpiJob).Spec.Template
+	for _, tc := range []struct {
+		name         string
+		meta         metav1.ObjectMeta
+		spec         corev1.PodSpec
+		role         string
+		nodeSelector map[string]string
+	}{
+		{name: "worker", meta: workerPod.ObjectMeta, spec: workerPod.Spec, role: worker, nodeSelector: map[string]string{"pool": "debug"}},
+		{name: "launcher", meta: launcherTemplate.ObjectMeta, spec: launcherTemplate.Spec, role: launcher, nodeSelector: map[string]string{"pool": "training"}},
+	} {
+		if team := tc.meta.Labels["team"]; team != "ml" {
+			t.Errorf("The %s has the team label %q, want ml", tc.name, team)
+		}
+		if role := tc.meta.Labels[kubeflow.JobRoleLabel]; role != tc.role {
+			t.Errorf("The %s has the role label %q, want %s", tc.name, role, tc.role)
+		}
+		if diff := cmp.Diff(tc.nodeSelector, tc.spec.NodeSelector); diff != "" {
+			t.Errorf("Unexpected node selector of the %s (-want,+got):\n%s", tc.name, diff)
+		}
+		if diff := cmp.Diff([]corev1.Toleration{toleration}, tc.spec.Tolerations); diff != "" {
+			t.Errorf("Unexpected tolerations of the %s (-want,+got):\n%s", tc.name, diff)
+		}
+	}
+}
+
+func TestPropagateMetadata(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+	mpiJob.Labels = map[string]string{
+		"team.example.com/cost-center": "42",
+		"team.example.com/owner":       "alice",
+		"app":                          "from-mpijob",
+		"unrelated":                    "value",
+	}
+	mpiJob.Annotations = map[string]string{
+		"policy.example.com/tier": "gold",
+		"unrelated":               "value",
+	}
+	workerTemplate := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template
+	workerTemplate.Labels = map[string]string{"team.example.com/owner": "bob"}
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{
+		recorder:                     &record.FakeRecorder{},
+		PropagatedLabelPrefixes:      []string{"team.example.com/", "app"},
+		PropagatedAnnotationPrefixes: []string{"policy.example.com/"},
+	}
+	wantAnnotations := map[string]string{"policy.example.com/tier": "gold"}
+
+	workerPod := c.newWorker(mpiJob, 0)
+	if got := workerPod.Labels["team.example.com/cost-center"]; got != "42" {
+		t.Errorf("Worker has cost center %q, want 42", got)
+	}
+	if got := workerPod.Labels["team.example.com/owner"]; got != "bob" {
+		t.Errorf("Worker has owner %q, want bob from its template", got)
+	}
+	if _, ok := workerPod.Labels["unrelated"]; ok {
+		t.Errorf("Worker has a label without a propagated prefix")
+	}
+	if diff := cmp.Diff(wantAnnotations, workerPod.Annotations); diff != "" {
+		t.Errorf("Unexpected annotations of the worker (-want,+got):\n%s", diff)
+	}
+
+	job := c.newLauncherJob(mpiJob)
+	for name, meta := range map[string]metav1.ObjectMeta{"launcher Job": job.ObjectMeta, "launcher pod": job.Spec.Template.ObjectMeta} {
+		if got := meta.Labels["team.example.com/owner"]; got != "alice" {
+			t.Errorf("The %s has owner %q, want alice", name, got)
+		}
+		if diff := cmp.Diff(wantAnnotations, meta.Annotations); diff != "" {
+			t.Errorf("Unexpected annotations of the %s (-want,+got):\n%s", name, diff)
+		}
+	}
+	if got := job.Labels["app"]; got != "test" {
+		t.Errorf("The launcher Job has the app label %q, want the one set by the operator", got)
+	}
+}
+
+func TestNewLauncherJobWithTemplate(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+	policy := &batchv1.PodFailurePolicy{
+		Rules: []batchv1.PodFailurePolicyRule{{
+			Action: batchv1.PodFailurePolicyActionIgnore,
+			OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{{
+				Type:   corev1.DisruptionTarget,
+				Status: corev1.ConditionTrue,
+			}},
+		}},
+	}
+	mpiJob.Spec.LauncherJob = &kubeflow.LauncherJobTemplate{
+		Labels: map[string]string{
+			"kueue.x-k8s.io/queue-name": "research",
+			"app":                       "other",
+		},
+		Annotations:      map[string]string{"cost.example.com/project": "42"},
+		PodFailurePolicy: policy,
+	}
+	mpiJob.Spec.RunPolicy.BackoffLimit = ptr.To[int32](3)
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+	job := c.newLauncherJob(mpiJob)
+	wantLabels := map[string]string{
+		"app":                       "test",
+		"kueue.x-k8s.io/queue-name": "research",
+	}
+	if diff := cmp.Diff(wantLabels, job.Labels); diff != "" {
+		t.Errorf("Unexpected labels of the launcher Job (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(mpiJob.Spec.LauncherJob.Annotations, job.Annotations); diff != "" {
+		t.Errorf("Unexpected annotations of the launcher Job (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(policy, job.Spec.PodFailurePolicy); diff != "" {
+		t.Errorf("Unexpected pod failure policy of the launcher Job (-want,+got):\n%s", diff)
+	}
+	if got := ptr.Deref(job.Spec.BackoffLimit, 0); got != 3 {
+		t.Errorf("The launcher Job has a backoffLimit of %d, want 3 from the runPolicy", got)
+	}
+}
+
+func TestNewJobServiceWithTemplate(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+	ports := []corev1.ServicePort{{Name: "metrics", Port: 9090}}
+	mpiJob.Spec.Service = &kubeflow.ServiceTemplate{
+		Labels: map[string]string{
+			"monitoring.example.com/scrape": "true",
+			"app":                           "other",
+		},
+		Annotations:              map[string]string{"prometheus.io/port": "9090"},
+		PublishNotReadyAddresses: ptr.To(true),
+		Ports:                    ports,
+	}
+
+	svc := newJobService(mpiJob)
+	wantLabels := map[string]string{
+		"app":                           "test",
+		"monitoring.example.com/scrape": "true",
+	}
+	if diff := cmp.Diff(wantLabels, svc.Labels); diff != "" {
+		t.Errorf("Unexpected labels of the Service (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(mpiJob.Spec.Service.Annotations, svc.Annotations); diff != "" {
+		t.Errorf("Unexpected annotations of the Service (-want,+got):\n%s", diff)
+	}
+	if !svc.Spec.PublishNotReadyAddresses {
+		t.Error("Service doesn't publish the addresses of the pods that aren't ready")
+	}
+	if diff := cmp.Diff(ports, svc.Spec.Ports); diff != "" {
+		t.Errorf("Unexpected ports of the Service (-want,+got):\n%s", diff)
+	}
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		t.Errorf("Service has clusterIP %q, want None", svc.Spec.ClusterIP)
+	}
+}
+
+func TestNewWorkerWithDNSPolicy(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+	searche := "test.default.svc.cluster.local"
+	workerTemplate := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template
+	workerTemplate.Spec.HostNetwork = true
+	workerTemplate.Spec.DNSPolicy = corev1.DNSNone
+	workerTemplate.Spec.DNSConfig = &corev1.PodDNSConfig{
+		Nameservers: []string{"169.254.20.10"},
+		Searches:    []string{searche, "example.com"},
+	}
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+	pod := c.newWorker(mpiJob, 0)
+	if pod.Spec.DNSPolicy != corev1.DNSNone {
+		t.Errorf("Worker has dnsPolicy %q, want None from its template", pod.Spec.DNSPolicy)
+	}
+	wantDNSConfig := &corev1.PodDNSConfig{
+		Nameservers: []string{"169.254.20.10"},
+		Searches:    []string{searche, "example.com"},
+	}
+	if diff := cmp.Diff(wantDNSConfig, pod.Spec.DNSConfig); diff != "" {
+		t.Errorf("Unexpected dnsConfig of the worker (-want,+got):\n%s", diff)
+	}
+
+	workerTemplate.Spec.DNSPolicy = ""
+	pod = c.newWorker(mpiJob, 0)
+	if pod.Spec.DNSPolicy != corev1.DNSClusterFirstWithHostNet {
+		t.Errorf("Worker has dnsPolicy %q, want ClusterFirstWithHostNet", pod.Spec.DNSPolicy)
+	}
+}
+
+func TestTerminationGracePeriod(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+	preStop := &corev1.Lifecycle{
+		PreStop: &corev1.LifecycleHandler{
+			Exec: &corev1.ExecAction{Command: []string{"sync"}},
+		},
+	}
+	workerTemplate := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template
+	workerTemplate.Spec.TerminationGracePeriodSeconds = ptr.To[int64](30)
+	workerTemplate.Spec.Containers[0].Lifecycle = preStop
+	mpiJob.Spec.RunPolicy.TerminationGracePeriodSeconds = ptr.To[int64](120)
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}}
+
+	workerPod := c.newWorker(mpiJob, 0)
+	if got := ptr.Deref(workerPod.Spec.TerminationGracePeriodSeconds, 0); got != 120 {
+		t.Errorf("Worker has a termination grace period of %d, want 120 from the runPolicy", got)
+	}
+	if diff := cmp.Diff(preStop, workerPod.Spec.Containers[0].Lifecycle); diff != "" {
+		t.Errorf("Unexpected lifecycle of the worker (-want,+got):\n%s", diff)
+	}
+	if got := ptr.Deref(podDeleteOptions(workerPod).GracePeriodSeconds, 0); got != 120 {
+		t.Errorf("Worker is deleted with a grace period of %d, want 120", got)
+	}
+	job := c.newLauncherJob(mpiJob)
+	if got := ptr.Deref(job.Spec.Template.Spec.TerminationGracePeriodSeconds, 0); got != 120 {
+		t.Errorf("Launcher has a termination grace period of %d, want 120 from the runPolicy", got)
+	}
+
+	mpiJob.Spec.RunPolicy.TerminationGracePeriodSeconds = nil
+	workerPod = c.newWorker(mpiJob, 0)
+	if got := ptr.Deref(workerPod.Spec.TerminationGracePeriodSeconds, 0); got != 30 {
+		t.Errorf("Worker has a termination grace period of %d, want 30 from its template", got)
+	}
+}
+
+func TestArtifactUpload(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+	mpiJob.Spec.Artifacts = &kubeflow.Artifacts{
+		Destination:           "s3://bucket/runs",
+		ResultsPath:           "/results",
+		CredentialsSecretName: "s3-credentials",
+	}
+	scheme.Scheme.Default(mpiJob)
+	c := &MPIJobController{recorder: &record.FakeRecorder{}, ArtifactUploaderImage: "uploader:v1"}
+
+	podSpec := c.newLauncherJob(mpiJob).Spec.Template.Spec
+	if len(podSpec.InitContainers) == 0 || podSpec.InitContainers[0].Name != artifactUploaderContainerName {
+		t.Fatalf("Launcher doesn't start with the %s sidecar: %v", artifactUploaderContainerName, podSpec.InitContainers)
+	}
+	sidecar := podSpec.InitContainers[0]
+	if sidecar.Image != "uploader:v1" {
+		t.Errorf("Sidecar has image %q, want uploader:v1", sidecar.Image)
+	}
+	if got := ptr.Deref(sidecar.RestartPolicy, ""); got != corev1.ContainerRestartPolicyAlways {
+		t.Errorf("Sidecar has restart policy %q, want Always", got)
+	}
+	env := make(map[string]string)
+	for _, e := range sidecar.Env {
+		env[e.Name] = e.Value
+	}
+	wantEnv := map[string]string{
+		"K_MPI_JOB_NAME":       "test",
+		"K_MPI_JOB_NAMESPACE":  metav1.NamespaceDefault,
+		"K_MPI_JOB_ROLE":       launcher,
+		artifactDestinationEnv: "s3://bucket/runs",
+		artifactResultsDirEnv:  artifactResultsMountPath,
+	}
+	for name, want := range wantEnv {
+		if env[name] != want {
+			t.Errorf("Sidecar has %s=%q, want %q", name, env[name], want)
+		}
+	}
+	wantEnvFrom := []corev1.EnvFromSource{{
+		SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "s3-credentials"}},
+	}}
+	if diff := cmp.Diff(wantEnvFrom, sidecar.EnvFrom); diff != "" {
+		t.Errorf("Unexpected envFrom of the sidecar (-want,+got):\n%s", diff)
+	}
+	wantMount := corev1.VolumeMount{Name: artifactResultsVolumeName, MountPath: "/results"}
+	if !slices.Contains(podSpec.Containers[0].VolumeMounts, wantMount) {
+		t.Errorf("Launcher container doesn't mount the results volume: %v", podSpec.Containers[0].VolumeMounts)
+	}
+
+	workerPod := c.newWorker(mpiJob, 0)
+	if len(workerPod.Spec.InitContainers) != 0 {
+		t.Errorf("Worker has init containers %v, want none", workerPod.Spec.InitContainers)
+	}
+	mpiJob.Spec.Artifacts.Workers = ptr.To(true)
+	workerPod = c.newWorker(mpiJob, 0)
+	if len(workerPod.Spec.InitContainers) != 1 || workerPod.Spec.InitContainers[0].Name != artifactUploaderContainerName {
+		t.Errorf("Worker doesn't have the %s sidecar: %v", artifactUploaderContainerName, workerPod.Spec.InitContainers)
+	}
+}
+
+func TestParseBenchmarkResults(t *testing.T) {
+	cases := map[string]struct {
+		benchmarkType kubeflow.BenchmarkType
+		output        string
+		want          *benchmarkResults
+		wantErr       bool
+	}{
+		"osu bandwidth": {
+			benchmarkType: kubeflow.BenchmarkOSUBandwidth,
+			output:        "1 2.34\n2 4.71\n4194304 11923.45\n",
+			want: &benchmarkResults{
+				Type: kubeflow.BenchmarkOSUBandwidth,
+				Unit: "MB/s",
+				Results: []benchmarkResult{
+					{SizeBytes: 1, Value: 2.34},
+					{SizeBytes: 2, Value: 4.71},
+					{SizeBytes: 4194304, Value: 11923.45},
+				},
+			},
+		},
+		"osu latency": {
+			benchmarkType: kubeflow.BenchmarkOSULatency,
+			output:        "0 1.52\n8 1.60\n",
+			want: &benchmarkResults{
+				Type: kubeflow.BenchmarkOSULatency,
+				Unit: "us",
+				Results: []benchmarkResult{
+					{SizeBytes: 0, Value: 1.52},
+					{SizeBytes: 8, Value: 1.60},
+				},
+			},
+		},
+		"nccl without root": {
+			benchmarkType: kubeflow.BenchmarkNCCLAllReduce,
+			output:        " 8 2 float sum 18.35 0.00 0.00 0 17.60 0.00 0.00 0\n 1073741824 268435456 float sum 9186.1 116.89 219.17 0 9184.3 116.91 219.21 0\n# Avg bus bandwidth : 65.43 \n",
+			want: &benchmarkResults{
+				Type: kubeflow.BenchmarkNCCLAllReduce,
+				Unit: "GB/s",
+				Results: []benchmarkResult{
+					{SizeBytes: 8, Value: 0},
+					{SizeBytes: 1073741824, Value: 219.17},
+				},
+				AvgBusBandwidthGBps: ptr.To(65.43),
+			},
+		},
+		"truncated line": {
+			benchmarkType: kubeflow.BenchmarkNCCLAllReduce,
+			output:        "float sum -1 18.35 0.00 0.00 0\n 1024 256 float sum -1 20.1 0.05 0.09 0 19.8 0.05 0.09 0\n",
+			want: &benchmarkResults{
+				Type: kubeflow.BenchmarkNCCLAllReduce,
+				Unit: "GB/s",
+				Results: []benchmarkResult{
+					{SizeBytes: 1024, Value: 0.09},
+				},
+			},
+		},
+		"no results": {
+			benchmarkType: kubeflow.BenchmarkOSUBandwidth,
+			output:        "mpirun was unable to launch the specified application\n",
+			wantErr:       true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseBenchmarkResults(tc.benchmarkType, tc.output)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseBenchmarkResults() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected results (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigMapNotControlledByUs(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 64
+	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
+	f.setUpMPIJob(mpiJob)
+	f.setUpService(newJobService(mpiJob))
+
+	configMap := newConfigMap(mpiJob, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJob, nil)
+	configMap.OwnerReferences = nil
+	f.setUpConfigMap(configMap)
+
+	f.runExpectError(getKey(mpiJob, t))
+}
+
+func TestWorkerServiceNotControlledByUs(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 2
+	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
+	f.setUpMPIJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	service := newJobService(mpiJobCopy)
+	service.OwnerReferences = nil
+	f.setUpService(service)
+
+	f.runExpectError(getKey(mpiJob, t))
+}
+
+func TestLauncherServiceNotControlledByUs(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 2
+	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
+	mpiJob.Spec.MPIImplementation = kubeflow.MPIImplementationIntel
+	f.setUpMPIJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	service := newJobService(mpiJobCopy)
+	service.OwnerReferences = nil
+	f.setUpService(service)
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth Secret: %v", err)
+	}
+	f.setUpSecret(secret)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+	f.setUpConfigMap(configMap)
+	fmjc := f.newFakeMPIJobController()
+	for i := 0; i < int(replicas); i++ {
+		worker := fmjc.newWorker(mpiJobCopy, i)
+		f.setUpPod(worker)
+	}
+
+	f.runExpectError(getKey(mpiJob, t))
+}
+
+func TestSecretNotControlledByUs(t *testing.T) {
+	f := newFixture(t, "")
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+
+	var replicas int32 = 64
+	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
+	f.setUpMPIJob(mpiJob)
+
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	configMap := newConfigMap(mpiJobCopy, replicas)
+	updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, nil)
+	f.setUpConfigMap(configMap)
+	f.setUpService(newJobService(mpiJobCopy))
+
+	secret, err := newSSHAuthSecret(mpiJobCopy)
+	if err != nil {
+		t.Fatalf("Creating SSH auth Secret: %v", err)
+	}
+	secret.OwnerReferences = nil
+	f.setUpSecret(secret)
+
+	f.runExpectError(getKey(mpiJob, t))
+}
+
+func TestLabelSSHAuthSecret(t *testing.T) {
+	for name, controlled := range map[string]bool{"controlled": true, "not controlled": false} {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t, "")
+			mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+			f.setUpMPIJob(mpiJob)
+			secret, err := newSSHAuthSecret(mpiJob)
+			if err != nil {
+				t.Fatalf("Creating SSH auth Secret: %v", err)
+			}
+			// Secrets created by older versions of the operator aren't in the
+			// informer cache, since they don't have the labels.
+			secret.Labels = map[string]string{"app": mpiJob.Name}
+			if !controlled {
+				secret.OwnerReferences = nil
+			}
+			f.kubeObjects = append(f.kubeObjects, secret)
+			c, _, _ := f.newController(clock.RealClock{})
+
+			got, err := c.getOrCreateSSHAuthSecret(mpiJob)
+			if !controlled {
+				if err == nil {
+					t.Fatalf("getOrCreateSSHAuthSecret() succeeded with a Secret not controlled by the MPIJob")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getOrCreateSSHAuthSecret(): %v", err)
+			}
+			wantLabels := map[string]string{
+				"app":                      mpiJob.Name,
+				kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+				kubeflow.JobNameLabel:      mpiJob.Name,
+			}
+			if diff := cmp.Diff(wantLabels, got.Labels); diff != "" {
+				t.Errorf("Unexpected Secret labels (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(secret.Data, got.Data); diff != "" {
+				t.Errorf("The SSH keys changed (-want,+got):\n%s", diff)
+			}
+		})
 	}
-	secret.OwnerReferences = nil
-	f.setUpSecret(secret)
-
-	f.runExpectError(getKey(mpiJob, t))
 }
 
 func TestShutdownWorker(t *testing.T) {
@@ -781,7 +2383,7 @@ func TestShutdownWorker(t *testing.T) {
 	mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
 	msg := fmt.Sprintf("MPIJob %s/%s successfully completed.", mpiJob.Namespace, mpiJob.Name)
 
-	updateMPIJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, mpiJobSucceededReason, msg)
+	updateMPIJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, kubeflow.JobSucceededReason, msg, clock.RealClock{})
 	f.setUpMPIJob(mpiJob)
 
 	fmjc := f.newFakeMPIJobController()
@@ -817,6 +2419,52 @@ func TestShutdownWorker(t *testing.T) {
 	f.run(getKey(mpiJob, t))
 }
 
+func TestReleaseFinishedPods(t *testing.T) {
+	f := newFixture(t, "")
+	f.releaseFinishedPods = true
+	startTime := metav1.Now()
+	completionTime := metav1.Now()
+	mpiJob := newMPIJob("test", ptr.To[int32](2), &startTime, &completionTime)
+	mpiJob.Spec.RunPolicy.CleanPodPolicy = ptr.To(kubeflow.CleanPodPolicyNone)
+	updateMPIJobConditions(mpiJob, kubeflow.JobSucceeded, corev1.ConditionTrue, kubeflow.JobSucceededReason, "", clock.RealClock{})
+	f.setUpMPIJob(mpiJob)
+	other := newMPIJob("other", ptr.To[int32](1), nil, nil)
+	f.setUpMPIJob(other)
+
+	fmjc := f.newFakeMPIJobController()
+	mpiJobCopy := mpiJob.DeepCopy()
+	scheme.Scheme.Default(mpiJobCopy)
+	otherCopy := other.DeepCopy()
+	scheme.Scheme.Default(otherCopy)
+	pods := []*corev1.Pod{fmjc.newWorker(mpiJobCopy, 0), fmjc.newWorker(mpiJobCopy, 1), fmjc.newWorker(otherCopy, 0)}
+	for _, pod := range pods {
+		f.setUpPod(pod)
+	}
+	c, _, _ := f.newController(clock.RealClock{})
+	key := getKey(mpiJob, t)
+	c.podExpectations.expectCreation(key, pods[0].Name)
+
+	if err := c.syncHandler(key); err != nil {
+		t.Fatalf("syncHandler(): %v", err)
+	}
+	for _, pod := range pods {
+		got, err := f.kubeClient.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Getting pod %s: %v", pod.Name, err)
+		}
+		want := ""
+		if pod.Labels[kubeflow.JobNameLabel] == mpiJob.Name {
+			want = "true"
+		}
+		if got := got.Labels[kubeflow.JobFinishedLabel]; got != want {
+			t.Errorf("Pod %s has label %s=%q, want %q", pod.Name, kubeflow.JobFinishedLabel, got, want)
+		}
+	}
+	if !c.podExpectations.satisfied(key) {
+		t.Errorf("The expectations of the finished MPIJob weren't released")
+	}
+}
+
 func TestCreateSuspendedMPIJob(t *testing.T) {
 	impls := []kubeflow.MPIImplementation{kubeflow.MPIImplementationOpenMPI, kubeflow.MPIImplementationIntel, kubeflow.MPIImplementationMPICH}
 	for _, implementation := range impls {
@@ -855,10 +2503,10 @@ func TestCreateSuspendedMPIJob(t *testing.T) {
 				kubeflow.MPIReplicaTypeWorker:   {},
 			}
 			msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-			updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
-			updateMPIJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "MPIJob suspended")
+			updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+			updateMPIJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, kubeflow.JobSuspendedReason, "MPIJob suspended", clock.RealClock{})
 			msg = fmt.Sprintf("MPIJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
-			updateMPIJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+			updateMPIJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, kubeflow.JobSuspendedReason, msg, clock.RealClock{})
 			f.expectUpdateMPIJobStatusAction(mpiJobCopy)
 
 			f.run(getKey(mpiJob, t))
@@ -875,9 +2523,9 @@ func TestSuspendedRunningMPIJob(t *testing.T) {
 	mpiJob := newMPIJob("test", &replicas, &startTime, nil)
 	mpiJob.Spec.RunPolicy.Suspend = ptr.To(false)
 	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
 	msg = fmt.Sprintf("MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+	updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, kubeflow.JobRunningReason, msg, clock.RealClock{})
 
 	mpiJob.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
 		kubeflow.MPIReplicaTypeLauncher: {
@@ -937,9 +2585,9 @@ func TestSuspendedRunningMPIJob(t *testing.T) {
 
 	// expect MPI job status update to add the suspend condition
 	mpiJobCopy := mpiJob.DeepCopy()
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "MPIJob suspended")
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionTrue, kubeflow.JobSuspendedReason, "MPIJob suspended", clock.RealClock{})
 	msg = fmt.Sprintf("MPIJob %s/%s is suspended.", mpiJobCopy.Namespace, mpiJobCopy.Name)
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionFalse, kubeflow.JobSuspendedReason, msg, clock.RealClock{})
 	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
 		// the launcher pod remains active. In live system it gets deleted by
 		// the launcher's Job controller.
@@ -963,10 +2611,10 @@ func TestResumeMPIJob(t *testing.T) {
 	mpiJob := newMPIJob("test", &replicas, &startTime, nil)
 	mpiJob.Spec.RunPolicy.Suspend = ptr.To(true)
 	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
-	updateMPIJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, mpiJobSuspendedReason, "MPIJob suspended")
+	updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+	updateMPIJobConditions(mpiJob, kubeflow.JobSuspended, corev1.ConditionTrue, kubeflow.JobSuspendedReason, "MPIJob suspended", clock.RealClock{})
 	msg = fmt.Sprintf("MPIJob %s/%s is suspended.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, mpiJobSuspendedReason, msg)
+	updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, kubeflow.JobSuspendedReason, msg, clock.RealClock{})
 	mpiJob.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
 		kubeflow.MPIReplicaTypeLauncher: {},
 		kubeflow.MPIReplicaTypeWorker:   {},
@@ -1011,7 +2659,7 @@ func TestResumeMPIJob(t *testing.T) {
 	// expect an update to add the conditions
 	mpiJobCopy := mpiJob.DeepCopy()
 	mpiJobCopy.Status.StartTime = &metav1.Time{Time: fakeClock.Now()}
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionFalse, "MPIJobResumed", "MPIJob resumed")
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobSuspended, corev1.ConditionFalse, kubeflow.JobResumedReason, "MPIJob resumed", clock.RealClock{})
 	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
 
 	f.runWithClock(getKey(mpiJob, t), fakeClock)
@@ -1082,7 +2730,7 @@ func TestLauncherActiveWorkerNotReady(t *testing.T) {
 		f.setUpPod(worker)
 	}
 	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
 	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
 		kubeflow.MPIReplicaTypeLauncher: {
 			Active:    1,
@@ -1123,6 +2771,8 @@ func TestLauncherActiveWorkerReady(t *testing.T) {
 	launcher := fmjc.newLauncherJob(mpiJobCopy)
 	launcherPod := mockJobPod(launcher)
 	launcherPod.Status.Phase = corev1.PodRunning
+	readyCondition := corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionTrue}
+	launcherPod.Status.Conditions = []corev1.PodCondition{readyCondition}
 	f.setUpLauncher(launcher)
 	f.setUpPod(launcherPod)
 
@@ -1130,6 +2780,9 @@ func TestLauncherActiveWorkerReady(t *testing.T) {
 	for i := 0; i < int(replicas); i++ {
 		worker := fmjc.newWorker(mpiJobCopy, i)
 		worker.Status.Phase = corev1.PodRunning
+		if i%2 == 0 {
+			worker.Status.Conditions = []corev1.PodCondition{readyCondition}
+		}
 		runningPodList = append(runningPodList, worker)
 		f.setUpPod(worker)
 	}
@@ -1141,25 +2794,119 @@ func TestLauncherActiveWorkerReady(t *testing.T) {
 	mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
 		kubeflow.MPIReplicaTypeLauncher: {
 			Active:    1,
+			Ready:     1,
 			Succeeded: 0,
 			Failed:    0,
 		},
 		kubeflow.MPIReplicaTypeWorker: {
 			Active:    8,
+			Ready:     4,
 			Succeeded: 0,
 			Failed:    0,
 		},
 	}
 	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
 	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
 	msg = fmt.Sprintf("MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, mpiJobRunningReason, msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, kubeflow.JobRunningReason, msg, clock.RealClock{})
 	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
 
 	f.run(getKey(mpiJob, t))
 }
 
+func TestPodsReadyCondition(t *testing.T) {
+	cases := map[string]struct {
+		readyWorkers  int
+		podsReadyWas  bool
+		wantPodsReady *corev1.ConditionStatus
+	}{
+		"workers not ready": {
+			readyWorkers: 2,
+		},
+		"all pods ready": {
+			readyWorkers:  4,
+			wantPodsReady: ptr.To(corev1.ConditionTrue),
+		},
+		"worker no longer ready": {
+			readyWorkers:  3,
+			podsReadyWas:  true,
+			wantPodsReady: ptr.To(corev1.ConditionFalse),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newFixture(t, "")
+			startTime := metav1.Now()
+			completionTime := metav1.Now()
+
+			var replicas int32 = 4
+			mpiJob := newMPIJob("test", &replicas, &startTime, &completionTime)
+			if tc.podsReadyWas {
+				updateMPIJobConditions(mpiJob, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name), clock.RealClock{})
+				updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, kubeflow.JobRunningReason, fmt.Sprintf("MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name), clock.RealClock{})
+				updatePodsReadyCondition(mpiJob, true, clock.RealClock{})
+			}
+			f.setUpMPIJob(mpiJob)
+
+			mpiJobCopy := mpiJob.DeepCopy()
+			scheme.Scheme.Default(mpiJobCopy)
+			f.setUpService(newJobService(mpiJobCopy))
+			secret, err := newSSHAuthSecret(mpiJobCopy)
+			if err != nil {
+				t.Fatalf("Creating SSH auth secret: %v", err)
+			}
+			f.setUpSecret(secret)
+
+			fmjc := f.newFakeMPIJobController()
+			launcher := fmjc.newLauncherJob(mpiJobCopy)
+			launcherPod := mockJobPod(launcher)
+			launcherPod.Status.Phase = corev1.PodRunning
+			readyCondition := corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionTrue}
+			launcherPod.Status.Conditions = []corev1.PodCondition{readyCondition}
+			f.setUpLauncher(launcher)
+			f.setUpPod(launcherPod)
+
+			var runningPodList []*corev1.Pod
+			for i := 0; i < int(replicas); i++ {
+				worker := fmjc.newWorker(mpiJobCopy, i)
+				worker.Status.Phase = corev1.PodRunning
+				if i < tc.readyWorkers {
+					worker.Status.Conditions = []corev1.PodCondition{readyCondition}
+				}
+				runningPodList = append(runningPodList, worker)
+				f.setUpPod(worker)
+			}
+
+			configMap := newConfigMap(mpiJobCopy, replicas)
+			updateDiscoverHostsInConfigMap(configMap, mpiJobCopy, runningPodList)
+			f.setUpConfigMap(configMap)
+
+			mpiJobCopy.Status.ReplicaStatuses = map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Active: 1,
+					Ready:  1,
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Active: replicas,
+					Ready:  int32(tc.readyWorkers),
+				},
+			}
+			setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
+			msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
+			updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
+			msg = fmt.Sprintf("MPIJob %s/%s is running.", mpiJob.Namespace, mpiJob.Name)
+			updateMPIJobConditions(mpiJobCopy, kubeflow.JobRunning, corev1.ConditionTrue, kubeflow.JobRunningReason, msg, clock.RealClock{})
+			if tc.wantPodsReady != nil {
+				updatePodsReadyCondition(mpiJobCopy, *tc.wantPodsReady == corev1.ConditionTrue, clock.RealClock{})
+			}
+			f.expectUpdateMPIJobStatusAction(mpiJobCopy)
+
+			f.run(getKey(mpiJob, t))
+		})
+	}
+}
+
 func TestWorkerReady(t *testing.T) {
 	f := newFixture(t, "")
 	startTime := metav1.Now()
@@ -1208,13 +2955,122 @@ func TestWorkerReady(t *testing.T) {
 		},
 	}
 	msg := fmt.Sprintf("MPIJob %s/%s is created.", mpiJob.Namespace, mpiJob.Name)
-	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, mpiJobCreatedReason, msg)
+	updateMPIJobConditions(mpiJobCopy, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, msg, clock.RealClock{})
 	setUpMPIJobTimestamp(mpiJobCopy, &startTime, &completionTime)
 	f.expectUpdateMPIJobStatusAction(mpiJobCopy)
 
 	f.run(getKey(mpiJob, t))
 }
 
+func TestCoalescePodEvents(t *testing.T) {
+	f := newFixture(t, "")
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	f.setUpMPIJob(mpiJob)
+	c, _, _ := f.newController(clock.RealClock{})
+	c.StatusCoalescingWindow = 100 * time.Millisecond
+
+	for i := 0; i < 2; i++ {
+		c.handleObject(c.newWorker(mpiJob, i))
+	}
+	if got := c.queue.Len(); got != 0 {
+		t.Errorf("Pod events were enqueued before the coalescing window, got %d keys", got)
+	}
+	if err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, time.Second, true, func(context.Context) (bool, error) {
+		return c.queue.Len() > 0, nil
+	}); err != nil {
+		t.Fatalf("Pod events weren't enqueued after the coalescing window: %v", err)
+	}
+	if got := c.queue.Len(); got != 1 {
+		t.Errorf("Pod events weren't coalesced, got %d keys, want 1", got)
+	}
+}
+
+func TestCreateWorkersInParallel(t *testing.T) {
+	const replicas = 20
+	f := newFixture(t, "")
+	mpiJob := newMPIJob("test", ptr.To[int32](replicas), nil, nil)
+	f.setUpMPIJob(mpiJob)
+	// An existing worker isn't created again.
+	f.setUpPod(f.newFakeMPIJobController().newWorker(mpiJob, 3))
+	c, _, _ := f.newController(clock.RealClock{})
+	c.WorkerCreationParallelism = 4
+
+	workers, err := c.getOrCreateWorker(mpiJob)
+	if err != nil {
+		t.Fatalf("getOrCreateWorker(): %v", err)
+	}
+	var gotNames []string
+	for _, pod := range workers {
+		gotNames = append(gotNames, pod.Name)
+	}
+	var wantNames []string
+	for i := 0; i < replicas; i++ {
+		wantNames = append(wantNames, workerName(mpiJob, i))
+	}
+	if diff := cmp.Diff(wantNames, gotNames); diff != "" {
+		t.Errorf("Unexpected workers (-want,+got):\n%s", diff)
+	}
+	if got := len(filterInformerActions(f.kubeClient.Actions())); got != replicas-1 {
+		t.Errorf("Got %d pod creations, want %d", got, replicas-1)
+	}
+}
+
+func TestSlowStartBatch(t *testing.T) {
+	errFailed := errors.New("failed")
+	cases := map[string]struct {
+		count         int
+		fn            func(piece int) error
+		wantSuccesses int
+		wantCalls     int
+		wantErr       bool
+	}{
+		"all succeed": {
+			count:         10,
+			fn:            func(int) error { return nil },
+			wantSuccesses: 10,
+			wantCalls:     10,
+		},
+		"all fail": {
+			count:     10,
+			fn:        func(int) error { return errFailed },
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		"fail from the fourth piece": {
+			count: 10,
+			fn: func(piece int) error {
+				if piece >= 3 {
+					return errFailed
+				}
+				return nil
+			},
+			// Batches of 1, 2 and 4 pieces.
+			wantSuccesses: 3,
+			wantCalls:     7,
+			wantErr:       true,
+		},
+		"nothing to do": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			successes, err := slowStartBatch(tc.count, 1, 4, func(piece int) error {
+				calls.Add(1)
+				return tc.fn(piece)
+			})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("slowStartBatch() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if successes != tc.wantSuccesses {
+				t.Errorf("Got %d successes, want %d", successes, tc.wantSuccesses)
+			}
+			if got := int(calls.Load()); got != tc.wantCalls {
+				t.Errorf("Got %d calls, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
 func TestNewLauncherAndWorker(t *testing.T) {
 	cases := map[string]struct {
 		job          kubeflow.MPIJob
@@ -1274,7 +3130,8 @@ func TestNewLauncherAndWorker(t *testing.T) {
 										launcherEnvVars,
 										ompiEnvVars,
 										corev1.EnvVar{Name: openMPISlotsEnv, Value: "1"},
-										nvidiaDisableEnvVars),
+										nvidiaDisableEnvVars,
+										jobMetadataEnvVars("foo", "bar", "1", "")),
 									VolumeMounts: []corev1.VolumeMount{
 										{Name: "ssh-auth", MountPath: "/root/.ssh"},
 										{Name: "mpi-job-config", MountPath: "/etc/mpi"},
@@ -1332,7 +3189,7 @@ func TestNewLauncherAndWorker(t *testing.T) {
 							VolumeMounts: []corev1.VolumeMount{
 								{Name: "ssh-auth", MountPath: "/root/.ssh"},
 							},
-							Env: workerEnvVars,
+							Env: joinEnvVars(workerEnvVars, jobMetadataEnvVars("foo", "bar", "1", "0")),
 						},
 					},
 					Volumes: []corev1.Volume{
@@ -1404,6 +3261,7 @@ func TestNewLauncherAndWorker(t *testing.T) {
 										launcherEnvVars,
 										ompiEnvVars,
 										corev1.EnvVar{Name: openMPISlotsEnv, Value: "1"},
+										jobMetadataEnvVars("foo", "bar", "1", ""),
 									),
 									VolumeMounts: []corev1.VolumeMount{
 										{Name: "ssh-auth", MountPath: "/root/.ssh"},
@@ -1462,7 +3320,7 @@ func TestNewLauncherAndWorker(t *testing.T) {
 							VolumeMounts: []corev1.VolumeMount{
 								{Name: "ssh-auth", MountPath: "/root/.ssh"},
 							},
-							Env: workerEnvVars,
+							Env: joinEnvVars(workerEnvVars, jobMetadataEnvVars("foo", "bar", "1", "0")),
 						},
 					},
 					Volumes: []corev1.Volume{
@@ -1580,14 +3438,17 @@ func TestNewLauncherAndWorker(t *testing.T) {
 										launcherEnvVars,
 										intelEnvVars,
 										corev1.EnvVar{Name: "I_MPI_PERHOST", Value: "5"},
-										nvidiaDisableEnvVars),
+										nvidiaDisableEnvVars,
+										jobMetadataEnvVars("bar", "foo", "5", "")),
 									VolumeMounts: []corev1.VolumeMount{
 										{Name: "fool-vol", MountPath: "/mnt/foo"},
 										{Name: "ssh-auth", MountPath: "/home/mpiuser/.ssh"},
 										{Name: "mpi-job-config", MountPath: "/etc/mpi"},
 									},
 								},
-								{},
+								{
+									Env: jobMetadataEnvVars("bar", "foo", "5", ""),
+								},
 							},
 							Volumes: []corev1.Volume{
 								{Name: "foo-vol"},
@@ -1642,7 +3503,7 @@ func TestNewLauncherAndWorker(t *testing.T) {
 							VolumeMounts: []corev1.VolumeMount{
 								{Name: "ssh-auth", MountPath: "/home/mpiuser/.ssh"},
 							},
-							Env: joinEnvVars(corev1.EnvVar{Name: "FOO", Value: "bar"}, workerEnvVars),
+							Env: joinEnvVars(corev1.EnvVar{Name: "FOO", Value: "bar"}, workerEnvVars, jobMetadataEnvVars("bar", "foo", "5", "12")),
 						},
 					},
 					Volumes: []corev1.Volume{
@@ -1683,6 +3544,218 @@ func TestNewLauncherAndWorker(t *testing.T) {
 	}
 }
 
+func TestUpdateLauncherRestartCount(t *testing.T) {
+	cases := map[string]struct {
+		restarts     int32
+		failed       int32
+		jobFailed    bool
+		podRestarts  int32
+		wantRestarts int32
+		wantEvent    bool
+	}{
+		"no restarts": {},
+		"container restarted": {
+			podRestarts:  2,
+			wantRestarts: 2,
+			wantEvent:    true,
+		},
+		"pods failed": {
+			restarts:     1,
+			failed:       2,
+			wantRestarts: 2,
+			wantEvent:    true,
+		},
+		"already counted": {
+			restarts:     2,
+			podRestarts:  2,
+			wantRestarts: 2,
+		},
+		"launcher failed": {
+			restarts:     1,
+			failed:       2,
+			jobFailed:    true,
+			wantRestarts: 1,
+		},
+		"failed pods deleted": {
+			restarts:     3,
+			wantRestarts: 3,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			c := &MPIJobController{recorder: recorder}
+			mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+			mpiJob.Status.LauncherRestartCount = tc.restarts
+			launcher := &batchv1.Job{Status: batchv1.JobStatus{Failed: tc.failed}}
+			if tc.jobFailed {
+				launcher.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+			}
+			pod := &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{RestartCount: tc.podRestarts}}}}
+			c.updateLauncherRestartCount(mpiJob, launcher, []*corev1.Pod{pod})
+			if mpiJob.Status.LauncherRestartCount != tc.wantRestarts {
+				t.Errorf("Unexpected restart count %d, want %d", mpiJob.Status.LauncherRestartCount, tc.wantRestarts)
+			}
+			if gotEvent := len(recorder.Events) > 0; gotEvent != tc.wantEvent {
+				t.Errorf("Unexpected event recorded %t, want %t", gotEvent, tc.wantEvent)
+			}
+		})
+	}
+}
+
+func TestSetCompletionTime(t *testing.T) {
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	start := metav1.NewTime(now.Add(-time.Hour))
+	cases := map[string]struct {
+		status kubeflow.JobStatus
+		want   kubeflow.JobStatus
+	}{
+		"running": {
+			status: kubeflow.JobStatus{StartTime: &start},
+			want:   kubeflow.JobStatus{StartTime: &start},
+		},
+		"succeeded": {
+			status: kubeflow.JobStatus{
+				Conditions:     []kubeflow.JobCondition{{Type: kubeflow.JobSucceeded, Status: corev1.ConditionTrue}},
+				StartTime:      &start,
+				CompletionTime: ptr.To(metav1.NewTime(start.Add(90 * time.Second))),
+			},
+			want: kubeflow.JobStatus{
+				Conditions:     []kubeflow.JobCondition{{Type: kubeflow.JobSucceeded, Status: corev1.ConditionTrue}},
+				StartTime:      &start,
+				CompletionTime: ptr.To(metav1.NewTime(start.Add(90 * time.Second))),
+				Duration:       &metav1.Duration{Duration: 90 * time.Second},
+			},
+		},
+		"failed without completion time": {
+			status: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{{Type: kubeflow.JobFailed, Status: corev1.ConditionTrue, Reason: kubeflow.JobEvictedReason}},
+				StartTime:  &start,
+			},
+			want: kubeflow.JobStatus{
+				Conditions:     []kubeflow.JobCondition{{Type: kubeflow.JobFailed, Status: corev1.ConditionTrue, Reason: kubeflow.JobEvictedReason}},
+				StartTime:      &start,
+				CompletionTime: &now,
+				Duration:       &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		"completed before the restart": {
+			status: kubeflow.JobStatus{
+				Conditions:     []kubeflow.JobCondition{{Type: kubeflow.JobSucceeded, Status: corev1.ConditionTrue}},
+				StartTime:      &now,
+				CompletionTime: &start,
+			},
+			want: kubeflow.JobStatus{
+				Conditions:     []kubeflow.JobCondition{{Type: kubeflow.JobSucceeded, Status: corev1.ConditionTrue}},
+				StartTime:      &now,
+				CompletionTime: &start,
+				Duration:       &metav1.Duration{},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &MPIJobController{clock: clocktesting.NewFakeClock(now.Time)}
+			mpiJob := &kubeflow.MPIJob{Status: tc.status}
+			c.setCompletionTime(mpiJob)
+			if diff := cmp.Diff(tc.want, mpiJob.Status); diff != "" {
+				t.Errorf("Unexpected status (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateReplicaNodes(t *testing.T) {
+	scheduled := func(node string) *corev1.Pod {
+		return &corev1.Pod{Spec: corev1.PodSpec{NodeName: node}}
+	}
+	cases := map[string]struct {
+		oldNodes []string
+		pods     []*corev1.Pod
+		want     []string
+	}{
+		"not scheduled": {
+			pods: []*corev1.Pod{scheduled(""), scheduled("")},
+		},
+		"scheduled": {
+			pods: []*corev1.Pod{scheduled("node-b"), scheduled(""), scheduled("node-a"), scheduled("node-b")},
+			want: []string{"node-a", "node-b"},
+		},
+		"rescheduled": {
+			oldNodes: []string{"node-a"},
+			pods:     []*corev1.Pod{scheduled("node-c")},
+			want:     []string{"node-c"},
+		},
+		"pods gone": {
+			oldNodes: []string{"node-a", "node-b"},
+			want:     []string{"node-a", "node-b"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+			oldStatus := kubeflow.JobStatus{
+				ReplicaStatuses: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus{
+					kubeflow.MPIReplicaTypeWorker: {Nodes: tc.oldNodes},
+				},
+			}
+			initializeMPIJobStatuses(mpiJob, kubeflow.MPIReplicaTypeWorker)
+			updateReplicaNodes(mpiJob, &oldStatus, kubeflow.MPIReplicaTypeWorker, tc.pods)
+			if diff := cmp.Diff(tc.want, mpiJob.Status.ReplicaStatuses[kubeflow.MPIReplicaTypeWorker].Nodes); diff != "" {
+				t.Errorf("Unexpected nodes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateMPIJobProgress(t *testing.T) {
+	now := metav1.Now()
+	launcherPod := func(created metav1.Time, progress *string) *corev1.Pod {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created}}
+		if progress != nil {
+			pod.Annotations = map[string]string{kubeflow.ProgressAnnotation: *progress}
+		}
+		return pod
+	}
+	cases := map[string]struct {
+		progress string
+		pods     []*corev1.Pod
+		want     string
+	}{
+		"no annotation": {
+			pods: []*corev1.Pod{launcherPod(now, nil)},
+		},
+		"reported": {
+			pods: []*corev1.Pod{launcherPod(now, ptr.To(" epoch 12/100 "))},
+			want: "epoch 12/100",
+		},
+		"latest pod": {
+			pods: []*corev1.Pod{
+				launcherPod(metav1.NewTime(now.Add(time.Second)), ptr.To("10%")),
+				launcherPod(now, ptr.To("34%")),
+			},
+			want: "10%",
+		},
+		"launcher pods deleted": {
+			progress: "34%",
+			want:     "34%",
+		},
+		"truncated": {
+			pods: []*corev1.Pod{launcherPod(now, ptr.To(strings.Repeat("x", 200)))},
+			want: strings.Repeat("x", maxProgressLength),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := &kubeflow.MPIJob{Status: kubeflow.JobStatus{Progress: tc.progress}}
+			updateMPIJobProgress(mpiJob, tc.pods)
+			if mpiJob.Status.Progress != tc.want {
+				t.Errorf("Unexpected progress %q, want %q", mpiJob.Status.Progress, tc.want)
+			}
+		})
+	}
+}
+
 func TestNewConfigMap(t *testing.T) {
 	testCases := map[string]struct {
 		mpiJob         *kubeflow.MPIJob
@@ -1714,6 +3787,66 @@ func TestNewConfigMap(t *testing.T) {
 				},
 			},
 		},
+		"OpenMPI with worker groups": {
+			mpiJob: &kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-groups",
+					Namespace: "tenant-a",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker:    ptr.To[int32](8),
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeWorker: {Replicas: ptr.To[int32](1)},
+					},
+					WorkerGroups: []kubeflow.WorkerGroup{
+						{Name: "analysis", Replicas: 2, SlotsPerWorker: ptr.To[int32](32)},
+					},
+				},
+			},
+			workerReplicas: 3,
+			wantCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-groups-config",
+					Namespace: "tenant-a",
+					Labels: map[string]string{
+						"app": "openmpi-groups",
+					},
+				},
+				Data: map[string]string{
+					"hostfile": "openmpi-groups-worker-0.openmpi-groups.tenant-a.svc slots=8\nopenmpi-groups-worker-1.openmpi-groups.tenant-a.svc slots=32\nopenmpi-groups-worker-2.openmpi-groups.tenant-a.svc slots=32\n",
+				},
+			},
+		},
+		"OpenMPI with worker naming": {
+			mpiJob: &kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-naming",
+					Namespace: "tenant-a",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker:    ptr.To[int32](2),
+					MPIImplementation: kubeflow.MPIImplementationOpenMPI,
+					WorkerNaming: &kubeflow.WorkerNaming{
+						Prefix:     "openmpi-naming-rank-",
+						IndexWidth: ptr.To[int32](2),
+					},
+				},
+			},
+			workerReplicas: 2,
+			wantCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "openmpi-naming-config",
+					Namespace: "tenant-a",
+					Labels: map[string]string{
+						"app": "openmpi-naming",
+					},
+				},
+				Data: map[string]string{
+					"hostfile": "openmpi-naming-rank-00.openmpi-naming.tenant-a.svc slots=2\nopenmpi-naming-rank-01.openmpi-naming.tenant-a.svc slots=2\n",
+				},
+			},
+		},
 		"OpenMPI without slots, zero explicit workers": {
 			mpiJob: &kubeflow.MPIJob{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1842,6 +3975,22 @@ func joinEnvVars(evs ...interface{}) []corev1.EnvVar {
 	return result
 }
 
+// jobMetadataEnvVars returns the variables describing the first attempt of
+// an MPIJob without workers, with the worker index if not empty.
+func jobMetadataEnvVars(name, namespace, slots, workerIndex string) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: "MPIJOB_NAME", Value: name},
+		{Name: "MPIJOB_NAMESPACE", Value: namespace},
+		{Name: "MPIJOB_WORKER_REPLICAS", Value: "0"},
+		{Name: "MPIJOB_SLOTS_PER_WORKER", Value: slots},
+		{Name: "MPIJOB_ATTEMPT", Value: "1"},
+	}
+	if workerIndex != "" {
+		env = append(env, corev1.EnvVar{Name: "MPIJOB_WORKER_INDEX", Value: workerIndex})
+	}
+	return env
+}
+
 func mockJobPod(job *batchv1.Job) *corev1.Pod {
 	job.Spec.Selector = &metav1.LabelSelector{
 		MatchLabels: map[string]string{
@@ -1869,3 +4018,106 @@ func (f *fixture) newFakeMPIJobController() *MPIJobController {
 		podLister: k8sI.Core().V1().Pods().Lister(),
 	}
 }
+
+type fakeHistoryBackend struct {
+	records []*history.Record
+}
+
+func (b *fakeHistoryBackend) Archive(_ context.Context, record *history.Record) error {
+	b.records = append(b.records, record)
+	return nil
+}
+
+type fakeCloudEventSink struct {
+	events []cloudevents.Event
+}
+
+func (s *fakeCloudEventSink) Send(_ context.Context, e cloudevents.Event) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+type fakeNotifier struct {
+	events []*notification.Event
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, e *notification.Event) error {
+	n.events = append(n.events, e)
+	return nil
+}
+
+type fakeMetricsPusher struct {
+	metrics []*jobmetrics.Metrics
+}
+
+func (p *fakeMetricsPusher) Push(_ context.Context, m *jobmetrics.Metrics) error {
+	p.metrics = append(p.metrics, m)
+	return nil
+}
+
+type fakeGPUUsageSource struct {
+	usage *gpuusage.Usage
+}
+
+func (s *fakeGPUUsageSource) Usage(context.Context, string, string, time.Time, time.Time) (*gpuusage.Usage, error) {
+	return s.usage, nil
+}
+
+type fakeSupportBundleStore struct {
+	bundles map[string][]byte
+}
+
+func (s *fakeSupportBundleStore) Store(_ context.Context, job *kubeflow.MPIJob, bundle []byte) (string, error) {
+	s.bundles[job.Namespace+"/"+job.Name] = bundle
+	return "memory", nil
+}
+
+func TestStoreSupportBundle(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	store := &fakeSupportBundleStore{bundles: make(map[string][]byte)}
+	recorder := record.NewFakeRecorder(1)
+	c := &MPIJobController{
+		kubeClient:         k8sfake.NewSimpleClientset(),
+		recorder:           recorder,
+		SupportBundleStore: store,
+	}
+	c.storeSupportBundle(mpiJob)
+
+	if len(store.bundles["default/test"]) == 0 {
+		t.Errorf("Support bundle of default/test wasn't stored")
+	}
+	if got, want := <-recorder.Events, "Normal SupportBundleStored Support bundle stored in memory"; got != want {
+		t.Errorf("Got event %q, want %q", got, want)
+	}
+}
+
+func TestProcessNextSupportBundle(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	store := &fakeSupportBundleStore{bundles: make(map[string][]byte)}
+	c := &MPIJobController{
+		kubeClient:         k8sfake.NewSimpleClientset(),
+		kubeflowClient:     fake.NewSimpleClientset(mpiJob),
+		recorder:           record.NewFakeRecorder(1),
+		queue:              workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[any]()),
+		supportBundleQueue: workqueue.NewTyped[string](),
+		SupportBundleStore: store,
+	}
+	c.enqueueSupportBundle(mpiJob)
+	// The pods are cleaned up once the support bundle is stored.
+	if !c.pendingSupportBundles.has("default/test") {
+		t.Errorf("Support bundle of default/test isn't pending")
+	}
+	if !c.processNextSupportBundle() {
+		t.Fatal("Support bundle queue was shut down")
+	}
+
+	if len(store.bundles["default/test"]) == 0 {
+		t.Errorf("Support bundle of default/test wasn't stored")
+	}
+	if c.pendingSupportBundles.has("default/test") {
+		t.Errorf("Support bundle of default/test is still pending")
+	}
+	if got := c.queue.Len(); got != 1 {
+		t.Errorf("Got %d keys in the queue, want the key of the MPIJob", got)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions/kubeflow/v2beta1"
+	listers "github.com/kubeflow/mpi-operator/pkg/client/listers/kubeflow/v2beta1"
+)
+
+// mpiJobDefaults reads the MPIJobDefaults of the namespaces.
+type mpiJobDefaults struct {
+	lister listers.MPIJobDefaultsLister
+	synced cache.InformerSynced
+}
+
+// EnableMPIJobDefaults makes the controller merge the MPIJobDefaults of the
+// namespace of each new MPIJob into it before setting the defaults of the API.
+// It must be called before the informer of the MPIJobDefaults is started.
+func (c *MPIJobController) EnableMPIJobDefaults(informer informers.MPIJobDefaultsInformer) {
+	c.mpiJobDefaults = &mpiJobDefaults{
+		lister: informer.Lister(),
+		synced: informer.Informer().HasSynced,
+	}
+}
+
+// applyMPIJobDefaults merges the MPIJobDefaults of the namespace of the
+// MPIJob into its fields that are unset, in the order of their names, and
+// updates the MPIJob with them and the MPIJobDefaultsAnnotation. It only
+// applies them to the MPIJobs that weren't synced yet, so that the changes of
+// the MPIJobDefaults don't change the running MPIJobs, and returns whether
+// the MPIJob was updated, in which case the rest of the sync is skipped.
+func (c *MPIJobController) applyMPIJobDefaults(mpiJob *kubeflow.MPIJob) (bool, error) {
+	if c.mpiJobDefaults == nil || len(mpiJob.Status.Conditions) != 0 || mpiJob.DeletionTimestamp != nil {
+		return false, nil
+	}
+	if _, applied := mpiJob.Annotations[kubeflow.MPIJobDefaultsAnnotation]; applied {
+		return false, nil
+	}
+	if managedByExternalController(mpiJob.Spec.RunPolicy.ManagedBy) != nil {
+		return false, nil
+	}
+	defaults, err := c.mpiJobDefaults.lister.MPIJobDefaults(mpiJob.Namespace).List(labels.Everything())
+	if err != nil {
+		return false, fmt.Errorf("listing MPIJobDefaults: %w", err)
+	}
+	if len(defaults) == 0 {
+		return false, nil
+	}
+	slices.SortFunc(defaults, func(a, b *kubeflow.MPIJobDefaults) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	names := make([]string, 0, len(defaults))
+	for _, d := range defaults {
+		mergeMPIJobDefaults(mpiJob, &d.Spec)
+		names = append(names, d.Name)
+	}
+	if mpiJob.Annotations == nil {
+		mpiJob.Annotations = make(map[string]string)
+	}
+	mpiJob.Annotations[kubeflow.MPIJobDefaultsAnnotation] = strings.Join(names, ",")
+	klog.V(4).Infof("Applying the MPIJobDefaults %s to %s/%s", strings.Join(names, ", "), mpiJob.Namespace, mpiJob.Name)
+	if _, err := c.kubeflowClient.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).Update(context.TODO(), mpiJob, metav1.UpdateOptions{}); err != nil {
+		return false, fmt.Errorf("applying the MPIJobDefaults: %w", err)
+	}
+	return true, nil
+}
+
+func mergeMPIJobDefaults(mpiJob *kubeflow.MPIJob, defaults *kubeflow.MPIJobDefaultsSpec) {
+	spec := &mpiJob.Spec
+	if spec.SlotsPerWorker == nil && defaults.SlotsPerWorker != nil {
+		spec.SlotsPerWorker = ptr.To(*defaults.SlotsPerWorker)
+	}
+	if spec.RunPolicy.CleanPodPolicy == nil && defaults.CleanPodPolicy != nil {
+		spec.RunPolicy.CleanPodPolicy = ptr.To(*defaults.CleanPodPolicy)
+	}
+	if spec.RunPolicy.TTLSecondsAfterFinished == nil && defaults.TTLSecondsAfterFinished != nil {
+		spec.RunPolicy.TTLSecondsAfterFinished = ptr.To(*defaults.TTLSecondsAfterFinished)
+	}
+	mergeReplicaDefaults(spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher], defaults.Launcher)
+	mergeReplicaDefaults(spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker], defaults.Worker)
+}
+
+func mergeReplicaDefaults(replica *kubeflow.ReplicaSpec, defaults *kubeflow.ReplicaDefaults) {
+	if replica == nil || defaults == nil {
+		return
+	}
+	podSpec := &replica.Template.Spec
+	for _, toleration := range defaults.Tolerations {
+		if !slices.ContainsFunc(podSpec.Tolerations, func(t corev1.Toleration) bool {
+			return t.Key == toleration.Key && t.Effect == toleration.Effect
+		}) {
+			podSpec.Tolerations = append(podSpec.Tolerations, toleration)
+		}
+	}
+	if len(podSpec.Containers) == 0 {
+		return
+	}
+	container := &podSpec.Containers[0]
+	if container.Image == "" {
+		container.Image = defaults.Image
+	}
+	if defaults.Resources == nil {
+		return
+	}
+	// A container limiting a resource without requesting it requests its
+	// limit, which a default request would lower.
+	limits := container.Resources.Limits
+	if len(defaults.Resources.Requests) > 0 && container.Resources.Requests == nil {
+		container.Resources.Requests = make(corev1.ResourceList)
+	}
+	for name, quantity := range defaults.Resources.Requests {
+		_, requested := container.Resources.Requests[name]
+		if _, limited := limits[name]; !limited && !requested {
+			container.Resources.Requests[name] = quantity.DeepCopy()
+		}
+	}
+	if len(defaults.Resources.Limits) > 0 && container.Resources.Limits == nil {
+		container.Resources.Limits = make(corev1.ResourceList)
+	}
+	for name, quantity := range defaults.Resources.Limits {
+		_, limited := container.Resources.Limits[name]
+		request, requested := container.Resources.Requests[name]
+		if !limited && (!requested || request.Cmp(quantity) <= 0) {
+			container.Resources.Limits[name] = quantity.DeepCopy()
+		}
+	}
+}
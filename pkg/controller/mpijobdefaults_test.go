@@ -0,0 +1,146 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions"
+)
+
+func TestApplyMPIJobDefaults(t *testing.T) {
+	gpuToleration := corev1.Toleration{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	defaults := []*kubeflow.MPIJobDefaults{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "b-team", Namespace: "default"},
+			Spec: kubeflow.MPIJobDefaultsSpec{
+				SlotsPerWorker: ptr.To[int32](4),
+				Worker: &kubeflow.ReplicaDefaults{
+					Image: "team/worker:v2",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-site", Namespace: "default"},
+			Spec: kubeflow.MPIJobDefaultsSpec{
+				SlotsPerWorker:          ptr.To[int32](8),
+				CleanPodPolicy:          ptr.To(kubeflow.CleanPodPolicyRunning),
+				TTLSecondsAfterFinished: ptr.To[int32](3600),
+				Worker: &kubeflow.ReplicaDefaults{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+							"nvidia.com/gpu":      resource.MustParse("1"),
+						},
+					},
+					Tolerations: []corev1.Toleration{gpuToleration},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "other"},
+			Spec: kubeflow.MPIJobDefaultsSpec{
+				CleanPodPolicy: ptr.To(kubeflow.CleanPodPolicyAll),
+			},
+		},
+	}
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.RunPolicy.CleanPodPolicy = nil
+	mpiJob.Spec.RunPolicy.TTLSecondsAfterFinished = ptr.To[int32](60)
+	worker := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template
+	worker.Spec.Containers[0].Image = ""
+	worker.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("2"),
+	}
+
+	client := fake.NewSimpleClientset(mpiJob.DeepCopy())
+	informer := informers.NewSharedInformerFactory(client, 0).Kubeflow().V2beta1().MPIJobDefaults()
+	for _, d := range defaults {
+		if err := informer.Informer().GetIndexer().Add(d); err != nil {
+			t.Fatalf("Adding MPIJobDefaults: %v", err)
+		}
+	}
+	c := &MPIJobController{kubeflowClient: client}
+	c.EnableMPIJobDefaults(informer)
+
+	started := mpiJob.DeepCopy()
+	updateMPIJobConditions(started, kubeflow.JobCreated, corev1.ConditionTrue, kubeflow.JobCreatedReason, "", clock.RealClock{})
+	if updated, err := c.applyMPIJobDefaults(started); updated || err != nil {
+		t.Fatalf("Applying the MPIJobDefaults to a synced MPIJob: updated=%t, err=%v", updated, err)
+	}
+	if updated, err := c.applyMPIJobDefaults(mpiJob); !updated || err != nil {
+		t.Fatalf("Applying the MPIJobDefaults: updated=%t, err=%v", updated, err)
+	}
+	stored, err := client.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).Get(context.Background(), mpiJob.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting the MPIJob: %v", err)
+	}
+	if diff := cmp.Diff(mpiJob, stored); diff != "" {
+		t.Errorf("Unexpected stored MPIJob (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff("a-site,b-team", mpiJob.Annotations[kubeflow.MPIJobDefaultsAnnotation]); diff != "" {
+		t.Errorf("Unexpected applied MPIJobDefaults (-want,+got):\n%s", diff)
+	}
+	// The MPIJobDefaults are only applied once.
+	if updated, err := c.applyMPIJobDefaults(stored.DeepCopy()); updated || err != nil {
+		t.Fatalf("Applying the MPIJobDefaults again: updated=%t, err=%v", updated, err)
+	}
+
+	if diff := cmp.Diff(ptr.To[int32](8), mpiJob.Spec.SlotsPerWorker); diff != "" {
+		t.Errorf("Unexpected slots per worker (-want,+got):\n%s", diff)
+	}
+	wantRunPolicy := kubeflow.RunPolicy{
+		CleanPodPolicy:          ptr.To(kubeflow.CleanPodPolicyRunning),
+		TTLSecondsAfterFinished: ptr.To[int32](60),
+	}
+	if diff := cmp.Diff(wantRunPolicy, mpiJob.Spec.RunPolicy); diff != "" {
+		t.Errorf("Unexpected run policy (-want,+got):\n%s", diff)
+	}
+	wantWorker := corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Name:  "foo",
+			Image: "team/worker:v2",
+			Resources: corev1.ResourceRequirements{
+				// The CPU limit of the MPIJob is also its request.
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+					"nvidia.com/gpu":      resource.MustParse("1"),
+				},
+			},
+		}},
+		Tolerations: []corev1.Toleration{gpuToleration},
+	}
+	if diff := cmp.Diff(wantWorker, worker.Spec); diff != "" {
+		t.Errorf("Unexpected worker pod spec (-want,+got):\n%s", diff)
+	}
+}
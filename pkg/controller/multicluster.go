@@ -0,0 +1,123 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// openMPINoTreeSpawnEnv makes mpirun start the daemons of all the workers
+// itself, rather than through other workers, which can't resolve the
+// hostnames of the workers of other clusters.
+const openMPINoTreeSpawnEnv = "OMPI_MCA_plm_rsh_no_tree_spawn"
+
+// workerPool returns the worker pool of the worker with the index, or nil if
+// the worker runs in the cluster of the MPIJob.
+func workerPool(mpiJob *kubeflow.MPIJob, index int) *kubeflow.WorkerPool {
+	if mpiJob.Spec.MultiCluster == nil {
+		return nil
+	}
+	first := 0
+	for i := range mpiJob.Spec.MultiCluster.WorkerPools {
+		pool := &mpiJob.Spec.MultiCluster.WorkerPools[i]
+		if index < first+int(pool.Replicas) {
+			return pool
+		}
+		first += int(pool.Replicas)
+	}
+	return nil
+}
+
+// setWorkerPool places the worker with the index on the virtual nodes of its
+// worker pool, if any.
+func setWorkerPool(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, index int) {
+	pool := workerPool(mpiJob, index)
+	if pool == nil {
+		return
+	}
+	podTemplate.Labels[kubeflow.WorkerPoolLabel] = pool.Name
+	if len(pool.Annotations) > 0 && podTemplate.Annotations == nil {
+		podTemplate.Annotations = make(map[string]string)
+	}
+	for key, value := range pool.Annotations {
+		podTemplate.Annotations[key] = value
+	}
+	if len(pool.NodeSelector) > 0 && podTemplate.Spec.NodeSelector == nil {
+		podTemplate.Spec.NodeSelector = make(map[string]string)
+	}
+	for key, value := range pool.NodeSelector {
+		podTemplate.Spec.NodeSelector[key] = value
+	}
+	podTemplate.Spec.Tolerations = append(podTemplate.Spec.Tolerations, pool.Tolerations...)
+}
+
+// setupMultiClusterOnLauncher makes mpirun start the daemons of the workers
+// of the worker pools directly. The variable set in the container takes
+// precedence.
+func setupMultiClusterOnLauncher(mpiJob *kubeflow.MPIJob, container *corev1.Container) {
+	if mpiJob.Spec.MultiCluster == nil {
+		return
+	}
+	setEnvIfUnset(container, openMPINoTreeSpawnEnv, "1")
+}
+
+// setWorkerPoolAddresses replaces the hostnames of the running workers of the
+// worker pools with their pod IPs in the hostfile and in discover_hosts.sh,
+// since the hostnames only resolve in the cluster of the MPIJob.
+func setWorkerPoolAddresses(configMap *corev1.ConfigMap, mpiJob *kubeflow.MPIJob, runningPods []*corev1.Pod) {
+	if mpiJob.Spec.MultiCluster == nil {
+		return
+	}
+	addresses := make(map[string]string)
+	for _, p := range runningPods {
+		if _, ok := p.Labels[kubeflow.WorkerPoolLabel]; ok && p.Status.PodIP != "" {
+			addresses[fmt.Sprintf("%s.%s.%s.svc", p.Name, mpiJob.Name, p.Namespace)] = p.Status.PodIP
+		}
+	}
+	if len(addresses) == 0 {
+		return
+	}
+	for _, key := range []string{hostfileName, discoverHostsScriptName} {
+		if content, ok := configMap.Data[key]; ok {
+			configMap.Data[key] = replaceHosts(content, addresses)
+		}
+	}
+}
+
+// replaceHosts replaces the hosts at the start of the lines of a hostfile,
+// like "host slots=2" or "host:2", or of the echo commands of
+// discover_hosts.sh.
+func replaceHosts(content string, addresses map[string]string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		prefix := ""
+		if rest, ok := strings.CutPrefix(line, "echo "); ok {
+			prefix, line = "echo ", rest
+		}
+		host, suffix := line, ""
+		if end := strings.IndexAny(line, " :"); end >= 0 {
+			host, suffix = line[:end], line[end:]
+		}
+		if address, ok := addresses[host]; ok {
+			lines[i] = prefix + address + suffix
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	ncclSocketIfnameEnv = "NCCL_SOCKET_IFNAME"
+	ucxNetDevicesEnv    = "UCX_NET_DEVICES"
+	// libfabricTCPIfaceEnv selects the interface of the TCP provider of
+	// libfabric, used by Intel MPI and MPICH.
+	libfabricTCPIfaceEnv = "FI_TCP_IFACE"
+
+	openMPITCPIfIncludeEnv = "OMPI_MCA_btl_tcp_if_include"
+	openMPITCPIfExcludeEnv = "OMPI_MCA_btl_tcp_if_exclude"
+	openMPIOOBIfIncludeEnv = "OMPI_MCA_oob_tcp_if_include"
+	openMPIOOBIfExcludeEnv = "OMPI_MCA_oob_tcp_if_exclude"
+	// The interfaces of the process managers of Intel MPI and MPICH.
+	intelMPIHydraIfaceEnv = "I_MPI_HYDRA_IFACE"
+	mpichHydraIfaceEnv    = "HYDRA_IFACE"
+	// openMPIEnvListEnv lists the environment variables that mpirun forwards
+	// to the ranks. Unlike Intel MPI and MPICH, Open MPI doesn't forward the
+	// environment of the launcher.
+	openMPIEnvListEnv = "OMPI_MCA_mca_base_env_list"
+
+	// podNetworkInterface is the interface of the pod network, as the
+	// container runtimes name it when they call the CNI plugins.
+	podNetworkInterface = "eth0"
+	// hostNetworkExcludedInterfaces are the interfaces of the nodes that
+	// don't reach the other nodes, excluded by Open MPI in the pods using the
+	// network of the node, which has no well-known interface.
+	hostNetworkExcludedInterfaces = "127.0.0.1/8,docker0,virbr0,cni0"
+)
+
+// networkInterfaces returns the interfaces of the secondary networks.
+func networkInterfaces(network *kubeflow.Network) []string {
+	interfaces := make([]string, 0, len(network.Attachments))
+	for _, attachment := range network.Attachments {
+		interfaces = append(interfaces, attachment.Interface)
+	}
+	return interfaces
+}
+
+// networksAnnotation returns the value of the k8s.v1.cni.cncf.io/networks
+// annotation attaching a pod to the secondary networks, like
+// sriov-a@net1,networks/sriov-b@net2.
+func networksAnnotation(network *kubeflow.Network) string {
+	elements := make([]string, 0, len(network.Attachments))
+	for _, attachment := range network.Attachments {
+		element := attachment.Name
+		if attachment.Namespace != "" {
+			element = attachment.Namespace + "/" + element
+		}
+		elements = append(elements, element+"@"+attachment.Interface)
+	}
+	return strings.Join(elements, ",")
+}
+
+// setNetworkAttachments attaches the pods of the template to the secondary
+// networks of the MPIJob.
+func setNetworkAttachments(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec) {
+	if mpiJob.Spec.Network == nil {
+		return
+	}
+	if podTemplate.Annotations == nil {
+		podTemplate.Annotations = make(map[string]string)
+	}
+	podTemplate.Annotations[kubeflow.NetworksAnnotation] = networksAnnotation(mpiJob.Spec.Network)
+}
+
+// setupNetworkOnLauncher restricts the traffic of the ranks started by the
+// launcher to the interfaces of the secondary networks. The variables set in
+// the container take precedence.
+func setupNetworkOnLauncher(mpiJob *kubeflow.MPIJob, container *corev1.Container) {
+	if mpiJob.Spec.Network == nil {
+		return
+	}
+	interfaces := networkInterfaces(mpiJob.Spec.Network)
+	setEnvIfUnset(container, ncclSocketIfnameEnv, strings.Join(interfaces, ","))
+	setEnvIfUnset(container, ucxNetDevicesEnv, strings.Join(interfaces, ","))
+	switch mpiJob.Spec.MPIImplementation {
+	case kubeflow.MPIImplementationOpenMPI:
+		// btl_tcp_if_include and btl_tcp_if_exclude are mutually exclusive.
+		if !hasEnv(container, openMPITCPIfExcludeEnv) {
+			setEnvIfUnset(container, openMPITCPIfIncludeEnv, strings.Join(interfaces, ","))
+		}
+		forwarded := ncclSocketIfnameEnv + ";" + ucxNetDevicesEnv
+		for i := range container.Env {
+			if env := &container.Env[i]; env.Name == openMPIEnvListEnv && env.ValueFrom == nil {
+				if env.Value != "" {
+					forwarded = env.Value + ";" + forwarded
+				}
+				env.Value = forwarded
+				return
+			}
+		}
+		setEnvIfUnset(container, openMPIEnvListEnv, forwarded)
+	case kubeflow.MPIImplementationIntel, kubeflow.MPIImplementationMPICH:
+		// The TCP provider only supports one interface.
+		setEnvIfUnset(container, libfabricTCPIfaceEnv, interfaces[0])
+	}
+}
+
+// setupTCPInterfacesOnLauncher restricts the TCP traffic of mpirun and of the
+// ranks to the interface of the pod network with spec.tcpInterfaceSelection
+// Auto. It must be called after setupNetworkOnLauncher, which selects the
+// interfaces of the secondary networks for the ranks. The variables set in the
+// container take precedence.
+func setupTCPInterfacesOnLauncher(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, container *corev1.Container) {
+	if mpiJob.Spec.TCPInterfaceSelection != kubeflow.TCPInterfaceSelectionAuto {
+		return
+	}
+	hostNetwork := podTemplate.Spec.HostNetwork
+	if worker := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]; worker != nil && worker.Template.Spec.HostNetwork {
+		hostNetwork = true
+	}
+	switch mpiJob.Spec.MPIImplementation {
+	case kubeflow.MPIImplementationOpenMPI:
+		if hostNetwork {
+			setEnvIfNeither(container, openMPIOOBIfExcludeEnv, openMPIOOBIfIncludeEnv, hostNetworkExcludedInterfaces)
+			setEnvIfNeither(container, openMPITCPIfExcludeEnv, openMPITCPIfIncludeEnv, hostNetworkExcludedInterfaces)
+		} else {
+			setEnvIfNeither(container, openMPIOOBIfIncludeEnv, openMPIOOBIfExcludeEnv, podNetworkInterface)
+			setEnvIfNeither(container, openMPITCPIfIncludeEnv, openMPITCPIfExcludeEnv, podNetworkInterface)
+		}
+	case kubeflow.MPIImplementationIntel, kubeflow.MPIImplementationMPICH:
+		// Intel MPI and MPICH only take the name of an interface.
+		if hostNetwork {
+			return
+		}
+		hydraIfaceEnv := intelMPIHydraIfaceEnv
+		if mpiJob.Spec.MPIImplementation == kubeflow.MPIImplementationMPICH {
+			hydraIfaceEnv = mpichHydraIfaceEnv
+		}
+		setEnvIfUnset(container, hydraIfaceEnv, podNetworkInterface)
+		setEnvIfUnset(container, libfabricTCPIfaceEnv, podNetworkInterface)
+	}
+}
+
+// setEnvIfNeither sets the variable unless it or the mutually exclusive one is
+// set, like the include and exclude lists of interfaces of Open MPI.
+func setEnvIfNeither(container *corev1.Container, name, exclusive, value string) {
+	if !hasEnv(container, exclusive) {
+		setEnvIfUnset(container, name, value)
+	}
+}
+
+func hasEnv(container *corev1.Container, name string) bool {
+	for _, env := range container.Env {
+		if env.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func setEnvIfUnset(container *corev1.Container, name, value string) {
+	if !hasEnv(container, name) {
+		container.Env = append(container.Env, corev1.EnvVar{Name: name, Value: value})
+	}
+}
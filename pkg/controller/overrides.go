@@ -0,0 +1,94 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// setWorkerOverrides applies the overrides whose range includes the index to
+// the pod template of the worker, in order, so that the last one takes
+// precedence.
+func setWorkerOverrides(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, index int) {
+	for i := range mpiJob.Spec.WorkerOverrides {
+		override := &mpiJob.Spec.WorkerOverrides[i]
+		start := int(override.StartIndex)
+		end := int(ptr.Deref(override.EndIndex, override.StartIndex))
+		if index < start || index > end {
+			continue
+		}
+		if len(override.NodeSelector) > 0 && podTemplate.Spec.NodeSelector == nil {
+			podTemplate.Spec.NodeSelector = make(map[string]string)
+		}
+		for key, value := range override.NodeSelector {
+			podTemplate.Spec.NodeSelector[key] = value
+		}
+		if len(podTemplate.Spec.Containers) == 0 {
+			continue
+		}
+		container := &podTemplate.Spec.Containers[0]
+		if override.Resources != nil {
+			mergeResources(&container.Resources, override.Resources)
+		}
+		for _, env := range override.Env {
+			setEnv(container, env)
+		}
+	}
+}
+
+// mergeResources sets the limits, requests and claims of the override in the
+// resources, replacing the ones with the same name.
+func mergeResources(resources, override *corev1.ResourceRequirements) {
+	if len(override.Limits) > 0 && resources.Limits == nil {
+		resources.Limits = make(corev1.ResourceList)
+	}
+	for name, quantity := range override.Limits {
+		resources.Limits[name] = quantity.DeepCopy()
+	}
+	if len(override.Requests) > 0 && resources.Requests == nil {
+		resources.Requests = make(corev1.ResourceList)
+	}
+	for name, quantity := range override.Requests {
+		resources.Requests[name] = quantity.DeepCopy()
+	}
+	for _, claim := range override.Claims {
+		found := false
+		for i := range resources.Claims {
+			if resources.Claims[i].Name == claim.Name {
+				resources.Claims[i] = claim
+				found = true
+				break
+			}
+		}
+		if !found {
+			resources.Claims = append(resources.Claims, claim)
+		}
+	}
+}
+
+// setEnv replaces the variable of the container with the same name, or adds
+// it.
+func setEnv(container *corev1.Container, env corev1.EnvVar) {
+	for i := range container.Env {
+		if container.Env[i].Name == env.Name {
+			container.Env[i] = *env.DeepCopy()
+			return
+		}
+	}
+	container.Env = append(container.Env, *env.DeepCopy())
+}
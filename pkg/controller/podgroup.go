@@ -16,6 +16,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	"github.com/google/go-cmp/cmp"
@@ -60,6 +61,8 @@ type PodGroupControl interface {
 	decoratePodTemplateSpec(pts *corev1.PodTemplateSpec, mpiJobName string)
 	// calculatePGMinResources will calculate minResources for podGroup.
 	calculatePGMinResources(minMember *int32, mpiJob *kubeflow.MPIJob) *corev1.ResourceList
+	// setDRAResources makes calculatePGMinResources count the devices of the claims.
+	setDRAResources(dra *DRAResources)
 	// pgSpecsAreEqual will return true if the spec fields of two podGroup are equals.
 	pgSpecsAreEqual(a, b metav1.Object) bool
 }
@@ -70,6 +73,7 @@ type VolcanoCtrl struct {
 	InformerFactory     volcanoinformers.SharedInformerFactory
 	PodGroupInformer    volcanopodgroupinformer.PodGroupInformer
 	PriorityClassLister schedulinglisters.PriorityClassLister
+	DRAResources        *DRAResources
 	schedulerName       string
 }
 
@@ -182,7 +186,11 @@ func (v *VolcanoCtrl) calculatePGMinResources(minMember *int32, mpiJob *kubeflow
 	}
 
 	// sort task by priorityClasses
-	return calPGMinResource(minMember, mpiJob, v.PriorityClassLister)
+	return calPGMinResource(minMember, mpiJob, v.PriorityClassLister, v.DRAResources)
+}
+
+func (v *VolcanoCtrl) setDRAResources(dra *DRAResources) {
+	v.DRAResources = dra
 }
 
 func (v *VolcanoCtrl) pgSpecsAreEqual(a, b metav1.Object) bool {
@@ -199,6 +207,7 @@ type SchedulerPluginsCtrl struct {
 	InformerFactory     schedinformers.SharedInformerFactory
 	PodGroupInformer    schedinformer.PodGroupInformer
 	PriorityClassLister schedulinglisters.PriorityClassLister
+	DRAResources        *DRAResources
 	schedulerName       string
 }
 
@@ -322,7 +331,11 @@ func (s *SchedulerPluginsCtrl) calculatePGMinResources(minMember *int32, mpiJob
 		return nil
 	}
 
-	return calPGMinResource(minMember, mpiJob, s.PriorityClassLister)
+	return calPGMinResource(minMember, mpiJob, s.PriorityClassLister, s.DRAResources)
+}
+
+func (s *SchedulerPluginsCtrl) setDRAResources(dra *DRAResources) {
+	s.DRAResources = dra
 }
 
 func (s *SchedulerPluginsCtrl) pgSpecsAreEqual(a, b metav1.Object) bool {
@@ -333,8 +346,46 @@ func (s *SchedulerPluginsCtrl) pgSpecsAreEqual(a, b metav1.Object) bool {
 
 var _ PodGroupControl = &SchedulerPluginsCtrl{}
 
-// calPGMinResource returns the minimum resource for mpiJob with minMembers
-func calPGMinResource(minMember *int32, mpiJob *kubeflow.MPIJob, pcLister schedulinglisters.PriorityClassLister) *corev1.ResourceList {
+// calPGMinResource returns the minimum resource for mpiJob with minMembers,
+// including the devices of the claims of the pods if dra is set.
+// podGroupUnschedulable returns whether the PodGroup reports that its pods
+// can't be scheduled together, with the message of the scheduler.
+func podGroupUnschedulable(pg metav1.Object) (bool, string) {
+	switch podGroup := pg.(type) {
+	case *volcanov1beta1.PodGroup:
+		for _, cond := range podGroup.Status.Conditions {
+			if cond.Type == volcanov1beta1.PodGroupUnschedulableType && cond.Status == corev1.ConditionTrue {
+				return true, cond.Message
+			}
+		}
+	case *schedv1alpha1.PodGroup:
+		if podGroup.Status.Phase == schedv1alpha1.PodGroupUnknown {
+			return true, "some of the minMember pods can't be scheduled"
+		}
+	}
+	return false, ""
+}
+
+// syncGangSchedulability sets the Running condition of the MPIJob to false
+// with the GangNotSchedulable reason while its PodGroup can't be scheduled,
+// and returns whether the condition changed.
+func (c *MPIJobController) syncGangSchedulability(mpiJob *kubeflow.MPIJob, pg metav1.Object) bool {
+	unschedulable, reason := podGroupUnschedulable(pg)
+	if !unschedulable {
+		return false
+	}
+	msg := fmt.Sprintf("PodGroup %s of MPIJob %s/%s is not schedulable", pg.GetName(), mpiJob.Namespace, mpiJob.Name)
+	if reason != "" {
+		msg = truncateMessage(msg + ": " + reason)
+	}
+	if !updateMPIJobConditions(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, kubeflow.GangNotSchedulableReason, msg, c.clock) {
+		return false
+	}
+	c.recorder.Event(mpiJob, corev1.EventTypeWarning, kubeflow.GangNotSchedulableReason, msg)
+	return true
+}
+
+func calPGMinResource(minMember *int32, mpiJob *kubeflow.MPIJob, pcLister schedulinglisters.PriorityClassLister, dra *DRAResources) *corev1.ResourceList {
 	var order replicasOrder
 	for rt, replica := range mpiJob.Spec.MPIReplicaSpecs {
 		rp := replicaPriority{
@@ -383,6 +434,34 @@ func calPGMinResource(minMember *int32, mpiJob *kubeflow.MPIJob, pcLister schedu
 		for _, c := range rp.Template.Spec.Containers {
 			addResources(minResources, c.Resources, int64(*rp.Replicas))
 		}
+		if dra != nil {
+			if devices := dra.resources(mpiJob.Namespace, &rp.Template.Spec); len(devices) > 0 {
+				addResources(minResources, corev1.ResourceRequirements{Requests: devices}, int64(*rp.Replicas))
+			}
+		}
+	}
+	// The workers of the groups follow the Worker replicas, up to minMember.
+	members := replicas
+	if minMember != nil {
+		members = min(members, *minMember)
+	}
+	for _, group := range mpiJob.Spec.WorkerGroups {
+		count := group.Replicas
+		if minMember != nil {
+			count = min(count, *minMember-members)
+		}
+		if count <= 0 {
+			break
+		}
+		for _, c := range group.Template.Spec.Containers {
+			addResources(minResources, c.Resources, int64(count))
+		}
+		if dra != nil {
+			if devices := dra.resources(mpiJob.Namespace, &group.Template.Spec); len(devices) > 0 {
+				addResources(minResources, corev1.ResourceRequirements{Requests: devices}, int64(count))
+			}
+		}
+		members += count
 	}
 	return &minResources
 }
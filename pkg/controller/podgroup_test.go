@@ -24,6 +24,7 @@ import (
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
@@ -576,6 +577,74 @@ func TestCalculatePGMinResources(t *testing.T) {
 		priorityClasses []*schedulingv1.PriorityClass
 		want            *corev1.ResourceList
 	}{
+		"with worker groups up to minMember": {
+			minMember: ptr.To[int32](4),
+			job: &kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: kubeflow.MPIJobSpec{
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas: ptr.To[int32](1),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Resources: corev1.ResourceRequirements{
+											Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+										},
+									}},
+								},
+							},
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas: ptr.To[int32](1),
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Resources: corev1.ResourceRequirements{
+											Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")},
+										},
+									}},
+								},
+							},
+						},
+					},
+					WorkerGroups: []kubeflow.WorkerGroup{
+						{
+							Name:     "gpu",
+							Replicas: 1,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Resources: corev1.ResourceRequirements{
+											Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+										},
+									}},
+								},
+							},
+						},
+						{
+							Name:     "cpu",
+							Replicas: 4,
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{
+										Resources: corev1.ResourceRequirements{
+											Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("64")},
+										},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("75"),
+				"nvidia.com/gpu":   resource.MustParse("8"),
+			},
+		},
 		"schedulingPolicy.minResources isn't empty": {
 			job: &kubeflow.MPIJob{
 				ObjectMeta: metav1.ObjectMeta{
@@ -959,3 +1028,89 @@ func TestReplicasOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncGangSchedulability(t *testing.T) {
+	unschedulableCondition := &kubeflow.JobCondition{Type: kubeflow.JobRunning, Status: corev1.ConditionFalse, Reason: kubeflow.GangNotSchedulableReason}
+	cases := map[string]struct {
+		condition     *kubeflow.JobCondition
+		podGroup      metav1.Object
+		wantChanged   bool
+		wantCondition *kubeflow.JobCondition
+	}{
+		"volcano podgroup pending": {
+			podGroup: &volcanov1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Status:     volcanov1beta1.PodGroupStatus{Phase: volcanov1beta1.PodGroupPending},
+			},
+		},
+		"volcano podgroup unschedulable": {
+			podGroup: &volcanov1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Status: volcanov1beta1.PodGroupStatus{
+					Phase: volcanov1beta1.PodGroupPending,
+					Conditions: []volcanov1beta1.PodGroupCondition{{
+						Type:    volcanov1beta1.PodGroupUnschedulableType,
+						Status:  corev1.ConditionTrue,
+						Reason:  volcanov1beta1.NotEnoughResourcesReason,
+						Message: "4/4 tasks in gang unschedulable: pod group is not ready, 4 Pending, 4 minAvailable",
+					}},
+				},
+			},
+			wantChanged:   true,
+			wantCondition: unschedulableCondition,
+		},
+		"volcano podgroup still unschedulable": {
+			condition: unschedulableCondition,
+			podGroup: &volcanov1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Status: volcanov1beta1.PodGroupStatus{
+					Conditions: []volcanov1beta1.PodGroupCondition{{
+						Type:   volcanov1beta1.PodGroupUnschedulableType,
+						Status: corev1.ConditionTrue,
+					}},
+				},
+			},
+			wantCondition: unschedulableCondition,
+		},
+		"scheduler-plugins podgroup unknown": {
+			podGroup: &schedv1alpha1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Status:     schedv1alpha1.PodGroupStatus{Phase: schedv1alpha1.PodGroupUnknown},
+			},
+			wantChanged:   true,
+			wantCondition: unschedulableCondition,
+		},
+		"scheduler-plugins podgroup scheduling": {
+			podGroup: &schedv1alpha1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Status:     schedv1alpha1.PodGroupStatus{Phase: schedv1alpha1.PodGroupScheduling},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			c := &MPIJobController{
+				recorder: recorder,
+				clock:    clock.RealClock{},
+			}
+			mpiJob := &kubeflow.MPIJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			if tc.condition != nil {
+				mpiJob.Status.Conditions = []kubeflow.JobCondition{*tc.condition}
+			}
+			if changed := c.syncGangSchedulability(mpiJob, tc.podGroup); changed != tc.wantChanged {
+				t.Errorf("Unexpected changed %t, want %t", changed, tc.wantChanged)
+			}
+			var gotCondition *kubeflow.JobCondition
+			if cond := getCondition(mpiJob.Status, kubeflow.JobRunning); cond != nil {
+				gotCondition = &kubeflow.JobCondition{Type: cond.Type, Status: cond.Status, Reason: cond.Reason}
+			}
+			if diff := cmp.Diff(tc.wantCondition, gotCondition); diff != "" {
+				t.Errorf("Unexpected Running condition (-want,+got):\n%s", diff)
+			}
+			if got := len(recorder.Events) == 1; got != tc.wantChanged {
+				t.Errorf("Recorded an event: %t, want %t", got, tc.wantChanged)
+			}
+		})
+	}
+}
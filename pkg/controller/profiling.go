@@ -0,0 +1,233 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	profilerInstallContainerName = "mpi-profiler-install"
+	profilerVolumeName           = "mpi-profiler"
+	profilerMountPath            = "/opt/mpi-profiler"
+	profilerImagePath            = "/profiler"
+	profilesVolumeName           = "mpi-profiles"
+	profilesMountPath            = "/profiles"
+	profilesDirEnv               = "MPI_PROFILES_DIR"
+
+	profilingNotAppliedReason = "ProfilingNotApplied"
+)
+
+var (
+	// mpiLaunchers are the programs of the MPI implementations starting the
+	// MPI processes.
+	mpiLaunchers = sets.New("mpirun", "mpiexec", "mpiexec.hydra", "orterun", "prterun")
+
+	// mpiLauncherOptionValues are the numbers of values of the options of the
+	// MPI launchers that take values not joined with '='. The other options
+	// are flags.
+	mpiLauncherOptionValues = map[string]int{
+		"-n": 1, "-np": 1, "--np": 1, "-c": 1, "-N": 1,
+		"-npernode": 1, "--npernode": 1, "-ppn": 1, "-perhost": 1,
+		"-H": 1, "-host": 1, "--host": 1, "-hosts": 1,
+		"-hostfile": 1, "--hostfile": 1, "-machinefile": 1, "--machinefile": 1, "-f": 1,
+		"-rf": 1, "--rankfile": 1,
+		"-x": 1, "-genvlist": 1, "-envlist": 1,
+		"-bind-to": 1, "--bind-to": 1, "-map-by": 1, "--map-by": 1, "-rank-by": 1, "--rank-by": 1,
+		"-wdir": 1, "-wd": 1, "--wdir": 1, "-prefix": 1, "--prefix": 1,
+		"-output-filename": 1, "--output-filename": 1,
+		"-am": 1, "--am": 1, "-tune": 1, "--tune": 1, "-timeout": 1, "--timeout": 1,
+		"-iface": 1, "-launcher": 1, "-bootstrap": 1, "-bootstrap-exec": 1, "-configfile": 1,
+		"-mca": 2, "--mca": 2, "-gmca": 2, "--gmca": 2, "-genv": 2, "-env": 2,
+	}
+
+	shellWordRE = regexp.MustCompile(`\S+`)
+)
+
+func isProfilingEnabled(mpiJob *kubeflow.MPIJob) bool {
+	profiling := mpiJob.Spec.Profiling
+	return profiling != nil && profiling.Tool != "" && profiling.Tool != kubeflow.ProfilingToolNone
+}
+
+// profilerCommand returns the command that wraps each MPI process.
+func profilerCommand(profiling *kubeflow.Profiling) []string {
+	bin := func(name string) string {
+		if profiling.Image != "" {
+			return path.Join(profilerMountPath, "bin", name)
+		}
+		return name
+	}
+	var command []string
+	switch profiling.Tool {
+	case kubeflow.ProfilingToolNsys:
+		// nsys replaces %h and %p with the hostname and the process ID.
+		command = []string{bin("nsys"), "profile", "--output=" + profilesMountPath + "/%h.%p"}
+	case kubeflow.ProfilingToolHPCToolkit:
+		command = []string{bin("hpcrun"), "-o", profilesMountPath + "/hpctoolkit-measurements"}
+	case kubeflow.ProfilingToolCustom:
+		command = slices.Clone(profiling.Command)
+	}
+	return append(command, profiling.Args...)
+}
+
+// setupProfiling mounts the volume of the profiles in the container and, when
+// spec.profiling.image is set, copies the profiler to the pod with an init
+// container.
+func setupProfiling(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, container *corev1.Container) {
+	profiling := mpiJob.Spec.Profiling
+	profilesVolume := corev1.Volume{
+		Name: profilesVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	if profiling.OutputClaimName != "" {
+		profilesVolume.VolumeSource = corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: profiling.OutputClaimName,
+			},
+		}
+	}
+	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, profilesVolume)
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      profilesVolumeName,
+		MountPath: profilesMountPath,
+	})
+	container.Env = append(container.Env, corev1.EnvVar{Name: profilesDirEnv, Value: profilesMountPath})
+	if profiling.Image == "" {
+		return
+	}
+	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
+		Name: profilerVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+	mount := corev1.VolumeMount{
+		Name:      profilerVolumeName,
+		MountPath: profilerMountPath,
+	}
+	podTemplate.Spec.InitContainers = append(podTemplate.Spec.InitContainers, corev1.Container{
+		Name:         profilerInstallContainerName,
+		Image:        profiling.Image,
+		Command:      []string{"cp", "-a", profilerImagePath + "/.", profilerMountPath},
+		VolumeMounts: []corev1.VolumeMount{mount},
+	})
+	mount.ReadOnly = true
+	container.VolumeMounts = append(container.VolumeMounts, mount)
+}
+
+// setupProfilingOnLauncher sets up the profiling in the launcher pod and
+// wraps the MPI processes of its command with the profiler.
+func (c *MPIJobController) setupProfilingOnLauncher(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, container *corev1.Container) {
+	setupProfiling(mpiJob, podTemplate, container)
+	if !wrapMPIProcesses(container, profilerCommand(mpiJob.Spec.Profiling)) {
+		c.recorder.Event(mpiJob, corev1.EventTypeWarning, profilingNotAppliedReason, "The launcher command doesn't run mpirun or mpiexec with a program to profile")
+	}
+}
+
+// wrapMPIProcesses inserts the profiler before the program of the first MPI
+// launcher in the command and arguments of the container. The launcher can be
+// an argument of its own, or a word of a shell script, like in
+// ["sh", "-c", "mpirun -np 2 python train.py"]. It returns false if no
+// launcher was found.
+func wrapMPIProcesses(container *corev1.Container, profiler []string) bool {
+	args := append(slices.Clone(container.Command), container.Args...)
+	for i, arg := range args {
+		if mpiLaunchers.Has(path.Base(arg)) {
+			program := mpiProgramIndex(args, i+1)
+			if program < 0 {
+				return false
+			}
+			if program < len(container.Command) {
+				container.Command = slices.Insert(container.Command, program, profiler...)
+			} else {
+				container.Args = slices.Insert(container.Args, program-len(container.Command), profiler...)
+			}
+			return true
+		}
+		if script, ok := wrapMPIProcessesInScript(arg, profiler); ok {
+			if i < len(container.Command) {
+				container.Command[i] = script
+			} else {
+				container.Args[i-len(container.Command)] = script
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// wrapMPIProcessesInScript inserts the profiler, quoted, before the program
+// of the first MPI launcher of the shell script.
+func wrapMPIProcessesInScript(script string, profiler []string) (string, bool) {
+	bounds := shellWordRE.FindAllStringIndex(script, -1)
+	if len(bounds) < 2 {
+		return "", false
+	}
+	words := make([]string, len(bounds))
+	for i, b := range bounds {
+		words[i] = script[b[0]:b[1]]
+	}
+	for i, word := range words {
+		if !mpiLaunchers.Has(path.Base(word)) {
+			continue
+		}
+		program := mpiProgramIndex(words, i+1)
+		if program < 0 {
+			return "", false
+		}
+		quoted := make([]string, len(profiler))
+		for j, arg := range profiler {
+			quoted[j] = shellQuote(arg)
+		}
+		offset := bounds[program][0]
+		return script[:offset] + strings.Join(quoted, " ") + " " + script[offset:], true
+	}
+	return "", false
+}
+
+// mpiProgramIndex returns the index of the program started by an MPI
+// launcher, whose options start at the index start, or -1 if there is none.
+func mpiProgramIndex(args []string, start int) int {
+	for i := start; i < len(args); {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			return i
+		}
+		if strings.Contains(arg, "=") {
+			i++
+			continue
+		}
+		i += 1 + mpiLauncherOptionValues[arg]
+	}
+	return -1
+}
+
+// shellQuote quotes the argument for a POSIX shell, if needed.
+func shellQuote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`!*?[]{}()<>|&;#~") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestWrapMPIProcesses(t *testing.T) {
+	profiler := []string{"nsys", "profile", "--output=/profiles/%h.%p"}
+	cases := map[string]struct {
+		container     corev1.Container
+		wantContainer corev1.Container
+		wantWrapped   bool
+	}{
+		"command": {
+			container: corev1.Container{
+				Command: []string{"mpirun", "-np", "4", "--mca", "btl", "^openib", "-x", "NCCL_DEBUG", "--bind-to=none", "--allow-run-as-root", "python", "train.py"},
+			},
+			wantContainer: corev1.Container{
+				Command: []string{"mpirun", "-np", "4", "--mca", "btl", "^openib", "-x", "NCCL_DEBUG", "--bind-to=none", "--allow-run-as-root", "nsys", "profile", "--output=/profiles/%h.%p", "python", "train.py"},
+			},
+			wantWrapped: true,
+		},
+		"program in the args": {
+			container: corev1.Container{
+				Command: []string{"/opt/openmpi/bin/mpirun", "-n", "2"},
+				Args:    []string{"/app/bench", "--size", "1G"},
+			},
+			wantContainer: corev1.Container{
+				Command: []string{"/opt/openmpi/bin/mpirun", "-n", "2"},
+				Args:    []string{"nsys", "profile", "--output=/profiles/%h.%p", "/app/bench", "--size", "1G"},
+			},
+			wantWrapped: true,
+		},
+		"shell script": {
+			container: corev1.Container{
+				Command: []string{"sh", "-c"},
+				Args:    []string{"cd /data && mpiexec -genv I_MPI_DEBUG 5 -ppn 1 python train.py; echo done"},
+			},
+			wantContainer: corev1.Container{
+				Command: []string{"sh", "-c"},
+				Args:    []string{"cd /data && mpiexec -genv I_MPI_DEBUG 5 -ppn 1 nsys profile --output=/profiles/%h.%p python train.py; echo done"},
+			},
+			wantWrapped: true,
+		},
+		"no MPI launcher": {
+			container: corev1.Container{
+				Command: []string{"python", "train.py"},
+			},
+			wantContainer: corev1.Container{
+				Command: []string{"python", "train.py"},
+			},
+		},
+		"no program": {
+			container: corev1.Container{
+				Command: []string{"mpirun", "--version"},
+			},
+			wantContainer: corev1.Container{
+				Command: []string{"mpirun", "--version"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			container := tc.container.DeepCopy()
+			if wrapped := wrapMPIProcesses(container, profiler); wrapped != tc.wantWrapped {
+				t.Errorf("wrapMPIProcesses() returned %t, want %t", wrapped, tc.wantWrapped)
+			}
+			if diff := cmp.Diff(tc.wantContainer, *container); diff != "" {
+				t.Errorf("Unexpected container (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProfilerCommand(t *testing.T) {
+	cases := map[string]struct {
+		profiling   kubeflow.Profiling
+		wantCommand []string
+	}{
+		"nsys": {
+			profiling: kubeflow.Profiling{
+				Tool: kubeflow.ProfilingToolNsys,
+				Args: []string{"--trace=cuda,nvtx,mpi"},
+			},
+			wantCommand: []string{"nsys", "profile", "--output=/profiles/%h.%p", "--trace=cuda,nvtx,mpi"},
+		},
+		"hpctoolkit from an image": {
+			profiling: kubeflow.Profiling{
+				Tool:  kubeflow.ProfilingToolHPCToolkit,
+				Image: "hpctoolkit:2024.01",
+			},
+			wantCommand: []string{"/opt/mpi-profiler/bin/hpcrun", "-o", "/profiles/hpctoolkit-measurements"},
+		},
+		"custom": {
+			profiling: kubeflow.Profiling{
+				Tool:    kubeflow.ProfilingToolCustom,
+				Command: []string{"perf", "record"},
+				Args:    []string{"-g"},
+			},
+			wantCommand: []string{"perf", "record", "-g"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantCommand, profilerCommand(&tc.profiling)); diff != "" {
+				t.Errorf("Unexpected command (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetupProfiling(t *testing.T) {
+	mpiJob := &kubeflow.MPIJob{
+		Spec: kubeflow.MPIJobSpec{
+			Profiling: &kubeflow.Profiling{
+				Tool:            kubeflow.ProfilingToolNsys,
+				Image:           "nsight-systems:2024.6",
+				OutputClaimName: "profiles",
+			},
+		},
+	}
+	podTemplate := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "worker"}},
+		},
+	}
+	setupProfiling(mpiJob, podTemplate, &podTemplate.Spec.Containers[0])
+	want := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{
+				Name:         "mpi-profiler-install",
+				Image:        "nsight-systems:2024.6",
+				Command:      []string{"cp", "-a", "/profiler/.", "/opt/mpi-profiler"},
+				VolumeMounts: []corev1.VolumeMount{{Name: "mpi-profiler", MountPath: "/opt/mpi-profiler"}},
+			}},
+			Containers: []corev1.Container{{
+				Name: "worker",
+				Env:  []corev1.EnvVar{{Name: "MPI_PROFILES_DIR", Value: "/profiles"}},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "mpi-profiles", MountPath: "/profiles"},
+					{Name: "mpi-profiler", MountPath: "/opt/mpi-profiler", ReadOnly: true},
+				},
+			}},
+			Volumes: []corev1.Volume{
+				{
+					Name: "mpi-profiles",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "profiles"},
+					},
+				},
+				{
+					Name: "mpi-profiler",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, podTemplate); diff != "" {
+		t.Errorf("Unexpected pod template (-want,+got):\n%s", diff)
+	}
+}
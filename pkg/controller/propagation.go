@@ -0,0 +1,53 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// propagateMetadata copies the labels and annotations of the MPIJob that
+// start with one of the propagated prefixes to an object generated for it.
+// The labels and annotations already set in the object take precedence.
+func (c *MPIJobController) propagateMetadata(mpiJob *kubeflow.MPIJob, meta *metav1.ObjectMeta) {
+	meta.Labels = propagate(mpiJob.Labels, meta.Labels, c.PropagatedLabelPrefixes)
+	meta.Annotations = propagate(mpiJob.Annotations, meta.Annotations, c.PropagatedAnnotationPrefixes)
+}
+
+func propagate(from, to map[string]string, prefixes []string) map[string]string {
+	for key, value := range from {
+		if _, ok := to[key]; ok || !hasAnyPrefix(key, prefixes) {
+			continue
+		}
+		if to == nil {
+			to = make(map[string]string)
+		}
+		to[key] = value
+	}
+	return to
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	volcanolisters "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// defaultVolcanoQueue is the queue of the PodGroups without queue.
+	defaultVolcanoQueue = "default"
+)
+
+// queueAdmission checks the capacity of the Volcano queues of MPIJobs.
+type queueAdmission struct {
+	lister volcanolisters.QueueLister
+	synced cache.InformerSynced
+	// hold postpones the creation of the pods while the queue is full.
+	hold bool
+}
+
+// EnableVolcanoQueueAdmission makes the controller check the capacity of the
+// Volcano queues of the MPIJobs before their PodGroups are admitted, and
+// report full queues with the QueueFull condition. If hold is set, the pods
+// are only created once the queue has capacity for the MPIJob. It must be
+// called before the informer factory of the PodGroupCtrl is started.
+func (c *MPIJobController) EnableVolcanoQueueAdmission(hold bool) error {
+	volcanoCtrl, ok := c.PodGroupCtrl.(*VolcanoCtrl)
+	if !ok {
+		return errors.New("the Volcano queue admission requires the volcano gang scheduler")
+	}
+	informer := volcanoCtrl.InformerFactory.Scheduling().V1beta1().Queues()
+	if _, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, new interface{}) {
+			c.enqueueMPIJobsOfFullQueue(new)
+		},
+	}); err != nil {
+		return fmt.Errorf("adding Queue event handler: %w", err)
+	}
+	c.queueAdmission = &queueAdmission{
+		lister: informer.Lister(),
+		synced: informer.Informer().HasSynced,
+		hold:   hold,
+	}
+	return nil
+}
+
+// enqueueMPIJobsOfFullQueue enqueues the MPIJobs waiting for capacity in the
+// queue, once its allocated resources or capability change.
+func (c *MPIJobController) enqueueMPIJobsOfFullQueue(obj interface{}) {
+	queue, ok := obj.(*volcanov1beta1.Queue)
+	if !ok {
+		return
+	}
+	mpiJobs, err := c.mpiJobLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list MPIJobs of queue %s: %v", queue.Name, err)
+		return
+	}
+	for _, mpiJob := range mpiJobs {
+		if cond := getCondition(mpiJob.Status, kubeflow.JobQueueFull); cond != nil && cond.Status == corev1.ConditionTrue {
+			if podGroup, err := c.PodGroupCtrl.getPodGroup(mpiJob.Namespace, mpiJob.Name); err == nil && volcanoQueueName(podGroup) == queue.Name {
+				c.enqueueMPIJob(mpiJob)
+			}
+		}
+	}
+}
+
+func volcanoQueueName(pg metav1.Object) string {
+	if podGroup, ok := pg.(*volcanov1beta1.PodGroup); ok && podGroup.Spec.Queue != "" {
+		return podGroup.Spec.Queue
+	}
+	return defaultVolcanoQueue
+}
+
+// syncQueueCapacity sets the QueueFull condition of the MPIJob from the
+// capacity of the queue of its PodGroup, and returns whether the creation of
+// the pods is held and whether the condition changed.
+func (c *MPIJobController) syncQueueCapacity(mpiJob *kubeflow.MPIJob, pg metav1.Object) (held, changed bool) {
+	if c.queueAdmission == nil {
+		return false, false
+	}
+	podGroup, ok := pg.(*volcanov1beta1.PodGroup)
+	if !ok {
+		return false, false
+	}
+	full, reason, message := c.queueFull(podGroup)
+	status := corev1.ConditionFalse
+	if full {
+		status = corev1.ConditionTrue
+	} else if getCondition(mpiJob.Status, kubeflow.JobQueueFull) == nil {
+		// The condition is only added to MPIJobs that waited for capacity.
+		return false, false
+	}
+	if updateMPIJobConditions(mpiJob, kubeflow.JobQueueFull, status, reason, message, c.clock) {
+		changed = true
+		if full {
+			c.recorder.Event(mpiJob, corev1.EventTypeWarning, reason, message)
+		}
+	}
+	return full && c.queueAdmission.hold, changed
+}
+
+// queueFull returns whether the queue of the PodGroup can't admit it, with
+// the reason and the message of the QueueFull condition.
+func (c *MPIJobController) queueFull(podGroup *volcanov1beta1.PodGroup) (bool, string, string) {
+	queueName := volcanoQueueName(podGroup)
+	// The resources of admitted PodGroups are in the allocated resources of
+	// the queue.
+	if podGroup.Status.Phase == volcanov1beta1.PodGroupInqueue || podGroup.Status.Phase == volcanov1beta1.PodGroupRunning {
+		return false, kubeflow.PodGroupAdmittedReason, fmt.Sprintf("PodGroup %s was admitted by queue %s", podGroup.Name, queueName)
+	}
+	queue, err := c.queueAdmission.lister.Get(queueName)
+	if apierrors.IsNotFound(err) {
+		return true, kubeflow.QueueNotFoundReason, fmt.Sprintf("Queue %s doesn't exist", queueName)
+	}
+	if err != nil {
+		klog.Errorf("Failed to get queue %s: %v", queueName, err)
+		return false, kubeflow.QueueHasCapacityReason, fmt.Sprintf("Queue %s can't be checked", queueName)
+	}
+	if queue.Status.State != "" && queue.Status.State != volcanov1beta1.QueueStateOpen {
+		return true, kubeflow.QueueClosedReason, fmt.Sprintf("Queue %s is %s", queueName, queue.Status.State)
+	}
+	if lacking := lackingResources(queue, podGroup.Spec.MinResources); len(lacking) > 0 {
+		return true, kubeflow.QueueFullReason, fmt.Sprintf("Queue %s lacks %s for the minResources of PodGroup %s", queueName, strings.Join(lacking, ", "), podGroup.Name)
+	}
+	return false, kubeflow.QueueHasCapacityReason, fmt.Sprintf("Queue %s has capacity for PodGroup %s", queueName, podGroup.Name)
+}
+
+// lackingResources returns the resources of the capability of the queue that
+// can't fit the minResources on top of the allocated resources.
+func lackingResources(queue *volcanov1beta1.Queue, minResources *corev1.ResourceList) []string {
+	if minResources == nil {
+		return nil
+	}
+	var lacking []string
+	for name, request := range *minResources {
+		capability, ok := queue.Spec.Capability[name]
+		if !ok {
+			continue
+		}
+		needed := queue.Status.Allocated[name]
+		needed.Add(request)
+		if needed.Cmp(capability) > 0 {
+			lacking = append(lacking, string(name))
+		}
+	}
+	sort.Strings(lacking)
+	return lacking
+}
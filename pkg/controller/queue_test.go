@@ -0,0 +1,154 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	volcanov1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	volcanofake "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	volcanoinformers "volcano.sh/apis/pkg/client/informers/externalversions"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestSyncQueueCapacity(t *testing.T) {
+	queue := func(name string, state volcanov1beta1.QueueState, capability, allocated corev1.ResourceList) *volcanov1beta1.Queue {
+		return &volcanov1beta1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       volcanov1beta1.QueueSpec{Capability: capability},
+			Status:     volcanov1beta1.QueueStatus{State: state, Allocated: allocated},
+		}
+	}
+	queues := []*volcanov1beta1.Queue{
+		queue("default", volcanov1beta1.QueueStateOpen,
+			corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("16"), "nvidia.com/gpu": resource.MustParse("8")},
+			corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), "nvidia.com/gpu": resource.MustParse("6")}),
+		queue("closed", volcanov1beta1.QueueStateClosed, nil, nil),
+	}
+	podGroup := func(queue string, phase volcanov1beta1.PodGroupPhase, minResources corev1.ResourceList) *volcanov1beta1.PodGroup {
+		return &volcanov1beta1.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       volcanov1beta1.PodGroupSpec{Queue: queue, MinResources: &minResources},
+			Status:     volcanov1beta1.PodGroupStatus{Phase: phase},
+		}
+	}
+	fullCondition := &kubeflow.JobCondition{Type: kubeflow.JobQueueFull, Status: corev1.ConditionTrue, Reason: kubeflow.QueueFullReason}
+	cases := map[string]struct {
+		hold          bool
+		condition     *kubeflow.JobCondition
+		podGroup      *volcanov1beta1.PodGroup
+		wantHeld      bool
+		wantChanged   bool
+		wantCondition *kubeflow.JobCondition
+	}{
+		"queue has capacity": {
+			podGroup: podGroup("", volcanov1beta1.PodGroupPending, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("12"), "nvidia.com/gpu": resource.MustParse("2")}),
+		},
+		"queue full": {
+			podGroup:      podGroup("", volcanov1beta1.PodGroupPending, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("16"), "nvidia.com/gpu": resource.MustParse("4"), corev1.ResourceMemory: resource.MustParse("1Ti")}),
+			wantChanged:   true,
+			wantCondition: fullCondition,
+		},
+		"queue full with hold": {
+			hold:          true,
+			podGroup:      podGroup("", volcanov1beta1.PodGroupPending, corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}),
+			wantHeld:      true,
+			wantChanged:   true,
+			wantCondition: fullCondition,
+		},
+		"still full with hold": {
+			hold:          true,
+			condition:     fullCondition,
+			podGroup:      podGroup("default", volcanov1beta1.PodGroupPending, corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}),
+			wantHeld:      true,
+			wantCondition: fullCondition,
+		},
+		"queue closed": {
+			hold:          true,
+			podGroup:      podGroup("closed", volcanov1beta1.PodGroupPending, nil),
+			wantHeld:      true,
+			wantChanged:   true,
+			wantCondition: &kubeflow.JobCondition{Type: kubeflow.JobQueueFull, Status: corev1.ConditionTrue, Reason: kubeflow.QueueClosedReason},
+		},
+		"queue not found": {
+			podGroup:      podGroup("research", volcanov1beta1.PodGroupPending, nil),
+			wantChanged:   true,
+			wantCondition: &kubeflow.JobCondition{Type: kubeflow.JobQueueFull, Status: corev1.ConditionTrue, Reason: kubeflow.QueueNotFoundReason},
+		},
+		"queue freed capacity": {
+			hold:          true,
+			condition:     fullCondition,
+			podGroup:      podGroup("", volcanov1beta1.PodGroupPending, corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")}),
+			wantChanged:   true,
+			wantCondition: &kubeflow.JobCondition{Type: kubeflow.JobQueueFull, Status: corev1.ConditionFalse, Reason: kubeflow.QueueHasCapacityReason},
+		},
+		"podgroup admitted": {
+			condition:     fullCondition,
+			podGroup:      podGroup("", volcanov1beta1.PodGroupInqueue, corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}),
+			wantChanged:   true,
+			wantCondition: &kubeflow.JobCondition{Type: kubeflow.JobQueueFull, Status: corev1.ConditionFalse, Reason: kubeflow.PodGroupAdmittedReason},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			informerFactory := volcanoinformers.NewSharedInformerFactory(volcanofake.NewSimpleClientset(), 0)
+			c := &MPIJobController{
+				PodGroupCtrl: &VolcanoCtrl{InformerFactory: informerFactory},
+				recorder:     record.NewFakeRecorder(1),
+				clock:        clock.RealClock{},
+			}
+			if err := c.EnableVolcanoQueueAdmission(tc.hold); err != nil {
+				t.Fatalf("EnableVolcanoQueueAdmission(): %v", err)
+			}
+			for _, q := range queues {
+				if err := informerFactory.Scheduling().V1beta1().Queues().Informer().GetIndexer().Add(q); err != nil {
+					t.Fatalf("Adding Queue: %v", err)
+				}
+			}
+			mpiJob := &kubeflow.MPIJob{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+			if tc.condition != nil {
+				mpiJob.Status.Conditions = []kubeflow.JobCondition{*tc.condition}
+			}
+			held, changed := c.syncQueueCapacity(mpiJob, tc.podGroup)
+			if held != tc.wantHeld {
+				t.Errorf("Unexpected held %t, want %t", held, tc.wantHeld)
+			}
+			if changed != tc.wantChanged {
+				t.Errorf("Unexpected changed %t, want %t", changed, tc.wantChanged)
+			}
+			var gotCondition *kubeflow.JobCondition
+			if cond := getCondition(mpiJob.Status, kubeflow.JobQueueFull); cond != nil {
+				gotCondition = &kubeflow.JobCondition{Type: cond.Type, Status: cond.Status, Reason: cond.Reason}
+			}
+			if diff := cmp.Diff(tc.wantCondition, gotCondition); diff != "" {
+				t.Errorf("Unexpected QueueFull condition (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnableVolcanoQueueAdmission(t *testing.T) {
+	c := &MPIJobController{PodGroupCtrl: &SchedulerPluginsCtrl{}}
+	if err := c.EnableVolcanoQueueAdmission(false); err == nil {
+		t.Errorf("EnableVolcanoQueueAdmission() succeeded with the scheduler-plugins")
+	}
+}
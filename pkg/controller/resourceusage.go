@@ -0,0 +1,134 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// setResourceUsage sets the resources used by the launcher and worker pods of
+// the finished MPIJob in its status.
+func setResourceUsage(mpiJob *kubeflow.MPIJob, launcherPods, workers []*corev1.Pod) {
+	if mpiJob.Status.StartTime == nil || mpiJob.Status.CompletionTime == nil {
+		return
+	}
+	end := mpiJob.Status.CompletionTime.Time
+	usage := &kubeflow.ResourceUsage{
+		WallClockSeconds: int64(max(end.Sub(mpiJob.Status.StartTime.Time), 0).Round(time.Second).Seconds()),
+	}
+	var cpuSeconds, gpuSeconds float64
+	// account adds the resources of the pod to the usage, and returns the
+	// time it ran.
+	account := func(pod *corev1.Pod) (time.Time, time.Time, bool) {
+		if pod == nil {
+			return time.Time{}, time.Time{}, false
+		}
+		start, stop, ok := podRunTime(pod, end)
+		if !ok {
+			return start, stop, false
+		}
+		seconds := stop.Sub(start).Seconds()
+		cpus, gpus := podResources(pod)
+		cpuSeconds += cpus * seconds
+		gpuSeconds += gpus * seconds
+		return start, stop, true
+	}
+	for _, pod := range launcherPods {
+		account(pod)
+	}
+	type edge struct {
+		time  time.Time
+		delta int32
+	}
+	var edges []edge
+	for _, pod := range workers {
+		if start, stop, ok := account(pod); ok {
+			edges = append(edges, edge{start, 1}, edge{stop, -1})
+		}
+	}
+	// The workers that stopped when others started didn't run at the same
+	// time.
+	slices.SortFunc(edges, func(a, b edge) int {
+		if c := a.time.Compare(b.time); c != 0 {
+			return c
+		}
+		return int(a.delta - b.delta)
+	})
+	var running int32
+	for _, e := range edges {
+		running += e.delta
+		usage.PeakWorkers = max(usage.PeakWorkers, running)
+	}
+	usage.CPUSeconds = int64(math.Round(cpuSeconds))
+	usage.GPUSeconds = int64(math.Round(gpuSeconds))
+	mpiJob.Status.ResourceUsage = usage
+}
+
+// podRunTime returns when the pod started and stopped running, which is when
+// its last container terminated or, if it didn't, the completion time of the
+// MPIJob. It returns false when the pod didn't start.
+func podRunTime(pod *corev1.Pod, completionTime time.Time) (time.Time, time.Time, bool) {
+	if pod.Status.StartTime == nil {
+		return time.Time{}, time.Time{}, false
+	}
+	start := pod.Status.StartTime.Time
+	stop := completionTime
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		var finished time.Time
+		for _, status := range pod.Status.ContainerStatuses {
+			if t := status.State.Terminated; t != nil && t.FinishedAt.After(finished) {
+				finished = t.FinishedAt.Time
+			}
+		}
+		if !finished.IsZero() && finished.Before(stop) {
+			stop = finished
+		}
+	}
+	if stop.Before(start) {
+		stop = start
+	}
+	return start, stop, true
+}
+
+// podResources returns the CPUs and GPUs requested by the containers of the
+// pod, falling back to their limits.
+func podResources(pod *corev1.Pod) (float64, float64) {
+	var cpus, gpus float64
+	for _, container := range pod.Spec.Containers {
+		resources := corev1.ResourceList{}
+		for name, q := range container.Resources.Limits {
+			resources[name] = q
+		}
+		for name, q := range container.Resources.Requests {
+			resources[name] = q
+		}
+		for name, q := range resources {
+			switch {
+			case name == corev1.ResourceCPU:
+				cpus += float64(q.MilliValue()) / 1000
+			case strings.HasSuffix(string(name), "/gpu"):
+				gpus += float64(q.Value())
+			}
+		}
+	}
+	return cpus, gpus
+}
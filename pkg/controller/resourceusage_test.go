@@ -0,0 +1,92 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestSetResourceUsage(t *testing.T) {
+	start := time.Now().Truncate(time.Second)
+	at := func(seconds int) *metav1.Time {
+		ts := metav1.NewTime(start.Add(time.Duration(seconds) * time.Second))
+		return &ts
+	}
+	pod := func(started *metav1.Time, finished *metav1.Time, resources corev1.ResourceRequirements) *corev1.Pod {
+		p := &corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "mpi", Resources: resources}},
+			},
+			Status: corev1.PodStatus{
+				Phase:     corev1.PodRunning,
+				StartTime: started,
+			},
+		}
+		if finished != nil {
+			p.Status.Phase = corev1.PodSucceeded
+			p.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name: "mpi",
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{FinishedAt: *finished},
+				},
+			}}
+		}
+		return p
+	}
+	mpiJob := newMPIJob("test", ptr.To[int32](4), at(0), at(100))
+	launcherPods := []*corev1.Pod{
+		pod(at(5), at(95), corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+		}),
+	}
+	workers := []*corev1.Pod{
+		pod(at(0), nil, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), "nvidia.com/gpu": resource.MustParse("1")},
+		}),
+		// Only the limits are set.
+		pod(at(10), at(50), corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), "amd.com/gpu": resource.MustParse("2")},
+		}),
+		// Started when the previous worker stopped.
+		pod(at(50), nil, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		}),
+		// Never started.
+		pod(nil, nil, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")},
+		}),
+	}
+
+	setResourceUsage(mpiJob, launcherPods, workers)
+	want := &kubeflow.ResourceUsage{
+		CPUSeconds:       335,
+		GPUSeconds:       180,
+		PeakWorkers:      2,
+		WallClockSeconds: 100,
+	}
+	if diff := cmp.Diff(want, mpiJob.Status.ResourceUsage); diff != "" {
+		t.Errorf("Unexpected resource usage (-want,+got):\n%s", diff)
+	}
+}
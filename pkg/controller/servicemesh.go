@@ -0,0 +1,109 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// Annotations of the Istio sidecar injector.
+	istioProxyConfigAnnotation   = "proxy.istio.io/config"
+	istioNativeSidecarAnnotation = "sidecar.istio.io/nativeSidecar"
+	istioHoldProxyConfig         = `{"holdApplicationUntilProxyStarts": true}`
+	istioShutdownURL             = "http://localhost:15020/quitquitquit"
+
+	// Annotations of the Linkerd proxy injector.
+	linkerdProxyAwaitAnnotation       = "config.linkerd.io/proxy-await"
+	linkerdNativeSidecarAnnotation    = "config.alpha.linkerd.io/proxy-enable-native-sidecar"
+	linkerdShutdownEndpointAnnotation = "config.alpha.linkerd.io/proxy-enable-shutdown-endpoint"
+	linkerdShutdownURL                = "http://localhost:4191/shutdown"
+
+	sidecarShutdownURLEnv = "MPI_SIDECAR_SHUTDOWN_URL"
+
+	// sidecarShutdownScript runs the command given as arguments, then asks
+	// the sidecar to exit, so that the launcher pod terminates.
+	sidecarShutdownScript = `"$@"
+rc=$?
+if command -v curl >/dev/null 2>&1; then
+  curl -fsS -X POST "$` + sidecarShutdownURLEnv + `" >/dev/null
+elif command -v wget >/dev/null 2>&1; then
+  wget -q -O /dev/null --post-data= "$` + sidecarShutdownURLEnv + `"
+else
+  false
+fi || echo "Failed to shut down the service mesh sidecar" >&2
+exit $rc
+`
+)
+
+func isNativeSidecar(mesh *kubeflow.ServiceMesh) bool {
+	return ptr.Deref(mesh.NativeSidecar, false)
+}
+
+// setServiceMeshAnnotations configures the injection of the sidecar in the
+// pods of the template. The containers start once the sidecar is ready. The
+// annotations set in the template take precedence.
+func setServiceMeshAnnotations(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, isLauncher bool) {
+	mesh := mpiJob.Spec.ServiceMesh
+	if mesh == nil {
+		return
+	}
+	if podTemplate.Annotations == nil {
+		podTemplate.Annotations = make(map[string]string)
+	}
+	setAnnotation := func(key, value string) {
+		if _, ok := podTemplate.Annotations[key]; !ok {
+			podTemplate.Annotations[key] = value
+		}
+	}
+	switch mesh.Provider {
+	case kubeflow.ServiceMeshIstio:
+		setAnnotation(istioProxyConfigAnnotation, istioHoldProxyConfig)
+		if isNativeSidecar(mesh) {
+			setAnnotation(istioNativeSidecarAnnotation, "true")
+		}
+	case kubeflow.ServiceMeshLinkerd:
+		setAnnotation(linkerdProxyAwaitAnnotation, "enabled")
+		if isNativeSidecar(mesh) {
+			setAnnotation(linkerdNativeSidecarAnnotation, "true")
+		} else if isLauncher {
+			setAnnotation(linkerdShutdownEndpointAnnotation, "true")
+		}
+	}
+}
+
+// setupServiceMeshOnLauncher wraps the command of the launcher container to
+// shut down the sidecar when the command exits, unless the sidecar is a
+// native sidecar, which Kubernetes stops.
+func setupServiceMeshOnLauncher(mpiJob *kubeflow.MPIJob, container *corev1.Container) {
+	mesh := mpiJob.Spec.ServiceMesh
+	if mesh == nil || isNativeSidecar(mesh) {
+		return
+	}
+	shutdownURL := istioShutdownURL
+	if mesh.Provider == kubeflow.ServiceMeshLinkerd {
+		shutdownURL = linkerdShutdownURL
+	}
+	command := append(append([]string{"sh", "-c", sidecarShutdownScript, "sidecar-shutdown"}, container.Command...), container.Args...)
+	container.Command = command
+	container.Args = nil
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  sidecarShutdownURLEnv,
+		Value: shutdownURL,
+	})
+}
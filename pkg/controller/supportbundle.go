@@ -0,0 +1,132 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/supportbundle"
+)
+
+// pendingKeys is a set of MPIJob keys that is safe for concurrent use.
+type pendingKeys struct {
+	mu   sync.Mutex
+	keys sets.Set[string]
+}
+
+func (p *pendingKeys) add(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.keys == nil {
+		p.keys = sets.New[string]()
+	}
+	p.keys.Insert(key)
+}
+
+func (p *pendingKeys) remove(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys.Delete(key)
+}
+
+func (p *pendingKeys) has(key string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.keys.Has(key)
+}
+
+// enqueueSupportBundle queues the collection of the support bundle of the
+// failed MPIJob, which holds the cleanup of its pods until it's stored.
+func (c *MPIJobController) enqueueSupportBundle(mpiJob *kubeflow.MPIJob) {
+	if c.SupportBundleStore == nil {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(mpiJob)
+	if err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	c.pendingSupportBundles.add(key)
+	c.supportBundleQueue.Add(key)
+}
+
+// runSupportBundleWorker stores the support bundles of the queued MPIJobs, one
+// at a time.
+func (c *MPIJobController) runSupportBundleWorker() {
+	for c.processNextSupportBundle() {
+	}
+}
+
+// processNextSupportBundle stores the support bundle of the next MPIJob in the
+// queue, and then syncs the MPIJob again to clean up its pods. The MPIJob is
+// read from the API server, as the informer cache might not have its failure
+// yet.
+func (c *MPIJobController) processNextSupportBundle() bool {
+	key, shutdown := c.supportBundleQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.supportBundleQueue.Done(key)
+	defer c.queue.Add(key)
+	defer c.pendingSupportBundles.remove(key)
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		runtime.HandleError(err)
+		return true
+	}
+	mpiJob, err := c.kubeflowClient.KubeflowV2beta1().MPIJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to get MPIJob %s to store its support bundle: %v", key, err)
+		}
+		return true
+	}
+	c.storeSupportBundle(mpiJob)
+	return true
+}
+
+// storeSupportBundle collects the support bundle of the failed MPIJob and
+// stores it, within supportBundleTimeout.
+func (c *MPIJobController) storeSupportBundle(mpiJob *kubeflow.MPIJob) {
+	if c.SupportBundleStore == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), supportBundleTimeout)
+	defer cancel()
+	var bundle bytes.Buffer
+	err := supportbundle.Collect(ctx, c.kubeClient, mpiJob, supportbundle.Options{TailLines: supportbundle.DefaultTailLines}, &bundle)
+	var location string
+	if err == nil {
+		location, err = c.SupportBundleStore.Store(ctx, mpiJob, bundle.Bytes())
+	}
+	if err != nil {
+		klog.Errorf("Failed to store the support bundle of MPIJob %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+		c.recorder.Eventf(mpiJob, corev1.EventTypeWarning, "SupportBundleFailed", "Failed to store the support bundle: %v", err)
+		return
+	}
+	c.recorder.Eventf(mpiJob, corev1.EventTypeNormal, "SupportBundleStored", "Support bundle stored in %s", location)
+}
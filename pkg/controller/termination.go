@@ -0,0 +1,153 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// maxWorkerFailures is the number of failed workers that are listed in
+	// the Failed condition.
+	maxWorkerFailures = 3
+	// terminationMessageLimit is the length at which the termination message
+	// of a worker is truncated in the Failed condition.
+	terminationMessageLimit = 128
+)
+
+var (
+	// infrastructurePodReasons are the reasons of the pods that failed
+	// because of their node.
+	infrastructurePodReasons = sets.New("Evicted", "NodeLost", "NodeAffinity", "Shutdown", "Terminated", "UnexpectedAdmissionError", "OutOfcpu", "OutOfmemory")
+	// imagePullReasons are the reasons of the containers waiting for an
+	// image that can't be pulled.
+	imagePullReasons = sets.New("ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull")
+)
+
+// workerFailures summarizes the containers of the workers of the MPIJob that
+// terminated with a non-zero exit code, so that the Failed condition keeps
+// the cause of the failure after the workers are cleaned up.
+func workerFailures(workers []*corev1.Pod) string {
+	var failures []string
+	for _, pod := range workers {
+		if failure := podFailure(pod); failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+	if len(failures) > maxWorkerFailures {
+		more := len(failures) - maxWorkerFailures
+		failures = append(failures[:maxWorkerFailures], fmt.Sprintf("and %d more", more))
+	}
+	return strings.Join(failures, "; ")
+}
+
+// listWorkers returns the workers of the MPIJob sorted by name, including the
+// ones that are no longer returned by getOrCreateWorker because the launcher
+// finished.
+func (c *MPIJobController) listWorkers(mpiJob *kubeflow.MPIJob) []*corev1.Pod {
+	selector, err := workerSelector(mpiJob.Name)
+	if err != nil {
+		return nil
+	}
+	pods, err := c.podLister.Pods(mpiJob.Namespace).List(selector)
+	if err != nil {
+		klog.Errorf("Failed to list the workers of %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+		return nil
+	}
+	var workers []*corev1.Pod
+	for _, pod := range pods {
+		if metav1.IsControlledBy(pod, mpiJob) {
+			workers = append(workers, pod)
+		}
+	}
+	sort.Slice(workers, func(i, j int) bool {
+		return workers[i].Name < workers[j].Name
+	})
+	return workers
+}
+
+// failureReasonClass returns whether the failure of the launcher was caused by
+// the infrastructure, as seen in its last failed pod or in one of the
+// workers, or by the application.
+func failureReasonClass(lastFailedPod *corev1.Pod, workers []*corev1.Pod) kubeflow.FailureReasonClass {
+	if lastFailedPod != nil && isInfrastructureFailure(lastFailedPod) {
+		return kubeflow.FailureReasonClassInfrastructure
+	}
+	for _, pod := range workers {
+		if isInfrastructureFailure(pod) {
+			return kubeflow.FailureReasonClassInfrastructure
+		}
+	}
+	return kubeflow.FailureReasonClassApplication
+}
+
+// isInfrastructureFailure returns whether the pod failed, or can't run,
+// because of its node, the scheduler, its image or its memory limit rather
+// than because of the command of its containers.
+func isInfrastructureFailure(pod *corev1.Pod) bool {
+	if infrastructurePodReasons.Has(pod.Status.Reason) || isPodOOMKilled(pod) {
+		return true
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.DisruptionTarget && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			return true
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && imagePullReasons.Has(status.State.Waiting.Reason) {
+			return true
+		}
+	}
+	return false
+}
+
+// podFailure describes the first container of the pod that terminated with a
+// non-zero exit code, either in its current or in its last state.
+func podFailure(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		terminated := status.State.Terminated
+		if terminated == nil || terminated.ExitCode == 0 {
+			terminated = status.LastTerminationState.Terminated
+		}
+		if terminated == nil || terminated.ExitCode == 0 {
+			continue
+		}
+		msg := strings.TrimSpace(terminated.Message)
+		if msg == "" {
+			msg = terminated.Reason
+		}
+		if len(msg) > terminationMessageLimit {
+			msg = msg[:terminationMessageLimit-len("...")] + "..."
+		}
+		failure := fmt.Sprintf("%s exited with code %d", pod.Name, terminated.ExitCode)
+		if msg != "" {
+			failure += ": " + msg
+		}
+		return failure
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestFailureReasonClass(t *testing.T) {
+	exited := func(exitCode int32, reason string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod"},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode, Reason: reason},
+					},
+				}},
+			},
+		}
+	}
+	cases := map[string]struct {
+		launcher *corev1.Pod
+		workers  []*corev1.Pod
+		want     kubeflow.FailureReasonClass
+	}{
+		"mpirun exited with an error": {
+			launcher: exited(1, "Error"),
+			workers:  []*corev1.Pod{{Status: corev1.PodStatus{Phase: corev1.PodRunning}}},
+			want:     kubeflow.FailureReasonClassApplication,
+		},
+		"no launcher pod": {
+			want: kubeflow.FailureReasonClassApplication,
+		},
+		"launcher out of memory": {
+			launcher: exited(137, "OOMKilled"),
+			want:     kubeflow.FailureReasonClassInfrastructure,
+		},
+		"launcher node lost": {
+			launcher: &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "NodeLost"}},
+			want:     kubeflow.FailureReasonClassInfrastructure,
+		},
+		"worker disrupted": {
+			launcher: exited(1, "Error"),
+			workers: []*corev1.Pod{{
+				Status: corev1.PodStatus{
+					Phase:      corev1.PodFailed,
+					Conditions: []corev1.PodCondition{{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue}},
+				},
+			}},
+			want: kubeflow.FailureReasonClassInfrastructure,
+		},
+		"worker unschedulable": {
+			launcher: exited(1, "Error"),
+			workers: []*corev1.Pod{{
+				Status: corev1.PodStatus{
+					Phase:      corev1.PodPending,
+					Conditions: []corev1.PodCondition{{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable}},
+				},
+			}},
+			want: kubeflow.FailureReasonClassInfrastructure,
+		},
+		"worker image pull": {
+			launcher: exited(1, "Error"),
+			workers: []*corev1.Pod{{
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					ContainerStatuses: []corev1.ContainerStatus{{
+						State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+					}},
+				},
+			}},
+			want: kubeflow.FailureReasonClassInfrastructure,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := failureReasonClass(tc.launcher, tc.workers); got != tc.want {
+				t.Errorf("Unexpected failure reason class %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
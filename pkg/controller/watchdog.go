@@ -0,0 +1,175 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// DefaultWatchdogImage is the image of the watchdog sidecar, built from
+	// build/watchdog.
+	DefaultWatchdogImage = "mpioperator/watchdog:latest"
+
+	watchdogContainerName     = "mpi-watchdog"
+	watchdogVolumeName        = "mpi-heartbeat"
+	watchdogHeartbeatPath     = "/heartbeat"
+	defaultWatchdogTimeout    = 1800
+	watchdogTimeoutEnv        = "WATCHDOG_TIMEOUT_SECONDS"
+	watchdogHeartbeatDirEnv   = "WATCHDOG_HEARTBEAT_DIR"
+	watchdogStalledExitCode   = 3
+	watchdogMaxRestartBackoff = 5 * time.Minute
+)
+
+func watchdogTimeout(watchdog *kubeflow.Watchdog) time.Duration {
+	return time.Duration(ptr.Deref(watchdog.TimeoutSeconds, defaultWatchdogTimeout)) * time.Second
+}
+
+// setupWatchdog injects the watchdog sidecar in a pod running MPI processes.
+// Without heartbeat directory, the pod shares its process namespace, so that
+// the watchdog reads the CPU usage of the processes of the other containers.
+func (c *MPIJobController) setupWatchdog(mpiJob *kubeflow.MPIJob, podTemplate *corev1.PodTemplateSpec, container *corev1.Container) {
+	watchdog := mpiJob.Spec.Watchdog
+	sidecar := corev1.Container{
+		Name:          watchdogContainerName,
+		Image:         overriddenImage(mpiJob, kubeflow.WatchdogImageAnnotation, c.WatchdogImage, DefaultWatchdogImage),
+		RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+		Env: []corev1.EnvVar{{
+			Name:  watchdogTimeoutEnv,
+			Value: strconv.Itoa(int(watchdogTimeout(watchdog).Seconds())),
+		}},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("16Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("16Mi"),
+			},
+		},
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+	}
+	if watchdog.HeartbeatDir != "" {
+		podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
+			Name: watchdogVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      watchdogVolumeName,
+			MountPath: watchdog.HeartbeatDir,
+		})
+		sidecar.VolumeMounts = []corev1.VolumeMount{{
+			Name:      watchdogVolumeName,
+			MountPath: watchdogHeartbeatPath,
+			ReadOnly:  true,
+		}}
+		sidecar.Env = append(sidecar.Env, corev1.EnvVar{Name: watchdogHeartbeatDirEnv, Value: watchdogHeartbeatPath})
+	} else {
+		podTemplate.Spec.ShareProcessNamespace = ptr.To(true)
+	}
+	podTemplate.Spec.InitContainers = append(podTemplate.Spec.InitContainers, sidecar)
+}
+
+// stalledPods returns the names of the pods whose watchdog reported that the
+// MPI processes made no progress since the given time, with its message.
+func stalledPods(pods []*corev1.Pod, since time.Time) map[string]string {
+	stalled := make(map[string]string)
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, status := range pod.Status.InitContainerStatuses {
+			if status.Name != watchdogContainerName {
+				continue
+			}
+			for _, terminated := range []*corev1.ContainerStateTerminated{status.State.Terminated, status.LastTerminationState.Terminated} {
+				if terminated != nil && terminated.ExitCode == watchdogStalledExitCode && !terminated.FinishedAt.Time.Before(since) {
+					stalled[pod.Name] = strings.TrimSpace(terminated.Message)
+				}
+			}
+		}
+	}
+	return stalled
+}
+
+// syncWatchdog sets the Stalled condition of the MPIJob while the watchdog of
+// any of its pods reports that the MPI processes make no progress, and
+// restarts the MPIJob with the Restart action. The watchdog exits when it
+// detects a stall, and the kubelet restarts it, so the reports of the last
+// timeout, plus the restart backoff, are current. It returns whether the
+// status was updated, or the MPIJob is waiting for the pods of a restart to
+// terminate, in which case the rest of the sync is skipped.
+func (c *MPIJobController) syncWatchdog(mpiJob *kubeflow.MPIJob, launcher *batchv1.Job) (bool, error) {
+	watchdog := mpiJob.Spec.Watchdog
+	if watchdog == nil {
+		return false, nil
+	}
+	selector := labels.SelectorFromSet(labels.Set{
+		kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+		kubeflow.JobNameLabel:      mpiJob.Name,
+	})
+	pods, err := c.podLister.Pods(mpiJob.Namespace).List(selector)
+	if err != nil {
+		return false, fmt.Errorf("obtaining pods: %w", err)
+	}
+	if isRestartingFor(mpiJob, kubeflow.JobStalledReason) && arePodsTerminating(launcher, pods) {
+		klog.V(4).Infof("Waiting for the pods of %s/%s to terminate before restarting it.", mpiJob.Namespace, mpiJob.Name)
+		return true, nil
+	}
+	window := watchdogTimeout(watchdog) + watchdogMaxRestartBackoff
+	stalled := stalledPods(pods, c.clock.Now().Add(-window))
+	if len(stalled) == 0 {
+		if !hasCondition(mpiJob.Status, kubeflow.JobStalled) {
+			return false, nil
+		}
+		msg := fmt.Sprintf("MPIJob %s/%s is making progress again.", mpiJob.Namespace, mpiJob.Name)
+		updateMPIJobConditions(mpiJob, kubeflow.JobStalled, corev1.ConditionFalse, kubeflow.JobProgressResumedReason, msg, c.clock)
+		c.recorder.Event(mpiJob, corev1.EventTypeNormal, kubeflow.JobProgressResumedReason, msg)
+		return true, c.updateStatusHandler(mpiJob)
+	}
+	// The condition is cleared once the reports are older than the window.
+	c.enqueueMPIJobAfter(mpiJob, window)
+	if hasCondition(mpiJob.Status, kubeflow.JobStalled) {
+		return false, nil
+	}
+	names := make([]string, 0, len(stalled))
+	for name := range stalled {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	msg := truncateMessage(fmt.Sprintf("MPIJob %s/%s is stalled in pods %s: %s", mpiJob.Namespace, mpiJob.Name, strings.Join(names, ", "), stalled[names[0]]))
+	updateMPIJobConditions(mpiJob, kubeflow.JobStalled, corev1.ConditionTrue, kubeflow.JobStalledReason, msg, c.clock)
+	if watchdog.Action == kubeflow.WatchdogActionRestart {
+		return true, c.restartMPIJob(mpiJob, launcher, kubeflow.JobStalledReason, msg)
+	}
+	c.recorder.Event(mpiJob, corev1.EventTypeWarning, kubeflow.JobStalledReason, msg)
+	return true, c.updateStatusHandler(mpiJob)
+}
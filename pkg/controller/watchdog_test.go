@@ -0,0 +1,237 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
+)
+
+func TestSetupWatchdog(t *testing.T) {
+	cases := map[string]struct {
+		watchdog           kubeflow.Watchdog
+		wantEnv            []corev1.EnvVar
+		wantMounts         []corev1.VolumeMount
+		wantShareProcesses *bool
+	}{
+		"CPU activity": {
+			wantEnv:            []corev1.EnvVar{{Name: "WATCHDOG_TIMEOUT_SECONDS", Value: "1800"}},
+			wantShareProcesses: ptr.To(true),
+		},
+		"heartbeat file": {
+			watchdog: kubeflow.Watchdog{
+				TimeoutSeconds: ptr.To[int32](600),
+				HeartbeatDir:   "/var/run/heartbeat",
+			},
+			wantEnv: []corev1.EnvVar{
+				{Name: "WATCHDOG_TIMEOUT_SECONDS", Value: "600"},
+				{Name: "WATCHDOG_HEARTBEAT_DIR", Value: "/heartbeat"},
+			},
+			wantMounts: []corev1.VolumeMount{{Name: "mpi-heartbeat", MountPath: "/heartbeat", ReadOnly: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := &kubeflow.MPIJob{
+				Spec: kubeflow.MPIJobSpec{Watchdog: &tc.watchdog},
+			}
+			podTemplate := &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "worker"}},
+				},
+			}
+			c := &MPIJobController{WatchdogImage: "watchdog:test"}
+			c.setupWatchdog(mpiJob, podTemplate, &podTemplate.Spec.Containers[0])
+
+			if len(podTemplate.Spec.InitContainers) != 1 {
+				t.Fatalf("Got %d init containers, want the watchdog", len(podTemplate.Spec.InitContainers))
+			}
+			sidecar := podTemplate.Spec.InitContainers[0]
+			if sidecar.Image != "watchdog:test" {
+				t.Errorf("Watchdog image is %q, want watchdog:test", sidecar.Image)
+			}
+			if diff := cmp.Diff(ptr.To(corev1.ContainerRestartPolicyAlways), sidecar.RestartPolicy); diff != "" {
+				t.Errorf("Unexpected restart policy of the watchdog (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantEnv, sidecar.Env); diff != "" {
+				t.Errorf("Unexpected env of the watchdog (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantMounts, sidecar.VolumeMounts); diff != "" {
+				t.Errorf("Unexpected volume mounts of the watchdog (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantShareProcesses, podTemplate.Spec.ShareProcessNamespace); diff != "" {
+				t.Errorf("Unexpected process namespace sharing (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncWatchdog(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	stalledWatchdog := func(finishedAt time.Time) func(*corev1.Pod) {
+		return func(pod *corev1.Pod) {
+			pod.Status.InitContainerStatuses = []corev1.ContainerStatus{{
+				Name: watchdogContainerName,
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						ExitCode:   watchdogStalledExitCode,
+						Message:    "no CPU activity of the MPI processes for 600s\n",
+						FinishedAt: metav1.NewTime(finishedAt),
+					},
+				},
+			}}
+		}
+	}
+	stalledCondition := func(mpiJob *kubeflow.MPIJob) {
+		updateMPIJobConditions(mpiJob, kubeflow.JobStalled, corev1.ConditionTrue, kubeflow.JobStalledReason, "", clocktesting.NewFakeClock(now))
+	}
+	cases := map[string]struct {
+		action        kubeflow.WatchdogAction
+		updateJob     func(*kubeflow.MPIJob)
+		updateWorker  func(*corev1.Pod)
+		wantHandled   bool
+		wantCondition *kubeflow.JobCondition
+		wantRestart   bool
+	}{
+		"making progress": {},
+		"stalled": {
+			updateWorker: stalledWatchdog(now.Add(-time.Minute)),
+			wantHandled:  true,
+			wantCondition: &kubeflow.JobCondition{
+				Type:    kubeflow.JobStalled,
+				Status:  corev1.ConditionTrue,
+				Reason:  kubeflow.JobStalledReason,
+				Message: "MPIJob default/test is stalled in pods test-worker-0: no CPU activity of the MPI processes for 600s",
+			},
+		},
+		"stalled with the restart action": {
+			action:       kubeflow.WatchdogActionRestart,
+			updateWorker: stalledWatchdog(now.Add(-time.Minute)),
+			wantHandled:  true,
+			wantCondition: &kubeflow.JobCondition{
+				Type:    kubeflow.JobStalled,
+				Status:  corev1.ConditionTrue,
+				Reason:  kubeflow.JobStalledReason,
+				Message: "MPIJob default/test is stalled in pods test-worker-0: no CPU activity of the MPI processes for 600s",
+			},
+			wantRestart: true,
+		},
+		"already stalled": {
+			updateJob:    stalledCondition,
+			updateWorker: stalledWatchdog(now.Add(-time.Minute)),
+		},
+		"outdated report": {
+			updateJob:    stalledCondition,
+			updateWorker: stalledWatchdog(now.Add(-time.Hour)),
+			wantHandled:  true,
+			wantCondition: &kubeflow.JobCondition{
+				Type:    kubeflow.JobStalled,
+				Status:  corev1.ConditionFalse,
+				Reason:  kubeflow.JobProgressResumedReason,
+				Message: "MPIJob default/test is making progress again.",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mpiJob := newMPIJob("test", ptr.To[int32](1), nil, nil)
+			scheme.Scheme.Default(mpiJob)
+			mpiJob.Spec.Watchdog = &kubeflow.Watchdog{
+				TimeoutSeconds: ptr.To[int32](600),
+				Action:         tc.action,
+			}
+			if tc.updateJob != nil {
+				tc.updateJob(mpiJob)
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            workerName(mpiJob, 0),
+					Namespace:       mpiJob.Namespace,
+					Labels:          defaultLabels(mpiJob.Name, worker),
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind)},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			}
+			if tc.updateWorker != nil {
+				tc.updateWorker(pod)
+			}
+			launcherJob := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            mpiJob.Name + launcherSuffix,
+					Namespace:       mpiJob.Namespace,
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(mpiJob, kubeflow.SchemeGroupVersionKind)},
+				},
+			}
+
+			kubeClient := k8sfake.NewSimpleClientset(pod, launcherJob)
+			podInformer := kubeinformers.NewSharedInformerFactory(kubeClient, 0).Core().V1().Pods()
+			if err := podInformer.Informer().GetIndexer().Add(pod); err != nil {
+				t.Fatalf("Adding the worker: %v", err)
+			}
+			var updated *kubeflow.MPIJob
+			c := &MPIJobController{
+				kubeClient: kubeClient,
+				podLister:  podInformer.Lister(),
+				queue:      workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[any]()),
+				recorder:   record.NewFakeRecorder(10),
+				clock:      clocktesting.NewFakeClock(now),
+				updateStatusHandler: func(mpiJob *kubeflow.MPIJob) error {
+					updated = mpiJob
+					return nil
+				},
+			}
+			defer c.queue.ShutDown()
+
+			handled, err := c.syncWatchdog(mpiJob, launcherJob)
+			if err != nil {
+				t.Fatalf("Syncing the watchdog: %v", err)
+			}
+			if handled != tc.wantHandled {
+				t.Errorf("syncWatchdog() returned %t, want %t", handled, tc.wantHandled)
+			}
+			var gotCondition *kubeflow.JobCondition
+			if updated != nil {
+				gotCondition = getCondition(updated.Status, kubeflow.JobStalled)
+			}
+			if diff := cmp.Diff(tc.wantCondition, gotCondition, cmpopts.IgnoreFields(kubeflow.JobCondition{}, "LastUpdateTime", "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected Stalled condition (-want,+got):\n%s", diff)
+			}
+			_, err = kubeClient.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
+			if deleted := apierrors.IsNotFound(err); deleted != tc.wantRestart {
+				t.Errorf("Worker deleted: %t, want %t", deleted, tc.wantRestart)
+			}
+			if restarting := updated != nil && isRestartingFor(updated, kubeflow.JobStalledReason); restarting != tc.wantRestart {
+				t.Errorf("Restarting condition set: %t, want %t", restarting, tc.wantRestart)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// workerGroupAt returns the worker group of the worker with the index, or nil
+// for the workers of the Worker replicas, which come first.
+func workerGroupAt(mpiJob *kubeflow.MPIJob, index int) *kubeflow.WorkerGroup {
+	if worker := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]; worker != nil {
+		index -= int(ptr.Deref(worker.Replicas, 0))
+	}
+	if index < 0 {
+		return nil
+	}
+	for i := range mpiJob.Spec.WorkerGroups {
+		group := &mpiJob.Spec.WorkerGroups[i]
+		if index < int(group.Replicas) {
+			return group
+		}
+		index -= int(group.Replicas)
+	}
+	return nil
+}
+
+// workerSlots returns the number of slots of the worker with the index in the
+// hostfile.
+func workerSlots(mpiJob *kubeflow.MPIJob, index int) int32 {
+	if group := workerGroupAt(mpiJob, index); group != nil && group.SlotsPerWorker != nil {
+		return *group.SlotsPerWorker
+	}
+	return ptr.Deref(mpiJob.Spec.SlotsPerWorker, 1)
+}
+
+// workerTemplate returns a copy of the pod template of the worker with the
+// index, which is the template of its group, labeled with the name of the
+// group, if any.
+func workerTemplate(mpiJob *kubeflow.MPIJob, index int) *corev1.PodTemplateSpec {
+	group := workerGroupAt(mpiJob, index)
+	if group == nil {
+		return mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template.DeepCopy()
+	}
+	podTemplate := group.Template.DeepCopy()
+	if podTemplate.Labels == nil {
+		podTemplate.Labels = make(map[string]string)
+	}
+	podTemplate.Labels[kubeflow.WorkerGroupLabel] = group.Name
+	return podTemplate
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestWorkerGroups(t *testing.T) {
+	mpiJob := newMPIJob("test", ptr.To[int32](2), nil, nil)
+	mpiJob.Spec.SlotsPerWorker = ptr.To[int32](8)
+	mpiJob.Spec.WorkerGroups = []kubeflow.WorkerGroup{
+		{
+			Name:           "analysis",
+			Replicas:       1,
+			SlotsPerWorker: ptr.To[int32](32),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "analysis"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "analysis", Image: "analysis:latest"}},
+				},
+			},
+		},
+		{
+			Name:     "io",
+			Replicas: 2,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "io", Image: "io:latest"}},
+				},
+			},
+		},
+	}
+
+	if got := workerReplicas(mpiJob); got != 5 {
+		t.Errorf("Got %d workers, want 5", got)
+	}
+	wantGroups := []string{"", "", "analysis", "io", "io", ""}
+	wantSlots := []int32{8, 8, 32, 8, 8, 8}
+	for i := range wantGroups {
+		var group string
+		if g := workerGroupAt(mpiJob, i); g != nil {
+			group = g.Name
+		}
+		if group != wantGroups[i] {
+			t.Errorf("Got group %q for worker %d, want %q", group, i, wantGroups[i])
+		}
+		if got := workerSlots(mpiJob, i); got != wantSlots[i] {
+			t.Errorf("Got %d slots for worker %d, want %d", got, i, wantSlots[i])
+		}
+	}
+	if got := totalSlots(mpiJob); got != 64 {
+		t.Errorf("Got %d total slots, want 64", got)
+	}
+
+	want := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"app":                     "analysis",
+				kubeflow.WorkerGroupLabel: "analysis",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "analysis", Image: "analysis:latest"}},
+		},
+	}
+	if diff := cmp.Diff(want, workerTemplate(mpiJob, 2)); diff != "" {
+		t.Errorf("Unexpected template of worker 2 (-want,+got):\n%s", diff)
+	}
+	if mpiJob.Spec.WorkerGroups[0].Template.Labels[kubeflow.WorkerGroupLabel] != "" {
+		t.Errorf("The template of the worker group was modified")
+	}
+	if diff := cmp.Diff(&mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Template, workerTemplate(mpiJob, 1)); diff != "" {
+		t.Errorf("Unexpected template of worker 1 (-want,+got):\n%s", diff)
+	}
+}
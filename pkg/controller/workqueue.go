@@ -0,0 +1,155 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	listers "github.com/kubeflow/mpi-operator/pkg/client/listers/kubeflow/v2beta1"
+)
+
+// housekeepingShare is the number of items popped from the work queue for
+// each housekeeping item while there are both kinds of items, so that the
+// cleanup of finished MPIJobs isn't starved by a steady stream of new ones.
+const housekeepingShare = 10
+
+// priorityQueue is the storage of the work queue. It pops the keys of the
+// running MPIJobs, like new or failing ones, ahead of the keys of the
+// housekeeping work, like the cleanup of finished MPIJobs, so that cleanup
+// storms don't delay the launch of MPIJobs.
+//
+// The work queue calls its methods with its lock held. The own lock of the
+// priorityQueue only guards the items for the snapshots of the state dumps.
+type priorityQueue struct {
+	mu             sync.Mutex
+	isHousekeeping func(item any) bool
+	high           []any
+	low            []any
+	// popped counts the consecutive items popped from high while low wasn't
+	// empty.
+	popped int
+}
+
+var _ workqueue.Queue[any] = &priorityQueue{}
+
+func newPriorityQueue(isHousekeeping func(item any) bool) *priorityQueue {
+	return &priorityQueue{isHousekeeping: isHousekeeping}
+}
+
+// Touch moves an item waiting as housekeeping to the front queue if it isn't
+// housekeeping anymore, like a finished MPIJob that was restarted.
+func (q *priorityQueue) Touch(item any) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, queued := range q.low {
+		if queued == item {
+			if !q.isHousekeeping(item) {
+				q.low = append(q.low[:i], q.low[i+1:]...)
+				q.high = append(q.high, item)
+			}
+			return
+		}
+	}
+}
+
+func (q *priorityQueue) Push(item any) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.isHousekeeping(item) {
+		q.low = append(q.low, item)
+	} else {
+		q.high = append(q.high, item)
+	}
+}
+
+func (q *priorityQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.high) + len(q.low)
+}
+
+func (q *priorityQueue) Pop() any {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.low) == 0 || (len(q.high) > 0 && q.popped < housekeepingShare-1) {
+		item := q.high[0]
+		q.high[0] = nil
+		q.high = q.high[1:]
+		if len(q.low) > 0 {
+			q.popped++
+		}
+		return item
+	}
+	q.popped = 0
+	item := q.low[0]
+	q.low[0] = nil
+	q.low = q.low[1:]
+	return item
+}
+
+// snapshot returns the items waiting in the queue, in the order of the running
+// MPIJobs and the housekeeping ones.
+func (q *priorityQueue) snapshot() (high, low []any) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]any(nil), q.high...), append([]any(nil), q.low...)
+}
+
+// isHousekeepingKey returns whether the key in the work queue belongs to a
+// finished or deleted MPIJob, whose syncs only clean up its resources.
+func isHousekeepingKey(mpiJobLister listers.MPIJobLister) func(item any) bool {
+	return func(item any) bool {
+		key, ok := item.(string)
+		if !ok {
+			return false
+		}
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return false
+		}
+		mpiJob, err := mpiJobLister.MPIJobs(namespace).Get(name)
+		if err != nil {
+			// The MPIJob was deleted.
+			return true
+		}
+		return mpiJob.DeletionTimestamp != nil || isFinished(mpiJob.Status)
+	}
+}
+
+// newPriorityWorkqueue returns a rate limited work queue processing the keys
+// of the running MPIJobs ahead of the housekeeping ones, and its storage. The
+// delayed keys are added when the clock reaches their time, so that a fake
+// clock controls the requeues in tests.
+func newPriorityWorkqueue(rateLimiter workqueue.TypedRateLimiter[any], mpiJobLister listers.MPIJobLister, clock clock.WithTicker) (workqueue.TypedRateLimitingInterface[any], *priorityQueue) {
+	const name = "MPIJob"
+	storage := newPriorityQueue(isHousekeepingKey(mpiJobLister))
+	queue := workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[any]{
+		Name:  name,
+		Queue: storage,
+		Clock: clock,
+	})
+	return workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
+		Name: name,
+		DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[any]{
+			Name:  name,
+			Queue: queue,
+			Clock: clock,
+		}),
+	}), storage
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	housekeeping := map[any]bool{"low-0": true, "low-1": true}
+	q := newPriorityQueue(func(item any) bool { return housekeeping[item] })
+	for _, item := range []any{"low-0", "high-0", "low-1", "high-1"} {
+		q.Push(item)
+	}
+	// low-1 was restarted.
+	housekeeping["low-1"] = false
+	q.Touch("low-1")
+	var got []any
+	for q.Len() > 0 {
+		got = append(got, q.Pop())
+	}
+	want := []any{"high-0", "high-1", "low-1", "low-0"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected order (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPriorityQueueHousekeepingShare(t *testing.T) {
+	q := newPriorityQueue(func(item any) bool { return item == "low" })
+	q.Push("low")
+	for i := 0; i < 2*housekeepingShare; i++ {
+		q.Push(fmt.Sprintf("high-%d", i))
+	}
+	for i := 1; i < housekeepingShare; i++ {
+		if item := q.Pop(); item == "low" {
+			t.Fatalf("Popped the housekeeping item after %d items, want after %d", i-1, housekeepingShare-1)
+		}
+	}
+	if item := q.Pop(); item != "low" {
+		t.Errorf("Popped %v, want the housekeeping item", item)
+	}
+}
+
+func TestPriorityWorkqueue(t *testing.T) {
+	running := newMPIJob("running", ptr.To[int32](1), nil, nil)
+	finished := newMPIJob("finished", ptr.To[int32](1), nil, nil)
+	updateMPIJobConditions(finished, kubeflow.JobSucceeded, corev1.ConditionTrue, kubeflow.JobSucceededReason, "", clock.RealClock{})
+	informer := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Kubeflow().V2beta1().MPIJobs()
+	for _, mpiJob := range []*kubeflow.MPIJob{running, finished} {
+		if err := informer.Informer().GetIndexer().Add(mpiJob); err != nil {
+			t.Fatalf("Adding MPIJob: %v", err)
+		}
+	}
+	queue, _ := newPriorityWorkqueue(workqueue.DefaultTypedControllerRateLimiter[any](), informer.Lister(), clock.RealClock{})
+	defer queue.ShutDown()
+	for _, key := range []string{"default/deleted", "default/finished", "default/running"} {
+		queue.Add(key)
+	}
+	var got []any
+	for queue.Len() > 0 {
+		item, _ := queue.Get()
+		got = append(got, item)
+		queue.Done(item)
+	}
+	want := []any{"default/running", "default/deleted", "default/finished"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected order (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPriorityWorkqueueFakeClock(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	informer := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Kubeflow().V2beta1().MPIJobs()
+	queue, _ := newPriorityWorkqueue(workqueue.DefaultTypedControllerRateLimiter[any](), informer.Lister(), fakeClock)
+	defer queue.ShutDown()
+	queue.AddAfter("default/test", time.Minute)
+	if queue.Len() != 0 {
+		t.Fatal("Key was added before its delay")
+	}
+	fakeClock.Step(time.Minute)
+	err := wait.PollUntilContextTimeout(context.Background(), time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+		return queue.Len() == 1, nil
+	})
+	if err != nil {
+		t.Errorf("Key wasn't added once the clock reached its delay: %v", err)
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dashboard serves a read-only overview of the MPIJobs, as JSON and
+// as an HTML page, for users without access to the Kubernetes API.
+package dashboard
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	listers "github.com/kubeflow/mpi-operator/pkg/client/listers/kubeflow/v2beta1"
+)
+
+// Server serves the overview of the MPIJobs and their pods from the informer
+// caches of the operator, and fetches the events of each MPIJob from the API
+// server, as the operator doesn't cache them. Every request must carry the token, either as a bearer token or
+// as the password of HTTP basic authentication, so that browsers can prompt
+// for it.
+type Server struct {
+	mpiJobLister listers.MPIJobLister
+	podLister    corelisters.PodLister
+	eventClient  kubernetes.Interface
+	token        []byte
+	enableUI     bool
+}
+
+// NewServer returns a Server for the given listers, which lists the events
+// with eventClient. The HTML pages are only served if enableUI is true.
+func NewServer(
+	mpiJobLister listers.MPIJobLister,
+	podLister corelisters.PodLister,
+	eventClient kubernetes.Interface,
+	token string,
+	enableUI bool,
+) (*Server, error) {
+	if token == "" {
+		return nil, fmt.Errorf("dashboard token can't be empty")
+	}
+	return &Server{
+		mpiJobLister: mpiJobLister,
+		podLister:    podLister,
+		eventClient:  eventClient,
+		token:        []byte(token),
+		enableUI:     enableUI,
+	}, nil
+}
+
+// Handler returns the handler of the dashboard. It only accepts GET and HEAD
+// requests.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/mpijobs", s.listJobs)
+	mux.HandleFunc("GET /api/v1/namespaces/{namespace}/mpijobs", s.listJobs)
+	mux.HandleFunc("GET /api/v1/namespaces/{namespace}/mpijobs/{name}", s.getJob)
+	if s.enableUI {
+		mux.HandleFunc("GET /{$}", s.overviewPage)
+		mux.HandleFunc("GET /namespaces/{namespace}/mpijobs/{name}", s.jobPage)
+	}
+	return s.authenticate(mux)
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+			token = strings.TrimPrefix(auth, "Bearer ")
+		} else if _, password, ok := r.BasicAuth(); ok {
+			token = password
+		}
+		if subtle.ConstantTimeCompare([]byte(token), s.token) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="mpi-operator"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// summaries returns the overview of the MPIJobs in the namespace, or in every
+// namespace if it's empty, ordered by namespace and name.
+func (s *Server) summaries(ctx context.Context, namespace string) ([]JobSummary, error) {
+	var (
+		jobs []*kubeflow.MPIJob
+		pods []*corev1.Pod
+		err  error
+	)
+	if namespace == "" {
+		jobs, err = s.mpiJobLister.List(labels.Everything())
+	} else {
+		jobs, err = s.mpiJobLister.MPIJobs(namespace).List(labels.Everything())
+	}
+	if err != nil {
+		return nil, err
+	}
+	podSelector := labels.SelectorFromSet(labels.Set{kubeflow.OperatorNameLabel: kubeflow.OperatorName})
+	if namespace == "" {
+		pods, err = s.podLister.List(podSelector)
+	} else {
+		pods, err = s.podLister.Pods(namespace).List(podSelector)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	podsByJob := make(map[string][]*corev1.Pod)
+	for _, pod := range pods {
+		key := pod.Namespace + "/" + pod.Labels[kubeflow.JobNameLabel]
+		podsByJob[key] = append(podsByJob[key], pod)
+	}
+	summaries := make([]JobSummary, 0, len(jobs))
+	for _, job := range jobs {
+		events, err := s.jobEvents(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, newJobSummary(job, podsByJob[job.Namespace+"/"+job.Name], events))
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Namespace != summaries[j].Namespace {
+			return summaries[i].Namespace < summaries[j].Namespace
+		}
+		return summaries[i].Name < summaries[j].Name
+	})
+	return summaries, nil
+}
+
+func (s *Server) detail(ctx context.Context, namespace, name string) (*JobDetail, error) {
+	job, err := s.mpiJobLister.MPIJobs(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := s.podLister.Pods(namespace).List(labels.SelectorFromSet(labels.Set{
+		kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+		kubeflow.JobNameLabel:      name,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.jobEvents(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	return newJobDetail(job, pods, events), nil
+}
+
+// jobEvents lists the events of the MPIJob, newest first.
+func (s *Server) jobEvents(ctx context.Context, job *kubeflow.MPIJob) ([]EventSummary, error) {
+	selector := fields.SelectorFromSet(fields.Set{
+		"involvedObject.kind": kubeflow.Kind,
+		"involvedObject.name": job.Name,
+		"involvedObject.uid":  string(job.UID),
+	})
+	list, err := s.eventClient.CoreV1().Events(job.Namespace).List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("listing events: %w", err)
+	}
+	events := make([]*corev1.Event, 0, len(list.Items))
+	for i := range list.Items {
+		events = append(events, &list.Items[i])
+	}
+	return eventsByObject(events)[job.UID], nil
+}
+
+func (s *Server) listJobs(w http.ResponseWriter, r *http.Request) {
+	summaries, err := s.summaries(r.Context(), r.PathValue("namespace"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, summaries)
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	detail, err := s.detail(r.Context(), r.PathValue("namespace"), r.PathValue("name"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, detail)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		klog.Errorf("Failed to write dashboard response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	if apierrors.IsNotFound(err) {
+		http.Error(w, "MPIJob not found", http.StatusNotFound)
+		return
+	}
+	klog.Errorf("Failed to serve dashboard request: %v", err)
+	http.Error(w, "Internal error", http.StatusInternalServerError)
+}
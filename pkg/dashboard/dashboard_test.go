@@ -0,0 +1,305 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	listers "github.com/kubeflow/mpi-operator/pkg/client/listers/kubeflow/v2beta1"
+)
+
+const testToken = "secret"
+
+func newTestServer(t *testing.T, enableUI bool) *Server {
+	t.Helper()
+	created := metav1.NewTime(time.Now().Add(-time.Hour))
+	jobs := []*kubeflow.MPIJob{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "research", UID: "pi-uid", CreationTimestamp: created},
+			Spec: kubeflow.MPIJobSpec{
+				MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+					kubeflow.MPIReplicaTypeLauncher: {Replicas: ptr.To[int32](1)},
+					kubeflow.MPIReplicaTypeWorker:   {Replicas: ptr.To[int32](2)},
+				},
+			},
+			Status: kubeflow.JobStatus{
+				Conditions: []kubeflow.JobCondition{
+					{Type: kubeflow.JobCreated, Status: corev1.ConditionTrue},
+					{Type: kubeflow.JobRunning, Status: corev1.ConditionTrue},
+				},
+				StartTime: &created,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "queued", Namespace: "default", UID: "queued-uid", CreationTimestamp: created},
+		},
+	}
+	pods := []*corev1.Pod{
+		newPod("pi-launcher", "research", "pi", launcherRole, true),
+		newPod("pi-worker-0", "research", "pi", workerRole, true),
+		newPod("pi-worker-1", "research", "pi", workerRole, false),
+	}
+	events := []*corev1.Event{
+		newEvent("e1", "research", "pi-uid", "MPIJobCreated", created.Add(time.Minute)),
+		newEvent("e2", "research", "pi-uid", "MPIJobRunning", created.Add(2*time.Minute)),
+		{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e3", Namespace: "research"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", UID: "pi-uid"},
+			Reason:         "Scheduled",
+		},
+	}
+
+	jobIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, job := range jobs {
+		if err := jobIndexer.Add(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+	podIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, pod := range pods {
+		if err := podIndexer.Add(pod); err != nil {
+			t.Fatal(err)
+		}
+	}
+	eventClient := fake.NewSimpleClientset()
+	for _, e := range events {
+		if _, err := eventClient.CoreV1().Events(e.Namespace).Create(context.Background(), e, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s, err := NewServer(listers.NewMPIJobLister(jobIndexer), corelisters.NewPodLister(podIndexer), eventClient, testToken, enableUI)
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+	return s
+}
+
+func newPod(name, namespace, jobName, role string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+				kubeflow.JobNameLabel:      jobName,
+				kubeflow.JobRoleLabel:      role,
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func newEvent(name, namespace, uid, reason string, at time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+		InvolvedObject: corev1.ObjectReference{Kind: kubeflow.Kind, UID: types.UID(uid)},
+		Type:           corev1.EventTypeNormal,
+		Reason:         reason,
+		LastTimestamp:  metav1.NewTime(at),
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	cases := map[string]struct {
+		setAuth    func(*http.Request)
+		wantStatus int
+	}{
+		"no credentials": {
+			setAuth:    func(*http.Request) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"wrong bearer token": {
+			setAuth:    func(r *http.Request) { r.Header.Set("Authorization", "Bearer wrong") },
+			wantStatus: http.StatusUnauthorized,
+		},
+		"bearer token": {
+			setAuth:    func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+testToken) },
+			wantStatus: http.StatusOK,
+		},
+		"basic authentication": {
+			setAuth:    func(r *http.Request) { r.SetBasicAuth("researcher", testToken) },
+			wantStatus: http.StatusOK,
+		},
+	}
+	handler := newTestServer(t, true).Handler()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/mpijobs", nil)
+			tc.setAuth(r)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tc.wantStatus {
+				t.Errorf("Got status %d, want %d", w.Code, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestAPI(t *testing.T) {
+	cases := map[string]struct {
+		method     string
+		path       string
+		wantStatus int
+		wantJobs   []string
+		wantDetail *JobDetail
+	}{
+		"all namespaces": {
+			path:       "/api/v1/mpijobs",
+			wantStatus: http.StatusOK,
+			wantJobs:   []string{"default/queued Pending 0/0", "research/pi Running 1/2"},
+		},
+		"one namespace": {
+			path:       "/api/v1/namespaces/research/mpijobs",
+			wantStatus: http.StatusOK,
+			wantJobs:   []string{"research/pi Running 1/2"},
+		},
+		"detail": {
+			path:       "/api/v1/namespaces/research/mpijobs/pi",
+			wantStatus: http.StatusOK,
+			wantDetail: &JobDetail{
+				JobSummary: JobSummary{Namespace: "research", Name: "pi", State: "Running", ReadyWorkers: 1, Workers: 2},
+				Pods: []PodSummary{
+					{Name: "pi-launcher", Role: launcherRole, Phase: corev1.PodRunning, Ready: true},
+					{Name: "pi-worker-0", Role: workerRole, Phase: corev1.PodRunning, Ready: true},
+					{Name: "pi-worker-1", Role: workerRole, Phase: corev1.PodRunning},
+				},
+				Events: []EventSummary{
+					{Type: corev1.EventTypeNormal, Reason: "MPIJobRunning"},
+					{Type: corev1.EventTypeNormal, Reason: "MPIJobCreated"},
+				},
+			},
+		},
+		"not found": {
+			path:       "/api/v1/namespaces/research/mpijobs/missing",
+			wantStatus: http.StatusNotFound,
+		},
+		"read only": {
+			method:     http.MethodDelete,
+			path:       "/api/v1/namespaces/research/mpijobs/pi",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	handler := newTestServer(t, false).Handler()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			r := httptest.NewRequest(method, tc.path, nil)
+			r.Header.Set("Authorization", "Bearer "+testToken)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tc.wantStatus {
+				t.Fatalf("Got status %d, want %d: %s", w.Code, tc.wantStatus, w.Body.String())
+			}
+			if tc.wantJobs != nil {
+				var summaries []JobSummary
+				if err := json.Unmarshal(w.Body.Bytes(), &summaries); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				var got []string
+				for _, s := range summaries {
+					got = append(got, fmt.Sprintf("%s/%s %s %d/%d", s.Namespace, s.Name, s.State, s.ReadyWorkers, s.Workers))
+				}
+				if diff := cmp.Diff(tc.wantJobs, got); diff != "" {
+					t.Errorf("Unexpected MPIJobs (-want,+got):\n%s", diff)
+				}
+			}
+			if tc.wantDetail != nil {
+				var got JobDetail
+				if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				opts := cmp.Options{
+					cmpopts.IgnoreFields(JobSummary{}, "CreationTime", "StartTime"),
+					cmpopts.IgnoreFields(JobDetail{}, "Conditions"),
+					cmpopts.IgnoreFields(PodSummary{}, "CreationTime"),
+					cmpopts.IgnoreFields(EventSummary{}, "Time"),
+				}
+				if diff := cmp.Diff(tc.wantDetail, &got, opts); diff != "" {
+					t.Errorf("Unexpected MPIJob detail (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPages(t *testing.T) {
+	cases := map[string]struct {
+		enableUI   bool
+		path       string
+		wantStatus int
+		wantBody   []string
+	}{
+		"overview": {
+			enableUI:   true,
+			path:       "/",
+			wantStatus: http.StatusOK,
+			wantBody:   []string{`href="namespaces/research/mpijobs/pi"`, "1/2", "MPIJobRunning", "queued"},
+		},
+		"job": {
+			enableUI:   true,
+			path:       "/namespaces/research/mpijobs/pi",
+			wantStatus: http.StatusOK,
+			wantBody:   []string{"research/pi", "pi-worker-1", "MPIJobCreated"},
+		},
+		"disabled": {
+			path:       "/",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			r.SetBasicAuth("", testToken)
+			w := httptest.NewRecorder()
+			newTestServer(t, tc.enableUI).Handler().ServeHTTP(w, r)
+			if w.Code != tc.wantStatus {
+				t.Fatalf("Got status %d, want %d", w.Code, tc.wantStatus)
+			}
+			for _, want := range tc.wantBody {
+				if !strings.Contains(w.Body.String(), want) {
+					t.Errorf("Page doesn't contain %q", want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"html/template"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/klog"
+)
+
+var templateFuncs = template.FuncMap{
+	"age": func(t any) string {
+		var at time.Time
+		switch v := t.(type) {
+		case metav1.Time:
+			at = v.Time
+		case *metav1.Time:
+			if v == nil {
+				return "-"
+			}
+			at = v.Time
+		case time.Time:
+			at = v
+		}
+		if at.IsZero() {
+			return "-"
+		}
+		return duration.HumanDuration(time.Since(at))
+	},
+}
+
+const pageHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta http-equiv="refresh" content="30">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border-bottom: 1px solid #ddd; padding: 0.3em 0.8em; text-align: left; vertical-align: top; }
+.Failed { color: #b00020; } .Succeeded { color: #1b7f3b; } .Running { color: #0b57d0; }
+</style>
+</head>
+<body>
+`
+
+var overviewTemplate = template.Must(template.New("overview").Funcs(templateFuncs).Parse(pageHeader + `
+<h1>MPIJobs</h1>
+<table>
+<tr><th>Namespace</th><th>Name</th><th>State</th><th>Workers ready</th><th>Age</th><th>Started</th><th>Latest event</th></tr>
+{{range .Jobs}}
+<tr>
+<td>{{.Namespace}}</td>
+<td><a href="namespaces/{{.Namespace}}/mpijobs/{{.Name}}">{{.Name}}</a></td>
+<td class="{{.State}}">{{.State}}</td>
+<td>{{.ReadyWorkers}}/{{.Workers}}</td>
+<td>{{age .CreationTime}}</td>
+<td>{{age .StartTime}}</td>
+<td>{{with .RecentEvents}}{{with index . 0}}{{.Reason}}: {{.Message}} ({{age .Time}} ago){{end}}{{end}}</td>
+</tr>
+{{else}}
+<tr><td colspan="7">No MPIJobs.</td></tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+var jobTemplate = template.Must(template.New("job").Funcs(templateFuncs).Parse(pageHeader + `
+{{with .Job}}
+<p><a href="../../../..">All MPIJobs</a></p>
+<h1>{{.Namespace}}/{{.Name}}</h1>
+<table>
+<tr><th>State</th><td class="{{.State}}">{{.State}}</td></tr>
+<tr><th>Workers ready</th><td>{{.ReadyWorkers}}/{{.Workers}}</td></tr>
+<tr><th>Age</th><td>{{age .CreationTime}}</td></tr>
+<tr><th>Started</th><td>{{age .StartTime}}</td></tr>
+<tr><th>Completed</th><td>{{age .CompletionTime}}</td></tr>
+</table>
+<h2>Conditions</h2>
+<table>
+<tr><th>Type</th><th>Status</th><th>Reason</th><th>Last transition</th><th>Message</th></tr>
+{{range .Conditions}}
+<tr><td>{{.Type}}</td><td>{{.Status}}</td><td>{{.Reason}}</td><td>{{age .LastTransitionTime}}</td><td>{{.Message}}</td></tr>
+{{end}}
+</table>
+<h2>Pods</h2>
+<table>
+<tr><th>Name</th><th>Role</th><th>Phase</th><th>Ready</th><th>Restarts</th><th>Node</th><th>Age</th></tr>
+{{range .Pods}}
+<tr><td>{{.Name}}</td><td>{{.Role}}</td><td>{{.Phase}}</td><td>{{.Ready}}</td><td>{{.Restarts}}</td><td>{{.NodeName}}</td><td>{{age .CreationTime}}</td></tr>
+{{else}}
+<tr><td colspan="7">No pods.</td></tr>
+{{end}}
+</table>
+<h2>Events</h2>
+<table>
+<tr><th>Type</th><th>Reason</th><th>Age</th><th>Message</th></tr>
+{{range .Events}}
+<tr><td>{{.Type}}</td><td>{{.Reason}}</td><td>{{age .Time}}</td><td>{{.Message}}</td></tr>
+{{else}}
+<tr><td colspan="4">No events.</td></tr>
+{{end}}
+</table>
+{{end}}
+</body>
+</html>
+`))
+
+func (s *Server) overviewPage(w http.ResponseWriter, r *http.Request) {
+	summaries, err := s.summaries(r.Context(), "")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	renderPage(w, overviewTemplate, map[string]any{"Title": "MPIJobs", "Jobs": summaries})
+}
+
+func (s *Server) jobPage(w http.ResponseWriter, r *http.Request) {
+	detail, err := s.detail(r.Context(), r.PathValue("namespace"), r.PathValue("name"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	renderPage(w, jobTemplate, map[string]any{"Title": detail.Namespace + "/" + detail.Name, "Job": detail})
+}
+
+func renderPage(w http.ResponseWriter, t *template.Template, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := t.Execute(w, data); err != nil {
+		klog.Errorf("Failed to render dashboard page: %v", err)
+	}
+}
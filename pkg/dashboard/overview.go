@@ -0,0 +1,191 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// recentEventsLimit is the number of events listed with each MPIJob in
+	// the overview.
+	recentEventsLimit = 3
+
+	// Values of the kubeflow.JobRoleLabel set by the operator.
+	launcherRole = "launcher"
+	workerRole   = "worker"
+)
+
+// JobSummary is the overview of an MPIJob.
+type JobSummary struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// State is the condition type that best describes the MPIJob, or Pending.
+	State          string       `json:"state"`
+	CreationTime   metav1.Time  `json:"creationTime"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// ReadyWorkers is the number of worker pods that are ready.
+	ReadyWorkers int32 `json:"readyWorkers"`
+	Workers      int32 `json:"workers"`
+	// RecentEvents are the latest events of the MPIJob, newest first.
+	RecentEvents []EventSummary `json:"recentEvents,omitempty"`
+}
+
+// JobDetail is the overview of an MPIJob with its pods and events.
+type JobDetail struct {
+	JobSummary `json:",inline"`
+	Conditions []kubeflow.JobCondition `json:"conditions,omitempty"`
+	Pods       []PodSummary            `json:"pods,omitempty"`
+	// Events are all the events of the MPIJob, newest first.
+	Events []EventSummary `json:"events,omitempty"`
+}
+
+// PodSummary is the overview of a launcher or worker pod.
+type PodSummary struct {
+	Name         string          `json:"name"`
+	Role         string          `json:"role"`
+	Phase        corev1.PodPhase `json:"phase"`
+	Ready        bool            `json:"ready"`
+	Restarts     int32           `json:"restarts"`
+	NodeName     string          `json:"nodeName,omitempty"`
+	CreationTime metav1.Time     `json:"creationTime"`
+}
+
+// EventSummary is an event of an MPIJob.
+type EventSummary struct {
+	Type    string    `json:"type"`
+	Reason  string    `json:"reason"`
+	Message string    `json:"message"`
+	Count   int32     `json:"count,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+func jobState(job *kubeflow.MPIJob) string {
+	if state := kubeflow.StateOf(job.Status); state != "" {
+		return string(state)
+	}
+	return "Pending"
+}
+
+func newJobSummary(job *kubeflow.MPIJob, pods []*corev1.Pod, events []EventSummary) JobSummary {
+	summary := JobSummary{
+		Namespace:      job.Namespace,
+		Name:           job.Name,
+		State:          jobState(job),
+		CreationTime:   job.CreationTimestamp,
+		StartTime:      job.Status.StartTime,
+		CompletionTime: job.Status.CompletionTime,
+	}
+	if spec := job.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]; spec != nil {
+		summary.Workers = ptr.Deref(spec.Replicas, 0)
+	}
+	for _, pod := range pods {
+		if pod.Labels[kubeflow.JobRoleLabel] == workerRole && isPodReady(pod) {
+			summary.ReadyWorkers++
+		}
+	}
+	if len(events) > recentEventsLimit {
+		events = events[:recentEventsLimit]
+	}
+	summary.RecentEvents = events
+	return summary
+}
+
+func newJobDetail(job *kubeflow.MPIJob, pods []*corev1.Pod, events []EventSummary) *JobDetail {
+	detail := &JobDetail{
+		JobSummary: newJobSummary(job, pods, events),
+		Conditions: job.Status.Conditions,
+		Events:     events,
+	}
+	detail.RecentEvents = nil
+	for _, pod := range pods {
+		detail.Pods = append(detail.Pods, newPodSummary(pod))
+	}
+	sort.Slice(detail.Pods, func(i, j int) bool {
+		a, b := detail.Pods[i], detail.Pods[j]
+		if a.Role != b.Role {
+			return a.Role == launcherRole
+		}
+		return a.Name < b.Name
+	})
+	return detail
+}
+
+func newPodSummary(pod *corev1.Pod) PodSummary {
+	summary := PodSummary{
+		Name:         pod.Name,
+		Role:         pod.Labels[kubeflow.JobRoleLabel],
+		Phase:        pod.Status.Phase,
+		Ready:        isPodReady(pod),
+		NodeName:     pod.Spec.NodeName,
+		CreationTime: pod.CreationTimestamp,
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		summary.Restarts += status.RestartCount
+	}
+	return summary
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// eventsByObject groups the events of MPIJobs by the UID of the MPIJob, with
+// the newest events first.
+func eventsByObject(events []*corev1.Event) map[types.UID][]EventSummary {
+	byObject := make(map[types.UID][]EventSummary)
+	for _, e := range events {
+		if e.InvolvedObject.Kind != kubeflow.Kind {
+			continue
+		}
+		byObject[e.InvolvedObject.UID] = append(byObject[e.InvolvedObject.UID], EventSummary{
+			Type:    e.Type,
+			Reason:  e.Reason,
+			Message: e.Message,
+			Count:   e.Count,
+			Time:    eventTime(e),
+		})
+	}
+	for _, list := range byObject {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].Time.After(list[j].Time)
+		})
+	}
+	return byObject
+}
+
+func eventTime(e *corev1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	if !e.EventTime.IsZero() {
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
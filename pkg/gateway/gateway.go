@@ -0,0 +1,330 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gateway serves a narrow REST API to submit MPIJobs from templates,
+// get their status and cancel them, for research portals whose users don't
+// have Kubernetes credentials. The gateway creates the MPIJobs with its own
+// service account, only in the allowed namespaces, and only gets and cancels
+// the MPIJobs that it submitted.
+package gateway
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/validation"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
+)
+
+const (
+	// TemplateLabel is the label of the MPIJobs submitted through the gateway,
+	// with the name of their template.
+	TemplateLabel = "training.kubeflow.org/gateway-template"
+	// SubmittedByAnnotation is the annotation of the MPIJobs submitted through
+	// the gateway with the user that the portal submitted them for, from the
+	// X-Remote-User header.
+	SubmittedByAnnotation = "training.kubeflow.org/submitted-by"
+
+	remoteUserHeader = "X-Remote-User"
+	maxRequestBytes  = 1 << 20
+)
+
+// Server serves the gateway API. Every request must carry the token as a
+// bearer token.
+type Server struct {
+	client     versioned.Interface
+	templates  map[string]*kubeflow.MPIJob
+	namespaces []string
+	maxWorkers int32
+	token      []byte
+}
+
+// NewServer returns a Server creating MPIJobs from the templates. The MPIJobs
+// can only be submitted to the given namespaces, or to any namespace if it's
+// empty, with up to maxWorkers workers, or any number if it's 0.
+func NewServer(client versioned.Interface, templates map[string]*kubeflow.MPIJob, namespaces []string, maxWorkers int32, token string) (*Server, error) {
+	if token == "" {
+		return nil, fmt.Errorf("gateway token can't be empty")
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("the gateway needs at least one template")
+	}
+	return &Server{
+		client:     client,
+		templates:  templates,
+		namespaces: namespaces,
+		maxWorkers: maxWorkers,
+		token:      []byte(token),
+	}, nil
+}
+
+// SubmitRequest is the body of the requests submitting an MPIJob.
+type SubmitRequest struct {
+	// Template is the name of the template of the MPIJob.
+	Template string `json:"template"`
+	// Name is the name of the MPIJob. If empty, it is generated from the name
+	// of the template.
+	Name string `json:"name,omitempty"`
+	// Workers overrides the number of workers of the template.
+	Workers int32 `json:"workers,omitempty"`
+}
+
+// Template describes a template that MPIJobs can be submitted from.
+type Template struct {
+	Name    string `json:"name"`
+	Workers int32  `json:"workers"`
+}
+
+// Job is the status of an MPIJob submitted through the gateway.
+type Job struct {
+	Namespace      string       `json:"namespace"`
+	Name           string       `json:"name"`
+	Template       string       `json:"template"`
+	Workers        int32        `json:"workers"`
+	State          string       `json:"state"`
+	Conditions     []Condition  `json:"conditions,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// Condition is a condition of an MPIJob.
+type Condition struct {
+	Type               string      `json:"type"`
+	Status             string      `json:"status"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// Handler returns the handler of the gateway API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/templates", s.listTemplates)
+	mux.HandleFunc("POST /api/v1/namespaces/{namespace}/mpijobs", s.submitJob)
+	mux.HandleFunc("GET /api/v1/namespaces/{namespace}/mpijobs/{name}", s.getJob)
+	mux.HandleFunc("DELETE /api/v1/namespaces/{namespace}/mpijobs/{name}", s.cancelJob)
+	return s.authenticate(mux)
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), s.token) != 1 {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) listTemplates(w http.ResponseWriter, _ *http.Request) {
+	templates := make([]Template, 0, len(s.templates))
+	for name, mpiJob := range s.templates {
+		templates = append(templates, Template{Name: name, Workers: workers(mpiJob)})
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	writeJSON(w, http.StatusOK, templates)
+}
+
+func (s *Server) submitJob(w http.ResponseWriter, r *http.Request) {
+	namespace := r.PathValue("namespace")
+	if !s.allowedNamespace(namespace) {
+		http.Error(w, fmt.Sprintf("Submitting to namespace %s isn't allowed", namespace), http.StatusForbidden)
+		return
+	}
+	var req SubmitRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+	mpiJob, err := s.newMPIJob(namespace, &req, r.Header.Get(remoteUserHeader))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	mpiJob, err = s.client.KubeflowV2beta1().MPIJobs(namespace).Create(r.Context(), mpiJob, metav1.CreateOptions{})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	klog.Infof("Submitted MPIJob %s/%s from template %s for user %q", mpiJob.Namespace, mpiJob.Name, req.Template, r.Header.Get(remoteUserHeader))
+	writeJSON(w, http.StatusCreated, newJob(mpiJob))
+}
+
+// newMPIJob creates the MPIJob to submit from its template.
+func (s *Server) newMPIJob(namespace string, req *SubmitRequest, user string) (*kubeflow.MPIJob, error) {
+	template, ok := s.templates[req.Template]
+	if !ok {
+		return nil, fmt.Errorf("template %q doesn't exist", req.Template)
+	}
+	mpiJob := template.DeepCopy()
+	mpiJob.Namespace = namespace
+	mpiJob.Name = req.Name
+	if req.Name == "" {
+		mpiJob.GenerateName = req.Template + "-"
+	}
+	if mpiJob.Labels == nil {
+		mpiJob.Labels = map[string]string{}
+	}
+	mpiJob.Labels[TemplateLabel] = req.Template
+	if user != "" {
+		if mpiJob.Annotations == nil {
+			mpiJob.Annotations = map[string]string{}
+		}
+		mpiJob.Annotations[SubmittedByAnnotation] = user
+	}
+	if req.Workers < 0 {
+		return nil, fmt.Errorf("workers must be positive")
+	}
+	if req.Workers > 0 {
+		worker := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]
+		if worker == nil {
+			return nil, fmt.Errorf("template %q doesn't have workers", req.Template)
+		}
+		worker.Replicas = ptr.To(req.Workers)
+	}
+	if s.maxWorkers > 0 && workers(mpiJob) > s.maxWorkers {
+		return nil, fmt.Errorf("at most %d workers are allowed", s.maxWorkers)
+	}
+
+	// Validate the MPIJob as the operator would, with the longest name that
+	// the API server can generate.
+	defaulted := mpiJob.DeepCopy()
+	if defaulted.Name == "" {
+		defaulted.Name = defaulted.GenerateName + "xxxxx"
+	}
+	kubeflow.SetDefaults_MPIJob(defaulted)
+	if errs := validation.ValidateMPIJob(defaulted); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid MPIJob: %w", errs.ToAggregate())
+	}
+	return mpiJob, nil
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	mpiJob, err := s.submittedJob(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newJob(mpiJob))
+}
+
+func (s *Server) cancelJob(w http.ResponseWriter, r *http.Request) {
+	mpiJob, err := s.submittedJob(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	err = s.client.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).Delete(r.Context(), mpiJob.Name, metav1.DeleteOptions{
+		Preconditions:     &metav1.Preconditions{UID: &mpiJob.UID},
+		PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	klog.Infof("Cancelled MPIJob %s/%s for user %q", mpiJob.Namespace, mpiJob.Name, r.Header.Get(remoteUserHeader))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// submittedJob gets the MPIJob of the request, which must have been
+// submitted through the gateway.
+func (s *Server) submittedJob(r *http.Request) (*kubeflow.MPIJob, error) {
+	namespace, name := r.PathValue("namespace"), r.PathValue("name")
+	notFound := apierrors.NewNotFound(kubeflow.Resource("mpijobs"), name)
+	if !s.allowedNamespace(namespace) {
+		return nil, notFound
+	}
+	mpiJob, err := s.client.KubeflowV2beta1().MPIJobs(namespace).Get(r.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := mpiJob.Labels[TemplateLabel]; !ok {
+		return nil, notFound
+	}
+	return mpiJob, nil
+}
+
+func (s *Server) allowedNamespace(namespace string) bool {
+	return len(s.namespaces) == 0 || slices.Contains(s.namespaces, namespace)
+}
+
+func workers(mpiJob *kubeflow.MPIJob) int32 {
+	if worker := mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker]; worker != nil {
+		return ptr.Deref(worker.Replicas, 0)
+	}
+	return 0
+}
+
+func newJob(mpiJob *kubeflow.MPIJob) Job {
+	job := Job{
+		Namespace:      mpiJob.Namespace,
+		Name:           mpiJob.Name,
+		Template:       mpiJob.Labels[TemplateLabel],
+		Workers:        workers(mpiJob),
+		State:          string(mpiJob.Status.State),
+		StartTime:      mpiJob.Status.StartTime,
+		CompletionTime: mpiJob.Status.CompletionTime,
+	}
+	if job.State == "" {
+		job.State = "Pending"
+	}
+	for _, c := range mpiJob.Status.Conditions {
+		job.Conditions = append(job.Conditions, Condition{
+			Type:               string(c.Type),
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return job
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		klog.Errorf("Failed to write gateway response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var status apierrors.APIStatus
+	switch {
+	case apierrors.IsNotFound(err):
+		http.Error(w, "MPIJob not found", http.StatusNotFound)
+	case apierrors.IsAlreadyExists(err):
+		http.Error(w, "MPIJob already exists", http.StatusConflict)
+	case apierrors.IsInvalid(err) && errors.As(err, &status):
+		http.Error(w, status.Status().Message, http.StatusUnprocessableEntity)
+	default:
+		klog.Errorf("Failed to serve gateway request: %v", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+	}
+}
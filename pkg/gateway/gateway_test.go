@@ -0,0 +1,258 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+)
+
+const (
+	testToken = "secret"
+
+	piTemplate = `apiVersion: kubeflow.org/v2beta1
+kind: MPIJob
+metadata:
+  name: ignored
+spec:
+  slotsPerWorker: 1
+  mpiReplicaSpecs:
+    Launcher:
+      replicas: 1
+      template:
+        spec:
+          containers:
+          - name: launcher
+            image: mpioperator/mpi-pi:openmpi
+            command: [mpirun, /home/mpiuser/pi]
+    Worker:
+      replicas: 2
+      template:
+        spec:
+          containers:
+          - name: worker
+            image: mpioperator/mpi-pi:openmpi
+`
+)
+
+func newTestServer(t *testing.T, objects ...*kubeflow.MPIJob) (*Server, *fake.Clientset) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pi.yaml"), []byte(piTemplate), 0o600); err != nil {
+		t.Fatalf("Writing template: %v", err)
+	}
+	templates, err := LoadTemplates(dir)
+	if err != nil {
+		t.Fatalf("LoadTemplates(): %v", err)
+	}
+	client := fake.NewSimpleClientset()
+	for _, obj := range objects {
+		if _, err := client.KubeflowV2beta1().MPIJobs(obj.Namespace).Create(context.Background(), obj, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Creating MPIJob: %v", err)
+		}
+	}
+	server, err := NewServer(client, templates, []string{"research"}, 4, testToken)
+	if err != nil {
+		t.Fatalf("NewServer(): %v", err)
+	}
+	return server, client
+}
+
+func do(t *testing.T, handler http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set(remoteUserHeader, "alice")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthentication(t *testing.T) {
+	server, _ := newTestServer(t)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/templates", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Got status %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestListTemplates(t *testing.T) {
+	server, _ := newTestServer(t)
+	rec := do(t, server.Handler(), http.MethodGet, "/api/v1/templates", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Got status %d: %s", rec.Code, rec.Body)
+	}
+	var got []Template
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("Decoding response: %v", err)
+	}
+	if diff := cmp.Diff([]Template{{Name: "pi", Workers: 2}}, got); diff != "" {
+		t.Errorf("Unexpected templates (-want,+got):\n%s", diff)
+	}
+}
+
+func TestSubmitJob(t *testing.T) {
+	cases := map[string]struct {
+		namespace   string
+		body        string
+		wantCode    int
+		wantWorkers int32
+	}{
+		"template workers": {
+			namespace:   "research",
+			body:        `{"template":"pi","name":"pi-1"}`,
+			wantCode:    http.StatusCreated,
+			wantWorkers: 2,
+		},
+		"more workers": {
+			namespace:   "research",
+			body:        `{"template":"pi","name":"pi-1","workers":4}`,
+			wantCode:    http.StatusCreated,
+			wantWorkers: 4,
+		},
+		"too many workers": {
+			namespace: "research",
+			body:      `{"template":"pi","name":"pi-1","workers":5}`,
+			wantCode:  http.StatusUnprocessableEntity,
+		},
+		"unknown template": {
+			namespace: "research",
+			body:      `{"template":"hpl","name":"hpl-1"}`,
+			wantCode:  http.StatusUnprocessableEntity,
+		},
+		"invalid name": {
+			namespace: "research",
+			body:      `{"template":"pi","name":"Pi"}`,
+			wantCode:  http.StatusUnprocessableEntity,
+		},
+		"namespace not allowed": {
+			namespace: "default",
+			body:      `{"template":"pi","name":"pi-1"}`,
+			wantCode:  http.StatusForbidden,
+		},
+		"malformed": {
+			namespace: "research",
+			body:      `{"template":`,
+			wantCode:  http.StatusBadRequest,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, client := newTestServer(t)
+			rec := do(t, server.Handler(), http.MethodPost, "/api/v1/namespaces/"+tc.namespace+"/mpijobs", tc.body)
+			if rec.Code != tc.wantCode {
+				t.Fatalf("Got status %d, want %d: %s", rec.Code, tc.wantCode, rec.Body)
+			}
+			if tc.wantCode != http.StatusCreated {
+				return
+			}
+			mpiJob, err := client.KubeflowV2beta1().MPIJobs(tc.namespace).Get(context.Background(), "pi-1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting the submitted MPIJob: %v", err)
+			}
+			if got := ptr.Deref(mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker].Replicas, 0); got != tc.wantWorkers {
+				t.Errorf("Submitted MPIJob has %d workers, want %d", got, tc.wantWorkers)
+			}
+			if mpiJob.Labels[TemplateLabel] != "pi" || mpiJob.Annotations[SubmittedByAnnotation] != "alice" {
+				t.Errorf("Submitted MPIJob has labels %v and annotations %v", mpiJob.Labels, mpiJob.Annotations)
+			}
+		})
+	}
+}
+
+func TestGetAndCancelJob(t *testing.T) {
+	submitted := &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pi-1",
+			Namespace: "research",
+			Labels:    map[string]string{TemplateLabel: "pi"},
+		},
+		Spec: kubeflow.MPIJobSpec{
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeWorker: {Replicas: ptr.To[int32](2)},
+			},
+		},
+		Status: kubeflow.JobStatus{
+			State:      kubeflow.JobRunning,
+			Conditions: []kubeflow.JobCondition{{Type: kubeflow.JobRunning, Status: corev1.ConditionTrue}},
+		},
+	}
+	other := &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "research"},
+	}
+	server, client := newTestServer(t, submitted, other)
+
+	rec := do(t, server.Handler(), http.MethodGet, "/api/v1/namespaces/research/mpijobs/pi-1", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Got status %d: %s", rec.Code, rec.Body)
+	}
+	var got Job
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("Decoding response: %v", err)
+	}
+	want := Job{
+		Namespace:  "research",
+		Name:       "pi-1",
+		Template:   "pi",
+		Workers:    2,
+		State:      "Running",
+		Conditions: []Condition{{Type: "Running", Status: "True"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected job (-want,+got):\n%s", diff)
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		if rec := do(t, server.Handler(), method, "/api/v1/namespaces/research/mpijobs/other", ""); rec.Code != http.StatusNotFound {
+			t.Errorf("%s of an MPIJob not submitted through the gateway returned %d, want %d", method, rec.Code, http.StatusNotFound)
+		}
+	}
+
+	rec = do(t, server.Handler(), http.MethodDelete, "/api/v1/namespaces/research/mpijobs/pi-1", "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Got status %d: %s", rec.Code, rec.Body)
+	}
+	if _, err := client.KubeflowV2beta1().MPIJobs("research").Get(context.Background(), "pi-1", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("MPIJob wasn't deleted: %v", err)
+	}
+}
+
+func TestLoadTemplatesRejectsOtherKinds(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pod.yaml"), []byte("apiVersion: v1\nkind: Pod\n"), 0o600); err != nil {
+		t.Fatalf("Writing template: %v", err)
+	}
+	if _, err := LoadTemplates(dir); err == nil {
+		t.Error("LoadTemplates() accepted a Pod")
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// LoadTemplates reads the MPIJob templates from the YAML files of the
+// directory, typically mounted from a ConfigMap. Each template is named after
+// its file, without the extension.
+func LoadTemplates(dir string) (map[string]*kubeflow.MPIJob, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading templates: %w", err)
+	}
+	templates := make(map[string]*kubeflow.MPIJob)
+	for _, entry := range entries {
+		// The files of ConfigMap volumes are symbolic links to a hidden
+		// directory.
+		ext := filepath.Ext(entry.Name())
+		if strings.HasPrefix(entry.Name(), ".") || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ext)
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading template %s: %w", name, err)
+		}
+		var mpiJob kubeflow.MPIJob
+		if err := yaml.UnmarshalStrict(data, &mpiJob); err != nil {
+			return nil, fmt.Errorf("decoding template %s: %w", name, err)
+		}
+		if mpiJob.APIVersion != kubeflow.SchemeGroupVersion.String() || mpiJob.Kind != kubeflow.Kind {
+			return nil, fmt.Errorf("template %s is a %s %s, want a %s %s", name, mpiJob.APIVersion, mpiJob.Kind, kubeflow.SchemeGroupVersion, kubeflow.Kind)
+		}
+		// The name and the namespace are set when submitting the MPIJob.
+		mpiJob.Name = ""
+		mpiJob.Namespace = ""
+		templates[name] = &mpiJob
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("no templates in %s", dir)
+	}
+	return templates, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gpuusage summarizes the usage of the GPUs of finished MPIJobs from
+// the metrics of the NVIDIA DCGM exporter, as stored by Prometheus.
+package gpuusage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+const (
+	// UtilizationMetric is the utilization of a GPU, in percent.
+	UtilizationMetric = "DCGM_FI_DEV_GPU_UTIL"
+	// PowerMetric is the power usage of a GPU, in watts.
+	PowerMetric = "DCGM_FI_DEV_POWER_USAGE"
+)
+
+// Usage is the usage of the GPUs of the pods of an MPIJob.
+type Usage struct {
+	// GPUs is the number of GPUs reported for the pods.
+	GPUs int
+	// AverageUtilization is the utilization of the GPUs, in percent,
+	// averaged over the GPUs and the time.
+	AverageUtilization float64
+	// PeakUtilization is the highest utilization of any of the GPUs, in
+	// percent.
+	PeakUtilization float64
+	// EnergyJoules is the energy consumed by the GPUs, estimated from their
+	// average power usage.
+	EnergyJoules float64
+}
+
+// Source reads the usage of the GPUs of MPIJobs.
+type Source interface {
+	// Usage returns the usage of the GPUs of the launcher and workers of the
+	// MPIJob between start and end, or nil if they didn't use any GPU.
+	Usage(ctx context.Context, namespace, name string, start, end time.Time) (*Usage, error)
+}
+
+// PrometheusSource queries the metrics of the DCGM exporter from Prometheus.
+// The exporter must run with its Kubernetes mode, which labels the metrics of
+// each GPU with the namespace and pod using it.
+type PrometheusSource struct {
+	api promv1.API
+}
+
+// NewPrometheusSource returns a PrometheusSource for the URL of a Prometheus
+// server, like http://prometheus.monitoring:9090.
+func NewPrometheusSource(rawURL string) (*PrometheusSource, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Prometheus URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported Prometheus URL %q, must be an http or https URL", rawURL)
+	}
+	client, err := api.NewClient(api.Config{Address: rawURL})
+	if err != nil {
+		return nil, fmt.Errorf("creating Prometheus client: %w", err)
+	}
+	return &PrometheusSource{api: promv1.NewAPI(client)}, nil
+}
+
+func (s *PrometheusSource) Usage(ctx context.Context, namespace, name string, start, end time.Time) (*Usage, error) {
+	duration := end.Sub(start).Truncate(time.Second)
+	if duration < time.Second {
+		return nil, nil
+	}
+	// The pods of the launcher Job get a random suffix.
+	pods := regexp.QuoteMeta(name) + "-(launcher-[a-z0-9]+|worker-[0-9]+)"
+	selector := fmt.Sprintf(`{namespace=%q,pod=~%q}`, namespace, pods)
+	window := model.Duration(duration).String()
+
+	gpus, found, err := s.query(ctx, fmt.Sprintf("count(max_over_time(%s%s[%s]))", UtilizationMetric, selector, window), end)
+	if err != nil || !found {
+		return nil, err
+	}
+	usage := &Usage{GPUs: int(gpus)}
+	if usage.AverageUtilization, _, err = s.query(ctx, fmt.Sprintf("avg(avg_over_time(%s%s[%s]))", UtilizationMetric, selector, window), end); err != nil {
+		return nil, err
+	}
+	if usage.PeakUtilization, _, err = s.query(ctx, fmt.Sprintf("max(max_over_time(%s%s[%s]))", UtilizationMetric, selector, window), end); err != nil {
+		return nil, err
+	}
+	power, _, err := s.query(ctx, fmt.Sprintf("sum(avg_over_time(%s%s[%s]))", PowerMetric, selector, window), end)
+	if err != nil {
+		return nil, err
+	}
+	usage.EnergyJoules = power * duration.Seconds()
+	return usage, nil
+}
+
+// query returns the value of the query returning a single sample at the time,
+// and whether it returned any.
+func (s *PrometheusSource) query(ctx context.Context, query string, ts time.Time) (float64, bool, error) {
+	result, _, err := s.api.Query(ctx, query, ts)
+	if err != nil {
+		return 0, false, fmt.Errorf("querying %q: %w", query, err)
+	}
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return 0, false, fmt.Errorf("query %q returned a %s, want a vector", query, result.Type())
+	}
+	if len(vector) == 0 {
+		return 0, false, nil
+	}
+	return float64(vector[0].Value), true, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpuusage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPrometheusSource(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	cases := map[string]struct {
+		results   map[string]string
+		wantUsage *Usage
+	}{
+		"GPUs": {
+			results: map[string]string{
+				"count": "64",
+				"avg":   "11.2",
+				"max":   "37",
+				"sum":   "2000",
+			},
+			wantUsage: &Usage{
+				GPUs:               64,
+				AverageUtilization: 11.2,
+				PeakUtilization:    37,
+				EnergyJoules:       2000 * 3600,
+			},
+		},
+		"no GPUs": {
+			results: map[string]string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("Parsing the query: %v", err)
+				}
+				query := r.Form.Get("query")
+				if want := `{namespace="research",pod=~"pi-(launcher-[a-z0-9]+|worker-[0-9]+)"}[1h]`; !strings.Contains(query, want) {
+					t.Errorf("Query %q doesn't select %s", query, want)
+				}
+				if got, want := r.Form.Get("time"), fmt.Sprint(end.Unix()); !strings.HasPrefix(got, want) {
+					t.Errorf("Query evaluated at %s, want %s", got, want)
+				}
+				result := "[]"
+				if value, ok := tc.results[query[:strings.Index(query, "(")]]; ok {
+					result = fmt.Sprintf(`[{"metric":{},"value":[%d,%q]}]`, end.Unix(), value)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":%s}}`, result)
+			}))
+			defer server.Close()
+
+			source, err := NewPrometheusSource(server.URL)
+			if err != nil {
+				t.Fatalf("Creating the source: %v", err)
+			}
+			usage, err := source.Usage(context.Background(), "research", "pi", start, end)
+			if err != nil {
+				t.Fatalf("Usage(): %v", err)
+			}
+			if diff := cmp.Diff(tc.wantUsage, usage); diff != "" {
+				t.Errorf("Unexpected usage (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewPrometheusSourceInvalidURL(t *testing.T) {
+	if _, err := NewPrometheusSource("prometheus:9090"); err == nil {
+		t.Error("NewPrometheusSource() succeeded with a URL without scheme")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const recordFileSuffix = ".json"
+
+// FileBackend stores each record as a JSON file in a directory and prunes
+// the files beyond the retention.
+type FileBackend struct {
+	dir       string
+	retention Retention
+	// now is replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+}
+
+var _ Backend = &FileBackend{}
+
+// NewFileBackend creates the directory if needed and returns a FileBackend
+// storing the records in it.
+func NewFileBackend(dir string, retention Retention) (*FileBackend, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("history directory can't be empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating history directory: %w", err)
+	}
+	return &FileBackend{dir: dir, retention: retention, now: time.Now}, nil
+}
+
+func (b *FileBackend) Archive(_ context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("encoding history record: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// The UID keeps the records of MPIJobs recreated with the same name.
+	name := fmt.Sprintf("%s_%s_%s%s", record.Namespace, record.Name, record.UID, recordFileSuffix)
+	tmp, err := os.CreateTemp(b.dir, ".tmp-")
+	if err != nil {
+		return fmt.Errorf("creating history record: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing history record: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing history record: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(b.dir, name)); err != nil {
+		return fmt.Errorf("writing history record: %w", err)
+	}
+	return b.prune()
+}
+
+// prune deletes the records older than MaxAge and the oldest records beyond
+// MaxRecords.
+func (b *FileBackend) prune() error {
+	if b.retention.MaxAge <= 0 && b.retention.MaxRecords <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return fmt.Errorf("listing history records: %w", err)
+	}
+	type recordFile struct {
+		name    string
+		modTime time.Time
+	}
+	var files []recordFile
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), recordFileSuffix) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, recordFile{name: e.Name(), modTime: info.ModTime()})
+	}
+	// Newest first.
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.After(files[j].modTime)
+	})
+	now := b.now()
+	for i, f := range files {
+		expired := b.retention.MaxAge > 0 && now.Sub(f.modTime) > b.retention.MaxAge
+		exceeded := b.retention.MaxRecords > 0 && i >= b.retention.MaxRecords
+		if !expired && !exceeded {
+			continue
+		}
+		if err := os.Remove(filepath.Join(b.dir, f.name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("pruning history record: %w", err)
+		}
+	}
+	return nil
+}
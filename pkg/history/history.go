@@ -0,0 +1,152 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package history archives the records of finished MPIJobs, so that they
+// outlive the MPIJob objects, which are deleted by users or after their
+// TTL.
+package history
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// Record is the archived record of a finished MPIJob.
+type Record struct {
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+	// State is the final state of the MPIJob, Succeeded or Failed.
+	State          kubeflow.JobConditionType `json:"state"`
+	Reason         string                    `json:"reason,omitempty"`
+	Message        string                    `json:"message,omitempty"`
+	CreationTime   metav1.Time               `json:"creationTime"`
+	StartTime      *metav1.Time              `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time              `json:"completionTime,omitempty"`
+	// Duration is the time between the start and the completion of the MPIJob.
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// Resources is the sum of the resource requests of the launcher and the
+	// workers.
+	Resources    corev1.ResourceList `json:"resources,omitempty"`
+	Spec         kubeflow.MPIJobSpec `json:"spec"`
+	Status       kubeflow.JobStatus  `json:"status"`
+	ArchivedTime metav1.Time         `json:"archivedTime"`
+}
+
+// Backend stores the records of finished MPIJobs.
+type Backend interface {
+	// Archive stores the record. It's called once when the MPIJob finishes,
+	// and can be called again for the same MPIJob after an operator restart.
+	Archive(ctx context.Context, record *Record) error
+}
+
+// Retention bounds the records kept by a Backend that enforces retention.
+// Zero values mean no bound.
+type Retention struct {
+	MaxAge     time.Duration
+	MaxRecords int
+}
+
+// NewRecord creates the record of the finished MPIJob.
+func NewRecord(mpiJob *kubeflow.MPIJob, now time.Time) *Record {
+	record := &Record{
+		Namespace:      mpiJob.Namespace,
+		Name:           mpiJob.Name,
+		UID:            mpiJob.UID,
+		CreationTime:   mpiJob.CreationTimestamp,
+		StartTime:      mpiJob.Status.StartTime,
+		CompletionTime: mpiJob.Status.CompletionTime,
+		Resources:      requestedResources(mpiJob),
+		Spec:           *mpiJob.Spec.DeepCopy(),
+		Status:         *mpiJob.Status.DeepCopy(),
+		ArchivedTime:   metav1.NewTime(now),
+	}
+	for _, condType := range []kubeflow.JobConditionType{kubeflow.JobFailed, kubeflow.JobSucceeded} {
+		for _, cond := range mpiJob.Status.Conditions {
+			if cond.Type == condType && cond.Status == corev1.ConditionTrue {
+				record.State = condType
+				record.Reason = cond.Reason
+				record.Message = cond.Message
+			}
+		}
+		if record.State != "" {
+			break
+		}
+	}
+	if record.StartTime != nil && record.CompletionTime != nil {
+		record.Duration = &metav1.Duration{Duration: record.CompletionTime.Sub(record.StartTime.Time)}
+	}
+	return record
+}
+
+// requestedResources sums the container resource requests of every replica.
+// Limits are used for the resources without requests, as the API server
+// does.
+func requestedResources(mpiJob *kubeflow.MPIJob) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, spec := range mpiJob.Spec.MPIReplicaSpecs {
+		if spec == nil {
+			continue
+		}
+		replicas := ptr.Deref(spec.Replicas, 1)
+		for _, container := range spec.Template.Spec.Containers {
+			requests := container.Resources.Requests.DeepCopy()
+			if requests == nil {
+				requests = corev1.ResourceList{}
+			}
+			for name, limit := range container.Resources.Limits {
+				if _, ok := requests[name]; !ok {
+					requests[name] = limit
+				}
+			}
+			for name, quantity := range requests {
+				quantity.Mul(int64(replicas))
+				sum := total[name]
+				sum.Add(quantity)
+				total[name] = sum
+			}
+		}
+	}
+	if len(total) == 0 {
+		return nil
+	}
+	return total
+}
+
+// NewBackend returns the Backend for the URL: file:///path stores the
+// records in a directory, which can be backed by a PersistentVolume, and
+// http:// or https:// URLs receive the records as JSON POST requests.
+func NewBackend(rawURL string, retention Retention) (Backend, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing history backend URL: %w", err)
+	}
+	switch u.Scheme {
+	case "file":
+		return NewFileBackend(u.Path, retention)
+	case "http", "https":
+		return NewHTTPBackend(rawURL), nil
+	default:
+		return nil, fmt.Errorf("unsupported history backend %q, must be a file, http or https URL", rawURL)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func newFinishedMPIJob(name string) *kubeflow.MPIJob {
+	start := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	completion := metav1.NewTime(start.Add(90 * time.Second))
+	return &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name)},
+		Spec: kubeflow.MPIJobSpec{
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+						},
+					}}}},
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Replicas: ptr.To[int32](4),
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+							Limits:   corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+						},
+					}}}},
+				},
+			},
+		},
+		Status: kubeflow.JobStatus{
+			Conditions: []kubeflow.JobCondition{
+				{Type: kubeflow.JobCreated, Status: corev1.ConditionTrue},
+				{Type: kubeflow.JobRunning, Status: corev1.ConditionFalse},
+				{Type: kubeflow.JobSucceeded, Status: corev1.ConditionTrue, Reason: "MPIJobSucceeded", Message: "done"},
+			},
+			StartTime:      &start,
+			CompletionTime: &completion,
+		},
+	}
+}
+
+func TestNewRecord(t *testing.T) {
+	mpiJob := newFinishedMPIJob("pi")
+	now := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+
+	got := NewRecord(mpiJob, now)
+	want := &Record{
+		Namespace:      "default",
+		Name:           "pi",
+		UID:            mpiJob.UID,
+		State:          kubeflow.JobSucceeded,
+		Reason:         "MPIJobSucceeded",
+		Message:        "done",
+		StartTime:      mpiJob.Status.StartTime,
+		CompletionTime: mpiJob.Status.CompletionTime,
+		Duration:       &metav1.Duration{Duration: 90 * time.Second},
+		Resources: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("9"),
+			"nvidia.com/gpu":   resource.MustParse("4"),
+		},
+		Spec:         mpiJob.Spec,
+		Status:       mpiJob.Status,
+		ArchivedTime: metav1.NewTime(now),
+	}
+	if diff := cmp.Diff(want, got, cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })); diff != "" {
+		t.Errorf("Unexpected record (-want,+got):\n%s", diff)
+	}
+}
+
+func TestFileBackend(t *testing.T) {
+	now := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		retention Retention
+		existing  map[string]time.Duration
+		wantFiles []string
+	}{
+		"no retention": {
+			existing: map[string]time.Duration{
+				"default_old_uid.json": 30 * 24 * time.Hour,
+			},
+			wantFiles: []string{"default_new_uid-new.json", "default_old_uid.json"},
+		},
+		"max age": {
+			retention: Retention{MaxAge: 7 * 24 * time.Hour},
+			existing: map[string]time.Duration{
+				"default_expired_uid.json": 8 * 24 * time.Hour,
+				"default_recent_uid.json":  24 * time.Hour,
+				"unrelated.txt":            30 * 24 * time.Hour,
+			},
+			wantFiles: []string{"default_new_uid-new.json", "default_recent_uid.json", "unrelated.txt"},
+		},
+		"max records": {
+			retention: Retention{MaxRecords: 2},
+			existing: map[string]time.Duration{
+				"default_a_uid.json": 3 * time.Hour,
+				"default_b_uid.json": 2 * time.Hour,
+				"default_c_uid.json": time.Hour,
+			},
+			wantFiles: []string{"default_c_uid.json", "default_new_uid-new.json"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "history")
+			b, err := NewFileBackend(dir, tc.retention)
+			if err != nil {
+				t.Fatalf("NewFileBackend() failed: %v", err)
+			}
+			b.now = func() time.Time { return now }
+			for file, age := range tc.existing {
+				path := filepath.Join(dir, file)
+				if err := os.WriteFile(path, []byte("{}"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Chtimes(path, now.Add(-age), now.Add(-age)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			record := NewRecord(newFinishedMPIJob("new"), now)
+			if err := b.Archive(context.Background(), record); err != nil {
+				t.Fatalf("Archive() failed: %v", err)
+			}
+			// The archived file has the real modification time, newer than
+			// the existing ones.
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var files []string
+			for _, e := range entries {
+				files = append(files, e.Name())
+			}
+			sort.Strings(files)
+			if diff := cmp.Diff(tc.wantFiles, files); diff != "" {
+				t.Errorf("Unexpected files (-want,+got):\n%s", diff)
+			}
+
+			data, err := os.ReadFile(filepath.Join(dir, "default_new_uid-new.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got Record
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Failed to decode record: %v", err)
+			}
+			if got.Name != "new" || got.State != kubeflow.JobSucceeded {
+				t.Errorf("Unexpected record %s with state %s", got.Name, got.State)
+			}
+		})
+	}
+}
+
+func TestHTTPBackend(t *testing.T) {
+	cases := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"accepted": {
+			status: http.StatusAccepted,
+		},
+		"server error": {
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got Record
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("Unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+				}
+				data, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(data, &got); err != nil {
+					t.Errorf("Failed to decode record: %v", err)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			b, err := NewBackend(server.URL, Retention{})
+			if err != nil {
+				t.Fatalf("NewBackend() failed: %v", err)
+			}
+			err = b.Archive(context.Background(), NewRecord(newFinishedMPIJob("pi"), time.Now()))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Archive() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if got.Name != "pi" {
+				t.Errorf("Server received record for %q, want pi", got.Name)
+			}
+		})
+	}
+}
+
+func TestNewBackend(t *testing.T) {
+	if _, err := NewBackend("s3://bucket/prefix", Retention{}); err == nil {
+		t.Error("NewBackend() succeeded for an unsupported scheme")
+	}
+	dir := t.TempDir()
+	b, err := NewBackend("file://"+dir, Retention{})
+	if err != nil {
+		t.Fatalf("NewBackend() failed: %v", err)
+	}
+	if _, ok := b.(*FileBackend); !ok {
+		t.Errorf("NewBackend() returned %T, want *FileBackend", b)
+	}
+}
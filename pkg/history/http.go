@@ -0,0 +1,66 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const httpBackendTimeout = 10 * time.Second
+
+// HTTPBackend sends each record as a JSON POST request to a URL. The
+// receiver is responsible for the retention of the records.
+type HTTPBackend struct {
+	url    string
+	client *http.Client
+}
+
+var _ Backend = &HTTPBackend{}
+
+// NewHTTPBackend returns an HTTPBackend posting the records to url.
+func NewHTTPBackend(url string) *HTTPBackend {
+	return &HTTPBackend{
+		url:    url,
+		client: &http.Client{Timeout: httpBackendTimeout},
+	}
+}
+
+func (b *HTTPBackend) Archive(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("encoding history record: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("creating history request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending history record: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sending history record: unexpected status %s", resp.Status)
+	}
+	return nil
+}
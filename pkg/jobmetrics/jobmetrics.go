@@ -0,0 +1,153 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jobmetrics pushes the final metrics of finished MPIJobs to a
+// Prometheus Pushgateway, so that jobs finishing between two scrapes are
+// still recorded.
+package jobmetrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"github.com/prometheus/common/expfmt"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// JobName is the value of the job label of the pushed metrics.
+	JobName = "mpi-operator"
+
+	pushTimeout = 10 * time.Second
+)
+
+// Metrics are the final metrics of a finished MPIJob.
+type Metrics struct {
+	Namespace string
+	Name      string
+	State     kubeflow.JobConditionType
+	// Ranks is the number of MPI processes, one per slot.
+	Ranks   int32
+	Workers int32
+	// LauncherFailures is the number of failed launcher pods.
+	LauncherFailures int32
+	// ExitCode is the exit code of the launcher container, if known.
+	ExitCode       *int32
+	StartTime      *time.Time
+	CompletionTime *time.Time
+	// GPUUsage is the usage of the GPUs of the MPIJob, if known.
+	GPUUsage *kubeflow.GPUUsage
+}
+
+// Pusher pushes the metrics of finished MPIJobs.
+type Pusher interface {
+	Push(ctx context.Context, m *Metrics) error
+}
+
+// PushgatewayPusher pushes the metrics of each MPIJob to its own group of a
+// Pushgateway, identified by the namespace and mpijob labels. The metrics of
+// a group are replaced by each push, so a recreated MPIJob replaces the
+// metrics of the previous one.
+type PushgatewayPusher struct {
+	url      string
+	username string
+	password string
+	client   *http.Client
+}
+
+// NewPushgatewayPusher returns a PushgatewayPusher for the URL of a
+// Pushgateway, like http://pushgateway.monitoring:9091. Endpoints
+// implementing the Pushgateway API under a path prefix are supported. The
+// credentials of the URL, if any, are sent with basic authentication.
+func NewPushgatewayPusher(rawURL string) (*PushgatewayPusher, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Pushgateway URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported Pushgateway URL %q, must be an http or https URL", rawURL)
+	}
+	p := &PushgatewayPusher{client: &http.Client{Timeout: pushTimeout}}
+	if u.User != nil {
+		// Errors of the push client contain the URL, so the credentials are
+		// kept out of it.
+		p.username = u.User.Username()
+		p.password, _ = u.User.Password()
+		u.User = nil
+	}
+	p.url = u.String()
+	return p, nil
+}
+
+func (p *PushgatewayPusher) Push(ctx context.Context, m *Metrics) error {
+	// The text format is accepted by more implementations of the Pushgateway
+	// API than the default protobuf format.
+	pusher := push.New(p.url, JobName).
+		Client(p.client).
+		Format(expfmt.NewFormat(expfmt.TypeTextPlain)).
+		Grouping("namespace", m.Namespace).
+		Grouping("mpijob", m.Name)
+	if p.username != "" {
+		pusher.BasicAuth(p.username, p.password)
+	}
+	for _, c := range collectors(m) {
+		pusher.Collector(c)
+	}
+	if err := pusher.PushContext(ctx); err != nil {
+		return fmt.Errorf("pushing metrics: %w", err)
+	}
+	return nil
+}
+
+func collectors(m *Metrics) []prometheus.Collector {
+	gauge := func(name, help string, value float64) prometheus.Collector {
+		g := prometheus.NewGauge(prometheus.GaugeOpts{Name: name, Help: help})
+		g.Set(value)
+		return g
+	}
+	succeeded := 0.0
+	if m.State == kubeflow.JobSucceeded {
+		succeeded = 1
+	}
+	result := []prometheus.Collector{
+		gauge("mpi_job_succeeded", "Whether the MPIJob succeeded (1) or failed (0)", succeeded),
+		gauge("mpi_job_ranks", "Number of MPI processes of the MPIJob", float64(m.Ranks)),
+		gauge("mpi_job_workers", "Number of workers of the MPIJob", float64(m.Workers)),
+		gauge("mpi_job_launcher_failures", "Number of failed launcher pods of the MPIJob", float64(m.LauncherFailures)),
+	}
+	if m.ExitCode != nil {
+		result = append(result, gauge("mpi_job_launcher_exit_code", "Exit code of the launcher of the MPIJob", float64(*m.ExitCode)))
+	}
+	if m.StartTime != nil && m.CompletionTime != nil {
+		result = append(result, gauge("mpi_job_duration_seconds", "Time between the start and the completion of the MPIJob", m.CompletionTime.Sub(*m.StartTime).Seconds()))
+	}
+	if m.GPUUsage != nil {
+		result = append(result,
+			gauge("mpi_job_gpus", "Number of GPUs used by the MPIJob", float64(m.GPUUsage.GPUs)),
+			gauge("mpi_job_gpu_utilization_average_percent", "Average utilization of the GPUs of the MPIJob", float64(m.GPUUsage.AverageUtilization)),
+			gauge("mpi_job_gpu_utilization_peak_percent", "Highest utilization of any GPU of the MPIJob", float64(m.GPUUsage.PeakUtilization)),
+			gauge("mpi_job_gpu_energy_joules", "Estimated energy consumed by the GPUs of the MPIJob", float64(m.GPUUsage.EnergyJoules)),
+		)
+	}
+	if m.CompletionTime != nil {
+		result = append(result, gauge("mpi_job_completion_timestamp_seconds", "Completion time of the MPIJob since the Unix epoch", float64(m.CompletionTime.UnixNano())/1e9))
+	}
+	return result
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobmetrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestPushgatewayPusher(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	completion := start.Add(90 * time.Second)
+	cases := map[string]struct {
+		metrics *Metrics
+		want    map[string]float64
+	}{
+		"succeeded": {
+			metrics: &Metrics{
+				Namespace:      "research",
+				Name:           "pi",
+				State:          kubeflow.JobSucceeded,
+				Ranks:          8,
+				Workers:        4,
+				ExitCode:       ptr.To[int32](0),
+				StartTime:      &start,
+				CompletionTime: &completion,
+			},
+			want: map[string]float64{
+				"mpi_job_succeeded":                    1,
+				"mpi_job_ranks":                        8,
+				"mpi_job_workers":                      4,
+				"mpi_job_launcher_failures":            0,
+				"mpi_job_launcher_exit_code":           0,
+				"mpi_job_duration_seconds":             90,
+				"mpi_job_completion_timestamp_seconds": float64(completion.Unix()),
+			},
+		},
+		"failed without launcher pod": {
+			metrics: &Metrics{
+				Namespace:        "research",
+				Name:             "pi",
+				State:            kubeflow.JobFailed,
+				Ranks:            2,
+				Workers:          2,
+				LauncherFailures: 6,
+			},
+			want: map[string]float64{
+				"mpi_job_succeeded":         0,
+				"mpi_job_ranks":             2,
+				"mpi_job_workers":           2,
+				"mpi_job_launcher_failures": 6,
+			},
+		},
+		"with GPU usage": {
+			metrics: &Metrics{
+				Namespace: "research",
+				Name:      "pi",
+				State:     kubeflow.JobSucceeded,
+				Ranks:     64,
+				Workers:   8,
+				GPUUsage: &kubeflow.GPUUsage{
+					GPUs:               64,
+					AverageUtilization: 11,
+					PeakUtilization:    37,
+					EnergyJoules:       4200000,
+				},
+			},
+			want: map[string]float64{
+				"mpi_job_succeeded":                       1,
+				"mpi_job_ranks":                           64,
+				"mpi_job_workers":                         8,
+				"mpi_job_launcher_failures":               0,
+				"mpi_job_gpus":                            64,
+				"mpi_job_gpu_utilization_average_percent": 11,
+				"mpi_job_gpu_utilization_peak_percent":    37,
+				"mpi_job_gpu_energy_joules":               4200000,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var method, path, user, password string
+			got := map[string]float64{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, path = r.Method, r.URL.Path
+				user, password, _ = r.BasicAuth()
+				dec := expfmt.NewDecoder(r.Body, expfmt.ResponseFormat(r.Header))
+				for {
+					var mf dto.MetricFamily
+					if err := dec.Decode(&mf); err != nil {
+						if !errors.Is(err, io.EOF) {
+							t.Errorf("Decoding metrics: %v", err)
+						}
+						break
+					}
+					for _, m := range mf.GetMetric() {
+						got[mf.GetName()] = m.GetGauge().GetValue()
+					}
+				}
+			}))
+			defer server.Close()
+
+			p, err := NewPushgatewayPusher(strings.Replace(server.URL, "://", "://mpi:secret@", 1) + "/prefix")
+			if err != nil {
+				t.Fatalf("NewPushgatewayPusher(): %v", err)
+			}
+			if err := p.Push(context.Background(), tc.metrics); err != nil {
+				t.Fatalf("Push(): %v", err)
+			}
+			if method != http.MethodPut {
+				t.Errorf("Unexpected method %s, want %s", method, http.MethodPut)
+			}
+			if user != "mpi" || password != "secret" {
+				t.Errorf("Unexpected basic authentication %s:%s, want mpi:secret", user, password)
+			}
+			// The order of the grouping labels in the path is unspecified.
+			gotGrouping := map[string]string{}
+			segments := strings.Split(strings.TrimPrefix(path, "/prefix/metrics/"), "/")
+			for i := 0; i+1 < len(segments); i += 2 {
+				gotGrouping[segments[i]] = segments[i+1]
+			}
+			wantGrouping := map[string]string{"job": JobName, "namespace": "research", "mpijob": "pi"}
+			if diff := cmp.Diff(wantGrouping, gotGrouping); diff != "" {
+				t.Errorf("Unexpected grouping of path %s (-want,+got):\n%s", path, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected metrics (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPushgatewayPusherError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	p, err := NewPushgatewayPusher(strings.Replace(server.URL, "://", "://mpi:secret@", 1))
+	if err != nil {
+		t.Fatalf("NewPushgatewayPusher(): %v", err)
+	}
+	err = p.Push(context.Background(), &Metrics{Namespace: "default", Name: "pi"})
+	if err == nil {
+		t.Fatalf("Push() succeeded with a failing Pushgateway")
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("Push() error contains the credentials: %v", err)
+	}
+}
+
+func TestNewPushgatewayPusher(t *testing.T) {
+	for _, rawURL := range []string{"pushgateway:9091", "ftp://pushgateway", "http://[::1"} {
+		if _, err := NewPushgatewayPusher(rawURL); err == nil {
+			t.Errorf("NewPushgatewayPusher(%q) succeeded, want error", rawURL)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logs streams the logs of all the pods of an MPIJob, merged line by
+// line and prefixed by the name of the pod that produced them.
+package logs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+// StreamMPIJob streams the logs of the launcher and worker pods of the
+// MPIJob to w. Each line is prefixed with the name of its pod. Lines of
+// different pods are interleaved in the order they are read, but a line is
+// never split.
+//
+// When opts.Follow is set, StreamMPIJob returns once all the streams are
+// closed or ctx is cancelled. Pods created after the call are not followed.
+func StreamMPIJob(ctx context.Context, client kubernetes.Interface, namespace, jobName string, opts *corev1.PodLogOptions, w io.Writer) error {
+	selector := labels.SelectorFromSet(labels.Set{
+		kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+		kubeflow.JobNameLabel:      jobName,
+	})
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return fmt.Errorf("listing pods of MPIJob %s/%s: %w", namespace, jobName, err)
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("MPIJob %s/%s has no pods", namespace, jobName)
+	}
+	sort.SliceStable(pods.Items, func(i, j int) bool {
+		return podRank(&pods.Items[i]) < podRank(&pods.Items[j])
+	})
+	return StreamPods(ctx, client, pods.Items, opts, w)
+}
+
+// StreamPods streams the logs of the given pods to w concurrently, with each
+// line prefixed with the name of its pod.
+func StreamPods(ctx context.Context, client kubernetes.Interface, pods []corev1.Pod, opts *corev1.PodLogOptions, w io.Writer) error {
+	out := &lineWriter{w: w}
+	errs := make([]error, len(pods))
+	var wg sync.WaitGroup
+	for i := range pods {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = streamPod(ctx, client, &pods[i], opts, out)
+		}(i)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func streamPod(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod, opts *corev1.PodLogOptions, out *lineWriter) error {
+	stream, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("getting logs of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	defer stream.Close()
+
+	prefix := []byte("[" + pod.Name + "] ")
+	r := bufio.NewReader(stream)
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 {
+			if line[len(line)-1] != '\n' {
+				line = append(line, '\n')
+			}
+			if werr := out.writeLine(prefix, line); werr != nil {
+				return werr
+			}
+		}
+		if err == io.EOF || (err != nil && ctx.Err() != nil) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading logs of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+	}
+}
+
+// lineWriter serializes the writes of whole lines to w.
+type lineWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (lw *lineWriter) writeLine(prefix, line []byte) error {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+	if _, err := lw.w.Write(prefix); err != nil {
+		return err
+	}
+	_, err := lw.w.Write(line)
+	return err
+}
+
+// podRank orders the launcher before the workers, and the workers by their
+// replica index.
+func podRank(pod *corev1.Pod) int {
+	if pod.Labels[kubeflow.JobRoleLabel] == "launcher" {
+		return -1
+	}
+	index, err := strconv.Atoi(pod.Labels[kubeflow.ReplicaIndexLabel])
+	if err != nil {
+		return math.MaxInt
+	}
+	return index
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestStreamMPIJob(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newPod("pi-launcher-abcde", "launcher", "0"),
+		newPod("pi-worker-0", "worker", "0"),
+		newPod("pi-worker-1", "worker", "1"),
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other",
+				Namespace: "default",
+			},
+		},
+	)
+	var out bytes.Buffer
+	if err := StreamMPIJob(context.Background(), client, "default", "pi", &corev1.PodLogOptions{}, &out); err != nil {
+		t.Fatalf("StreamMPIJob() failed: %v", err)
+	}
+	got := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	sort.Strings(got)
+	want := []string{
+		"[pi-launcher-abcde] fake logs",
+		"[pi-worker-0] fake logs",
+		"[pi-worker-1] fake logs",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected logs (-want,+got):\n%s", diff)
+	}
+}
+
+func TestStreamMPIJobWithoutPods(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	var out bytes.Buffer
+	if err := StreamMPIJob(context.Background(), client, "default", "pi", &corev1.PodLogOptions{}, &out); err == nil {
+		t.Error("StreamMPIJob() succeeded, want error")
+	}
+}
+
+func newPod(name, role, index string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+				kubeflow.JobNameLabel:      "pi",
+				kubeflow.JobRoleLabel:      role,
+				kubeflow.ReplicaIndexLabel: index,
+			},
+		},
+	}
+}
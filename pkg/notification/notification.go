@@ -0,0 +1,245 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notification sends HTTP webhooks, such as Slack, Teams or PagerDuty
+// ones, when MPIJobs succeed or fail.
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// Config is the content of the notification configuration file.
+type Config struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// Webhook is an HTTP endpoint receiving a POST request for every terminal
+// transition of the MPIJobs it's subscribed to.
+type Webhook struct {
+	// Name identifies the webhook in logs and events.
+	Name string `json:"name"`
+	// URL of the webhook. Environment variables, like ${SLACK_WEBHOOK_URL},
+	// are expanded, so that it can be read from a Secret.
+	URL string `json:"url"`
+	// Headers of the request. Environment variables are expanded in the
+	// values.
+	Headers map[string]string `json:"headers,omitempty"`
+	// States are the terminal states notified, Succeeded and Failed.
+	// Defaults to both.
+	States []kubeflow.JobConditionType `json:"states,omitempty"`
+	// Namespaces restricts the notifications to the MPIJobs of these
+	// namespaces. Defaults to all namespaces.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Template is the text/template of the body of the request, executed
+	// with an Event. The json function encodes a value as JSON, which
+	// escapes strings, and the env function returns the value of an
+	// environment variable. Defaults to the Event encoded as JSON.
+	Template string `json:"template,omitempty"`
+	// ContentType of the request. Defaults to application/json.
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// Event is a terminal transition of an MPIJob.
+type Event struct {
+	Namespace      string                    `json:"namespace"`
+	Name           string                    `json:"name"`
+	UID            string                    `json:"uid"`
+	State          kubeflow.JobConditionType `json:"state"`
+	Reason         string                    `json:"reason"`
+	Message        string                    `json:"message"`
+	StartTime      *time.Time                `json:"startTime,omitempty"`
+	CompletionTime *time.Time                `json:"completionTime,omitempty"`
+	// Duration is the time between the start and the completion, like 1h2m3s.
+	Duration string `json:"duration,omitempty"`
+}
+
+// NewEvent returns the Event of a finished MPIJob, or false if the MPIJob
+// isn't finished.
+func NewEvent(job *kubeflow.MPIJob) (*Event, bool) {
+	for _, condType := range []kubeflow.JobConditionType{kubeflow.JobFailed, kubeflow.JobSucceeded} {
+		for _, cond := range job.Status.Conditions {
+			if cond.Type != condType || cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			e := &Event{
+				Namespace: job.Namespace,
+				Name:      job.Name,
+				UID:       string(job.UID),
+				State:     condType,
+				Reason:    cond.Reason,
+				Message:   cond.Message,
+			}
+			if job.Status.StartTime != nil {
+				e.StartTime = &job.Status.StartTime.Time
+			}
+			if job.Status.CompletionTime != nil {
+				e.CompletionTime = &job.Status.CompletionTime.Time
+			}
+			if e.StartTime != nil && e.CompletionTime != nil {
+				e.Duration = e.CompletionTime.Sub(*e.StartTime).Round(time.Second).String()
+			}
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// Notifier sends the notifications of finished MPIJobs.
+type Notifier interface {
+	// Notify sends the Event to the webhooks subscribed to it.
+	Notify(ctx context.Context, e *Event) error
+}
+
+type webhookNotifier struct {
+	client   *http.Client
+	webhooks []webhook
+}
+
+type webhook struct {
+	Webhook
+	template   *template.Template
+	states     map[kubeflow.JobConditionType]bool
+	namespaces map[string]bool
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"env": os.Getenv,
+}
+
+// LoadConfig reads the notification configuration from the YAML file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading notification config: %w", err)
+	}
+	var cfg Config
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing notification config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// NewNotifier returns a Notifier sending the webhooks of the configuration.
+func NewNotifier(cfg *Config) (Notifier, error) {
+	n := &webhookNotifier{client: &http.Client{Timeout: webhookTimeout}}
+	for i, w := range cfg.Webhooks {
+		if w.Name == "" {
+			w.Name = fmt.Sprintf("webhook-%d", i)
+		}
+		if w.URL == "" {
+			return nil, fmt.Errorf("webhook %s has no url", w.Name)
+		}
+		wh := webhook{Webhook: w}
+		if w.Template != "" {
+			t, err := template.New(w.Name).Funcs(templateFuncs).Option("missingkey=error").Parse(w.Template)
+			if err != nil {
+				return nil, fmt.Errorf("parsing template of webhook %s: %w", w.Name, err)
+			}
+			wh.template = t
+		}
+		if len(w.States) > 0 {
+			wh.states = make(map[kubeflow.JobConditionType]bool)
+			for _, s := range w.States {
+				if s != kubeflow.JobSucceeded && s != kubeflow.JobFailed {
+					return nil, fmt.Errorf("webhook %s has unsupported state %q, must be %s or %s", w.Name, s, kubeflow.JobSucceeded, kubeflow.JobFailed)
+				}
+				wh.states[s] = true
+			}
+		}
+		if len(w.Namespaces) > 0 {
+			wh.namespaces = make(map[string]bool)
+			for _, ns := range w.Namespaces {
+				wh.namespaces[ns] = true
+			}
+		}
+		n.webhooks = append(n.webhooks, wh)
+	}
+	return n, nil
+}
+
+// Notify sends the Event to every subscribed webhook, even if some of them
+// fail, and returns the errors joined.
+func (n *webhookNotifier) Notify(ctx context.Context, e *Event) error {
+	var errs []error
+	for i := range n.webhooks {
+		w := &n.webhooks[i]
+		if (w.states != nil && !w.states[e.State]) || (w.namespaces != nil && !w.namespaces[e.Namespace]) {
+			continue
+		}
+		if err := n.send(ctx, w, e); err != nil {
+			errs = append(errs, fmt.Errorf("webhook %s: %w", w.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *webhookNotifier) send(ctx context.Context, w *webhook, e *Event) error {
+	var body bytes.Buffer
+	if w.template != nil {
+		if err := w.template.Execute(&body, e); err != nil {
+			return fmt.Errorf("executing template: %w", err)
+		}
+	} else if err := json.NewEncoder(&body).Encode(e); err != nil {
+		return fmt.Errorf("encoding event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, os.ExpandEnv(w.URL), &body)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	contentType := w.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	req.Header.Set("Content-Type", contentType)
+	for key, value := range w.Headers {
+		req.Header.Set(key, os.ExpandEnv(value))
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		// The error contains the URL, which may be a secret.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func TestNewEvent(t *testing.T) {
+	start := metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	completion := metav1.NewTime(start.Add(90 * time.Minute))
+	job := &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "research", UID: "pi-uid"},
+		Status: kubeflow.JobStatus{
+			Conditions: []kubeflow.JobCondition{
+				{Type: kubeflow.JobCreated, Status: corev1.ConditionTrue},
+				{Type: kubeflow.JobRunning, Status: corev1.ConditionFalse},
+				{Type: kubeflow.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+			},
+			StartTime:      &start,
+			CompletionTime: &completion,
+		},
+	}
+	got, ok := NewEvent(job)
+	if !ok {
+		t.Fatalf("NewEvent() didn't return an event")
+	}
+	want := &Event{
+		Namespace:      "research",
+		Name:           "pi",
+		UID:            "pi-uid",
+		State:          kubeflow.JobFailed,
+		Reason:         "BackoffLimitExceeded",
+		Message:        "Job has reached the specified backoff limit",
+		StartTime:      &start.Time,
+		CompletionTime: &completion.Time,
+		Duration:       "1h30m0s",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected event (-want,+got):\n%s", diff)
+	}
+
+	job.Status.Conditions = job.Status.Conditions[:2]
+	if _, ok := NewEvent(job); ok {
+		t.Errorf("NewEvent() returned an event for an MPIJob that isn't finished")
+	}
+}
+
+type request struct {
+	Path        string
+	ContentType string
+	Auth        string
+	Body        string
+}
+
+func TestNotify(t *testing.T) {
+	var got []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = append(got, request{
+			Path:        r.URL.Path,
+			ContentType: r.Header.Get("Content-Type"),
+			Auth:        r.Header.Get("Authorization"),
+			Body:        string(body),
+		})
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	t.Setenv("TEST_WEBHOOK_TOKEN", "token")
+
+	notifier, err := NewNotifier(&Config{
+		Webhooks: []Webhook{
+			{
+				Name:     "slack",
+				URL:      server.URL + "/slack",
+				Template: `{"text": {{json (printf "MPIJob %s/%s %s: %s" .Namespace .Name .State .Message)}}, "token": {{json (env "TEST_WEBHOOK_TOKEN")}}}`,
+			},
+			{
+				Name:    "failures",
+				URL:     server.URL + "/failures",
+				States:  []kubeflow.JobConditionType{kubeflow.JobFailed},
+				Headers: map[string]string{"Authorization": "Bearer ${TEST_WEBHOOK_TOKEN}"},
+			},
+			{
+				Name:       "other-team",
+				URL:        server.URL + "/other-team",
+				Namespaces: []string{"other"},
+			},
+			{
+				Name: "broken",
+				URL:  server.URL + "/broken",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewNotifier() failed: %v", err)
+	}
+	err = notifier.Notify(context.Background(), &Event{
+		Namespace: "research",
+		Name:      "pi",
+		State:     kubeflow.JobSucceeded,
+		Message:   `"pi" completed`,
+	})
+	if err == nil {
+		t.Errorf("Notify() succeeded with a broken webhook")
+	}
+	want := []request{
+		{
+			Path:        "/slack",
+			ContentType: "application/json",
+			Body:        `{"text": "MPIJob research/pi Succeeded: \"pi\" completed", "token": "token"}`,
+		},
+		{
+			Path:        "/broken",
+			ContentType: "application/json",
+			Body:        `{"namespace":"research","name":"pi","uid":"","state":"Succeeded","reason":"","message":"\"pi\" completed"}` + "\n",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+	}
+
+	got = nil
+	if err := notifier.Notify(context.Background(), &Event{Namespace: "research", Name: "pi", State: kubeflow.JobFailed}); err == nil {
+		t.Errorf("Notify() succeeded with a broken webhook")
+	}
+	if len(got) != 3 || got[1].Path != "/failures" || got[1].Auth != "Bearer token" {
+		t.Errorf("Unexpected requests for a failed MPIJob: %+v", got)
+	}
+}
+
+func TestNewNotifierErrors(t *testing.T) {
+	cases := map[string]Webhook{
+		"no url":            {Name: "slack"},
+		"invalid template":  {Name: "slack", URL: "http://example.com", Template: "{{.Name"},
+		"unsupported state": {Name: "slack", URL: "http://example.com", States: []kubeflow.JobConditionType{kubeflow.JobRunning}},
+	}
+	for name, w := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewNotifier(&Config{Webhooks: []Webhook{w}}); err == nil {
+				t.Errorf("NewNotifier() succeeded")
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.yaml")
+	content := `webhooks:
+- name: slack
+  url: ${SLACK_WEBHOOK_URL}
+  states: [Failed]
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() failed: %v", err)
+	}
+	want := &Config{Webhooks: []Webhook{{
+		Name:   "slack",
+		URL:    "${SLACK_WEBHOOK_URL}",
+		States: []kubeflow.JobConditionType{kubeflow.JobFailed},
+	}}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected config (-want,+got):\n%s", diff)
+	}
+
+	if err := os.WriteFile(path, []byte("webhooks:\n- name: slack\n  uri: http://example.com\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(path); err == nil {
+		t.Errorf("LoadConfig() accepted an unknown field")
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package poddefaults merges the defaults of the operator, like the
+// tolerations and node selector of the nodes dedicated to training or the
+// volumes and environment variables of the site, into the pods of the
+// MPIJobs.
+package poddefaults
+
+import (
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// Config is the content of the pod defaults configuration file. The values
+// set in the pod templates of an MPIJob take precedence.
+type Config struct {
+	// Labels are set in the launcher and worker pods, unless their pod
+	// template sets the same label.
+	Labels map[string]string `json:"labels,omitempty"`
+	// NodeSelector is merged into the node selector of the launcher and
+	// worker pods, unless their pod template selects the same label.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are added to the launcher and worker pods, unless their pod
+	// template has a toleration with the same key and effect.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Volumes are added to the launcher and worker pods, unless their pod
+	// template has a volume with the same name.
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// VolumeMounts are added to the containers and init containers of the
+	// launcher and worker pods, unless the container has a mount with the
+	// same name or path. They must mount Volumes.
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Env is added to the containers and init containers of the launcher and
+	// worker pods, unless the container sets the same variable, like the
+	// HTTPS_PROXY variable or the path of a CA bundle.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// LoadConfig reads the pod defaults from the YAML file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading pod defaults config: %w", err)
+	}
+	var cfg Config
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing pod defaults config: %w", err)
+	}
+	if errs := cfg.validate(); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid pod defaults config: %w", errs.ToAggregate())
+	}
+	return &cfg, nil
+}
+
+func (c *Config) validate() field.ErrorList {
+	errs := metav1validation.ValidateLabels(c.Labels, field.NewPath("labels"))
+	errs = append(errs, metav1validation.ValidateLabels(c.NodeSelector, field.NewPath("nodeSelector"))...)
+	volumes := sets.New[string]()
+	for i, volume := range c.Volumes {
+		namePath := field.NewPath("volumes").Index(i).Child("name")
+		for _, msg := range validation.IsDNS1123Label(volume.Name) {
+			errs = append(errs, field.Invalid(namePath, volume.Name, msg))
+		}
+		if volumes.Has(volume.Name) {
+			errs = append(errs, field.Duplicate(namePath, volume.Name))
+		}
+		volumes.Insert(volume.Name)
+	}
+	for i, mount := range c.VolumeMounts {
+		mountPath := field.NewPath("volumeMounts").Index(i)
+		if !volumes.Has(mount.Name) {
+			errs = append(errs, field.NotFound(mountPath.Child("name"), mount.Name))
+		}
+		if mount.MountPath == "" {
+			errs = append(errs, field.Required(mountPath.Child("mountPath"), ""))
+		}
+	}
+	for i, env := range c.Env {
+		for _, msg := range validation.IsEnvVarName(env.Name) {
+			errs = append(errs, field.Invalid(field.NewPath("env").Index(i).Child("name"), env.Name, msg))
+		}
+	}
+	return errs
+}
+
+// Apply merges the defaults into the pod template. The pod template must be a
+// copy owned by the caller.
+func (c *Config) Apply(podTemplate *corev1.PodTemplateSpec) {
+	if len(c.Labels) > 0 && podTemplate.Labels == nil {
+		podTemplate.Labels = make(map[string]string)
+	}
+	for key, value := range c.Labels {
+		if _, ok := podTemplate.Labels[key]; !ok {
+			podTemplate.Labels[key] = value
+		}
+	}
+	if len(c.NodeSelector) > 0 && podTemplate.Spec.NodeSelector == nil {
+		podTemplate.Spec.NodeSelector = make(map[string]string)
+	}
+	for key, value := range c.NodeSelector {
+		if _, ok := podTemplate.Spec.NodeSelector[key]; !ok {
+			podTemplate.Spec.NodeSelector[key] = value
+		}
+	}
+	for _, toleration := range c.Tolerations {
+		if !hasToleration(podTemplate.Spec.Tolerations, toleration) {
+			podTemplate.Spec.Tolerations = append(podTemplate.Spec.Tolerations, toleration)
+		}
+	}
+	for _, volume := range c.Volumes {
+		if !hasVolume(podTemplate.Spec.Volumes, volume.Name) {
+			podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, *volume.DeepCopy())
+		}
+	}
+	for i := range podTemplate.Spec.InitContainers {
+		c.applyToContainer(&podTemplate.Spec.InitContainers[i])
+	}
+	for i := range podTemplate.Spec.Containers {
+		c.applyToContainer(&podTemplate.Spec.Containers[i])
+	}
+}
+
+func (c *Config) applyToContainer(container *corev1.Container) {
+	for _, mount := range c.VolumeMounts {
+		if !hasVolumeMount(container.VolumeMounts, mount) {
+			container.VolumeMounts = append(container.VolumeMounts, mount)
+		}
+	}
+	for _, env := range c.Env {
+		if !hasEnv(container.Env, env.Name) {
+			container.Env = append(container.Env, *env.DeepCopy())
+		}
+	}
+}
+
+// hasToleration returns whether one of the tolerations has the key and the
+// effect of the toleration.
+func hasToleration(tolerations []corev1.Toleration, toleration corev1.Toleration) bool {
+	for _, t := range tolerations {
+		if t.Key == toleration.Key && t.Effect == toleration.Effect {
+			return true
+		}
+	}
+	return false
+}
+
+func hasVolume(volumes []corev1.Volume, name string) bool {
+	for _, v := range volumes {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasVolumeMount returns whether one of the mounts has the name or the path
+// of the mount.
+func hasVolumeMount(mounts []corev1.VolumeMount, mount corev1.VolumeMount) bool {
+	for _, m := range mounts {
+		if m.Name == mount.Name || m.MountPath == mount.MountPath {
+			return true
+		}
+	}
+	return false
+}
+
+func hasEnv(env []corev1.EnvVar, name string) bool {
+	for _, e := range env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poddefaults
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pod-defaults.yaml")
+	content := `labels:
+  team: ml
+nodeSelector:
+  pool: training
+tolerations:
+- key: dedicated
+  operator: Equal
+  value: gpu
+  effect: NoSchedule
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() failed: %v", err)
+	}
+	want := &Config{
+		Labels:       map[string]string{"team": "ml"},
+		NodeSelector: map[string]string{"pool": "training"},
+		Tolerations: []corev1.Toleration{{
+			Key:      "dedicated",
+			Operator: corev1.TolerationOpEqual,
+			Value:    "gpu",
+			Effect:   corev1.TaintEffectNoSchedule,
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected config (-want,+got):\n%s", diff)
+	}
+
+	for name, content := range map[string]string{
+		"unknown field":  "nodeSelectors:\n  pool: training\n",
+		"invalid label":  "labels:\n  bad key: ml\n",
+		"unknown volume": "volumeMounts:\n- name: scratch\n  mountPath: /scratch\n",
+		"invalid env":    "env:\n- name: 1PROXY\n",
+	} {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadConfig(path); err == nil {
+			t.Errorf("LoadConfig() accepted a config with an %s", name)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	cfg := &Config{
+		Labels:       map[string]string{"team": "ml", "tier": "batch"},
+		NodeSelector: map[string]string{"pool": "training", "zone": "a"},
+		Tolerations: []corev1.Toleration{
+			{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+		},
+	}
+	cases := map[string]struct {
+		podTemplate corev1.PodTemplateSpec
+		want        corev1.PodTemplateSpec
+	}{
+		"empty template": {
+			want: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"team": "ml", "tier": "batch"},
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{"pool": "training", "zone": "a"},
+					Tolerations:  cfg.Tolerations,
+				},
+			},
+		},
+		"template takes precedence": {
+			podTemplate: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"team": "hpc"},
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{"pool": "inference"},
+					Tolerations: []corev1.Toleration{
+						{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "cpu", Effect: corev1.TaintEffectNoSchedule},
+					},
+				},
+			},
+			want: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"team": "hpc", "tier": "batch"},
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{"pool": "inference", "zone": "a"},
+					Tolerations: []corev1.Toleration{
+						{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "cpu", Effect: corev1.TaintEffectNoSchedule},
+						{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			podTemplate := tc.podTemplate.DeepCopy()
+			cfg.Apply(podTemplate)
+			if diff := cmp.Diff(&tc.want, podTemplate); diff != "" {
+				t.Errorf("Unexpected pod template (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyVolumesAndEnv(t *testing.T) {
+	cfg := &Config{
+		Volumes: []corev1.Volume{
+			{Name: "scratch", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "scratch"}}},
+			{Name: "ca-bundle", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "ca-bundle"}}}},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "scratch", MountPath: "/scratch"},
+			{Name: "ca-bundle", MountPath: "/etc/ssl/site", ReadOnly: true},
+		},
+		Env: []corev1.EnvVar{
+			{Name: "HTTPS_PROXY", Value: "http://proxy:3128"},
+			{Name: "SSL_CERT_DIR", Value: "/etc/ssl/site"},
+		},
+	}
+	podTemplate := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers: []corev1.Container{{
+				Name:         "mpi",
+				VolumeMounts: []corev1.VolumeMount{{Name: "scratch", MountPath: "/tmp/scratch"}},
+				Env:          []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: ""}},
+			}},
+		},
+	}
+	cfg.Apply(podTemplate)
+	want := corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			cfg.Volumes[1],
+		},
+		InitContainers: []corev1.Container{{
+			Name:         "init",
+			VolumeMounts: cfg.VolumeMounts,
+			Env:          cfg.Env,
+		}},
+		Containers: []corev1.Container{{
+			Name: "mpi",
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "scratch", MountPath: "/tmp/scratch"},
+				{Name: "ca-bundle", MountPath: "/etc/ssl/site", ReadOnly: true},
+			},
+			Env: []corev1.EnvVar{
+				{Name: "HTTPS_PROXY", Value: ""},
+				{Name: "SSL_CERT_DIR", Value: "/etc/ssl/site"},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, podTemplate.Spec); diff != "" {
+		t.Errorf("Unexpected pod spec (-want,+got):\n%s", diff)
+	}
+}
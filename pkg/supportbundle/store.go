@@ -0,0 +1,155 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// ConfigMapStorage is the storage keeping each bundle in a ConfigMap
+	// owned by the MPIJob.
+	ConfigMapStorage = "configmap"
+
+	configMapSuffix = "-support-bundle"
+	// BundleKey is the key of the bundle in the binary data of the ConfigMap.
+	BundleKey = "bundle.tar.gz"
+
+	// maxConfigMapBundleSize leaves room for the metadata of the ConfigMap
+	// within the 1MiB limit of etcd objects.
+	maxConfigMapBundleSize = 1000 * 1024
+)
+
+// Store stores the support bundles of failed MPIJobs.
+type Store interface {
+	// Store stores the bundle of the MPIJob and returns its location.
+	Store(ctx context.Context, job *kubeflow.MPIJob, bundle []byte) (string, error)
+}
+
+// NewStore returns the Store for the storage, which is either "configmap" or
+// a file:///path URL of a directory, which can be backed by a
+// PersistentVolume.
+func NewStore(storage string, client kubernetes.Interface) (Store, error) {
+	if storage == ConfigMapStorage {
+		return &ConfigMapStore{client: client}, nil
+	}
+	u, err := url.Parse(storage)
+	if err != nil {
+		return nil, fmt.Errorf("parsing support bundle storage: %w", err)
+	}
+	if u.Scheme != "file" {
+		return nil, fmt.Errorf("unsupported support bundle storage %q, must be %q or a file URL", storage, ConfigMapStorage)
+	}
+	return NewFileStore(u.Path)
+}
+
+// ConfigMapStore stores each bundle in the <name>-support-bundle ConfigMap,
+// owned by the MPIJob, so that it's deleted with the MPIJob.
+type ConfigMapStore struct {
+	client kubernetes.Interface
+}
+
+func (s *ConfigMapStore) Store(ctx context.Context, job *kubeflow.MPIJob, bundle []byte) (string, error) {
+	if len(bundle) > maxConfigMapBundleSize {
+		return "", fmt.Errorf("support bundle of %d bytes doesn't fit in a ConfigMap", len(bundle))
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name + configMapSuffix,
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				"app": job.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(job, kubeflow.SchemeGroupVersionKind),
+			},
+		},
+		BinaryData: map[string][]byte{
+			BundleKey: bundle,
+		},
+	}
+	location := fmt.Sprintf("ConfigMap %s/%s", cm.Namespace, cm.Name)
+	_, err := s.client.CoreV1().ConfigMaps(job.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		existing, err := s.client.CoreV1().ConfigMaps(job.Namespace).Get(ctx, cm.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("getting support bundle ConfigMap: %w", err)
+		}
+		if !metav1.IsControlledBy(existing, job) {
+			return "", fmt.Errorf("%s already exists and is not managed by the MPIJob", location)
+		}
+		existing.BinaryData = cm.BinaryData
+		_, err = s.client.CoreV1().ConfigMaps(job.Namespace).Update(ctx, existing, metav1.UpdateOptions{})
+		if err != nil {
+			return "", fmt.Errorf("updating support bundle ConfigMap: %w", err)
+		}
+		return location, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("creating support bundle ConfigMap: %w", err)
+	}
+	return location, nil
+}
+
+// FileStore stores each bundle as a file in a directory.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a FileStore writing to the directory, which is created
+// if it doesn't exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("support bundle directory can't be empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating support bundle directory: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Store writes the bundle to <namespace>_<name>_<uid>.tar.gz. The file is
+// written to a temporary file first, so that a partial bundle is never
+// visible.
+func (s *FileStore) Store(_ context.Context, job *kubeflow.MPIJob, bundle []byte) (string, error) {
+	name := filepath.Join(s.dir, fmt.Sprintf("%s_%s_%s.tar.gz", job.Namespace, job.Name, job.UID))
+	tmp, err := os.CreateTemp(s.dir, ".bundle-*")
+	if err != nil {
+		return "", fmt.Errorf("creating support bundle file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(bundle); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("writing support bundle file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("writing support bundle file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return "", fmt.Errorf("writing support bundle file: %w", err)
+	}
+	return name, nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package supportbundle collects the state of an MPIJob, the logs of its pods,
+// its events and its rendered configuration into a single archive, so that a
+// failure can be triaged after the pods are gone.
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// Suffixes of the objects owned by an MPIJob, as named by the controller.
+	launcherSuffix = "-launcher"
+	configSuffix   = "-config"
+	sshAuthSuffix  = "-ssh"
+
+	sshPublicKey = "ssh-publickey"
+
+	// DefaultTailLines is the default number of recent lines collected from
+	// each container log.
+	DefaultTailLines = 1000
+)
+
+// Options configure the collection of a support bundle.
+type Options struct {
+	// TailLines is the number of recent lines collected from each container
+	// log. 0 means all the lines.
+	TailLines int64
+}
+
+// Collect writes the support bundle of the MPIJob to w as a gzipped tarball.
+// Objects that can't be collected don't fail the collection; they are listed
+// in the errors.txt file of the bundle instead. The bundle contains the
+// public SSH key of the MPIJob, but never the private key.
+func Collect(ctx context.Context, client kubernetes.Interface, job *kubeflow.MPIJob, opts Options, w io.Writer) error {
+	b := newBuilder(w, job.Namespace+"_"+job.Name, time.Now())
+
+	job = job.DeepCopy()
+	job.APIVersion = kubeflow.SchemeGroupVersion.String()
+	job.Kind = kubeflow.Kind
+	job.ManagedFields = nil
+	b.addYAML("mpijob.yaml", job)
+
+	uids := map[types.UID]bool{job.UID: true}
+	launcher, err := client.BatchV1().Jobs(job.Namespace).Get(ctx, job.Name+launcherSuffix, metav1.GetOptions{})
+	if err == nil {
+		uids[launcher.UID] = true
+		launcher.APIVersion = batchv1.SchemeGroupVersion.String()
+		launcher.Kind = "Job"
+		launcher.ManagedFields = nil
+		b.addYAML("launcher-job.yaml", launcher)
+	} else if !apierrors.IsNotFound(err) {
+		b.addError("getting launcher Job: %v", err)
+	}
+
+	pods, err := client.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+			kubeflow.JobNameLabel:      job.Name,
+		}).String(),
+	})
+	if err != nil {
+		b.addError("listing pods: %v", err)
+	} else {
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			uids[pod.UID] = true
+			pod.APIVersion = corev1.SchemeGroupVersion.String()
+			pod.Kind = "Pod"
+			pod.ManagedFields = nil
+			b.addYAML(path.Join("pods", pod.Name+".yaml"), pod)
+			b.addPodLogs(ctx, client, pod, opts)
+		}
+	}
+
+	if cm, err := client.CoreV1().ConfigMaps(job.Namespace).Get(ctx, job.Name+configSuffix, metav1.GetOptions{}); err == nil {
+		keys := make([]string, 0, len(cm.Data))
+		for key := range cm.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			b.addFile(path.Join("config", key), []byte(cm.Data[key]))
+		}
+	} else if !apierrors.IsNotFound(err) {
+		b.addError("getting ConfigMap: %v", err)
+	}
+
+	if secret, err := client.CoreV1().Secrets(job.Namespace).Get(ctx, job.Name+sshAuthSuffix, metav1.GetOptions{}); err == nil {
+		if key, ok := secret.Data[sshPublicKey]; ok {
+			b.addFile(path.Join("ssh", sshPublicKey), key)
+		}
+	} else if !apierrors.IsNotFound(err) {
+		b.addError("getting SSH Secret: %v", err)
+	}
+
+	events, err := client.CoreV1().Events(job.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		b.addError("listing events: %v", err)
+	} else {
+		var related []corev1.Event
+		for _, e := range events.Items {
+			if uids[e.InvolvedObject.UID] {
+				e.ManagedFields = nil
+				related = append(related, e)
+			}
+		}
+		sort.SliceStable(related, func(i, j int) bool {
+			return eventTime(&related[i]).Before(eventTime(&related[j]))
+		})
+		b.addYAML("events.yaml", related)
+	}
+
+	return b.close()
+}
+
+func (b *builder) addPodLogs(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod, opts Options) {
+	statuses := append(append([]corev1.ContainerStatus(nil), pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if status.State.Waiting != nil && status.RestartCount == 0 {
+			// The container never ran.
+			continue
+		}
+		b.addContainerLog(ctx, client, pod, status.Name, false, opts)
+		if status.RestartCount > 0 {
+			b.addContainerLog(ctx, client, pod, status.Name, true, opts)
+		}
+	}
+}
+
+func (b *builder) addContainerLog(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod, container string, previous bool, opts Options) {
+	logOpts := &corev1.PodLogOptions{Container: container, Previous: previous}
+	if opts.TailLines > 0 {
+		logOpts.TailLines = &opts.TailLines
+	}
+	data, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOpts).DoRaw(ctx)
+	if err != nil {
+		b.addError("getting logs of container %s of pod %s: %v", container, pod.Name, err)
+		return
+	}
+	name := container + ".log"
+	if previous {
+		name = container + ".previous.log"
+	}
+	b.addFile(path.Join("logs", pod.Name, name), data)
+}
+
+func eventTime(e *corev1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	if !e.EventTime.IsZero() {
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+// builder writes the files of the bundle under a root directory. The first
+// write error is kept and returned by close.
+type builder struct {
+	root    string
+	modTime time.Time
+	gz      *gzip.Writer
+	tw      *tar.Writer
+	errs    []string
+	err     error
+}
+
+func newBuilder(w io.Writer, root string, modTime time.Time) *builder {
+	gz := gzip.NewWriter(w)
+	return &builder{
+		root:    root,
+		modTime: modTime,
+		gz:      gz,
+		tw:      tar.NewWriter(gz),
+	}
+}
+
+func (b *builder) addFile(name string, data []byte) {
+	if b.err != nil {
+		return
+	}
+	hdr := &tar.Header{
+		Name:    path.Join(b.root, name),
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: b.modTime,
+	}
+	if err := b.tw.WriteHeader(hdr); err != nil {
+		b.err = fmt.Errorf("writing %s: %w", name, err)
+		return
+	}
+	if _, err := b.tw.Write(data); err != nil {
+		b.err = fmt.Errorf("writing %s: %w", name, err)
+	}
+}
+
+func (b *builder) addYAML(name string, obj any) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		b.addError("encoding %s: %v", name, err)
+		return
+	}
+	b.addFile(name, data)
+}
+
+func (b *builder) addError(format string, args ...any) {
+	b.errs = append(b.errs, fmt.Sprintf(format, args...))
+}
+
+func (b *builder) close() error {
+	if len(b.errs) > 0 {
+		b.addFile("errors.txt", []byte(strings.Join(b.errs, "\n")+"\n"))
+	}
+	if b.err != nil {
+		return b.err
+	}
+	if err := b.tw.Close(); err != nil {
+		return fmt.Errorf("closing support bundle: %w", err)
+	}
+	if err := b.gz.Close(); err != nil {
+		return fmt.Errorf("closing support bundle: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func newTestJob() *kubeflow.MPIJob {
+	return &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "default", UID: "pi-uid"},
+	}
+}
+
+func newTestPod(name, role string, restarts int32) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("uid-" + name),
+			Labels: map[string]string{
+				kubeflow.OperatorNameLabel: kubeflow.OperatorName,
+				kubeflow.JobNameLabel:      "pi",
+				kubeflow.JobRoleLabel:      role,
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "mpi",
+				RestartCount: restarts,
+				State:        corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}},
+			}},
+		},
+	}
+}
+
+// readBundle returns the content of the files of the bundle.
+func readBundle(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Reading gzip: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	files := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		if err != nil {
+			t.Fatalf("Reading tar: %v", err)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("Reading %s: %v", hdr.Name, err)
+		}
+		files[hdr.Name] = string(content)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	job := newTestJob()
+	client := fake.NewSimpleClientset(
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "pi-launcher", Namespace: "default", UID: "launcher-uid"}},
+		newTestPod("pi-launcher-abcde", "launcher", 0),
+		newTestPod("pi-worker-0", "worker", 1),
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "pi-config", Namespace: "default"},
+			Data:       map[string]string{"hostfile": "pi-worker-0.pi.default.svc slots=1\n"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pi-ssh", Namespace: "default"},
+			Data: map[string][]byte{
+				corev1.SSHAuthPrivateKey: []byte("PRIVATE"),
+				sshPublicKey:             []byte("PUBLIC"),
+			},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{UID: "uid-pi-worker-0"},
+			Reason:         "BackOff",
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "e2", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{UID: "other"},
+			Reason:         "Unrelated",
+		},
+	)
+
+	var buf bytes.Buffer
+	if err := Collect(context.Background(), client, job, Options{TailLines: 10}, &buf); err != nil {
+		t.Fatalf("Collect() failed: %v", err)
+	}
+	files := readBundle(t, buf.Bytes())
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	wantNames := []string{
+		"default_pi/config/hostfile",
+		"default_pi/events.yaml",
+		"default_pi/launcher-job.yaml",
+		"default_pi/logs/pi-launcher-abcde/mpi.log",
+		"default_pi/logs/pi-worker-0/mpi.log",
+		"default_pi/logs/pi-worker-0/mpi.previous.log",
+		"default_pi/mpijob.yaml",
+		"default_pi/pods/pi-launcher-abcde.yaml",
+		"default_pi/pods/pi-worker-0.yaml",
+		"default_pi/ssh/ssh-publickey",
+	}
+	if diff := cmp.Diff(wantNames, names); diff != "" {
+		t.Errorf("Unexpected files in bundle (-want,+got):\n%s", diff)
+	}
+	for name, content := range files {
+		if strings.Contains(content, "PRIVATE") {
+			t.Errorf("File %s contains the private SSH key", name)
+		}
+	}
+	if events := files["default_pi/events.yaml"]; !strings.Contains(events, "BackOff") || strings.Contains(events, "Unrelated") {
+		t.Errorf("Unexpected events:\n%s", events)
+	}
+	if !strings.Contains(files["default_pi/mpijob.yaml"], "kind: MPIJob") {
+		t.Errorf("MPIJob doesn't have its kind:\n%s", files["default_pi/mpijob.yaml"])
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "bundles")
+	store, err := NewStore("file://"+dir, nil)
+	if err != nil {
+		t.Fatalf("NewStore() failed: %v", err)
+	}
+	location, err := store.Store(context.Background(), newTestJob(), []byte("bundle"))
+	if err != nil {
+		t.Fatalf("Store() failed: %v", err)
+	}
+	if want := filepath.Join(dir, "default_pi_pi-uid.tar.gz"); location != want {
+		t.Errorf("Stored in %s, want %s", location, want)
+	}
+	data, err := os.ReadFile(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "bundle" {
+		t.Errorf("Stored %q, want %q", data, "bundle")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("Directory has %d files, want 1", len(entries))
+	}
+}
+
+func TestConfigMapStore(t *testing.T) {
+	job := newTestJob()
+	client := fake.NewSimpleClientset()
+	store, err := NewStore(ConfigMapStorage, client)
+	if err != nil {
+		t.Fatalf("NewStore() failed: %v", err)
+	}
+	ctx := context.Background()
+	for _, bundle := range []string{"first", "second"} {
+		if _, err := store.Store(ctx, job, []byte(bundle)); err != nil {
+			t.Fatalf("Store() failed: %v", err)
+		}
+	}
+	cm, err := client.CoreV1().ConfigMaps("default").Get(ctx, "pi-support-bundle", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Getting ConfigMap: %v", err)
+	}
+	if got := string(cm.BinaryData[BundleKey]); got != "second" {
+		t.Errorf("ConfigMap has bundle %q, want %q", got, "second")
+	}
+	if !metav1.IsControlledBy(cm, job) {
+		t.Errorf("ConfigMap is not controlled by the MPIJob")
+	}
+
+	if _, err := store.Store(ctx, job, make([]byte, maxConfigMapBundleSize+1)); err == nil {
+		t.Errorf("Store() succeeded with a bundle larger than a ConfigMap")
+	}
+}
+
+func TestNewStoreUnsupported(t *testing.T) {
+	if _, err := NewStore("s3://bucket", nil); err == nil {
+		t.Errorf("NewStore() succeeded with an unsupported storage")
+	}
+}
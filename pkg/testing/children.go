@@ -0,0 +1,207 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	gotesting "testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
+)
+
+// WaitInterval is how often the helpers waiting for the operator poll the
+// API server.
+const WaitInterval = 100 * time.Millisecond
+
+// Children are the resources that the operator creates for an MPIJob.
+type Children struct {
+	Service     *corev1.Service
+	ConfigMap   *corev1.ConfigMap
+	Secret      *corev1.Secret
+	LauncherJob *batchv1.Job
+	// Workers are the worker pods that aren't being deleted, sorted by
+	// their index.
+	Workers []corev1.Pod
+}
+
+// GetChildren lists the resources controlled by the MPIJob. The missing
+// resources are left nil.
+func GetChildren(ctx context.Context, client kubernetes.Interface, mpiJob *kubeflow.MPIJob) (*Children, error) {
+	children := &Children{}
+	services, err := client.CoreV1().Services(mpiJob.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Services: %w", err)
+	}
+	for i := range services.Items {
+		if metav1.IsControlledBy(&services.Items[i], mpiJob) {
+			children.Service = &services.Items[i]
+		}
+	}
+	configMaps, err := client.CoreV1().ConfigMaps(mpiJob.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing ConfigMaps: %w", err)
+	}
+	for i := range configMaps.Items {
+		if metav1.IsControlledBy(&configMaps.Items[i], mpiJob) {
+			children.ConfigMap = &configMaps.Items[i]
+		}
+	}
+	secrets, err := client.CoreV1().Secrets(mpiJob.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Secrets: %w", err)
+	}
+	for i := range secrets.Items {
+		if metav1.IsControlledBy(&secrets.Items[i], mpiJob) {
+			children.Secret = &secrets.Items[i]
+		}
+	}
+	jobs, err := client.BatchV1().Jobs(mpiJob.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Jobs: %w", err)
+	}
+	for i := range jobs.Items {
+		if metav1.IsControlledBy(&jobs.Items[i], mpiJob) {
+			children.LauncherJob = &jobs.Items[i]
+		}
+	}
+	pods, err := client.CoreV1().Pods(mpiJob.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Pods: %w", err)
+	}
+	for _, p := range pods.Items {
+		if p.DeletionTimestamp == nil && metav1.IsControlledBy(&p, mpiJob) {
+			children.Workers = append(children.Workers, p)
+		}
+	}
+	sort.Slice(children.Workers, func(i, j int) bool {
+		return replicaIndex(&children.Workers[i]) < replicaIndex(&children.Workers[j])
+	})
+	return children, nil
+}
+
+func replicaIndex(pod *corev1.Pod) int {
+	index, _ := strconv.Atoi(pod.Labels[kubeflow.ReplicaIndexLabel])
+	return index
+}
+
+// Problems returns what is missing or inconsistent in the resources of an
+// MPIJob with the given number of workers: the Service must select the
+// workers, the Secret with the SSH keys must be mounted in the launcher and
+// the workers and the ConfigMap with the hostfile in the launcher.
+func (c *Children) Problems(workers int) []string {
+	var problems []string
+	if c.Service == nil {
+		problems = append(problems, "Service not found")
+	}
+	if c.ConfigMap == nil {
+		problems = append(problems, "ConfigMap not found")
+	}
+	if c.Secret == nil {
+		problems = append(problems, "Secret not found")
+	}
+	if c.LauncherJob == nil {
+		problems = append(problems, "launcher Job not found")
+	}
+	if len(c.Workers) != workers {
+		problems = append(problems, fmt.Sprintf("got %d workers, want %d", len(c.Workers), workers))
+	}
+	if len(problems) > 0 {
+		return problems
+	}
+	selector := labels.SelectorFromSet(c.Service.Spec.Selector)
+	for _, p := range c.Workers {
+		if !selector.Matches(labels.Set(p.Labels)) {
+			problems = append(problems, fmt.Sprintf("Service selector doesn't match pod %s", p.Name))
+		}
+		if !hasVolume(&p.Spec, func(v corev1.Volume) bool { return v.Secret != nil && v.Secret.SecretName == c.Secret.Name }) {
+			problems = append(problems, fmt.Sprintf("Secret %s isn't mounted in pod %s", c.Secret.Name, p.Name))
+		}
+	}
+	launcher := &c.LauncherJob.Spec.Template.Spec
+	if !hasVolume(launcher, func(v corev1.Volume) bool { return v.Secret != nil && v.Secret.SecretName == c.Secret.Name }) {
+		problems = append(problems, fmt.Sprintf("Secret %s isn't mounted in the launcher", c.Secret.Name))
+	}
+	if !hasVolume(launcher, func(v corev1.Volume) bool { return v.ConfigMap != nil && v.ConfigMap.Name == c.ConfigMap.Name }) {
+		problems = append(problems, fmt.Sprintf("ConfigMap %s isn't mounted in the launcher", c.ConfigMap.Name))
+	}
+	return problems
+}
+
+func hasVolume(spec *corev1.PodSpec, match func(corev1.Volume) bool) bool {
+	for _, v := range spec.Volumes {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitForChildren waits until the operator created all the resources of the
+// MPIJob with the given number of workers, failing the test with the
+// problems left after wait.ForeverTestTimeout.
+func WaitForChildren(t gotesting.TB, client kubernetes.Interface, mpiJob *kubeflow.MPIJob, workers int) *Children {
+	t.Helper()
+	var (
+		children *Children
+		problems []string
+	)
+	err := wait.PollUntilContextTimeout(context.Background(), WaitInterval, wait.ForeverTestTimeout, true, func(ctx context.Context) (bool, error) {
+		var err error
+		children, err = GetChildren(ctx, client, mpiJob)
+		if err != nil {
+			return false, err
+		}
+		problems = children.Problems(workers)
+		return len(problems) == 0, nil
+	})
+	if err != nil {
+		for _, p := range problems {
+			t.Error(p)
+		}
+		t.Fatalf("Waiting for the resources of MPIJob %s/%s: %v", mpiJob.Namespace, mpiJob.Name, err)
+	}
+	return children
+}
+
+// WaitForCondition waits until the MPIJob has the condition with the True
+// status and returns it, failing the test after wait.ForeverTestTimeout.
+func WaitForCondition(t gotesting.TB, client versioned.Interface, mpiJob *kubeflow.MPIJob, condType kubeflow.JobConditionType) *kubeflow.MPIJob {
+	t.Helper()
+	var got *kubeflow.MPIJob
+	err := wait.PollUntilContextTimeout(context.Background(), WaitInterval, wait.ForeverTestTimeout, true, func(ctx context.Context) (bool, error) {
+		var err error
+		got, err = client.KubeflowV2beta1().MPIJobs(mpiJob.Namespace).Get(ctx, mpiJob.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return HasCondition(got, condType), nil
+	})
+	if err != nil {
+		t.Fatalf("Waiting for MPIJob %s/%s to have condition %s: %v", mpiJob.Namespace, mpiJob.Name, condType, err)
+	}
+	return got
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	gotesting "testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
+	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions"
+)
+
+// Clients are fake clientsets of the MPIJobs and of the Kubernetes
+// resources, with their informer factories.
+type Clients struct {
+	Client        *fake.Clientset
+	KubeClient    *k8sfake.Clientset
+	Informers     informers.SharedInformerFactory
+	KubeInformers kubeinformers.SharedInformerFactory
+}
+
+// NewClients returns fake clientsets tracking the given objects. The
+// MPIJobs are tracked by the MPIJob clientset and the other objects by the
+// Kubernetes clientset.
+func NewClients(objects ...runtime.Object) *Clients {
+	var mpiJobObjects, kubeObjects []runtime.Object
+	for _, obj := range objects {
+		gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+		if err == nil && len(gvks) > 0 && gvks[0].Group == "kubeflow.org" {
+			mpiJobObjects = append(mpiJobObjects, obj)
+		} else {
+			kubeObjects = append(kubeObjects, obj)
+		}
+	}
+	c := &Clients{
+		Client:     fake.NewSimpleClientset(mpiJobObjects...),
+		KubeClient: k8sfake.NewSimpleClientset(kubeObjects...),
+	}
+	c.Informers = informers.NewSharedInformerFactory(c.Client, 0)
+	c.KubeInformers = kubeinformers.NewSharedInformerFactory(c.KubeClient, 0)
+	return c
+}
+
+// Start starts the informers requested from the factories and waits for
+// their caches to sync. The informers are stopped when the test finishes.
+func (c *Clients) Start(t gotesting.TB) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		c.Informers.Shutdown()
+		c.KubeInformers.Shutdown()
+	})
+	c.Informers.Start(ctx.Done())
+	c.KubeInformers.Start(ctx.Done())
+	for typ, synced := range c.Informers.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			t.Fatalf("Informer of %v didn't sync", typ)
+		}
+	}
+	for typ, synced := range c.KubeInformers.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			t.Fatalf("Informer of %v didn't sync", typ)
+		}
+	}
+}
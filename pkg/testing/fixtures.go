@@ -0,0 +1,87 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testing helps testing the controllers built on top of MPIJobs,
+// with MPIJob fixtures, fake clientsets and informers, and assertions on the
+// resources that the operator creates for an MPIJob.
+package testing
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/builder"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/scheme"
+)
+
+const (
+	// Image is the image of the containers of the MPIJob fixtures.
+	Image = "mpioperator/mpi-pi:openmpi"
+)
+
+// NewMPIJob returns a valid MPIJob with the given number of workers, which
+// is defaulted and has a UID like the MPIJobs read from the API server.
+func NewMPIJob(namespace, name string, workers int32) *kubeflow.MPIJob {
+	mpiJob, err := builder.NewMPIJob(namespace, name).
+		SlotsPerWorker(1).
+		Launcher(builder.Container("launcher", Image, "mpirun", "/home/mpiuser/pi")).
+		Workers(workers, builder.Container("worker", Image, "/usr/sbin/sshd", "-De")).
+		Build()
+	if err != nil {
+		panic(err)
+	}
+	mpiJob.UID = uuid.NewUUID()
+	mpiJob.CreationTimestamp = metav1.Now()
+	scheme.Scheme.Default(mpiJob)
+	return mpiJob
+}
+
+// SetCondition sets a condition of the MPIJob, like the operator does when
+// the MPIJob is created, runs or finishes.
+func SetCondition(mpiJob *kubeflow.MPIJob, condType kubeflow.JobConditionType, status corev1.ConditionStatus, reason string) {
+	now := metav1.Now()
+	for i := range mpiJob.Status.Conditions {
+		cond := &mpiJob.Status.Conditions[i]
+		if cond.Type != condType {
+			continue
+		}
+		if cond.Status != status {
+			cond.LastTransitionTime = now
+		}
+		cond.Status = status
+		cond.Reason = reason
+		cond.LastUpdateTime = now
+		return
+	}
+	mpiJob.Status.Conditions = append(mpiJob.Status.Conditions, kubeflow.JobCondition{
+		Type:               condType,
+		Status:             status,
+		Reason:             reason,
+		LastUpdateTime:     now,
+		LastTransitionTime: now,
+	})
+}
+
+// HasCondition returns whether the MPIJob has the condition with the True
+// status.
+func HasCondition(mpiJob *kubeflow.MPIJob, condType kubeflow.JobConditionType) bool {
+	for _, cond := range mpiJob.Status.Conditions {
+		if cond.Type == condType {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/controller"
+	mpitesting "github.com/kubeflow/mpi-operator/pkg/testing"
+)
+
+func TestControllerCreatesChildren(t *testing.T) {
+	mpiJob := mpitesting.NewMPIJob(metav1.NamespaceDefault, "pi", 2)
+	clients := mpitesting.NewClients(mpiJob)
+	c, err := controller.NewMPIJobController(
+		clients.KubeClient,
+		clients.Client,
+		nil,
+		nil,
+		clients.KubeInformers.Core().V1().ConfigMaps(),
+		clients.KubeInformers.Core().V1().Secrets(),
+		clients.KubeInformers.Core().V1().Services(),
+		clients.KubeInformers.Batch().V1().Jobs(),
+		clients.KubeInformers.Core().V1().Pods(),
+		clients.KubeInformers.Scheduling().V1().PriorityClasses(),
+		clients.Informers.Kubeflow().V2beta1().MPIJobs(),
+		metav1.NamespaceAll,
+		"",
+		workqueue.DefaultTypedControllerRateLimiter[any](),
+	)
+	if err != nil {
+		t.Fatalf("Creating the controller: %v", err)
+	}
+	clients.Start(t)
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := c.Run(1, stopCh); err != nil {
+			t.Errorf("Running the controller: %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		close(stopCh)
+		<-done
+	})
+
+	children := mpitesting.WaitForChildren(t, clients.KubeClient, mpiJob, 2)
+	if got := children.Workers[1].Name; got != "pi-worker-1" {
+		t.Errorf("Second worker is %s, want pi-worker-1", got)
+	}
+	mpitesting.WaitForCondition(t, clients.Client, mpiJob, kubeflow.JobCreated)
+}
+
+func TestSetCondition(t *testing.T) {
+	mpiJob := mpitesting.NewMPIJob(metav1.NamespaceDefault, "pi", 1)
+	mpitesting.SetCondition(mpiJob, kubeflow.JobRunning, corev1.ConditionTrue, kubeflow.JobRunningReason)
+	if !mpitesting.HasCondition(mpiJob, kubeflow.JobRunning) {
+		t.Error("MPIJob isn't running")
+	}
+	mpitesting.SetCondition(mpiJob, kubeflow.JobRunning, corev1.ConditionFalse, kubeflow.JobSuspendedReason)
+	if mpitesting.HasCondition(mpiJob, kubeflow.JobRunning) || len(mpiJob.Status.Conditions) != 1 {
+		t.Errorf("Got conditions %v, want Running=False", mpiJob.Status.Conditions)
+	}
+}
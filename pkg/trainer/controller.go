@@ -0,0 +1,286 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trainer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	clientset "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
+	kubeflowinformers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions/kubeflow/v2beta1"
+	listers "github.com/kubeflow/mpi-operator/pkg/client/listers/kubeflow/v2beta1"
+)
+
+// errUnsupportedRuntime is returned for runtimes that can't be run as MPIJobs.
+var errUnsupportedRuntime = errors.New("unsupported runtime")
+
+// Controller creates the MPIJobs of the TrainJobs managed by the operator, and
+// reflects the conditions of the MPIJobs in the status of the TrainJobs.
+type Controller struct {
+	dynamicClient  dynamic.Interface
+	kubeflowClient clientset.Interface
+
+	trainJobLister               cache.GenericLister
+	trainingRuntimeLister        cache.GenericLister
+	clusterTrainingRuntimeLister cache.GenericLister
+	mpiJobLister                 listers.MPIJobLister
+	synced                       []cache.InformerSynced
+
+	queue workqueue.TypedRateLimitingInterface[any]
+}
+
+// NewController returns a Controller for the informers of the TrainJobs, the
+// TrainingRuntimes, the ClusterTrainingRuntimes and the MPIJobs.
+func NewController(
+	dynamicClient dynamic.Interface,
+	kubeflowClient clientset.Interface,
+	trainJobInformer informers.GenericInformer,
+	trainingRuntimeInformer informers.GenericInformer,
+	clusterTrainingRuntimeInformer informers.GenericInformer,
+	mpiJobInformer kubeflowinformers.MPIJobInformer,
+	workqueueRateLimiter workqueue.TypedRateLimiter[any]) (*Controller, error) {
+	c := &Controller{
+		dynamicClient:                dynamicClient,
+		kubeflowClient:               kubeflowClient,
+		trainJobLister:               trainJobInformer.Lister(),
+		trainingRuntimeLister:        trainingRuntimeInformer.Lister(),
+		clusterTrainingRuntimeLister: clusterTrainingRuntimeInformer.Lister(),
+		mpiJobLister:                 mpiJobInformer.Lister(),
+		synced: []cache.InformerSynced{
+			trainJobInformer.Informer().HasSynced,
+			trainingRuntimeInformer.Informer().HasSynced,
+			clusterTrainingRuntimeInformer.Informer().HasSynced,
+			mpiJobInformer.Informer().HasSynced,
+		},
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(workqueueRateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{Name: "TrainJob"}),
+	}
+	if _, err := trainJobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueue,
+		UpdateFunc: func(_, new interface{}) {
+			c.enqueue(new)
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("adding TrainJob event handler: %w", err)
+	}
+	if _, err := mpiJobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, new interface{}) {
+			c.enqueueOwner(new)
+		},
+		DeleteFunc: c.enqueueOwner,
+	}); err != nil {
+		return nil, fmt.Errorf("adding MPIJob event handler: %w", err)
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// enqueueOwner enqueues the TrainJob owning the MPIJob, if any.
+func (c *Controller) enqueueOwner(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	mpiJob, ok := obj.(*kubeflow.MPIJob)
+	if !ok {
+		return
+	}
+	if ref := metav1.GetControllerOf(mpiJob); ref != nil && ref.Kind == trainJobKind.Kind && ref.APIVersion == trainJobKind.GroupVersion().String() {
+		c.queue.Add(mpiJob.Namespace + "/" + ref.Name)
+	}
+}
+
+// Run waits for the caches to sync and processes the TrainJobs until stopCh
+// is closed.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	klog.Info("Starting TrainJob controller")
+	if ok := cache.WaitForCacheSync(stopCh, c.synced...); !ok {
+		return fmt.Errorf("failed to wait for TrainJob caches to sync")
+	}
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+	<-stopCh
+	klog.Info("Shutting down TrainJob workers")
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	obj, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(obj)
+	key, ok := obj.(string)
+	if !ok {
+		c.queue.Forget(obj)
+		utilruntime.HandleError(fmt.Errorf("expected string in workqueue but got %#v", obj))
+		return true
+	}
+	if err := c.sync(key); err != nil {
+		c.queue.AddRateLimited(key)
+		utilruntime.HandleError(fmt.Errorf("error syncing TrainJob '%s': %w", key, err))
+		return true
+	}
+	c.queue.Forget(obj)
+	return true
+}
+
+// sync creates the MPIJob of the TrainJob, propagates its suspension and
+// updates the conditions of the TrainJob.
+func (c *Controller) sync(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("invalid resource key: %s", key))
+		return nil
+	}
+	obj, err := c.trainJobLister.ByNamespace(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		klog.V(4).Infof("TrainJob has been deleted: %v", key)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("obtaining TrainJob: %w", err)
+	}
+	trainJob, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unexpected TrainJob type %T", obj)
+	}
+	if !IsManaged(trainJob) || trainJob.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	desired, err := c.newMPIJob(trainJob)
+	if errors.Is(err, errUnsupportedRuntime) {
+		return c.updateConditions(trainJob, []metav1.Condition{{
+			Type:    TrainJobFailed,
+			Status:  metav1.ConditionTrue,
+			Reason:  UnsupportedRuntimeReason,
+			Message: err.Error(),
+		}})
+	}
+	if err != nil {
+		return err
+	}
+	mpiJob, err := c.mpiJobLister.MPIJobs(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		if mpiJob, err = c.kubeflowClient.KubeflowV2beta1().MPIJobs(namespace).Create(context.TODO(), desired, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating MPIJob: %w", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("obtaining MPIJob: %w", err)
+	}
+	if !metav1.IsControlledBy(mpiJob, trainJob) {
+		return fmt.Errorf("MPIJob %s/%s already exists and isn't controlled by the TrainJob", namespace, name)
+	}
+	if !equality.Semantic.DeepEqual(mpiJob.Spec.RunPolicy.Suspend, desired.Spec.RunPolicy.Suspend) {
+		mpiJob = mpiJob.DeepCopy()
+		mpiJob.Spec.RunPolicy.Suspend = desired.Spec.RunPolicy.Suspend
+		if mpiJob, err = c.kubeflowClient.KubeflowV2beta1().MPIJobs(namespace).Update(context.TODO(), mpiJob, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("updating suspension of MPIJob: %w", err)
+		}
+	}
+	return c.updateConditions(trainJob, Conditions(mpiJob))
+}
+
+// newMPIJob returns the MPIJob of the TrainJob from its runtime. Runtimes
+// without MPI policy are reported with errUnsupportedRuntime.
+func (c *Controller) newMPIJob(trainJob *unstructured.Unstructured) (*kubeflow.MPIJob, error) {
+	resource, name, err := RuntimeOf(trainJob)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnsupportedRuntime, err)
+	}
+	var obj runtime.Object
+	if resource == TrainingRuntimeResource {
+		obj, err = c.trainingRuntimeLister.ByNamespace(trainJob.GetNamespace()).Get(name)
+	} else {
+		obj, err = c.clusterTrainingRuntimeLister.Get(name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("obtaining runtime %s: %w", name, err)
+	}
+	trainingRuntime, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected runtime type %T", obj)
+	}
+	mpiJob, err := NewMPIJob(trainJob, trainingRuntime)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnsupportedRuntime, err)
+	}
+	return mpiJob, nil
+}
+
+// updateConditions sets the conditions of the TrainJob, if they changed.
+func (c *Controller) updateConditions(trainJob *unstructured.Unstructured, conditions []metav1.Condition) error {
+	current, err := conditionsOf(trainJob)
+	if err != nil {
+		return err
+	}
+	updated := append([]metav1.Condition(nil), current...)
+	for _, cond := range conditions {
+		cond.ObservedGeneration = trainJob.GetGeneration()
+		meta.SetStatusCondition(&updated, cond)
+	}
+	if equality.Semantic.DeepEqual(current, updated) {
+		return nil
+	}
+	raw := make([]interface{}, 0, len(updated))
+	for i := range updated {
+		cond, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&updated[i])
+		if err != nil {
+			return fmt.Errorf("converting condition %s: %w", updated[i].Type, err)
+		}
+		raw = append(raw, cond)
+	}
+	trainJob = trainJob.DeepCopy()
+	if err := unstructured.SetNestedSlice(trainJob.Object, raw, "status", "conditions"); err != nil {
+		return fmt.Errorf("setting conditions: %w", err)
+	}
+	if _, err := c.dynamicClient.Resource(TrainJobResource).Namespace(trainJob.GetNamespace()).UpdateStatus(context.TODO(), trainJob, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating status of TrainJob: %w", err)
+	}
+	return nil
+}
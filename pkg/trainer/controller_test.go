@@ -0,0 +1,178 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trainer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned/fake"
+	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions"
+)
+
+type fixture struct {
+	t              *testing.T
+	dynamicClient  *dynamicfake.FakeDynamicClient
+	kubeflowClient *fake.Clientset
+	controller     *Controller
+}
+
+func newFixture(t *testing.T, trainJob, trainingRuntime *unstructured.Unstructured, mpiJobs ...*kubeflow.MPIJob) *fixture {
+	t.Helper()
+	f := &fixture{t: t}
+	f.dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			TrainJobResource:               "TrainJobList",
+			TrainingRuntimeResource:        "TrainingRuntimeList",
+			ClusterTrainingRuntimeResource: "ClusterTrainingRuntimeList",
+		}, trainJob)
+	var objects []runtime.Object
+	for _, mpiJob := range mpiJobs {
+		objects = append(objects, mpiJob)
+	}
+	f.kubeflowClient = fake.NewSimpleClientset(objects...)
+	dynamicInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(f.dynamicClient, 0)
+	mpiJobInformer := informers.NewSharedInformerFactory(f.kubeflowClient, 0).Kubeflow().V2beta1().MPIJobs()
+	var err error
+	f.controller, err = NewController(f.dynamicClient, f.kubeflowClient,
+		dynamicInformerFactory.ForResource(TrainJobResource),
+		dynamicInformerFactory.ForResource(TrainingRuntimeResource),
+		dynamicInformerFactory.ForResource(ClusterTrainingRuntimeResource),
+		mpiJobInformer,
+		workqueue.DefaultTypedControllerRateLimiter[any]())
+	if err != nil {
+		t.Fatalf("NewController(): %v", err)
+	}
+	f.add(dynamicInformerFactory.ForResource(TrainJobResource).Informer().GetIndexer().Add, trainJob)
+	f.add(dynamicInformerFactory.ForResource(ClusterTrainingRuntimeResource).Informer().GetIndexer().Add, trainingRuntime)
+	for _, mpiJob := range mpiJobs {
+		f.add(mpiJobInformer.Informer().GetIndexer().Add, mpiJob)
+	}
+	return f
+}
+
+func (f *fixture) add(add func(interface{}) error, obj interface{}) {
+	if err := add(obj); err != nil {
+		f.t.Fatalf("Adding object to informer: %v", err)
+	}
+}
+
+func (f *fixture) conditions() []metav1.Condition {
+	obj, err := f.dynamicClient.Resource(TrainJobResource).Namespace("default").Get(context.Background(), "pi", metav1.GetOptions{})
+	if err != nil {
+		f.t.Fatalf("Getting TrainJob: %v", err)
+	}
+	conditions, err := conditionsOf(obj)
+	if err != nil {
+		f.t.Fatalf("Reading conditions: %v", err)
+	}
+	return conditions
+}
+
+var ignoreConditionTimes = cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
+
+func TestSync(t *testing.T) {
+	mpi := map[string]interface{}{"numProcPerNode": int64(2)}
+
+	t.Run("create MPIJob", func(t *testing.T) {
+		f := newFixture(t, newTrainJob(nil), newTrainingRuntime(mpi))
+		if err := f.controller.sync("default/pi"); err != nil {
+			t.Fatalf("sync(): %v", err)
+		}
+		mpiJob, err := f.kubeflowClient.KubeflowV2beta1().MPIJobs("default").Get(context.Background(), "pi", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Getting MPIJob: %v", err)
+		}
+		if ref := metav1.GetControllerOf(mpiJob); ref == nil || ref.Kind != "TrainJob" || ref.Name != "pi" {
+			t.Errorf("Unexpected controller of the MPIJob: %v", ref)
+		}
+	})
+
+	t.Run("reflect MPIJob conditions", func(t *testing.T) {
+		trainJob := newTrainJob(nil)
+		trainJob.SetGeneration(2)
+		if err := unstructured.SetNestedField(trainJob.Object, true, "spec", "suspend"); err != nil {
+			t.Fatal(err)
+		}
+		mpiJob, err := NewMPIJob(trainJob, newTrainingRuntime(mpi))
+		if err != nil {
+			t.Fatalf("NewMPIJob(): %v", err)
+		}
+		mpiJob.Status.Conditions = []kubeflow.JobCondition{
+			{Type: kubeflow.JobCreated, Status: corev1.ConditionTrue, Reason: "MPIJobCreated", Message: "MPIJob default/pi is created."},
+			{Type: kubeflow.JobRunning, Status: corev1.ConditionFalse, Reason: "MPIJobSuspended"},
+			{Type: kubeflow.JobSucceeded, Status: corev1.ConditionTrue, Reason: "MPIJobSucceeded"},
+		}
+		f := newFixture(t, trainJob, newTrainingRuntime(mpi), mpiJob)
+		if err := f.controller.sync("default/pi"); err != nil {
+			t.Fatalf("sync(): %v", err)
+		}
+		want := []metav1.Condition{
+			{Type: TrainJobCreated, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: "MPIJobCreated", Message: "MPIJob default/pi is created."},
+			{Type: TrainJobComplete, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: "MPIJobSucceeded"},
+		}
+		if diff := cmp.Diff(want, f.conditions(), ignoreConditionTimes); diff != "" {
+			t.Errorf("Unexpected TrainJob conditions (-want,+got):\n%s", diff)
+		}
+		got, err := f.kubeflowClient.KubeflowV2beta1().MPIJobs("default").Get(context.Background(), "pi", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Getting MPIJob: %v", err)
+		}
+		if !ptr.Deref(got.Spec.RunPolicy.Suspend, false) {
+			t.Errorf("MPIJob isn't suspended")
+		}
+	})
+
+	t.Run("unsupported runtime", func(t *testing.T) {
+		f := newFixture(t, newTrainJob(nil), newTrainingRuntime(nil))
+		if err := f.controller.sync("default/pi"); err != nil {
+			t.Fatalf("sync(): %v", err)
+		}
+		want := []metav1.Condition{{
+			Type:    TrainJobFailed,
+			Status:  metav1.ConditionTrue,
+			Reason:  UnsupportedRuntimeReason,
+			Message: "unsupported runtime: runtime mpi-distributed has no MPI policy",
+		}}
+		if diff := cmp.Diff(want, f.conditions(), ignoreConditionTimes); diff != "" {
+			t.Errorf("Unexpected TrainJob conditions (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("TrainJob managed by Trainer", func(t *testing.T) {
+		trainJob := newTrainJob(nil)
+		unstructured.RemoveNestedField(trainJob.Object, "spec", "managedBy")
+		f := newFixture(t, trainJob, newTrainingRuntime(mpi))
+		if err := f.controller.sync("default/pi"); err != nil {
+			t.Fatalf("sync(): %v", err)
+		}
+		if actions := f.kubeflowClient.Actions(); len(actions) > 0 {
+			t.Errorf("Unexpected actions on MPIJobs: %v", actions)
+		}
+	})
+}
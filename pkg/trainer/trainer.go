@@ -0,0 +1,351 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trainer runs the TrainJobs of Kubeflow Trainer V2 that use MPI
+// runtimes as MPIJobs, so that they are launched with the SSH setup, the
+// hostfile and the slots of the operator.
+package trainer
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+const (
+	// ManagedBy is the spec.managedBy of the TrainJobs run by the operator.
+	ManagedBy = kubeflow.KubeflowJobController
+
+	// TrainJobNameLabel is the label of the MPIJobs with the name of their
+	// TrainJob.
+	TrainJobNameLabel = "trainer.kubeflow.org/trainjob-name"
+
+	// Conditions of TrainJobs.
+	TrainJobCreated   = "Created"
+	TrainJobSuspended = "Suspended"
+	TrainJobComplete  = "Complete"
+	TrainJobFailed    = "Failed"
+
+	// UnsupportedRuntimeReason is the reason of the Failed condition of the
+	// TrainJobs whose runtime can't be run as an MPIJob.
+	UnsupportedRuntimeReason = "UnsupportedRuntime"
+
+	trainerGroup               = "trainer.kubeflow.org"
+	trainingRuntimeKind        = "TrainingRuntime"
+	clusterTrainingRuntimeKind = "ClusterTrainingRuntime"
+
+	// Names of the replicated jobs of MPI runtimes and of the container of
+	// the trainer.
+	launcherJobName      = "launcher"
+	nodeJobName          = "node"
+	trainerContainerName = "node"
+)
+
+var (
+	TrainJobResource               = schema.GroupVersionResource{Group: trainerGroup, Version: "v1alpha1", Resource: "trainjobs"}
+	TrainingRuntimeResource        = schema.GroupVersionResource{Group: trainerGroup, Version: "v1alpha1", Resource: "trainingruntimes"}
+	ClusterTrainingRuntimeResource = schema.GroupVersionResource{Group: trainerGroup, Version: "v1alpha1", Resource: "clustertrainingruntimes"}
+
+	trainJobKind = schema.GroupVersionKind{Group: trainerGroup, Version: "v1alpha1", Kind: "TrainJob"}
+)
+
+// The fields of the trainer.kubeflow.org/v1alpha1 API used by the operator.
+
+type trainJobSpec struct {
+	RuntimeRef  runtimeRef        `json:"runtimeRef"`
+	Trainer     *trainer          `json:"trainer,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Suspend     *bool             `json:"suspend,omitempty"`
+	ManagedBy   *string           `json:"managedBy,omitempty"`
+}
+
+type runtimeRef struct {
+	Name     string  `json:"name"`
+	APIGroup *string `json:"apiGroup,omitempty"`
+	Kind     *string `json:"kind,omitempty"`
+}
+
+type trainer struct {
+	Image            *string                      `json:"image,omitempty"`
+	Command          []string                     `json:"command,omitempty"`
+	Args             []string                     `json:"args,omitempty"`
+	Env              []corev1.EnvVar              `json:"env,omitempty"`
+	NumNodes         *int32                       `json:"numNodes,omitempty"`
+	ResourcesPerNode *corev1.ResourceRequirements `json:"resourcesPerNode,omitempty"`
+	NumProcPerNode   *intstr.IntOrString          `json:"numProcPerNode,omitempty"`
+}
+
+type trainingRuntimeSpec struct {
+	MLPolicy *mlPolicy      `json:"mlPolicy,omitempty"`
+	Template jobSetTemplate `json:"template"`
+}
+
+type mlPolicy struct {
+	NumNodes *int32     `json:"numNodes,omitempty"`
+	MPI      *mpiPolicy `json:"mpi,omitempty"`
+}
+
+type mpiPolicy struct {
+	NumProcPerNode    *int32  `json:"numProcPerNode,omitempty"`
+	MPIImplementation *string `json:"mpiImplementation,omitempty"`
+	SSHAuthMountPath  *string `json:"sshAuthMountPath,omitempty"`
+	RunLauncherAsNode *bool   `json:"runLauncherAsNode,omitempty"`
+}
+
+type jobSetTemplate struct {
+	Spec struct {
+		ReplicatedJobs []replicatedJob `json:"replicatedJobs,omitempty"`
+	} `json:"spec"`
+}
+
+type replicatedJob struct {
+	Name     string                  `json:"name"`
+	Template batchv1.JobTemplateSpec `json:"template"`
+}
+
+func specOf(obj *unstructured.Unstructured, spec interface{}) error {
+	s, _, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return fmt.Errorf("reading spec of %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(s, spec); err != nil {
+		return fmt.Errorf("converting spec of %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	return nil
+}
+
+// IsManaged returns whether the TrainJob is run by the operator.
+func IsManaged(trainJob *unstructured.Unstructured) bool {
+	managedBy, _, _ := unstructured.NestedString(trainJob.Object, "spec", "managedBy")
+	return managedBy == ManagedBy
+}
+
+// RuntimeOf returns the resource and the name of the runtime of the TrainJob.
+// ClusterTrainingRuntimes are cluster-scoped, while TrainingRuntimes are in
+// the namespace of the TrainJob.
+func RuntimeOf(trainJob *unstructured.Unstructured) (schema.GroupVersionResource, string, error) {
+	var spec trainJobSpec
+	if err := specOf(trainJob, &spec); err != nil {
+		return schema.GroupVersionResource{}, "", err
+	}
+	ref := spec.RuntimeRef
+	if group := ptr.Deref(ref.APIGroup, trainerGroup); group != trainerGroup {
+		return schema.GroupVersionResource{}, "", fmt.Errorf("unsupported runtime API group %s", group)
+	}
+	switch kind := ptr.Deref(ref.Kind, clusterTrainingRuntimeKind); kind {
+	case clusterTrainingRuntimeKind:
+		return ClusterTrainingRuntimeResource, ref.Name, nil
+	case trainingRuntimeKind:
+		return TrainingRuntimeResource, ref.Name, nil
+	default:
+		return schema.GroupVersionResource{}, "", fmt.Errorf("unsupported runtime kind %s", kind)
+	}
+}
+
+// NewMPIJob returns the MPIJob running the TrainJob with its runtime, which
+// must have an MPI policy and launcher and node replicated jobs. The image of
+// the trainer applies to the launcher and the workers, while its command and
+// arguments apply to the launcher, which runs mpirun. The MPIJob is owned by
+// the TrainJob.
+func NewMPIJob(trainJob, trainingRuntime *unstructured.Unstructured) (*kubeflow.MPIJob, error) {
+	var jobSpec trainJobSpec
+	if err := specOf(trainJob, &jobSpec); err != nil {
+		return nil, err
+	}
+	var runtimeSpec trainingRuntimeSpec
+	if err := specOf(trainingRuntime, &runtimeSpec); err != nil {
+		return nil, err
+	}
+	if runtimeSpec.MLPolicy == nil || runtimeSpec.MLPolicy.MPI == nil {
+		return nil, fmt.Errorf("runtime %s has no MPI policy", trainingRuntime.GetName())
+	}
+	mpi := runtimeSpec.MLPolicy.MPI
+	templates := make(map[string]*corev1.PodTemplateSpec)
+	for i := range runtimeSpec.Template.Spec.ReplicatedJobs {
+		job := &runtimeSpec.Template.Spec.ReplicatedJobs[i]
+		templates[job.Name] = &job.Template.Spec.Template
+	}
+	launcher := templates[launcherJobName]
+	if launcher == nil {
+		return nil, fmt.Errorf("runtime %s has no %s replicated job", trainingRuntime.GetName(), launcherJobName)
+	}
+	runLauncherAsNode := ptr.Deref(mpi.RunLauncherAsNode, false)
+	numNodes := ptr.Deref(runtimeSpec.MLPolicy.NumNodes, 1)
+	slots := ptr.Deref(mpi.NumProcPerNode, 1)
+	t := jobSpec.Trainer
+	if t == nil {
+		t = &trainer{}
+	}
+	if t.NumNodes != nil {
+		numNodes = *t.NumNodes
+	}
+	if t.NumProcPerNode != nil {
+		if t.NumProcPerNode.Type != intstr.Int {
+			return nil, fmt.Errorf("unsupported numProcPerNode %s, must be a number", t.NumProcPerNode.String())
+		}
+		slots = t.NumProcPerNode.IntVal
+	}
+	workers := numNodes
+	if runLauncherAsNode {
+		workers--
+	}
+
+	mpiJob := &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        trainJob.GetName(),
+			Namespace:   trainJob.GetNamespace(),
+			Labels:      map[string]string{TrainJobNameLabel: trainJob.GetName()},
+			Annotations: jobSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(trainJob, trainJobKind),
+			},
+		},
+		Spec: kubeflow.MPIJobSpec{
+			SlotsPerWorker:      ptr.To(slots),
+			RunLauncherAsWorker: ptr.To(runLauncherAsNode),
+			RunPolicy: kubeflow.RunPolicy{
+				Suspend: jobSpec.Suspend,
+			},
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Replicas: ptr.To[int32](1),
+					Template: *launcher.DeepCopy(),
+				},
+			},
+		},
+	}
+	for k, v := range jobSpec.Labels {
+		mpiJob.Labels[k] = v
+	}
+	if mpi.MPIImplementation != nil {
+		mpiJob.Spec.MPIImplementation = kubeflow.MPIImplementation(*mpi.MPIImplementation)
+	}
+	if mpi.SSHAuthMountPath != nil {
+		mpiJob.Spec.SSHAuthMountPath = *mpi.SSHAuthMountPath
+	}
+	launcherSpec := &mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeLauncher].Template.Spec
+	applyTrainer(launcherSpec, t, runLauncherAsNode)
+	if c := trainerContainer(launcherSpec); c != nil {
+		if t.Command != nil {
+			c.Command = t.Command
+		}
+		if t.Args != nil {
+			c.Args = t.Args
+		}
+	}
+	if workers > 0 {
+		node := templates[nodeJobName]
+		if node == nil {
+			return nil, fmt.Errorf("runtime %s has no %s replicated job", trainingRuntime.GetName(), nodeJobName)
+		}
+		worker := &kubeflow.ReplicaSpec{
+			Replicas: ptr.To(workers),
+			Template: *node.DeepCopy(),
+		}
+		applyTrainer(&worker.Template.Spec, t, true)
+		mpiJob.Spec.MPIReplicaSpecs[kubeflow.MPIReplicaTypeWorker] = worker
+	}
+	return mpiJob, nil
+}
+
+func trainerContainer(spec *corev1.PodSpec) *corev1.Container {
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == trainerContainerName {
+			return &spec.Containers[i]
+		}
+	}
+	return nil
+}
+
+// applyTrainer sets the image and the environment of the trainer container,
+// and its resources if the pod is a node of the TrainJob.
+func applyTrainer(spec *corev1.PodSpec, t *trainer, isNode bool) {
+	c := trainerContainer(spec)
+	if c == nil {
+		return
+	}
+	if t.Image != nil {
+		c.Image = *t.Image
+	}
+	for _, env := range t.Env {
+		found := false
+		for i := range c.Env {
+			if c.Env[i].Name == env.Name {
+				c.Env[i] = env
+				found = true
+			}
+		}
+		if !found {
+			c.Env = append(c.Env, env)
+		}
+	}
+	if isNode && t.ResourcesPerNode != nil {
+		c.Resources = *t.ResourcesPerNode
+	}
+}
+
+// Conditions returns the conditions of a TrainJob reflecting the conditions
+// of its MPIJob.
+func Conditions(mpiJob *kubeflow.MPIJob) []metav1.Condition {
+	mapping := map[kubeflow.JobConditionType]string{
+		kubeflow.JobCreated:   TrainJobCreated,
+		kubeflow.JobSuspended: TrainJobSuspended,
+		kubeflow.JobSucceeded: TrainJobComplete,
+		kubeflow.JobFailed:    TrainJobFailed,
+	}
+	var conditions []metav1.Condition
+	for _, cond := range mpiJob.Status.Conditions {
+		condType, ok := mapping[cond.Type]
+		if !ok {
+			continue
+		}
+		reason := cond.Reason
+		if reason == "" {
+			// The reason of the conditions of TrainJobs is required.
+			reason = string(cond.Type)
+		}
+		conditions = append(conditions, metav1.Condition{
+			Type:               condType,
+			Status:             metav1.ConditionStatus(cond.Status),
+			LastTransitionTime: cond.LastTransitionTime,
+			Reason:             reason,
+			Message:            cond.Message,
+		})
+	}
+	return conditions
+}
+
+func conditionsOf(trainJob *unstructured.Unstructured) ([]metav1.Condition, error) {
+	raw, _, err := unstructured.NestedSlice(trainJob.Object, "status", "conditions")
+	if err != nil {
+		return nil, fmt.Errorf("reading conditions of TrainJob %s: %w", trainJob.GetName(), err)
+	}
+	var status struct {
+		Conditions []metav1.Condition `json:"conditions,omitempty"`
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(map[string]interface{}{"conditions": raw}, &status); err != nil {
+		return nil, fmt.Errorf("converting conditions of TrainJob %s: %w", trainJob.GetName(), err)
+	}
+	return status.Conditions, nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trainer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+)
+
+func newTrainJob(trainer map[string]interface{}) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"runtimeRef": map[string]interface{}{"name": "mpi-distributed"},
+		"managedBy":  ManagedBy,
+		"labels":     map[string]interface{}{"team": "research"},
+	}
+	if trainer != nil {
+		spec["trainer"] = trainer
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "trainer.kubeflow.org/v1alpha1",
+		"kind":       "TrainJob",
+		"metadata": map[string]interface{}{
+			"name":      "pi",
+			"namespace": "default",
+			"uid":       "0123",
+		},
+		"spec": spec,
+	}}
+}
+
+func newReplicatedJob(name string, container map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name": name,
+		"template": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{container},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTrainingRuntime(mpi map[string]interface{}) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"template": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicatedJobs": []interface{}{
+					newReplicatedJob(launcherJobName, map[string]interface{}{"name": "node", "image": "mpi-pi", "command": []interface{}{"mpirun", "pi"}}),
+					newReplicatedJob(nodeJobName, map[string]interface{}{"name": "node", "image": "mpi-pi", "command": []interface{}{"/usr/sbin/sshd", "-De"}}),
+				},
+			},
+		},
+	}
+	if mpi != nil {
+		spec["mlPolicy"] = map[string]interface{}{"numNodes": int64(3), "mpi": mpi}
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "trainer.kubeflow.org/v1alpha1",
+		"kind":       "ClusterTrainingRuntime",
+		"metadata":   map[string]interface{}{"name": "mpi-distributed"},
+		"spec":       spec,
+	}}
+}
+
+func podTemplate(container corev1.Container) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{container}}}
+}
+
+func TestNewMPIJob(t *testing.T) {
+	ownerRefs := []metav1.OwnerReference{{
+		APIVersion:         "trainer.kubeflow.org/v1alpha1",
+		Kind:               "TrainJob",
+		Name:               "pi",
+		UID:                "0123",
+		Controller:         ptr.To(true),
+		BlockOwnerDeletion: ptr.To(true),
+	}}
+	cases := map[string]struct {
+		trainJob *unstructured.Unstructured
+		runtime  *unstructured.Unstructured
+		want     *kubeflow.MPIJob
+		wantErr  bool
+	}{
+		"runtime defaults": {
+			trainJob: newTrainJob(nil),
+			runtime: newTrainingRuntime(map[string]interface{}{
+				"numProcPerNode":    int64(2),
+				"mpiImplementation": "OpenMPI",
+				"sshAuthMountPath":  "/home/mpiuser/.ssh",
+			}),
+			want: &kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "pi",
+					Namespace:       "default",
+					Labels:          map[string]string{TrainJobNameLabel: "pi", "team": "research"},
+					OwnerReferences: ownerRefs,
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker:      ptr.To[int32](2),
+					RunLauncherAsWorker: ptr.To(false),
+					MPIImplementation:   kubeflow.MPIImplementationOpenMPI,
+					SSHAuthMountPath:    "/home/mpiuser/.ssh",
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas: ptr.To[int32](1),
+							Template: podTemplate(corev1.Container{Name: "node", Image: "mpi-pi", Command: []string{"mpirun", "pi"}}),
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas: ptr.To[int32](3),
+							Template: podTemplate(corev1.Container{Name: "node", Image: "mpi-pi", Command: []string{"/usr/sbin/sshd", "-De"}}),
+						},
+					},
+				},
+			},
+		},
+		"trainer overrides": {
+			trainJob: newTrainJob(map[string]interface{}{
+				"image":          "mpi-pi:v2",
+				"command":        []interface{}{"mpirun", "pi", "--steps=10"},
+				"env":            []interface{}{map[string]interface{}{"name": "NCCL_DEBUG", "value": "INFO"}},
+				"numNodes":       int64(2),
+				"numProcPerNode": int64(4),
+				"resourcesPerNode": map[string]interface{}{
+					"limits": map[string]interface{}{"nvidia.com/gpu": "4"},
+				},
+			}),
+			runtime: newTrainingRuntime(map[string]interface{}{"runLauncherAsNode": true}),
+			want: &kubeflow.MPIJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "pi",
+					Namespace:       "default",
+					Labels:          map[string]string{TrainJobNameLabel: "pi", "team": "research"},
+					OwnerReferences: ownerRefs,
+				},
+				Spec: kubeflow.MPIJobSpec{
+					SlotsPerWorker:      ptr.To[int32](4),
+					RunLauncherAsWorker: ptr.To(true),
+					MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+						kubeflow.MPIReplicaTypeLauncher: {
+							Replicas: ptr.To[int32](1),
+							Template: podTemplate(corev1.Container{
+								Name:      "node",
+								Image:     "mpi-pi:v2",
+								Command:   []string{"mpirun", "pi", "--steps=10"},
+								Env:       []corev1.EnvVar{{Name: "NCCL_DEBUG", Value: "INFO"}},
+								Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}},
+							}),
+						},
+						kubeflow.MPIReplicaTypeWorker: {
+							Replicas: ptr.To[int32](1),
+							Template: podTemplate(corev1.Container{
+								Name:      "node",
+								Image:     "mpi-pi:v2",
+								Command:   []string{"/usr/sbin/sshd", "-De"},
+								Env:       []corev1.EnvVar{{Name: "NCCL_DEBUG", Value: "INFO"}},
+								Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}},
+							}),
+						},
+					},
+				},
+			},
+		},
+		"runtime without MPI policy": {
+			trainJob: newTrainJob(nil),
+			runtime:  newTrainingRuntime(nil),
+			wantErr:  true,
+		},
+		"automatic processes per node": {
+			trainJob: newTrainJob(map[string]interface{}{"numProcPerNode": "auto"}),
+			runtime:  newTrainingRuntime(map[string]interface{}{}),
+			wantErr:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewMPIJob(tc.trainJob, tc.runtime)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewMPIJob() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected MPIJob (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRuntimeOf(t *testing.T) {
+	cases := map[string]struct {
+		runtimeRef   map[string]interface{}
+		wantResource string
+		wantErr      bool
+	}{
+		"default kind": {
+			runtimeRef:   map[string]interface{}{"name": "mpi"},
+			wantResource: ClusterTrainingRuntimeResource.Resource,
+		},
+		"namespaced runtime": {
+			runtimeRef:   map[string]interface{}{"name": "mpi", "kind": "TrainingRuntime"},
+			wantResource: TrainingRuntimeResource.Resource,
+		},
+		"other API group": {
+			runtimeRef: map[string]interface{}{"name": "mpi", "apiGroup": "example.com"},
+			wantErr:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			trainJob := newTrainJob(nil)
+			trainJob.Object["spec"].(map[string]interface{})["runtimeRef"] = tc.runtimeRef
+			gotResource, gotName, err := RuntimeOf(trainJob)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RuntimeOf() returned error %v, want error %t", err, tc.wantErr)
+			}
+			if err == nil && (gotResource.Resource != tc.wantResource || gotName != "mpi") {
+				t.Errorf("RuntimeOf() = %s, %s, want %s, mpi", gotResource.Resource, gotName, tc.wantResource)
+			}
+		})
+	}
+}
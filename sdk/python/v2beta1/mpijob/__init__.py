@@ -75,13 +75,42 @@ from mpijob.models.v1_timestamp import V1Timestamp
 from mpijob.models.v1_type_meta import V1TypeMeta
 from mpijob.models.v1_update_options import V1UpdateOptions
 from mpijob.models.v1_watch_event import V1WatchEvent
+from mpijob.models.v2beta1_artifacts import V2beta1Artifacts
+from mpijob.models.v2beta1_benchmark import V2beta1Benchmark
+from mpijob.models.v2beta1_cluster_autoscaler import V2beta1ClusterAutoscaler
+from mpijob.models.v2beta1_core_dump import V2beta1CoreDump
+from mpijob.models.v2beta1_core_dumps import V2beta1CoreDumps
+from mpijob.models.v2beta1_diagnostics import V2beta1Diagnostics
+from mpijob.models.v2beta1_gpu_pre_check import V2beta1GPUPreCheck
+from mpijob.models.v2beta1_gpu_usage import V2beta1GPUUsage
+from mpijob.models.v2beta1_hook import V2beta1Hook
+from mpijob.models.v2beta1_hooks import V2beta1Hooks
 from mpijob.models.v2beta1_job_condition import V2beta1JobCondition
 from mpijob.models.v2beta1_job_status import V2beta1JobStatus
+from mpijob.models.v2beta1_launch_result import V2beta1LaunchResult
+from mpijob.models.v2beta1_launch_wrapper import V2beta1LaunchWrapper
+from mpijob.models.v2beta1_launcher_job_template import V2beta1LauncherJobTemplate
 from mpijob.models.v2beta1_mpi_job import V2beta1MPIJob
+from mpijob.models.v2beta1_mpi_job_defaults import V2beta1MPIJobDefaults
+from mpijob.models.v2beta1_mpi_job_defaults_list import V2beta1MPIJobDefaultsList
+from mpijob.models.v2beta1_mpi_job_defaults_spec import V2beta1MPIJobDefaultsSpec
 from mpijob.models.v2beta1_mpi_job_list import V2beta1MPIJobList
 from mpijob.models.v2beta1_mpi_job_spec import V2beta1MPIJobSpec
+from mpijob.models.v2beta1_multi_cluster import V2beta1MultiCluster
+from mpijob.models.v2beta1_network import V2beta1Network
+from mpijob.models.v2beta1_network_attachment import V2beta1NetworkAttachment
+from mpijob.models.v2beta1_profiling import V2beta1Profiling
+from mpijob.models.v2beta1_replica_defaults import V2beta1ReplicaDefaults
 from mpijob.models.v2beta1_replica_spec import V2beta1ReplicaSpec
 from mpijob.models.v2beta1_replica_status import V2beta1ReplicaStatus
+from mpijob.models.v2beta1_resource_usage import V2beta1ResourceUsage
 from mpijob.models.v2beta1_run_policy import V2beta1RunPolicy
 from mpijob.models.v2beta1_scheduling_policy import V2beta1SchedulingPolicy
+from mpijob.models.v2beta1_service_mesh import V2beta1ServiceMesh
+from mpijob.models.v2beta1_service_template import V2beta1ServiceTemplate
+from mpijob.models.v2beta1_watchdog import V2beta1Watchdog
+from mpijob.models.v2beta1_worker_group import V2beta1WorkerGroup
+from mpijob.models.v2beta1_worker_naming import V2beta1WorkerNaming
+from mpijob.models.v2beta1_worker_override import V2beta1WorkerOverride
+from mpijob.models.v2beta1_worker_pool import V2beta1WorkerPool
 
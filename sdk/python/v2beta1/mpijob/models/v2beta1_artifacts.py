@@ -0,0 +1,215 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1Artifacts(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'credentials_secret_name': 'str',
+        'destination': 'str',
+        'results_path': 'str',
+        'workers': 'bool'
+    }
+
+    attribute_map = {
+        'credentials_secret_name': 'credentialsSecretName',
+        'destination': 'destination',
+        'results_path': 'resultsPath',
+        'workers': 'workers'
+    }
+
+    def __init__(self, credentials_secret_name=None, destination='', results_path=None, workers=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1Artifacts - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._credentials_secret_name = None
+        self._destination = None
+        self._results_path = None
+        self._workers = None
+        self.discriminator = None
+
+        if credentials_secret_name is not None:
+            self.credentials_secret_name = credentials_secret_name
+        self.destination = destination
+        if results_path is not None:
+            self.results_path = results_path
+        if workers is not None:
+            self.workers = workers
+
+    @property
+    def credentials_secret_name(self):
+        """Gets the credentials_secret_name of this V2beta1Artifacts.  # noqa: E501
+
+        CredentialsSecretName is the Secret with the credentials of the sidecar, like AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which are set as environment variables. If unset, the sidecar uses the credentials of the service account of the pod.  # noqa: E501
+
+        :return: The credentials_secret_name of this V2beta1Artifacts.  # noqa: E501
+        :rtype: str
+        """
+        return self._credentials_secret_name
+
+    @credentials_secret_name.setter
+    def credentials_secret_name(self, credentials_secret_name):
+        """Sets the credentials_secret_name of this V2beta1Artifacts.
+
+        CredentialsSecretName is the Secret with the credentials of the sidecar, like AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which are set as environment variables. If unset, the sidecar uses the credentials of the service account of the pod.  # noqa: E501
+
+        :param credentials_secret_name: The credentials_secret_name of this V2beta1Artifacts.  # noqa: E501
+        :type credentials_secret_name: str
+        """
+
+        self._credentials_secret_name = credentials_secret_name
+
+    @property
+    def destination(self):
+        """Gets the destination of this V2beta1Artifacts.  # noqa: E501
+
+        Destination is the URL of the bucket and prefix, as s3://bucket/prefix or gs://bucket/prefix. The files of each pod are uploaded to <destination>/<namespace>/<mpijob>/<pod>.  # noqa: E501
+
+        :return: The destination of this V2beta1Artifacts.  # noqa: E501
+        :rtype: str
+        """
+        return self._destination
+
+    @destination.setter
+    def destination(self, destination):
+        """Sets the destination of this V2beta1Artifacts.
+
+        Destination is the URL of the bucket and prefix, as s3://bucket/prefix or gs://bucket/prefix. The files of each pod are uploaded to <destination>/<namespace>/<mpijob>/<pod>.  # noqa: E501
+
+        :param destination: The destination of this V2beta1Artifacts.  # noqa: E501
+        :type destination: str
+        """
+        if self.local_vars_configuration.client_side_validation and destination is None:  # noqa: E501
+            raise ValueError("Invalid value for `destination`, must not be `None`")  # noqa: E501
+
+        self._destination = destination
+
+    @property
+    def results_path(self):
+        """Gets the results_path of this V2beta1Artifacts.  # noqa: E501
+
+        ResultsPath is the directory of the launcher container whose files are uploaded with the logs. It is mounted from a volume shared with the sidecar.  # noqa: E501
+
+        :return: The results_path of this V2beta1Artifacts.  # noqa: E501
+        :rtype: str
+        """
+        return self._results_path
+
+    @results_path.setter
+    def results_path(self, results_path):
+        """Sets the results_path of this V2beta1Artifacts.
+
+        ResultsPath is the directory of the launcher container whose files are uploaded with the logs. It is mounted from a volume shared with the sidecar.  # noqa: E501
+
+        :param results_path: The results_path of this V2beta1Artifacts.  # noqa: E501
+        :type results_path: str
+        """
+
+        self._results_path = results_path
+
+    @property
+    def workers(self):
+        """Gets the workers of this V2beta1Artifacts.  # noqa: E501
+
+        Workers injects the sidecar in the workers too. Defaults to false.  # noqa: E501
+
+        :return: The workers of this V2beta1Artifacts.  # noqa: E501
+        :rtype: bool
+        """
+        return self._workers
+
+    @workers.setter
+    def workers(self, workers):
+        """Sets the workers of this V2beta1Artifacts.
+
+        Workers injects the sidecar in the workers too. Defaults to false.  # noqa: E501
+
+        :param workers: The workers of this V2beta1Artifacts.  # noqa: E501
+        :type workers: bool
+        """
+
+        self._workers = workers
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1Artifacts):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1Artifacts):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,159 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1Benchmark(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'args': 'list[str]',
+        'type': 'str'
+    }
+
+    attribute_map = {
+        'args': 'args',
+        'type': 'type'
+    }
+
+    def __init__(self, args=None, type='', local_vars_configuration=None):  # noqa: E501
+        """V2beta1Benchmark - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._args = None
+        self._type = None
+        self.discriminator = None
+
+        if args is not None:
+            self.args = args
+        self.type = type
+
+    @property
+    def args(self):
+        """Gets the args of this V2beta1Benchmark.  # noqa: E501
+
+        Args replace the default arguments of the benchmark.  # noqa: E501
+
+        :return: The args of this V2beta1Benchmark.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._args
+
+    @args.setter
+    def args(self, args):
+        """Sets the args of this V2beta1Benchmark.
+
+        Args replace the default arguments of the benchmark.  # noqa: E501
+
+        :param args: The args of this V2beta1Benchmark.  # noqa: E501
+        :type args: list[str]
+        """
+
+        self._args = args
+
+    @property
+    def type(self):
+        """Gets the type of this V2beta1Benchmark.  # noqa: E501
+
+        Type is the benchmark. Options are \"OSUBandwidth\", \"OSULatency\" and \"NCCLAllReduce\".  # noqa: E501
+
+        :return: The type of this V2beta1Benchmark.  # noqa: E501
+        :rtype: str
+        """
+        return self._type
+
+    @type.setter
+    def type(self, type):
+        """Sets the type of this V2beta1Benchmark.
+
+        Type is the benchmark. Options are \"OSUBandwidth\", \"OSULatency\" and \"NCCLAllReduce\".  # noqa: E501
+
+        :param type: The type of this V2beta1Benchmark.  # noqa: E501
+        :type type: str
+        """
+        if self.local_vars_configuration.client_side_validation and type is None:  # noqa: E501
+            raise ValueError("Invalid value for `type`, must not be `None`")  # noqa: E501
+
+        self._type = type
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1Benchmark):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1Benchmark):
+            return True
+
+        return self.to_dict() != other.to_dict()
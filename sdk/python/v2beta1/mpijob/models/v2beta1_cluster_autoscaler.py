@@ -0,0 +1,130 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1ClusterAutoscaler(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'provisioning_class_name': 'str'
+    }
+
+    attribute_map = {
+        'provisioning_class_name': 'provisioningClassName'
+    }
+
+    def __init__(self, provisioning_class_name=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1ClusterAutoscaler - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._provisioning_class_name = None
+        self.discriminator = None
+
+        if provisioning_class_name is not None:
+            self.provisioning_class_name = provisioning_class_name
+
+    @property
+    def provisioning_class_name(self):
+        """Gets the provisioning_class_name of this V2beta1ClusterAutoscaler.  # noqa: E501
+
+        ProvisioningClassName requests the nodes of all the workers at once with a ProvisioningRequest of this class, like best-effort-atscale.autoscaling.x-k8s.io. The workers have a scheduling gate until the ProvisioningRequest is provisioned, so that they are scheduled together instead of one by one as the nodes are created. Requires the operator to manage ProvisioningRequests.  # noqa: E501
+
+        :return: The provisioning_class_name of this V2beta1ClusterAutoscaler.  # noqa: E501
+        :rtype: str
+        """
+        return self._provisioning_class_name
+
+    @provisioning_class_name.setter
+    def provisioning_class_name(self, provisioning_class_name):
+        """Sets the provisioning_class_name of this V2beta1ClusterAutoscaler.
+
+        ProvisioningClassName requests the nodes of all the workers at once with a ProvisioningRequest of this class, like best-effort-atscale.autoscaling.x-k8s.io. The workers have a scheduling gate until the ProvisioningRequest is provisioned, so that they are scheduled together instead of one by one as the nodes are created. Requires the operator to manage ProvisioningRequests.  # noqa: E501
+
+        :param provisioning_class_name: The provisioning_class_name of this V2beta1ClusterAutoscaler.  # noqa: E501
+        :type provisioning_class_name: str
+        """
+
+        self._provisioning_class_name = provisioning_class_name
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1ClusterAutoscaler):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1ClusterAutoscaler):
+            return True
+
+        return self.to_dict() != other.to_dict()
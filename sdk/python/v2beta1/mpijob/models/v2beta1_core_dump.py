@@ -0,0 +1,188 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1CoreDump(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'host': 'str',
+        'rank': 'int',
+        'signal': 'str'
+    }
+
+    attribute_map = {
+        'host': 'host',
+        'rank': 'rank',
+        'signal': 'signal'
+    }
+
+    def __init__(self, host=None, rank=0, signal='', local_vars_configuration=None):  # noqa: E501
+        """V2beta1CoreDump - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._host = None
+        self._rank = None
+        self._signal = None
+        self.discriminator = None
+
+        if host is not None:
+            self.host = host
+        self.rank = rank
+        self.signal = signal
+
+    @property
+    def host(self):
+        """Gets the host of this V2beta1CoreDump.  # noqa: E501
+
+        The host of the process, like <mpijob>-worker-0, which is the %h of the name of its core file.  # noqa: E501
+
+        :return: The host of this V2beta1CoreDump.  # noqa: E501
+        :rtype: str
+        """
+        return self._host
+
+    @host.setter
+    def host(self, host):
+        """Sets the host of this V2beta1CoreDump.
+
+        The host of the process, like <mpijob>-worker-0, which is the %h of the name of its core file.  # noqa: E501
+
+        :param host: The host of this V2beta1CoreDump.  # noqa: E501
+        :type host: str
+        """
+
+        self._host = host
+
+    @property
+    def rank(self):
+        """Gets the rank of this V2beta1CoreDump.  # noqa: E501
+
+        The rank of the process.  # noqa: E501
+
+        :return: The rank of this V2beta1CoreDump.  # noqa: E501
+        :rtype: int
+        """
+        return self._rank
+
+    @rank.setter
+    def rank(self, rank):
+        """Sets the rank of this V2beta1CoreDump.
+
+        The rank of the process.  # noqa: E501
+
+        :param rank: The rank of this V2beta1CoreDump.  # noqa: E501
+        :type rank: int
+        """
+        if self.local_vars_configuration.client_side_validation and rank is None:  # noqa: E501
+            raise ValueError("Invalid value for `rank`, must not be `None`")  # noqa: E501
+
+        self._rank = rank
+
+    @property
+    def signal(self):
+        """Gets the signal of this V2beta1CoreDump.  # noqa: E501
+
+        The signal that killed the process, like SIGSEGV.  # noqa: E501
+
+        :return: The signal of this V2beta1CoreDump.  # noqa: E501
+        :rtype: str
+        """
+        return self._signal
+
+    @signal.setter
+    def signal(self, signal):
+        """Sets the signal of this V2beta1CoreDump.
+
+        The signal that killed the process, like SIGSEGV.  # noqa: E501
+
+        :param signal: The signal of this V2beta1CoreDump.  # noqa: E501
+        :type signal: str
+        """
+        if self.local_vars_configuration.client_side_validation and signal is None:  # noqa: E501
+            raise ValueError("Invalid value for `signal`, must not be `None`")  # noqa: E501
+
+        self._signal = signal
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1CoreDump):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1CoreDump):
+            return True
+
+        return self.to_dict() != other.to_dict()
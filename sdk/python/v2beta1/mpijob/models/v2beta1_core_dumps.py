@@ -0,0 +1,214 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1CoreDumps(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'claim_name': 'str',
+        'credentials_secret_name': 'str',
+        'destination': 'str',
+        'path': 'str'
+    }
+
+    attribute_map = {
+        'claim_name': 'claimName',
+        'credentials_secret_name': 'credentialsSecretName',
+        'destination': 'destination',
+        'path': 'path'
+    }
+
+    def __init__(self, claim_name=None, credentials_secret_name=None, destination=None, path=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1CoreDumps - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._claim_name = None
+        self._credentials_secret_name = None
+        self._destination = None
+        self._path = None
+        self.discriminator = None
+
+        if claim_name is not None:
+            self.claim_name = claim_name
+        if credentials_secret_name is not None:
+            self.credentials_secret_name = credentials_secret_name
+        if destination is not None:
+            self.destination = destination
+        if path is not None:
+            self.path = path
+
+    @property
+    def claim_name(self):
+        """Gets the claim_name of this V2beta1CoreDumps.  # noqa: E501
+
+        ClaimName is the PersistentVolumeClaim keeping the core files. It must support the ReadWriteMany access mode when the pods run on several nodes. Exactly one of claimName and destination must be set.  # noqa: E501
+
+        :return: The claim_name of this V2beta1CoreDumps.  # noqa: E501
+        :rtype: str
+        """
+        return self._claim_name
+
+    @claim_name.setter
+    def claim_name(self, claim_name):
+        """Sets the claim_name of this V2beta1CoreDumps.
+
+        ClaimName is the PersistentVolumeClaim keeping the core files. It must support the ReadWriteMany access mode when the pods run on several nodes. Exactly one of claimName and destination must be set.  # noqa: E501
+
+        :param claim_name: The claim_name of this V2beta1CoreDumps.  # noqa: E501
+        :type claim_name: str
+        """
+
+        self._claim_name = claim_name
+
+    @property
+    def credentials_secret_name(self):
+        """Gets the credentials_secret_name of this V2beta1CoreDumps.  # noqa: E501
+
+        CredentialsSecretName is the Secret with the credentials of the sidecar, like AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which are set as environment variables. If unset, the sidecar uses the credentials of the service account of the pod.  # noqa: E501
+
+        :return: The credentials_secret_name of this V2beta1CoreDumps.  # noqa: E501
+        :rtype: str
+        """
+        return self._credentials_secret_name
+
+    @credentials_secret_name.setter
+    def credentials_secret_name(self, credentials_secret_name):
+        """Sets the credentials_secret_name of this V2beta1CoreDumps.
+
+        CredentialsSecretName is the Secret with the credentials of the sidecar, like AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which are set as environment variables. If unset, the sidecar uses the credentials of the service account of the pod.  # noqa: E501
+
+        :param credentials_secret_name: The credentials_secret_name of this V2beta1CoreDumps.  # noqa: E501
+        :type credentials_secret_name: str
+        """
+
+        self._credentials_secret_name = credentials_secret_name
+
+    @property
+    def destination(self):
+        """Gets the destination of this V2beta1CoreDumps.  # noqa: E501
+
+        Destination is the URL of the bucket and prefix, as s3://bucket/prefix or gs://bucket/prefix, to which the sidecar uploads the core files of each pod, to <destination>/<namespace>/<mpijob>/<pod>/cores.  # noqa: E501
+
+        :return: The destination of this V2beta1CoreDumps.  # noqa: E501
+        :rtype: str
+        """
+        return self._destination
+
+    @destination.setter
+    def destination(self, destination):
+        """Sets the destination of this V2beta1CoreDumps.
+
+        Destination is the URL of the bucket and prefix, as s3://bucket/prefix or gs://bucket/prefix, to which the sidecar uploads the core files of each pod, to <destination>/<namespace>/<mpijob>/<pod>/cores.  # noqa: E501
+
+        :param destination: The destination of this V2beta1CoreDumps.  # noqa: E501
+        :type destination: str
+        """
+
+        self._destination = destination
+
+    @property
+    def path(self):
+        """Gets the path of this V2beta1CoreDumps.  # noqa: E501
+
+        Path is the directory of the core_pattern of the nodes, where the volume of the core files is mounted. Defaults to /cores.  # noqa: E501
+
+        :return: The path of this V2beta1CoreDumps.  # noqa: E501
+        :rtype: str
+        """
+        return self._path
+
+    @path.setter
+    def path(self, path):
+        """Sets the path of this V2beta1CoreDumps.
+
+        Path is the directory of the core_pattern of the nodes, where the volume of the core files is mounted. Defaults to /cores.  # noqa: E501
+
+        :param path: The path of this V2beta1CoreDumps.  # noqa: E501
+        :type path: str
+        """
+
+        self._path = path
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1CoreDumps):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1CoreDumps):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,214 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1Diagnostics(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'command': 'list[str]',
+        'enabled': 'bool',
+        'image': 'str',
+        'min_bus_bandwidth_g_bps': 'int'
+    }
+
+    attribute_map = {
+        'command': 'command',
+        'enabled': 'enabled',
+        'image': 'image',
+        'min_bus_bandwidth_g_bps': 'minBusBandwidthGBps'
+    }
+
+    def __init__(self, command=None, enabled=None, image=None, min_bus_bandwidth_g_bps=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1Diagnostics - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._command = None
+        self._enabled = None
+        self._image = None
+        self._min_bus_bandwidth_g_bps = None
+        self.discriminator = None
+
+        if command is not None:
+            self.command = command
+        if enabled is not None:
+            self.enabled = enabled
+        if image is not None:
+            self.image = image
+        if min_bus_bandwidth_g_bps is not None:
+            self.min_bus_bandwidth_g_bps = min_bus_bandwidth_g_bps
+
+    @property
+    def command(self):
+        """Gets the command of this V2beta1Diagnostics.  # noqa: E501
+
+        Command is the benchmark that mpirun starts with one process per slot. Defaults to the NCCL all-reduce test: [\"all_reduce_perf\", \"-b\", \"8\", \"-e\", \"128M\", \"-f\", \"2\", \"-g\", \"1\"].  # noqa: E501
+
+        :return: The command of this V2beta1Diagnostics.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._command
+
+    @command.setter
+    def command(self, command):
+        """Sets the command of this V2beta1Diagnostics.
+
+        Command is the benchmark that mpirun starts with one process per slot. Defaults to the NCCL all-reduce test: [\"all_reduce_perf\", \"-b\", \"8\", \"-e\", \"128M\", \"-f\", \"2\", \"-g\", \"1\"].  # noqa: E501
+
+        :param command: The command of this V2beta1Diagnostics.  # noqa: E501
+        :type command: list[str]
+        """
+
+        self._command = command
+
+    @property
+    def enabled(self):
+        """Gets the enabled of this V2beta1Diagnostics.  # noqa: E501
+
+        Enabled runs the diagnostics before the launcher command. Defaults to false.  # noqa: E501
+
+        :return: The enabled of this V2beta1Diagnostics.  # noqa: E501
+        :rtype: bool
+        """
+        return self._enabled
+
+    @enabled.setter
+    def enabled(self, enabled):
+        """Sets the enabled of this V2beta1Diagnostics.
+
+        Enabled runs the diagnostics before the launcher command. Defaults to false.  # noqa: E501
+
+        :param enabled: The enabled of this V2beta1Diagnostics.  # noqa: E501
+        :type enabled: bool
+        """
+
+        self._enabled = enabled
+
+    @property
+    def image(self):
+        """Gets the image of this V2beta1Diagnostics.  # noqa: E501
+
+        Image runs the diagnostics. It must contain the MPI implementation and the benchmark. Defaults to the image of the launcher.  # noqa: E501
+
+        :return: The image of this V2beta1Diagnostics.  # noqa: E501
+        :rtype: str
+        """
+        return self._image
+
+    @image.setter
+    def image(self, image):
+        """Sets the image of this V2beta1Diagnostics.
+
+        Image runs the diagnostics. It must contain the MPI implementation and the benchmark. Defaults to the image of the launcher.  # noqa: E501
+
+        :param image: The image of this V2beta1Diagnostics.  # noqa: E501
+        :type image: str
+        """
+
+        self._image = image
+
+    @property
+    def min_bus_bandwidth_g_bps(self):
+        """Gets the min_bus_bandwidth_g_bps of this V2beta1Diagnostics.  # noqa: E501
+
+        MinBusBandwidthGBps fails the MPIJob if the average bus bandwidth reported by the benchmark, in GB/s, is lower. The benchmark must print it as \"Avg bus bandwidth : <value>\", like the NCCL tests do. If unset, only the exit code of the benchmark is checked.  # noqa: E501
+
+        :return: The min_bus_bandwidth_g_bps of this V2beta1Diagnostics.  # noqa: E501
+        :rtype: int
+        """
+        return self._min_bus_bandwidth_g_bps
+
+    @min_bus_bandwidth_g_bps.setter
+    def min_bus_bandwidth_g_bps(self, min_bus_bandwidth_g_bps):
+        """Sets the min_bus_bandwidth_g_bps of this V2beta1Diagnostics.
+
+        MinBusBandwidthGBps fails the MPIJob if the average bus bandwidth reported by the benchmark, in GB/s, is lower. The benchmark must print it as \"Avg bus bandwidth : <value>\", like the NCCL tests do. If unset, only the exit code of the benchmark is checked.  # noqa: E501
+
+        :param min_bus_bandwidth_g_bps: The min_bus_bandwidth_g_bps of this V2beta1Diagnostics.  # noqa: E501
+        :type min_bus_bandwidth_g_bps: int
+        """
+
+        self._min_bus_bandwidth_g_bps = min_bus_bandwidth_g_bps
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1Diagnostics):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1Diagnostics):
+            return True
+
+        return self.to_dict() != other.to_dict()
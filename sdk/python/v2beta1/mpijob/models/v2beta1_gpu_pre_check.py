@@ -0,0 +1,214 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1GPUPreCheck(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'command': 'list[str]',
+        'image': 'str',
+        'max_failed_nodes': 'int',
+        'resource_name': 'str'
+    }
+
+    attribute_map = {
+        'command': 'command',
+        'image': 'image',
+        'max_failed_nodes': 'maxFailedNodes',
+        'resource_name': 'resourceName'
+    }
+
+    def __init__(self, command=None, image=None, max_failed_nodes=None, resource_name=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1GPUPreCheck - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._command = None
+        self._image = None
+        self._max_failed_nodes = None
+        self._resource_name = None
+        self.discriminator = None
+
+        if command is not None:
+            self.command = command
+        if image is not None:
+            self.image = image
+        if max_failed_nodes is not None:
+            self.max_failed_nodes = max_failed_nodes
+        if resource_name is not None:
+            self.resource_name = resource_name
+
+    @property
+    def command(self):
+        """Gets the command of this V2beta1GPUPreCheck.  # noqa: E501
+
+        Command is the check, which fails with a non-zero exit code. Defaults to the quick DCGM diagnostics: [\"/bin/sh\", \"-c\", \"nv-hostengine && dcgmi diag -r 1\"].  # noqa: E501
+
+        :return: The command of this V2beta1GPUPreCheck.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._command
+
+    @command.setter
+    def command(self, command):
+        """Sets the command of this V2beta1GPUPreCheck.
+
+        Command is the check, which fails with a non-zero exit code. Defaults to the quick DCGM diagnostics: [\"/bin/sh\", \"-c\", \"nv-hostengine && dcgmi diag -r 1\"].  # noqa: E501
+
+        :param command: The command of this V2beta1GPUPreCheck.  # noqa: E501
+        :type command: list[str]
+        """
+
+        self._command = command
+
+    @property
+    def image(self):
+        """Gets the image of this V2beta1GPUPreCheck.  # noqa: E501
+
+        Image runs the check. Defaults to the DCGM image configured in the operator.  # noqa: E501
+
+        :return: The image of this V2beta1GPUPreCheck.  # noqa: E501
+        :rtype: str
+        """
+        return self._image
+
+    @image.setter
+    def image(self, image):
+        """Sets the image of this V2beta1GPUPreCheck.
+
+        Image runs the check. Defaults to the DCGM image configured in the operator.  # noqa: E501
+
+        :param image: The image of this V2beta1GPUPreCheck.  # noqa: E501
+        :type image: str
+        """
+
+        self._image = image
+
+    @property
+    def max_failed_nodes(self):
+        """Gets the max_failed_nodes of this V2beta1GPUPreCheck.  # noqa: E501
+
+        MaxFailedNodes is the number of nodes on which the check can fail before the MPIJob fails. Defaults to 3.  # noqa: E501
+
+        :return: The max_failed_nodes of this V2beta1GPUPreCheck.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_failed_nodes
+
+    @max_failed_nodes.setter
+    def max_failed_nodes(self, max_failed_nodes):
+        """Sets the max_failed_nodes of this V2beta1GPUPreCheck.
+
+        MaxFailedNodes is the number of nodes on which the check can fail before the MPIJob fails. Defaults to 3.  # noqa: E501
+
+        :param max_failed_nodes: The max_failed_nodes of this V2beta1GPUPreCheck.  # noqa: E501
+        :type max_failed_nodes: int
+        """
+
+        self._max_failed_nodes = max_failed_nodes
+
+    @property
+    def resource_name(self):
+        """Gets the resource_name of this V2beta1GPUPreCheck.  # noqa: E501
+
+        ResourceName is the extended resource of the GPUs. Only the workers requesting it run the check. Defaults to nvidia.com/gpu.  # noqa: E501
+
+        :return: The resource_name of this V2beta1GPUPreCheck.  # noqa: E501
+        :rtype: str
+        """
+        return self._resource_name
+
+    @resource_name.setter
+    def resource_name(self, resource_name):
+        """Sets the resource_name of this V2beta1GPUPreCheck.
+
+        ResourceName is the extended resource of the GPUs. Only the workers requesting it run the check. Defaults to nvidia.com/gpu.  # noqa: E501
+
+        :param resource_name: The resource_name of this V2beta1GPUPreCheck.  # noqa: E501
+        :type resource_name: str
+        """
+
+        self._resource_name = resource_name
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1GPUPreCheck):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1GPUPreCheck):
+            return True
+
+        return self.to_dict() != other.to_dict()
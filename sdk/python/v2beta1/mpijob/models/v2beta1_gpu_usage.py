@@ -0,0 +1,217 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1GPUUsage(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'average_utilization': 'int',
+        'energy_joules': 'int',
+        'gpus': 'int',
+        'peak_utilization': 'int'
+    }
+
+    attribute_map = {
+        'average_utilization': 'averageUtilization',
+        'energy_joules': 'energyJoules',
+        'gpus': 'gpus',
+        'peak_utilization': 'peakUtilization'
+    }
+
+    def __init__(self, average_utilization=0, energy_joules=None, gpus=0, peak_utilization=0, local_vars_configuration=None):  # noqa: E501
+        """V2beta1GPUUsage - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._average_utilization = None
+        self._energy_joules = None
+        self._gpus = None
+        self._peak_utilization = None
+        self.discriminator = None
+
+        self.average_utilization = average_utilization
+        if energy_joules is not None:
+            self.energy_joules = energy_joules
+        self.gpus = gpus
+        self.peak_utilization = peak_utilization
+
+    @property
+    def average_utilization(self):
+        """Gets the average_utilization of this V2beta1GPUUsage.  # noqa: E501
+
+        The utilization of the GPUs, in percent, averaged over the GPUs and the duration of the job.  # noqa: E501
+
+        :return: The average_utilization of this V2beta1GPUUsage.  # noqa: E501
+        :rtype: int
+        """
+        return self._average_utilization
+
+    @average_utilization.setter
+    def average_utilization(self, average_utilization):
+        """Sets the average_utilization of this V2beta1GPUUsage.
+
+        The utilization of the GPUs, in percent, averaged over the GPUs and the duration of the job.  # noqa: E501
+
+        :param average_utilization: The average_utilization of this V2beta1GPUUsage.  # noqa: E501
+        :type average_utilization: int
+        """
+        if self.local_vars_configuration.client_side_validation and average_utilization is None:  # noqa: E501
+            raise ValueError("Invalid value for `average_utilization`, must not be `None`")  # noqa: E501
+
+        self._average_utilization = average_utilization
+
+    @property
+    def energy_joules(self):
+        """Gets the energy_joules of this V2beta1GPUUsage.  # noqa: E501
+
+        The energy consumed by the GPUs, in joules, estimated from their average power usage and the duration of the job.  # noqa: E501
+
+        :return: The energy_joules of this V2beta1GPUUsage.  # noqa: E501
+        :rtype: int
+        """
+        return self._energy_joules
+
+    @energy_joules.setter
+    def energy_joules(self, energy_joules):
+        """Sets the energy_joules of this V2beta1GPUUsage.
+
+        The energy consumed by the GPUs, in joules, estimated from their average power usage and the duration of the job.  # noqa: E501
+
+        :param energy_joules: The energy_joules of this V2beta1GPUUsage.  # noqa: E501
+        :type energy_joules: int
+        """
+
+        self._energy_joules = energy_joules
+
+    @property
+    def gpus(self):
+        """Gets the gpus of this V2beta1GPUUsage.  # noqa: E501
+
+        The number of GPUs that the pods of the job used.  # noqa: E501
+
+        :return: The gpus of this V2beta1GPUUsage.  # noqa: E501
+        :rtype: int
+        """
+        return self._gpus
+
+    @gpus.setter
+    def gpus(self, gpus):
+        """Sets the gpus of this V2beta1GPUUsage.
+
+        The number of GPUs that the pods of the job used.  # noqa: E501
+
+        :param gpus: The gpus of this V2beta1GPUUsage.  # noqa: E501
+        :type gpus: int
+        """
+        if self.local_vars_configuration.client_side_validation and gpus is None:  # noqa: E501
+            raise ValueError("Invalid value for `gpus`, must not be `None`")  # noqa: E501
+
+        self._gpus = gpus
+
+    @property
+    def peak_utilization(self):
+        """Gets the peak_utilization of this V2beta1GPUUsage.  # noqa: E501
+
+        The highest utilization of any of the GPUs, in percent.  # noqa: E501
+
+        :return: The peak_utilization of this V2beta1GPUUsage.  # noqa: E501
+        :rtype: int
+        """
+        return self._peak_utilization
+
+    @peak_utilization.setter
+    def peak_utilization(self, peak_utilization):
+        """Sets the peak_utilization of this V2beta1GPUUsage.
+
+        The highest utilization of any of the GPUs, in percent.  # noqa: E501
+
+        :param peak_utilization: The peak_utilization of this V2beta1GPUUsage.  # noqa: E501
+        :type peak_utilization: int
+        """
+        if self.local_vars_configuration.client_side_validation and peak_utilization is None:  # noqa: E501
+            raise ValueError("Invalid value for `peak_utilization`, must not be `None`")  # noqa: E501
+
+        self._peak_utilization = peak_utilization
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1GPUUsage):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1GPUUsage):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,210 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1Hook(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'active_deadline_seconds': 'int',
+        'backoff_limit': 'int',
+        'container': 'V1Container',
+        'job_template': 'V1JobTemplateSpec'
+    }
+
+    attribute_map = {
+        'active_deadline_seconds': 'activeDeadlineSeconds',
+        'backoff_limit': 'backoffLimit',
+        'container': 'container',
+        'job_template': 'jobTemplate'
+    }
+
+    def __init__(self, active_deadline_seconds=None, backoff_limit=None, container=None, job_template=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1Hook - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._active_deadline_seconds = None
+        self._backoff_limit = None
+        self._container = None
+        self._job_template = None
+        self.discriminator = None
+
+        if active_deadline_seconds is not None:
+            self.active_deadline_seconds = active_deadline_seconds
+        if backoff_limit is not None:
+            self.backoff_limit = backoff_limit
+        if container is not None:
+            self.container = container
+        if job_template is not None:
+            self.job_template = job_template
+
+    @property
+    def active_deadline_seconds(self):
+        """Gets the active_deadline_seconds of this V2beta1Hook.  # noqa: E501
+
+        ActiveDeadlineSeconds is the duration after which the hook fails. It takes precedence over the activeDeadlineSeconds of jobTemplate.  # noqa: E501
+
+        :return: The active_deadline_seconds of this V2beta1Hook.  # noqa: E501
+        :rtype: int
+        """
+        return self._active_deadline_seconds
+
+    @active_deadline_seconds.setter
+    def active_deadline_seconds(self, active_deadline_seconds):
+        """Sets the active_deadline_seconds of this V2beta1Hook.
+
+        ActiveDeadlineSeconds is the duration after which the hook fails. It takes precedence over the activeDeadlineSeconds of jobTemplate.  # noqa: E501
+
+        :param active_deadline_seconds: The active_deadline_seconds of this V2beta1Hook.  # noqa: E501
+        :type active_deadline_seconds: int
+        """
+
+        self._active_deadline_seconds = active_deadline_seconds
+
+    @property
+    def backoff_limit(self):
+        """Gets the backoff_limit of this V2beta1Hook.  # noqa: E501
+
+        BackoffLimit is the number of retries of the hook before it fails. Defaults to 0. It takes precedence over the backoffLimit of jobTemplate.  # noqa: E501
+
+        :return: The backoff_limit of this V2beta1Hook.  # noqa: E501
+        :rtype: int
+        """
+        return self._backoff_limit
+
+    @backoff_limit.setter
+    def backoff_limit(self, backoff_limit):
+        """Sets the backoff_limit of this V2beta1Hook.
+
+        BackoffLimit is the number of retries of the hook before it fails. Defaults to 0. It takes precedence over the backoffLimit of jobTemplate.  # noqa: E501
+
+        :param backoff_limit: The backoff_limit of this V2beta1Hook.  # noqa: E501
+        :type backoff_limit: int
+        """
+
+        self._backoff_limit = backoff_limit
+
+    @property
+    def container(self):
+        """Gets the container of this V2beta1Hook.  # noqa: E501
+
+
+        :return: The container of this V2beta1Hook.  # noqa: E501
+        :rtype: V1Container
+        """
+        return self._container
+
+    @container.setter
+    def container(self, container):
+        """Sets the container of this V2beta1Hook.
+
+
+        :param container: The container of this V2beta1Hook.  # noqa: E501
+        :type container: V1Container
+        """
+
+        self._container = container
+
+    @property
+    def job_template(self):
+        """Gets the job_template of this V2beta1Hook.  # noqa: E501
+
+
+        :return: The job_template of this V2beta1Hook.  # noqa: E501
+        :rtype: V1JobTemplateSpec
+        """
+        return self._job_template
+
+    @job_template.setter
+    def job_template(self, job_template):
+        """Sets the job_template of this V2beta1Hook.
+
+
+        :param job_template: The job_template of this V2beta1Hook.  # noqa: E501
+        :type job_template: V1JobTemplateSpec
+        """
+
+        self._job_template = job_template
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1Hook):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1Hook):
+            return True
+
+        return self.to_dict() != other.to_dict()
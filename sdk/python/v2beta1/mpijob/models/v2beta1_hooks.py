@@ -0,0 +1,154 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1Hooks(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'post_run': 'V2beta1Hook',
+        'pre_run': 'V2beta1Hook'
+    }
+
+    attribute_map = {
+        'post_run': 'postRun',
+        'pre_run': 'preRun'
+    }
+
+    def __init__(self, post_run=None, pre_run=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1Hooks - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._post_run = None
+        self._pre_run = None
+        self.discriminator = None
+
+        if post_run is not None:
+            self.post_run = post_run
+        if pre_run is not None:
+            self.pre_run = pre_run
+
+    @property
+    def post_run(self):
+        """Gets the post_run of this V2beta1Hooks.  # noqa: E501
+
+
+        :return: The post_run of this V2beta1Hooks.  # noqa: E501
+        :rtype: V2beta1Hook
+        """
+        return self._post_run
+
+    @post_run.setter
+    def post_run(self, post_run):
+        """Sets the post_run of this V2beta1Hooks.
+
+
+        :param post_run: The post_run of this V2beta1Hooks.  # noqa: E501
+        :type post_run: V2beta1Hook
+        """
+
+        self._post_run = post_run
+
+    @property
+    def pre_run(self):
+        """Gets the pre_run of this V2beta1Hooks.  # noqa: E501
+
+
+        :return: The pre_run of this V2beta1Hooks.  # noqa: E501
+        :rtype: V2beta1Hook
+        """
+        return self._pre_run
+
+    @pre_run.setter
+    def pre_run(self, pre_run):
+        """Sets the pre_run of this V2beta1Hooks.
+
+
+        :param pre_run: The pre_run of this V2beta1Hooks.  # noqa: E501
+        :type pre_run: V2beta1Hook
+        """
+
+        self._pre_run = pre_run
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1Hooks):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1Hooks):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -35,20 +35,41 @@ class V2beta1JobStatus(object):
     openapi_types = {
         'completion_time': 'datetime',
         'conditions': 'list[V2beta1JobCondition]',
+        'core_dumps': 'list[V2beta1CoreDump]',
+        'duration': 'str',
+        'failure_reason_class': 'str',
+        'gpu_pre_check_failed_nodes': 'list[str]',
+        'gpu_usage': 'V2beta1GPUUsage',
         'last_reconcile_time': 'datetime',
+        'launcher_restart_count': 'int',
+        'progress': 'str',
         'replica_statuses': 'dict(str, V2beta1ReplicaStatus)',
-        'start_time': 'datetime'
+        'resource_usage': 'V2beta1ResourceUsage',
+        'restart_count': 'int',
+        'start_time': 'datetime',
+        'state': 'str'
     }
 
     attribute_map = {
         'completion_time': 'completionTime',
         'conditions': 'conditions',
+        'core_dumps': 'coreDumps',
+        'duration': 'duration',
+        'failure_reason_class': 'failureReasonClass',
+        'gpu_pre_check_failed_nodes': 'gpuPreCheckFailedNodes',
+        'gpu_usage': 'gpuUsage',
         'last_reconcile_time': 'lastReconcileTime',
+        'launch_result': 'launchResult',
+        'launcher_restart_count': 'launcherRestartCount',
+        'progress': 'progress',
         'replica_statuses': 'replicaStatuses',
-        'start_time': 'startTime'
+        'resource_usage': 'resourceUsage',
+        'restart_count': 'restartCount',
+        'start_time': 'startTime',
+        'state': 'state'
     }
 
-    def __init__(self, completion_time=None, conditions=None, last_reconcile_time=None, replica_statuses=None, start_time=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, completion_time=None, conditions=None, core_dumps=None, duration=None, failure_reason_class=None, gpu_pre_check_failed_nodes=None, gpu_usage=None, last_reconcile_time=None, launch_result=None, launcher_restart_count=None, progress=None, replica_statuses=None, resource_usage=None, restart_count=None, start_time=None, state=None, local_vars_configuration=None):  # noqa: E501
         """V2beta1JobStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration.get_default_copy()
@@ -56,21 +77,54 @@ class V2beta1JobStatus(object):
 
         self._completion_time = None
         self._conditions = None
+        self._core_dumps = None
+        self._duration = None
+        self._failure_reason_class = None
+        self._gpu_pre_check_failed_nodes = None
+        self._gpu_usage = None
         self._last_reconcile_time = None
+        self._launch_result = None
+        self._launcher_restart_count = None
+        self._progress = None
         self._replica_statuses = None
+        self._resource_usage = None
+        self._restart_count = None
         self._start_time = None
+        self._state = None
         self.discriminator = None
 
         if completion_time is not None:
             self.completion_time = completion_time
         if conditions is not None:
             self.conditions = conditions
+        if core_dumps is not None:
+            self.core_dumps = core_dumps
+        if duration is not None:
+            self.duration = duration
+        if failure_reason_class is not None:
+            self.failure_reason_class = failure_reason_class
+        if gpu_pre_check_failed_nodes is not None:
+            self.gpu_pre_check_failed_nodes = gpu_pre_check_failed_nodes
+        if gpu_usage is not None:
+            self.gpu_usage = gpu_usage
         if last_reconcile_time is not None:
             self.last_reconcile_time = last_reconcile_time
+        if launch_result is not None:
+            self.launch_result = launch_result
+        if launcher_restart_count is not None:
+            self.launcher_restart_count = launcher_restart_count
+        if progress is not None:
+            self.progress = progress
         if replica_statuses is not None:
             self.replica_statuses = replica_statuses
+        if resource_usage is not None:
+            self.resource_usage = resource_usage
+        if restart_count is not None:
+            self.restart_count = restart_count
         if start_time is not None:
             self.start_time = start_time
+        if state is not None:
+            self.state = state
 
     @property
     def completion_time(self):
@@ -118,6 +172,119 @@ class V2beta1JobStatus(object):
 
         self._conditions = conditions
 
+    @property
+    def core_dumps(self):
+        """Gets the core_dumps of this V2beta1JobStatus.  # noqa: E501
+
+        The MPI processes killed by a signal that dumps core, as reported by mpirun in the logs of the launcher. It is only set when spec.coreDumps is set.  # noqa: E501
+
+        :return: The core_dumps of this V2beta1JobStatus.  # noqa: E501
+        :rtype: list[V2beta1CoreDump]
+        """
+        return self._core_dumps
+
+    @core_dumps.setter
+    def core_dumps(self, core_dumps):
+        """Sets the core_dumps of this V2beta1JobStatus.
+
+        The MPI processes killed by a signal that dumps core, as reported by mpirun in the logs of the launcher. It is only set when spec.coreDumps is set.  # noqa: E501
+
+        :param core_dumps: The core_dumps of this V2beta1JobStatus.  # noqa: E501
+        :type core_dumps: list[V2beta1CoreDump]
+        """
+
+        self._core_dumps = core_dumps
+
+    @property
+    def duration(self):
+        """Gets the duration of this V2beta1JobStatus.  # noqa: E501
+
+        Duration is a wrapper around time.Duration which supports correct marshaling to YAML and JSON. In particular, it marshals into strings, which can be used as map keys in json.  # noqa: E501
+
+        :return: The duration of this V2beta1JobStatus.  # noqa: E501
+        :rtype: str
+        """
+        return self._duration
+
+    @duration.setter
+    def duration(self, duration):
+        """Sets the duration of this V2beta1JobStatus.
+
+        Duration is a wrapper around time.Duration which supports correct marshaling to YAML and JSON. In particular, it marshals into strings, which can be used as map keys in json.  # noqa: E501
+
+        :param duration: The duration of this V2beta1JobStatus.  # noqa: E501
+        :type duration: str
+        """
+
+        self._duration = duration
+
+    @property
+    def failure_reason_class(self):
+        """Gets the failure_reason_class of this V2beta1JobStatus.  # noqa: E501
+
+        The class of the failure of the job, once it failed: Infrastructure when it was caused by the cluster, like lost nodes, evictions, image pulls or containers killed for running out of memory, which are worth retrying, or Application otherwise, like a non-zero exit code of mpirun.  # noqa: E501
+
+        :return: The failure_reason_class of this V2beta1JobStatus.  # noqa: E501
+        :rtype: str
+        """
+        return self._failure_reason_class
+
+    @failure_reason_class.setter
+    def failure_reason_class(self, failure_reason_class):
+        """Sets the failure_reason_class of this V2beta1JobStatus.
+
+        The class of the failure of the job, once it failed: Infrastructure when it was caused by the cluster, like lost nodes, evictions, image pulls or containers killed for running out of memory, which are worth retrying, or Application otherwise, like a non-zero exit code of mpirun.  # noqa: E501
+
+        :param failure_reason_class: The failure_reason_class of this V2beta1JobStatus.  # noqa: E501
+        :type failure_reason_class: str
+        """
+
+        self._failure_reason_class = failure_reason_class
+
+    @property
+    def gpu_pre_check_failed_nodes(self):
+        """Gets the gpu_pre_check_failed_nodes of this V2beta1JobStatus.  # noqa: E501
+
+        The nodes on which the GPU pre-check of a worker failed, sorted by name. The workers aren't scheduled on them anymore. It is only set when spec.gpuPreCheck is set.  # noqa: E501
+
+        :return: The gpu_pre_check_failed_nodes of this V2beta1JobStatus.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._gpu_pre_check_failed_nodes
+
+    @gpu_pre_check_failed_nodes.setter
+    def gpu_pre_check_failed_nodes(self, gpu_pre_check_failed_nodes):
+        """Sets the gpu_pre_check_failed_nodes of this V2beta1JobStatus.
+
+        The nodes on which the GPU pre-check of a worker failed, sorted by name. The workers aren't scheduled on them anymore. It is only set when spec.gpuPreCheck is set.  # noqa: E501
+
+        :param gpu_pre_check_failed_nodes: The gpu_pre_check_failed_nodes of this V2beta1JobStatus.  # noqa: E501
+        :type gpu_pre_check_failed_nodes: list[str]
+        """
+
+        self._gpu_pre_check_failed_nodes = gpu_pre_check_failed_nodes
+
+    @property
+    def gpu_usage(self):
+        """Gets the gpu_usage of this V2beta1JobStatus.  # noqa: E501
+
+
+        :return: The gpu_usage of this V2beta1JobStatus.  # noqa: E501
+        :rtype: V2beta1GPUUsage
+        """
+        return self._gpu_usage
+
+    @gpu_usage.setter
+    def gpu_usage(self, gpu_usage):
+        """Sets the gpu_usage of this V2beta1JobStatus.
+
+
+        :param gpu_usage: The gpu_usage of this V2beta1JobStatus.  # noqa: E501
+        :type gpu_usage: V2beta1GPUUsage
+        """
+
+        self._gpu_usage = gpu_usage
+
     @property
     def last_reconcile_time(self):
         """Gets the last_reconcile_time of this V2beta1JobStatus.  # noqa: E501
@@ -141,6 +308,73 @@ class V2beta1JobStatus(object):
 
         self._last_reconcile_time = last_reconcile_time
 
+    @property
+    def launch_result(self):
+        """Gets the launch_result of this V2beta1JobStatus.  # noqa: E501
+
+
+        :return: The launch_result of this V2beta1JobStatus.  # noqa: E501
+        :rtype: V2beta1LaunchResult
+        """
+        return self._launch_result
+
+    @launch_result.setter
+    def launch_result(self, launch_result):
+        """Sets the launch_result of this V2beta1JobStatus.
+
+
+        :param launch_result: The launch_result of this V2beta1JobStatus.  # noqa: E501
+        :type launch_result: V2beta1LaunchResult
+        """
+
+        self._launch_result = launch_result
+
+    @property
+    def launcher_restart_count(self):
+        """Gets the launcher_restart_count of this V2beta1JobStatus.  # noqa: E501
+
+        The number of times the launcher restarted, counting its failed pods and the restarts of their containers.  # noqa: E501
+
+        :return: The launcher_restart_count of this V2beta1JobStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._launcher_restart_count
+
+    @launcher_restart_count.setter
+    def launcher_restart_count(self, launcher_restart_count):
+        """Sets the launcher_restart_count of this V2beta1JobStatus.
+
+        The number of times the launcher restarted, counting its failed pods and the restarts of their containers.  # noqa: E501
+
+        :param launcher_restart_count: The launcher_restart_count of this V2beta1JobStatus.  # noqa: E501
+        :type launcher_restart_count: int
+        """
+
+        self._launcher_restart_count = launcher_restart_count
+
+    @property
+    def progress(self):
+        """Gets the progress of this V2beta1JobStatus.  # noqa: E501
+
+        The progress of the application, like "epoch 12/100" or "34%", which the launcher reports in the training.kubeflow.org/progress annotation of its pod.  # noqa: E501
+
+        :return: The progress of this V2beta1JobStatus.  # noqa: E501
+        :rtype: str
+        """
+        return self._progress
+
+    @progress.setter
+    def progress(self, progress):
+        """Sets the progress of this V2beta1JobStatus.
+
+        The progress of the application, like "epoch 12/100" or "34%", which the launcher reports in the training.kubeflow.org/progress annotation of its pod.  # noqa: E501
+
+        :param progress: The progress of this V2beta1JobStatus.  # noqa: E501
+        :type progress: str
+        """
+
+        self._progress = progress
+
     @property
     def replica_statuses(self):
         """Gets the replica_statuses of this V2beta1JobStatus.  # noqa: E501
@@ -164,6 +398,50 @@ class V2beta1JobStatus(object):
 
         self._replica_statuses = replica_statuses
 
+    @property
+    def resource_usage(self):
+        """Gets the resource_usage of this V2beta1JobStatus.  # noqa: E501
+
+
+        :return: The resource_usage of this V2beta1JobStatus.  # noqa: E501
+        :rtype: V2beta1ResourceUsage
+        """
+        return self._resource_usage
+
+    @resource_usage.setter
+    def resource_usage(self, resource_usage):
+        """Sets the resource_usage of this V2beta1JobStatus.
+
+
+        :param resource_usage: The resource_usage of this V2beta1JobStatus.  # noqa: E501
+        :type resource_usage: V2beta1ResourceUsage
+        """
+
+        self._resource_usage = resource_usage
+
+    @property
+    def restart_count(self):
+        """Gets the restart_count of this V2beta1JobStatus.  # noqa: E501
+
+        The number of times the operator restarted the launcher and the workers together, like for node drains or stalled MPI processes.  # noqa: E501
+
+        :return: The restart_count of this V2beta1JobStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._restart_count
+
+    @restart_count.setter
+    def restart_count(self, restart_count):
+        """Sets the restart_count of this V2beta1JobStatus.
+
+        The number of times the operator restarted the launcher and the workers together, like for node drains or stalled MPI processes.  # noqa: E501
+
+        :param restart_count: The restart_count of this V2beta1JobStatus.  # noqa: E501
+        :type restart_count: int
+        """
+
+        self._restart_count = restart_count
+
     @property
     def start_time(self):
         """Gets the start_time of this V2beta1JobStatus.  # noqa: E501
@@ -187,6 +465,29 @@ class V2beta1JobStatus(object):
 
         self._start_time = start_time
 
+    @property
+    def state(self):
+        """Gets the state of this V2beta1JobStatus.  # noqa: E501
+
+        The state of the job: the type of the condition that finished it or, otherwise, of the first of the Suspended, Restarting, Running and Created conditions that is true.  # noqa: E501
+
+        :return: The state of this V2beta1JobStatus.  # noqa: E501
+        :rtype: str
+        """
+        return self._state
+
+    @state.setter
+    def state(self, state):
+        """Sets the state of this V2beta1JobStatus.
+
+        The state of the job: the type of the condition that finished it or, otherwise, of the first of the Suspended, Restarting, Running and Created conditions that is true.  # noqa: E501
+
+        :param state: The state of this V2beta1JobStatus.  # noqa: E501
+        :type state: str
+        """
+
+        self._state = state
+
     def to_dict(self, serialize=False):
         """Returns the model properties as a dict"""
         result = {}
@@ -0,0 +1,189 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1LaunchResult(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'attempts': 'int',
+        'exit_code': 'int',
+        'reason': 'str'
+    }
+
+    attribute_map = {
+        'attempts': 'attempts',
+        'exit_code': 'exitCode',
+        'reason': 'reason'
+    }
+
+    def __init__(self, attempts=0, exit_code=0, reason='', local_vars_configuration=None):  # noqa: E501
+        """V2beta1LaunchResult - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._attempts = None
+        self._exit_code = None
+        self._reason = None
+        self.discriminator = None
+
+        self.attempts = attempts
+        self.exit_code = exit_code
+        self.reason = reason
+
+    @property
+    def attempts(self):
+        """Gets the attempts of this V2beta1LaunchResult.  # noqa: E501
+
+        The number of attempts of the command.  # noqa: E501
+
+        :return: The attempts of this V2beta1LaunchResult.  # noqa: E501
+        :rtype: int
+        """
+        return self._attempts
+
+    @attempts.setter
+    def attempts(self, attempts):
+        """Sets the attempts of this V2beta1LaunchResult.
+
+        The number of attempts of the command.  # noqa: E501
+
+        :param attempts: The attempts of this V2beta1LaunchResult.  # noqa: E501
+        :type attempts: int
+        """
+        if self.local_vars_configuration.client_side_validation and attempts is None:  # noqa: E501
+            raise ValueError("Invalid value for `attempts`, must not be `None`")  # noqa: E501
+
+        self._attempts = attempts
+
+    @property
+    def exit_code(self):
+        """Gets the exit_code of this V2beta1LaunchResult.  # noqa: E501
+
+        The exit code of the last attempt.  # noqa: E501
+
+        :return: The exit_code of this V2beta1LaunchResult.  # noqa: E501
+        :rtype: int
+        """
+        return self._exit_code
+
+    @exit_code.setter
+    def exit_code(self, exit_code):
+        """Sets the exit_code of this V2beta1LaunchResult.
+
+        The exit code of the last attempt.  # noqa: E501
+
+        :param exit_code: The exit_code of this V2beta1LaunchResult.  # noqa: E501
+        :type exit_code: int
+        """
+        if self.local_vars_configuration.client_side_validation and exit_code is None:  # noqa: E501
+            raise ValueError("Invalid value for `exit_code`, must not be `None`")  # noqa: E501
+
+        self._exit_code = exit_code
+
+    @property
+    def reason(self):
+        """Gets the reason of this V2beta1LaunchResult.  # noqa: E501
+
+        Why the last attempt finished: Succeeded, Failed or TimedOut.  # noqa: E501
+
+        :return: The reason of this V2beta1LaunchResult.  # noqa: E501
+        :rtype: str
+        """
+        return self._reason
+
+    @reason.setter
+    def reason(self, reason):
+        """Sets the reason of this V2beta1LaunchResult.
+
+        Why the last attempt finished: Succeeded, Failed or TimedOut.  # noqa: E501
+
+        :param reason: The reason of this V2beta1LaunchResult.  # noqa: E501
+        :type reason: str
+        """
+        if self.local_vars_configuration.client_side_validation and reason is None:  # noqa: E501
+            raise ValueError("Invalid value for `reason`, must not be `None`")  # noqa: E501
+
+        self._reason = reason
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1LaunchResult):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1LaunchResult):
+            return True
+
+        return self.to_dict() != other.to_dict()
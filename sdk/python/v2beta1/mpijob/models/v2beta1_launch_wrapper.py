@@ -0,0 +1,214 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1LaunchWrapper(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'attempt_timeout_seconds': 'int',
+        'max_attempts': 'int',
+        'retry_delay_seconds': 'int',
+        'retry_on_exit_codes': 'list[int]'
+    }
+
+    attribute_map = {
+        'attempt_timeout_seconds': 'attemptTimeoutSeconds',
+        'max_attempts': 'maxAttempts',
+        'retry_delay_seconds': 'retryDelaySeconds',
+        'retry_on_exit_codes': 'retryOnExitCodes'
+    }
+
+    def __init__(self, attempt_timeout_seconds=None, max_attempts=None, retry_delay_seconds=None, retry_on_exit_codes=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1LaunchWrapper - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._attempt_timeout_seconds = None
+        self._max_attempts = None
+        self._retry_delay_seconds = None
+        self._retry_on_exit_codes = None
+        self.discriminator = None
+
+        if attempt_timeout_seconds is not None:
+            self.attempt_timeout_seconds = attempt_timeout_seconds
+        if max_attempts is not None:
+            self.max_attempts = max_attempts
+        if retry_delay_seconds is not None:
+            self.retry_delay_seconds = retry_delay_seconds
+        if retry_on_exit_codes is not None:
+            self.retry_on_exit_codes = retry_on_exit_codes
+
+    @property
+    def attempt_timeout_seconds(self):
+        """Gets the attempt_timeout_seconds of this V2beta1LaunchWrapper.  # noqa: E501
+
+        AttemptTimeoutSeconds is how long an attempt can run before it is terminated and fails. If unset, the attempts have no timeout.  # noqa: E501
+
+        :return: The attempt_timeout_seconds of this V2beta1LaunchWrapper.  # noqa: E501
+        :rtype: int
+        """
+        return self._attempt_timeout_seconds
+
+    @attempt_timeout_seconds.setter
+    def attempt_timeout_seconds(self, attempt_timeout_seconds):
+        """Sets the attempt_timeout_seconds of this V2beta1LaunchWrapper.
+
+        AttemptTimeoutSeconds is how long an attempt can run before it is terminated and fails. If unset, the attempts have no timeout.  # noqa: E501
+
+        :param attempt_timeout_seconds: The attempt_timeout_seconds of this V2beta1LaunchWrapper.  # noqa: E501
+        :type attempt_timeout_seconds: int
+        """
+
+        self._attempt_timeout_seconds = attempt_timeout_seconds
+
+    @property
+    def max_attempts(self):
+        """Gets the max_attempts of this V2beta1LaunchWrapper.  # noqa: E501
+
+        MaxAttempts is the number of times the command is run in a launcher pod before it fails. Defaults to 1.  # noqa: E501
+
+        :return: The max_attempts of this V2beta1LaunchWrapper.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_attempts
+
+    @max_attempts.setter
+    def max_attempts(self, max_attempts):
+        """Sets the max_attempts of this V2beta1LaunchWrapper.
+
+        MaxAttempts is the number of times the command is run in a launcher pod before it fails. Defaults to 1.  # noqa: E501
+
+        :param max_attempts: The max_attempts of this V2beta1LaunchWrapper.  # noqa: E501
+        :type max_attempts: int
+        """
+
+        self._max_attempts = max_attempts
+
+    @property
+    def retry_delay_seconds(self):
+        """Gets the retry_delay_seconds of this V2beta1LaunchWrapper.  # noqa: E501
+
+        RetryDelaySeconds is how long to wait between attempts. Defaults to 10.  # noqa: E501
+
+        :return: The retry_delay_seconds of this V2beta1LaunchWrapper.  # noqa: E501
+        :rtype: int
+        """
+        return self._retry_delay_seconds
+
+    @retry_delay_seconds.setter
+    def retry_delay_seconds(self, retry_delay_seconds):
+        """Sets the retry_delay_seconds of this V2beta1LaunchWrapper.
+
+        RetryDelaySeconds is how long to wait between attempts. Defaults to 10.  # noqa: E501
+
+        :param retry_delay_seconds: The retry_delay_seconds of this V2beta1LaunchWrapper.  # noqa: E501
+        :type retry_delay_seconds: int
+        """
+
+        self._retry_delay_seconds = retry_delay_seconds
+
+    @property
+    def retry_on_exit_codes(self):
+        """Gets the retry_on_exit_codes of this V2beta1LaunchWrapper.  # noqa: E501
+
+        RetryOnExitCodes are the exit codes of the command that are retried. The exit code 124 is the one of the attempts that timed out. If empty, any non-zero exit code is retried.  # noqa: E501
+
+        :return: The retry_on_exit_codes of this V2beta1LaunchWrapper.  # noqa: E501
+        :rtype: list[int]
+        """
+        return self._retry_on_exit_codes
+
+    @retry_on_exit_codes.setter
+    def retry_on_exit_codes(self, retry_on_exit_codes):
+        """Sets the retry_on_exit_codes of this V2beta1LaunchWrapper.
+
+        RetryOnExitCodes are the exit codes of the command that are retried. The exit code 124 is the one of the attempts that timed out. If empty, any non-zero exit code is retried.  # noqa: E501
+
+        :param retry_on_exit_codes: The retry_on_exit_codes of this V2beta1LaunchWrapper.  # noqa: E501
+        :type retry_on_exit_codes: list[int]
+        """
+
+        self._retry_on_exit_codes = retry_on_exit_codes
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1LaunchWrapper):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1LaunchWrapper):
+            return True
+
+        return self.to_dict() != other.to_dict()
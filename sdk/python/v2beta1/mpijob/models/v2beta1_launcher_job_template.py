@@ -0,0 +1,184 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1LauncherJobTemplate(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'annotations': 'dict(str, str)',
+        'labels': 'dict(str, str)',
+        'pod_failure_policy': 'V1PodFailurePolicy'
+    }
+
+    attribute_map = {
+        'annotations': 'annotations',
+        'labels': 'labels',
+        'pod_failure_policy': 'podFailurePolicy'
+    }
+
+    def __init__(self, annotations=None, labels=None, pod_failure_policy=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1LauncherJobTemplate - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._annotations = None
+        self._labels = None
+        self._pod_failure_policy = None
+        self.discriminator = None
+
+        if annotations is not None:
+            self.annotations = annotations
+        if labels is not None:
+            self.labels = labels
+        if pod_failure_policy is not None:
+            self.pod_failure_policy = pod_failure_policy
+
+    @property
+    def annotations(self):
+        """Gets the annotations of this V2beta1LauncherJobTemplate.  # noqa: E501
+
+        Annotations are set in the launcher Job.  # noqa: E501
+
+        :return: The annotations of this V2beta1LauncherJobTemplate.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._annotations
+
+    @annotations.setter
+    def annotations(self, annotations):
+        """Sets the annotations of this V2beta1LauncherJobTemplate.
+
+        Annotations are set in the launcher Job.  # noqa: E501
+
+        :param annotations: The annotations of this V2beta1LauncherJobTemplate.  # noqa: E501
+        :type annotations: dict(str, str)
+        """
+
+        self._annotations = annotations
+
+    @property
+    def labels(self):
+        """Gets the labels of this V2beta1LauncherJobTemplate.  # noqa: E501
+
+        Labels are set in the launcher Job. The labels set by the operator take precedence.  # noqa: E501
+
+        :return: The labels of this V2beta1LauncherJobTemplate.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._labels
+
+    @labels.setter
+    def labels(self, labels):
+        """Sets the labels of this V2beta1LauncherJobTemplate.
+
+        Labels are set in the launcher Job. The labels set by the operator take precedence.  # noqa: E501
+
+        :param labels: The labels of this V2beta1LauncherJobTemplate.  # noqa: E501
+        :type labels: dict(str, str)
+        """
+
+        self._labels = labels
+
+    @property
+    def pod_failure_policy(self):
+        """Gets the pod_failure_policy of this V2beta1LauncherJobTemplate.  # noqa: E501
+
+
+        :return: The pod_failure_policy of this V2beta1LauncherJobTemplate.  # noqa: E501
+        :rtype: V1PodFailurePolicy
+        """
+        return self._pod_failure_policy
+
+    @pod_failure_policy.setter
+    def pod_failure_policy(self, pod_failure_policy):
+        """Sets the pod_failure_policy of this V2beta1LauncherJobTemplate.
+
+
+        :param pod_failure_policy: The pod_failure_policy of this V2beta1LauncherJobTemplate.  # noqa: E501
+        :type pod_failure_policy: V1PodFailurePolicy
+        """
+
+        self._pod_failure_policy = pod_failure_policy
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1LauncherJobTemplate):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1LauncherJobTemplate):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,238 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1MPIJobDefaultsSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'clean_pod_policy': 'str',
+        'launcher': 'V2beta1ReplicaDefaults',
+        'slots_per_worker': 'int',
+        'ttl_seconds_after_finished': 'int',
+        'worker': 'V2beta1ReplicaDefaults'
+    }
+
+    attribute_map = {
+        'clean_pod_policy': 'cleanPodPolicy',
+        'launcher': 'launcher',
+        'slots_per_worker': 'slotsPerWorker',
+        'ttl_seconds_after_finished': 'ttlSecondsAfterFinished',
+        'worker': 'worker'
+    }
+
+    def __init__(self, clean_pod_policy=None, launcher=None, slots_per_worker=None, ttl_seconds_after_finished=None, worker=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1MPIJobDefaultsSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._clean_pod_policy = None
+        self._launcher = None
+        self._slots_per_worker = None
+        self._ttl_seconds_after_finished = None
+        self._worker = None
+        self.discriminator = None
+
+        if clean_pod_policy is not None:
+            self.clean_pod_policy = clean_pod_policy
+        if launcher is not None:
+            self.launcher = launcher
+        if slots_per_worker is not None:
+            self.slots_per_worker = slots_per_worker
+        if ttl_seconds_after_finished is not None:
+            self.ttl_seconds_after_finished = ttl_seconds_after_finished
+        if worker is not None:
+            self.worker = worker
+
+    @property
+    def clean_pod_policy(self):
+        """Gets the clean_pod_policy of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+
+        CleanPodPolicy is the default of spec.runPolicy.cleanPodPolicy.  # noqa: E501
+
+        :return: The clean_pod_policy of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._clean_pod_policy
+
+    @clean_pod_policy.setter
+    def clean_pod_policy(self, clean_pod_policy):
+        """Sets the clean_pod_policy of this V2beta1MPIJobDefaultsSpec.
+
+        CleanPodPolicy is the default of spec.runPolicy.cleanPodPolicy.  # noqa: E501
+
+        :param clean_pod_policy: The clean_pod_policy of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :type clean_pod_policy: str
+        """
+
+        self._clean_pod_policy = clean_pod_policy
+
+    @property
+    def launcher(self):
+        """Gets the launcher of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+
+
+        :return: The launcher of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :rtype: V2beta1ReplicaDefaults
+        """
+        return self._launcher
+
+    @launcher.setter
+    def launcher(self, launcher):
+        """Sets the launcher of this V2beta1MPIJobDefaultsSpec.
+
+
+        :param launcher: The launcher of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :type launcher: V2beta1ReplicaDefaults
+        """
+
+        self._launcher = launcher
+
+    @property
+    def slots_per_worker(self):
+        """Gets the slots_per_worker of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+
+        SlotsPerWorker is the default of spec.slotsPerWorker.  # noqa: E501
+
+        :return: The slots_per_worker of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._slots_per_worker
+
+    @slots_per_worker.setter
+    def slots_per_worker(self, slots_per_worker):
+        """Sets the slots_per_worker of this V2beta1MPIJobDefaultsSpec.
+
+        SlotsPerWorker is the default of spec.slotsPerWorker.  # noqa: E501
+
+        :param slots_per_worker: The slots_per_worker of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :type slots_per_worker: int
+        """
+
+        self._slots_per_worker = slots_per_worker
+
+    @property
+    def ttl_seconds_after_finished(self):
+        """Gets the ttl_seconds_after_finished of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+
+        TTLSecondsAfterFinished is the default of spec.runPolicy.ttlSecondsAfterFinished.  # noqa: E501
+
+        :return: The ttl_seconds_after_finished of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._ttl_seconds_after_finished
+
+    @ttl_seconds_after_finished.setter
+    def ttl_seconds_after_finished(self, ttl_seconds_after_finished):
+        """Sets the ttl_seconds_after_finished of this V2beta1MPIJobDefaultsSpec.
+
+        TTLSecondsAfterFinished is the default of spec.runPolicy.ttlSecondsAfterFinished.  # noqa: E501
+
+        :param ttl_seconds_after_finished: The ttl_seconds_after_finished of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :type ttl_seconds_after_finished: int
+        """
+
+        self._ttl_seconds_after_finished = ttl_seconds_after_finished
+
+    @property
+    def worker(self):
+        """Gets the worker of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+
+
+        :return: The worker of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :rtype: V2beta1ReplicaDefaults
+        """
+        return self._worker
+
+    @worker.setter
+    def worker(self, worker):
+        """Sets the worker of this V2beta1MPIJobDefaultsSpec.
+
+
+        :param worker: The worker of this V2beta1MPIJobDefaultsSpec.  # noqa: E501
+        :type worker: V2beta1ReplicaDefaults
+        """
+
+        self._worker = worker
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1MPIJobDefaultsSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1MPIJobDefaultsSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -33,59 +33,331 @@ class V2beta1MPIJobSpec(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'artifacts': 'V2beta1Artifacts',
+        'benchmark': 'V2beta1Benchmark',
+        'cluster_autoscaler': 'V2beta1ClusterAutoscaler',
+        'core_dumps': 'V2beta1CoreDumps',
+        'diagnostics': 'V2beta1Diagnostics',
+        'gpu_pre_check': 'V2beta1GPUPreCheck',
+        'hooks': 'V2beta1Hooks',
+        'launch_wrapper': 'V2beta1LaunchWrapper',
         'launcher_creation_policy': 'str',
+        'launcher_job': 'V2beta1LauncherJobTemplate',
         'mpi_implementation': 'str',
         'mpi_replica_specs': 'dict(str, V2beta1ReplicaSpec)',
+        'multi_cluster': 'V2beta1MultiCluster',
+        'network': 'V2beta1Network',
+        'profiling': 'V2beta1Profiling',
         'run_launcher_as_worker': 'bool',
         'run_policy': 'V2beta1RunPolicy',
+        'service': 'V2beta1ServiceTemplate',
+        'service_mesh': 'V2beta1ServiceMesh',
         'slots_per_worker': 'int',
-        'ssh_auth_mount_path': 'str'
+        'slots_per_worker_device_class': 'str',
+        'ssh_auth_mount_path': 'str',
+        'tcp_interface_selection': 'str',
+        'watchdog': 'V2beta1Watchdog',
+        'worker_groups': 'list[V2beta1WorkerGroup]',
+        'worker_naming': 'V2beta1WorkerNaming',
+        'worker_overrides': 'list[V2beta1WorkerOverride]'
     }
 
     attribute_map = {
+        'artifacts': 'artifacts',
+        'benchmark': 'benchmark',
+        'cluster_autoscaler': 'clusterAutoscaler',
+        'core_dumps': 'coreDumps',
+        'diagnostics': 'diagnostics',
+        'gpu_pre_check': 'gpuPreCheck',
+        'hooks': 'hooks',
+        'launch_wrapper': 'launchWrapper',
         'launcher_creation_policy': 'launcherCreationPolicy',
+        'launcher_job': 'launcherJob',
         'mpi_implementation': 'mpiImplementation',
         'mpi_replica_specs': 'mpiReplicaSpecs',
+        'multi_cluster': 'multiCluster',
+        'network': 'network',
+        'profiling': 'profiling',
         'run_launcher_as_worker': 'runLauncherAsWorker',
         'run_policy': 'runPolicy',
+        'service': 'service',
+        'service_mesh': 'serviceMesh',
         'slots_per_worker': 'slotsPerWorker',
-        'ssh_auth_mount_path': 'sshAuthMountPath'
+        'slots_per_worker_device_class': 'slotsPerWorkerDeviceClass',
+        'ssh_auth_mount_path': 'sshAuthMountPath',
+        'tcp_interface_selection': 'tcpInterfaceSelection',
+        'watchdog': 'watchdog',
+        'worker_groups': 'workerGroups',
+        'worker_naming': 'workerNaming',
+        'worker_overrides': 'workerOverrides'
     }
 
-    def __init__(self, launcher_creation_policy=None, mpi_implementation=None, mpi_replica_specs=None, run_launcher_as_worker=None, run_policy=None, slots_per_worker=None, ssh_auth_mount_path=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, artifacts=None, benchmark=None, cluster_autoscaler=None, core_dumps=None, diagnostics=None, gpu_pre_check=None, hooks=None, launch_wrapper=None, launcher_creation_policy=None, launcher_job=None, mpi_implementation=None, mpi_replica_specs=None, multi_cluster=None, network=None, profiling=None, run_launcher_as_worker=None, run_policy=None, service=None, service_mesh=None, slots_per_worker=None, slots_per_worker_device_class=None, ssh_auth_mount_path=None, tcp_interface_selection=None, watchdog=None, worker_groups=None, worker_naming=None, worker_overrides=None, local_vars_configuration=None):  # noqa: E501
         """V2beta1MPIJobSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration.get_default_copy()
         self.local_vars_configuration = local_vars_configuration
 
+        self._artifacts = None
+        self._benchmark = None
+        self._cluster_autoscaler = None
+        self._core_dumps = None
+        self._diagnostics = None
+        self._gpu_pre_check = None
+        self._hooks = None
+        self._launch_wrapper = None
         self._launcher_creation_policy = None
+        self._launcher_job = None
         self._mpi_implementation = None
         self._mpi_replica_specs = None
+        self._multi_cluster = None
+        self._network = None
+        self._profiling = None
         self._run_launcher_as_worker = None
         self._run_policy = None
+        self._service = None
+        self._service_mesh = None
         self._slots_per_worker = None
+        self._slots_per_worker_device_class = None
         self._ssh_auth_mount_path = None
+        self._tcp_interface_selection = None
+        self._watchdog = None
+        self._worker_groups = None
+        self._worker_naming = None
+        self._worker_overrides = None
         self.discriminator = None
 
+        if artifacts is not None:
+            self.artifacts = artifacts
+        if benchmark is not None:
+            self.benchmark = benchmark
+        if cluster_autoscaler is not None:
+            self.cluster_autoscaler = cluster_autoscaler
+        if core_dumps is not None:
+            self.core_dumps = core_dumps
+        if diagnostics is not None:
+            self.diagnostics = diagnostics
+        if gpu_pre_check is not None:
+            self.gpu_pre_check = gpu_pre_check
+        if hooks is not None:
+            self.hooks = hooks
+        if launch_wrapper is not None:
+            self.launch_wrapper = launch_wrapper
         if launcher_creation_policy is not None:
             self.launcher_creation_policy = launcher_creation_policy
+        if launcher_job is not None:
+            self.launcher_job = launcher_job
         if mpi_implementation is not None:
             self.mpi_implementation = mpi_implementation
         self.mpi_replica_specs = mpi_replica_specs
+        if multi_cluster is not None:
+            self.multi_cluster = multi_cluster
+        if network is not None:
+            self.network = network
+        if profiling is not None:
+            self.profiling = profiling
         if run_launcher_as_worker is not None:
             self.run_launcher_as_worker = run_launcher_as_worker
         if run_policy is not None:
             self.run_policy = run_policy
+        if service is not None:
+            self.service = service
+        if service_mesh is not None:
+            self.service_mesh = service_mesh
         if slots_per_worker is not None:
             self.slots_per_worker = slots_per_worker
+        if slots_per_worker_device_class is not None:
+            self.slots_per_worker_device_class = slots_per_worker_device_class
         if ssh_auth_mount_path is not None:
             self.ssh_auth_mount_path = ssh_auth_mount_path
+        if tcp_interface_selection is not None:
+            self.tcp_interface_selection = tcp_interface_selection
+        if watchdog is not None:
+            self.watchdog = watchdog
+        if worker_groups is not None:
+            self.worker_groups = worker_groups
+        if worker_naming is not None:
+            self.worker_naming = worker_naming
+        if worker_overrides is not None:
+            self.worker_overrides = worker_overrides
+
+    @property
+    def artifacts(self):
+        """Gets the artifacts of this V2beta1MPIJobSpec.  # noqa: E501
+
+          # noqa: E501
+
+        :return: The artifacts of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1Artifacts
+        """
+        return self._artifacts
+
+    @artifacts.setter
+    def artifacts(self, artifacts):
+        """Sets the artifacts of this V2beta1MPIJobSpec.
+
+          # noqa: E501
+
+        :param artifacts: The artifacts of this V2beta1MPIJobSpec.  # noqa: E501
+        :type artifacts: V2beta1Artifacts
+        """
+
+        self._artifacts = artifacts
+
+    @property
+    def benchmark(self):
+        """Gets the benchmark of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The benchmark of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1Benchmark
+        """
+        return self._benchmark
+
+    @benchmark.setter
+    def benchmark(self, benchmark):
+        """Sets the benchmark of this V2beta1MPIJobSpec.
+
+
+        :param benchmark: The benchmark of this V2beta1MPIJobSpec.  # noqa: E501
+        :type benchmark: V2beta1Benchmark
+        """
+
+        self._benchmark = benchmark
+
+    @property
+    def cluster_autoscaler(self):
+        """Gets the cluster_autoscaler of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The cluster_autoscaler of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1ClusterAutoscaler
+        """
+        return self._cluster_autoscaler
+
+    @cluster_autoscaler.setter
+    def cluster_autoscaler(self, cluster_autoscaler):
+        """Sets the cluster_autoscaler of this V2beta1MPIJobSpec.
+
+
+        :param cluster_autoscaler: The cluster_autoscaler of this V2beta1MPIJobSpec.  # noqa: E501
+        :type cluster_autoscaler: V2beta1ClusterAutoscaler
+        """
+
+        self._cluster_autoscaler = cluster_autoscaler
+
+    @property
+    def core_dumps(self):
+        """Gets the core_dumps of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The core_dumps of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1CoreDumps
+        """
+        return self._core_dumps
+
+    @core_dumps.setter
+    def core_dumps(self, core_dumps):
+        """Sets the core_dumps of this V2beta1MPIJobSpec.
+
+
+        :param core_dumps: The core_dumps of this V2beta1MPIJobSpec.  # noqa: E501
+        :type core_dumps: V2beta1CoreDumps
+        """
+
+        self._core_dumps = core_dumps
+
+    @property
+    def diagnostics(self):
+        """Gets the diagnostics of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The diagnostics of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1Diagnostics
+        """
+        return self._diagnostics
+
+    @diagnostics.setter
+    def diagnostics(self, diagnostics):
+        """Sets the diagnostics of this V2beta1MPIJobSpec.
+
+
+        :param diagnostics: The diagnostics of this V2beta1MPIJobSpec.  # noqa: E501
+        :type diagnostics: V2beta1Diagnostics
+        """
+
+        self._diagnostics = diagnostics
+
+    @property
+    def gpu_pre_check(self):
+        """Gets the gpu_pre_check of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The gpu_pre_check of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1GPUPreCheck
+        """
+        return self._gpu_pre_check
+
+    @gpu_pre_check.setter
+    def gpu_pre_check(self, gpu_pre_check):
+        """Sets the gpu_pre_check of this V2beta1MPIJobSpec.
+
+
+        :param gpu_pre_check: The gpu_pre_check of this V2beta1MPIJobSpec.  # noqa: E501
+        :type gpu_pre_check: V2beta1GPUPreCheck
+        """
+
+        self._gpu_pre_check = gpu_pre_check
+
+    @property
+    def hooks(self):
+        """Gets the hooks of this V2beta1MPIJobSpec.  # noqa: E501
+
+          # noqa: E501
+
+        :return: The hooks of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1Hooks
+        """
+        return self._hooks
+
+    @hooks.setter
+    def hooks(self, hooks):
+        """Sets the hooks of this V2beta1MPIJobSpec.
+
+          # noqa: E501
+
+        :param hooks: The hooks of this V2beta1MPIJobSpec.  # noqa: E501
+        :type hooks: V2beta1Hooks
+        """
+
+        self._hooks = hooks
+
+    @property
+    def launch_wrapper(self):
+        """Gets the launch_wrapper of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The launch_wrapper of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1LaunchWrapper
+        """
+        return self._launch_wrapper
+
+    @launch_wrapper.setter
+    def launch_wrapper(self, launch_wrapper):
+        """Sets the launch_wrapper of this V2beta1MPIJobSpec.
+
+
+        :param launch_wrapper: The launch_wrapper of this V2beta1MPIJobSpec.  # noqa: E501
+        :type launch_wrapper: V2beta1LaunchWrapper
+        """
+
+        self._launch_wrapper = launch_wrapper
 
     @property
     def launcher_creation_policy(self):
         """Gets the launcher_creation_policy of this V2beta1MPIJobSpec.  # noqa: E501
 
-        launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. Defaults to AtStartup.  # noqa: E501
+        launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. If WaitForWorkersScheduled, the launcher is created only after all workers are scheduled to nodes. Defaults to AtStartup.  # noqa: E501
 
         :return: The launcher_creation_policy of this V2beta1MPIJobSpec.  # noqa: E501
         :rtype: str
@@ -96,7 +368,7 @@ class V2beta1MPIJobSpec(object):
     def launcher_creation_policy(self, launcher_creation_policy):
         """Sets the launcher_creation_policy of this V2beta1MPIJobSpec.
 
-        launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. Defaults to AtStartup.  # noqa: E501
+        launcherCreationPolicy if WaitForWorkersReady, the launcher is created only after all workers are in Ready state. If WaitForWorkersScheduled, the launcher is created only after all workers are scheduled to nodes. Defaults to AtStartup.  # noqa: E501
 
         :param launcher_creation_policy: The launcher_creation_policy of this V2beta1MPIJobSpec.  # noqa: E501
         :type launcher_creation_policy: str
@@ -104,6 +376,29 @@ class V2beta1MPIJobSpec(object):
 
         self._launcher_creation_policy = launcher_creation_policy
 
+    @property
+    def launcher_job(self):
+        """Gets the launcher_job of this V2beta1MPIJobSpec.  # noqa: E501
+
+          # noqa: E501
+
+        :return: The launcher_job of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1LauncherJobTemplate
+        """
+        return self._launcher_job
+
+    @launcher_job.setter
+    def launcher_job(self, launcher_job):
+        """Sets the launcher_job of this V2beta1MPIJobSpec.
+
+          # noqa: E501
+
+        :param launcher_job: The launcher_job of this V2beta1MPIJobSpec.  # noqa: E501
+        :type launcher_job: V2beta1LauncherJobTemplate
+        """
+
+        self._launcher_job = launcher_job
+
     @property
     def mpi_implementation(self):
         """Gets the mpi_implementation of this V2beta1MPIJobSpec.  # noqa: E501
@@ -152,6 +447,69 @@ class V2beta1MPIJobSpec(object):
 
         self._mpi_replica_specs = mpi_replica_specs
 
+    @property
+    def multi_cluster(self):
+        """Gets the multi_cluster of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The multi_cluster of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1MultiCluster
+        """
+        return self._multi_cluster
+
+    @multi_cluster.setter
+    def multi_cluster(self, multi_cluster):
+        """Sets the multi_cluster of this V2beta1MPIJobSpec.
+
+
+        :param multi_cluster: The multi_cluster of this V2beta1MPIJobSpec.  # noqa: E501
+        :type multi_cluster: V2beta1MultiCluster
+        """
+
+        self._multi_cluster = multi_cluster
+
+    @property
+    def network(self):
+        """Gets the network of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The network of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1Network
+        """
+        return self._network
+
+    @network.setter
+    def network(self, network):
+        """Sets the network of this V2beta1MPIJobSpec.
+
+
+        :param network: The network of this V2beta1MPIJobSpec.  # noqa: E501
+        :type network: V2beta1Network
+        """
+
+        self._network = network
+
+    @property
+    def profiling(self):
+        """Gets the profiling of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The profiling of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1Profiling
+        """
+        return self._profiling
+
+    @profiling.setter
+    def profiling(self, profiling):
+        """Sets the profiling of this V2beta1MPIJobSpec.
+
+
+        :param profiling: The profiling of this V2beta1MPIJobSpec.  # noqa: E501
+        :type profiling: V2beta1Profiling
+        """
+
+        self._profiling = profiling
+
     @property
     def run_launcher_as_worker(self):
         """Gets the run_launcher_as_worker of this V2beta1MPIJobSpec.  # noqa: E501
@@ -196,6 +554,50 @@ class V2beta1MPIJobSpec(object):
 
         self._run_policy = run_policy
 
+    @property
+    def service(self):
+        """Gets the service of this V2beta1MPIJobSpec.  # noqa: E501
+
+          # noqa: E501
+
+        :return: The service of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1ServiceTemplate
+        """
+        return self._service
+
+    @service.setter
+    def service(self, service):
+        """Sets the service of this V2beta1MPIJobSpec.
+
+          # noqa: E501
+
+        :param service: The service of this V2beta1MPIJobSpec.  # noqa: E501
+        :type service: V2beta1ServiceTemplate
+        """
+
+        self._service = service
+
+    @property
+    def service_mesh(self):
+        """Gets the service_mesh of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The service_mesh of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1ServiceMesh
+        """
+        return self._service_mesh
+
+    @service_mesh.setter
+    def service_mesh(self, service_mesh):
+        """Sets the service_mesh of this V2beta1MPIJobSpec.
+
+
+        :param service_mesh: The service_mesh of this V2beta1MPIJobSpec.  # noqa: E501
+        :type service_mesh: V2beta1ServiceMesh
+        """
+
+        self._service_mesh = service_mesh
+
     @property
     def slots_per_worker(self):
         """Gets the slots_per_worker of this V2beta1MPIJobSpec.  # noqa: E501
@@ -219,6 +621,29 @@ class V2beta1MPIJobSpec(object):
 
         self._slots_per_worker = slots_per_worker
 
+    @property
+    def slots_per_worker_device_class(self):
+        """Gets the slots_per_worker_device_class of this V2beta1MPIJobSpec.  # noqa: E501
+
+        SlotsPerWorkerDeviceClass derives the slots per worker from the devices requested by the ResourceClaimTemplates of the worker pod template, with one slot per device of this DeviceClass, like gpu.nvidia.com. It takes precedence over slotsPerWorker, which is used if the devices can't be counted. Requires the operator to watch ResourceClaimTemplates.  # noqa: E501
+
+        :return: The slots_per_worker_device_class of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._slots_per_worker_device_class
+
+    @slots_per_worker_device_class.setter
+    def slots_per_worker_device_class(self, slots_per_worker_device_class):
+        """Sets the slots_per_worker_device_class of this V2beta1MPIJobSpec.
+
+        SlotsPerWorkerDeviceClass derives the slots per worker from the devices requested by the ResourceClaimTemplates of the worker pod template, with one slot per device of this DeviceClass, like gpu.nvidia.com. It takes precedence over slotsPerWorker, which is used if the devices can't be counted. Requires the operator to watch ResourceClaimTemplates.  # noqa: E501
+
+        :param slots_per_worker_device_class: The slots_per_worker_device_class of this V2beta1MPIJobSpec.  # noqa: E501
+        :type slots_per_worker_device_class: str
+        """
+
+        self._slots_per_worker_device_class = slots_per_worker_device_class
+
     @property
     def ssh_auth_mount_path(self):
         """Gets the ssh_auth_mount_path of this V2beta1MPIJobSpec.  # noqa: E501
@@ -242,6 +667,117 @@ class V2beta1MPIJobSpec(object):
 
         self._ssh_auth_mount_path = ssh_auth_mount_path
 
+    @property
+    def tcp_interface_selection(self):
+        """Gets the tcp_interface_selection of this V2beta1MPIJobSpec.  # noqa: E501
+
+        TCPInterfaceSelection selects the interfaces of the TCP traffic of MPI. With Auto, the launcher restricts the traffic of mpirun and of the ranks to the interface of the pod network, or the ranks to the interfaces of spec.network, instead of the defaults of the MPI implementation, which can pick bridges like docker0 on multi-homed nodes. Defaults to None, which keeps the defaults.  # noqa: E501
+
+        :return: The tcp_interface_selection of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._tcp_interface_selection
+
+    @tcp_interface_selection.setter
+    def tcp_interface_selection(self, tcp_interface_selection):
+        """Sets the tcp_interface_selection of this V2beta1MPIJobSpec.
+
+        TCPInterfaceSelection selects the interfaces of the TCP traffic of MPI. With Auto, the launcher restricts the traffic of mpirun and of the ranks to the interface of the pod network, or the ranks to the interfaces of spec.network, instead of the defaults of the MPI implementation, which can pick bridges like docker0 on multi-homed nodes. Defaults to None, which keeps the defaults.  # noqa: E501
+
+        :param tcp_interface_selection: The tcp_interface_selection of this V2beta1MPIJobSpec.  # noqa: E501
+        :type tcp_interface_selection: str
+        """
+
+        self._tcp_interface_selection = tcp_interface_selection
+
+    @property
+    def watchdog(self):
+        """Gets the watchdog of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The watchdog of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1Watchdog
+        """
+        return self._watchdog
+
+    @watchdog.setter
+    def watchdog(self, watchdog):
+        """Sets the watchdog of this V2beta1MPIJobSpec.
+
+
+        :param watchdog: The watchdog of this V2beta1MPIJobSpec.  # noqa: E501
+        :type watchdog: V2beta1Watchdog
+        """
+
+        self._watchdog = watchdog
+
+    @property
+    def worker_groups(self):
+        """Gets the worker_groups of this V2beta1MPIJobSpec.  # noqa: E501
+
+        WorkerGroups are workers with their own pod template, replica count and slots, like GPU workers for a simulation and CPU workers for its analysis, in the same MPI run. The workers of the groups follow the Worker replicas in the hostfile, in the order of the groups, and share the restart policy of the Worker replicas.  # noqa: E501
+
+        :return: The worker_groups of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: list[V2beta1WorkerGroup]
+        """
+        return self._worker_groups
+
+    @worker_groups.setter
+    def worker_groups(self, worker_groups):
+        """Sets the worker_groups of this V2beta1MPIJobSpec.
+
+        WorkerGroups are workers with their own pod template, replica count and slots, like GPU workers for a simulation and CPU workers for its analysis, in the same MPI run. The workers of the groups follow the Worker replicas in the hostfile, in the order of the groups, and share the restart policy of the Worker replicas.  # noqa: E501
+
+        :param worker_groups: The worker_groups of this V2beta1MPIJobSpec.  # noqa: E501
+        :type worker_groups: list[V2beta1WorkerGroup]
+        """
+
+        self._worker_groups = worker_groups
+
+    @property
+    def worker_naming(self):
+        """Gets the worker_naming of this V2beta1MPIJobSpec.  # noqa: E501
+
+
+        :return: The worker_naming of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: V2beta1WorkerNaming
+        """
+        return self._worker_naming
+
+    @worker_naming.setter
+    def worker_naming(self, worker_naming):
+        """Sets the worker_naming of this V2beta1MPIJobSpec.
+
+
+        :param worker_naming: The worker_naming of this V2beta1MPIJobSpec.  # noqa: E501
+        :type worker_naming: V2beta1WorkerNaming
+        """
+
+        self._worker_naming = worker_naming
+
+    @property
+    def worker_overrides(self):
+        """Gets the worker_overrides of this V2beta1MPIJobSpec.  # noqa: E501
+
+        WorkerOverrides replace fields of the worker pod template for ranges of worker indexes, like more memory for worker-0 when it aggregates the I/O of the other workers. When ranges overlap, the last override takes precedence.  # noqa: E501
+
+        :return: The worker_overrides of this V2beta1MPIJobSpec.  # noqa: E501
+        :rtype: list[V2beta1WorkerOverride]
+        """
+        return self._worker_overrides
+
+    @worker_overrides.setter
+    def worker_overrides(self, worker_overrides):
+        """Sets the worker_overrides of this V2beta1MPIJobSpec.
+
+        WorkerOverrides replace fields of the worker pod template for ranges of worker indexes, like more memory for worker-0 when it aggregates the I/O of the other workers. When ranges overlap, the last override takes precedence.  # noqa: E501
+
+        :param worker_overrides: The worker_overrides of this V2beta1MPIJobSpec.  # noqa: E501
+        :type worker_overrides: list[V2beta1WorkerOverride]
+        """
+
+        self._worker_overrides = worker_overrides
+
     def to_dict(self, serialize=False):
         """Returns the model properties as a dict"""
         result = {}
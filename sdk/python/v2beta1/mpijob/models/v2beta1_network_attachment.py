@@ -0,0 +1,187 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1NetworkAttachment(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'interface': 'str',
+        'name': 'str',
+        'namespace': 'str'
+    }
+
+    attribute_map = {
+        'interface': 'interface',
+        'name': 'name',
+        'namespace': 'namespace'
+    }
+
+    def __init__(self, interface=None, name='', namespace=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1NetworkAttachment - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._interface = None
+        self._name = None
+        self._namespace = None
+        self.discriminator = None
+
+        if interface is not None:
+            self.interface = interface
+        self.name = name
+        if namespace is not None:
+            self.namespace = namespace
+
+    @property
+    def interface(self):
+        """Gets the interface of this V2beta1NetworkAttachment.  # noqa: E501
+
+        Interface is the name of the interface in the pods. Defaults to net<n>, where n is the position of the attachment, starting at 1, as named by Multus.  # noqa: E501
+
+        :return: The interface of this V2beta1NetworkAttachment.  # noqa: E501
+        :rtype: str
+        """
+        return self._interface
+
+    @interface.setter
+    def interface(self, interface):
+        """Sets the interface of this V2beta1NetworkAttachment.
+
+        Interface is the name of the interface in the pods. Defaults to net<n>, where n is the position of the attachment, starting at 1, as named by Multus.  # noqa: E501
+
+        :param interface: The interface of this V2beta1NetworkAttachment.  # noqa: E501
+        :type interface: str
+        """
+
+        self._interface = interface
+
+    @property
+    def name(self):
+        """Gets the name of this V2beta1NetworkAttachment.  # noqa: E501
+
+        Name of the NetworkAttachmentDefinition.  # noqa: E501
+
+        :return: The name of this V2beta1NetworkAttachment.  # noqa: E501
+        :rtype: str
+        """
+        return self._name
+
+    @name.setter
+    def name(self, name):
+        """Sets the name of this V2beta1NetworkAttachment.
+
+        Name of the NetworkAttachmentDefinition.  # noqa: E501
+
+        :param name: The name of this V2beta1NetworkAttachment.  # noqa: E501
+        :type name: str
+        """
+        if self.local_vars_configuration.client_side_validation and name is None:  # noqa: E501
+            raise ValueError("Invalid value for `name`, must not be `None`")  # noqa: E501
+
+        self._name = name
+
+    @property
+    def namespace(self):
+        """Gets the namespace of this V2beta1NetworkAttachment.  # noqa: E501
+
+        Namespace of the NetworkAttachmentDefinition. Defaults to the namespace of the MPIJob.  # noqa: E501
+
+        :return: The namespace of this V2beta1NetworkAttachment.  # noqa: E501
+        :rtype: str
+        """
+        return self._namespace
+
+    @namespace.setter
+    def namespace(self, namespace):
+        """Sets the namespace of this V2beta1NetworkAttachment.
+
+        Namespace of the NetworkAttachmentDefinition. Defaults to the namespace of the MPIJob.  # noqa: E501
+
+        :param namespace: The namespace of this V2beta1NetworkAttachment.  # noqa: E501
+        :type namespace: str
+        """
+
+        self._namespace = namespace
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1NetworkAttachment):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1NetworkAttachment):
+            return True
+
+        return self.to_dict() != other.to_dict()
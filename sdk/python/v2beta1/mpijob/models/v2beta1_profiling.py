@@ -0,0 +1,242 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1Profiling(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'args': 'list[str]',
+        'command': 'list[str]',
+        'image': 'str',
+        'output_claim_name': 'str',
+        'tool': 'str'
+    }
+
+    attribute_map = {
+        'args': 'args',
+        'command': 'command',
+        'image': 'image',
+        'output_claim_name': 'outputClaimName',
+        'tool': 'tool'
+    }
+
+    def __init__(self, args=None, command=None, image=None, output_claim_name=None, tool=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1Profiling - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._args = None
+        self._command = None
+        self._image = None
+        self._output_claim_name = None
+        self._tool = None
+        self.discriminator = None
+
+        if args is not None:
+            self.args = args
+        if command is not None:
+            self.command = command
+        if image is not None:
+            self.image = image
+        if output_claim_name is not None:
+            self.output_claim_name = output_claim_name
+        if tool is not None:
+            self.tool = tool
+
+    @property
+    def args(self):
+        """Gets the args of this V2beta1Profiling.  # noqa: E501
+
+        Args are appended to the command of the profiler, like [\"--trace=cuda,nvtx,mpi\"] for Nsys.  # noqa: E501
+
+        :return: The args of this V2beta1Profiling.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._args
+
+    @args.setter
+    def args(self, args):
+        """Sets the args of this V2beta1Profiling.
+
+        Args are appended to the command of the profiler, like [\"--trace=cuda,nvtx,mpi\"] for Nsys.  # noqa: E501
+
+        :param args: The args of this V2beta1Profiling.  # noqa: E501
+        :type args: list[str]
+        """
+
+        self._args = args
+
+    @property
+    def command(self):
+        """Gets the command of this V2beta1Profiling.  # noqa: E501
+
+        Command is the profiler of the Custom tool, which is followed by the program of the MPI processes.  # noqa: E501
+
+        :return: The command of this V2beta1Profiling.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._command
+
+    @command.setter
+    def command(self, command):
+        """Sets the command of this V2beta1Profiling.
+
+        Command is the profiler of the Custom tool, which is followed by the program of the MPI processes.  # noqa: E501
+
+        :param command: The command of this V2beta1Profiling.  # noqa: E501
+        :type command: list[str]
+        """
+
+        self._command = command
+
+    @property
+    def image(self):
+        """Gets the image of this V2beta1Profiling.  # noqa: E501
+
+        Image contains the profiler in its /profiler directory, which an init container copies to /opt/mpi-profiler in the launcher and the workers. The Nsys and HPCToolkit tools then run from /opt/mpi-profiler/bin. If unset, the profiler must be installed in the images of the launcher and the workers.  # noqa: E501
+
+        :return: The image of this V2beta1Profiling.  # noqa: E501
+        :rtype: str
+        """
+        return self._image
+
+    @image.setter
+    def image(self, image):
+        """Sets the image of this V2beta1Profiling.
+
+        Image contains the profiler in its /profiler directory, which an init container copies to /opt/mpi-profiler in the launcher and the workers. The Nsys and HPCToolkit tools then run from /opt/mpi-profiler/bin. If unset, the profiler must be installed in the images of the launcher and the workers.  # noqa: E501
+
+        :param image: The image of this V2beta1Profiling.  # noqa: E501
+        :type image: str
+        """
+
+        self._image = image
+
+    @property
+    def output_claim_name(self):
+        """Gets the output_claim_name of this V2beta1Profiling.  # noqa: E501
+
+        OutputClaimName is the PersistentVolumeClaim mounted at /profiles. It must support the ReadWriteMany access mode when the pods run on several nodes. If unset, the profiles are written to an emptyDir volume, which is deleted with the pods.  # noqa: E501
+
+        :return: The output_claim_name of this V2beta1Profiling.  # noqa: E501
+        :rtype: str
+        """
+        return self._output_claim_name
+
+    @output_claim_name.setter
+    def output_claim_name(self, output_claim_name):
+        """Sets the output_claim_name of this V2beta1Profiling.
+
+        OutputClaimName is the PersistentVolumeClaim mounted at /profiles. It must support the ReadWriteMany access mode when the pods run on several nodes. If unset, the profiles are written to an emptyDir volume, which is deleted with the pods.  # noqa: E501
+
+        :param output_claim_name: The output_claim_name of this V2beta1Profiling.  # noqa: E501
+        :type output_claim_name: str
+        """
+
+        self._output_claim_name = output_claim_name
+
+    @property
+    def tool(self):
+        """Gets the tool of this V2beta1Profiling.  # noqa: E501
+
+        Tool is the profiler. Options are \"None\" (default), \"Nsys\", \"HPCToolkit\" and \"Custom\".  # noqa: E501
+
+        :return: The tool of this V2beta1Profiling.  # noqa: E501
+        :rtype: str
+        """
+        return self._tool
+
+    @tool.setter
+    def tool(self, tool):
+        """Sets the tool of this V2beta1Profiling.
+
+        Tool is the profiler. Options are \"None\" (default), \"Nsys\", \"HPCToolkit\" and \"Custom\".  # noqa: E501
+
+        :param tool: The tool of this V2beta1Profiling.  # noqa: E501
+        :type tool: str
+        """
+
+        self._tool = tool
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1Profiling):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1Profiling):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,184 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1ReplicaDefaults(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'image': 'str',
+        'resources': 'V1ResourceRequirements',
+        'tolerations': 'list[V1Toleration]'
+    }
+
+    attribute_map = {
+        'image': 'image',
+        'resources': 'resources',
+        'tolerations': 'tolerations'
+    }
+
+    def __init__(self, image=None, resources=None, tolerations=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1ReplicaDefaults - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._image = None
+        self._resources = None
+        self._tolerations = None
+        self.discriminator = None
+
+        if image is not None:
+            self.image = image
+        if resources is not None:
+            self.resources = resources
+        if tolerations is not None:
+            self.tolerations = tolerations
+
+    @property
+    def image(self):
+        """Gets the image of this V2beta1ReplicaDefaults.  # noqa: E501
+
+        Image is the image of the first container of the pods, if it has none.  # noqa: E501
+
+        :return: The image of this V2beta1ReplicaDefaults.  # noqa: E501
+        :rtype: str
+        """
+        return self._image
+
+    @image.setter
+    def image(self, image):
+        """Sets the image of this V2beta1ReplicaDefaults.
+
+        Image is the image of the first container of the pods, if it has none.  # noqa: E501
+
+        :param image: The image of this V2beta1ReplicaDefaults.  # noqa: E501
+        :type image: str
+        """
+
+        self._image = image
+
+    @property
+    def resources(self):
+        """Gets the resources of this V2beta1ReplicaDefaults.  # noqa: E501
+
+
+        :return: The resources of this V2beta1ReplicaDefaults.  # noqa: E501
+        :rtype: V1ResourceRequirements
+        """
+        return self._resources
+
+    @resources.setter
+    def resources(self, resources):
+        """Sets the resources of this V2beta1ReplicaDefaults.
+
+
+        :param resources: The resources of this V2beta1ReplicaDefaults.  # noqa: E501
+        :type resources: V1ResourceRequirements
+        """
+
+        self._resources = resources
+
+    @property
+    def tolerations(self):
+        """Gets the tolerations of this V2beta1ReplicaDefaults.  # noqa: E501
+
+        Tolerations are added to the pods, unless they have a toleration with the same key and effect.  # noqa: E501
+
+        :return: The tolerations of this V2beta1ReplicaDefaults.  # noqa: E501
+        :rtype: list[V1Toleration]
+        """
+        return self._tolerations
+
+    @tolerations.setter
+    def tolerations(self, tolerations):
+        """Sets the tolerations of this V2beta1ReplicaDefaults.
+
+        Tolerations are added to the pods, unless they have a toleration with the same key and effect.  # noqa: E501
+
+        :param tolerations: The tolerations of this V2beta1ReplicaDefaults.  # noqa: E501
+        :type tolerations: list[V1Toleration]
+        """
+
+        self._tolerations = tolerations
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1ReplicaDefaults):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1ReplicaDefaults):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -36,6 +36,8 @@ class V2beta1ReplicaStatus(object):
         'active': 'int',
         'failed': 'int',
         'label_selector': 'V1LabelSelector',
+        'nodes': 'list[str]',
+        'ready': 'int',
         'selector': 'str',
         'succeeded': 'int'
     }
@@ -44,11 +46,13 @@ class V2beta1ReplicaStatus(object):
         'active': 'active',
         'failed': 'failed',
         'label_selector': 'labelSelector',
+        'nodes': 'nodes',
+        'ready': 'ready',
         'selector': 'selector',
         'succeeded': 'succeeded'
     }
 
-    def __init__(self, active=None, failed=None, label_selector=None, selector=None, succeeded=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, active=None, failed=None, label_selector=None, nodes=None, ready=None, selector=None, succeeded=None, local_vars_configuration=None):  # noqa: E501
         """V2beta1ReplicaStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration.get_default_copy()
@@ -57,6 +61,8 @@ class V2beta1ReplicaStatus(object):
         self._active = None
         self._failed = None
         self._label_selector = None
+        self._nodes = None
+        self._ready = None
         self._selector = None
         self._succeeded = None
         self.discriminator = None
@@ -67,6 +73,10 @@ class V2beta1ReplicaStatus(object):
             self.failed = failed
         if label_selector is not None:
             self.label_selector = label_selector
+        if nodes is not None:
+            self.nodes = nodes
+        if ready is not None:
+            self.ready = ready
         if selector is not None:
             self.selector = selector
         if succeeded is not None:
@@ -139,6 +149,52 @@ class V2beta1ReplicaStatus(object):
 
         self._label_selector = label_selector
 
+    @property
+    def nodes(self):
+        """Gets the nodes of this V2beta1ReplicaStatus.  # noqa: E501
+
+        The nodes hosting the scheduled pods of the replica type, sorted by name.  # noqa: E501
+
+        :return: The nodes of this V2beta1ReplicaStatus.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._nodes
+
+    @nodes.setter
+    def nodes(self, nodes):
+        """Sets the nodes of this V2beta1ReplicaStatus.
+
+        The nodes hosting the scheduled pods of the replica type, sorted by name.  # noqa: E501
+
+        :param nodes: The nodes of this V2beta1ReplicaStatus.  # noqa: E501
+        :type nodes: list[str]
+        """
+
+        self._nodes = nodes
+
+    @property
+    def ready(self):
+        """Gets the ready of this V2beta1ReplicaStatus.  # noqa: E501
+
+        The number of running pods which have a Ready condition, that is, whose readiness probes, like the probes of sshd, passed.  # noqa: E501
+
+        :return: The ready of this V2beta1ReplicaStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._ready
+
+    @ready.setter
+    def ready(self, ready):
+        """Sets the ready of this V2beta1ReplicaStatus.
+
+        The number of running pods which have a Ready condition, that is, whose readiness probes, like the probes of sshd, passed.  # noqa: E501
+
+        :param ready: The ready of this V2beta1ReplicaStatus.  # noqa: E501
+        :type ready: int
+        """
+
+        self._ready = ready
+
     @property
     def selector(self):
         """Gets the selector of this V2beta1ReplicaStatus.  # noqa: E501
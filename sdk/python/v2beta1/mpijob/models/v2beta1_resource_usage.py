@@ -0,0 +1,218 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1ResourceUsage(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'cpu_seconds': 'int',
+        'gpu_seconds': 'int',
+        'peak_workers': 'int',
+        'wall_clock_seconds': 'int'
+    }
+
+    attribute_map = {
+        'cpu_seconds': 'cpuSeconds',
+        'gpu_seconds': 'gpuSeconds',
+        'peak_workers': 'peakWorkers',
+        'wall_clock_seconds': 'wallClockSeconds'
+    }
+
+    def __init__(self, cpu_seconds=0, gpu_seconds=0, peak_workers=0, wall_clock_seconds=0, local_vars_configuration=None):  # noqa: E501
+        """V2beta1ResourceUsage - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._cpu_seconds = None
+        self._gpu_seconds = None
+        self._peak_workers = None
+        self._wall_clock_seconds = None
+        self.discriminator = None
+
+        self.cpu_seconds = cpu_seconds
+        self.gpu_seconds = gpu_seconds
+        self.peak_workers = peak_workers
+        self.wall_clock_seconds = wall_clock_seconds
+
+    @property
+    def cpu_seconds(self):
+        """Gets the cpu_seconds of this V2beta1ResourceUsage.  # noqa: E501
+
+        The CPUs requested by the containers of the pods, or their limits when they don't request CPUs, multiplied by the seconds the pods ran.  # noqa: E501
+
+        :return: The cpu_seconds of this V2beta1ResourceUsage.  # noqa: E501
+        :rtype: int
+        """
+        return self._cpu_seconds
+
+    @cpu_seconds.setter
+    def cpu_seconds(self, cpu_seconds):
+        """Sets the cpu_seconds of this V2beta1ResourceUsage.
+
+        The CPUs requested by the containers of the pods, or their limits when they don't request CPUs, multiplied by the seconds the pods ran.  # noqa: E501
+
+        :param cpu_seconds: The cpu_seconds of this V2beta1ResourceUsage.  # noqa: E501
+        :type cpu_seconds: int
+        """
+        if self.local_vars_configuration.client_side_validation and cpu_seconds is None:  # noqa: E501
+            raise ValueError("Invalid value for `cpu_seconds`, must not be `None`")  # noqa: E501
+
+        self._cpu_seconds = cpu_seconds
+
+    @property
+    def gpu_seconds(self):
+        """Gets the gpu_seconds of this V2beta1ResourceUsage.  # noqa: E501
+
+        The GPUs, that is the resources named like <vendor>/gpu, requested by the containers of the pods multiplied by the seconds the pods ran.  # noqa: E501
+
+        :return: The gpu_seconds of this V2beta1ResourceUsage.  # noqa: E501
+        :rtype: int
+        """
+        return self._gpu_seconds
+
+    @gpu_seconds.setter
+    def gpu_seconds(self, gpu_seconds):
+        """Sets the gpu_seconds of this V2beta1ResourceUsage.
+
+        The GPUs, that is the resources named like <vendor>/gpu, requested by the containers of the pods multiplied by the seconds the pods ran.  # noqa: E501
+
+        :param gpu_seconds: The gpu_seconds of this V2beta1ResourceUsage.  # noqa: E501
+        :type gpu_seconds: int
+        """
+        if self.local_vars_configuration.client_side_validation and gpu_seconds is None:  # noqa: E501
+            raise ValueError("Invalid value for `gpu_seconds`, must not be `None`")  # noqa: E501
+
+        self._gpu_seconds = gpu_seconds
+
+    @property
+    def peak_workers(self):
+        """Gets the peak_workers of this V2beta1ResourceUsage.  # noqa: E501
+
+        The highest number of workers that ran at the same time.  # noqa: E501
+
+        :return: The peak_workers of this V2beta1ResourceUsage.  # noqa: E501
+        :rtype: int
+        """
+        return self._peak_workers
+
+    @peak_workers.setter
+    def peak_workers(self, peak_workers):
+        """Sets the peak_workers of this V2beta1ResourceUsage.
+
+        The highest number of workers that ran at the same time.  # noqa: E501
+
+        :param peak_workers: The peak_workers of this V2beta1ResourceUsage.  # noqa: E501
+        :type peak_workers: int
+        """
+        if self.local_vars_configuration.client_side_validation and peak_workers is None:  # noqa: E501
+            raise ValueError("Invalid value for `peak_workers`, must not be `None`")  # noqa: E501
+
+        self._peak_workers = peak_workers
+
+    @property
+    def wall_clock_seconds(self):
+        """Gets the wall_clock_seconds of this V2beta1ResourceUsage.  # noqa: E501
+
+        The seconds between the start time and the completion time of the job.  # noqa: E501
+
+        :return: The wall_clock_seconds of this V2beta1ResourceUsage.  # noqa: E501
+        :rtype: int
+        """
+        return self._wall_clock_seconds
+
+    @wall_clock_seconds.setter
+    def wall_clock_seconds(self, wall_clock_seconds):
+        """Sets the wall_clock_seconds of this V2beta1ResourceUsage.
+
+        The seconds between the start time and the completion time of the job.  # noqa: E501
+
+        :param wall_clock_seconds: The wall_clock_seconds of this V2beta1ResourceUsage.  # noqa: E501
+        :type wall_clock_seconds: int
+        """
+        if self.local_vars_configuration.client_side_validation and wall_clock_seconds is None:  # noqa: E501
+            raise ValueError("Invalid value for `wall_clock_seconds`, must not be `None`")  # noqa: E501
+
+        self._wall_clock_seconds = wall_clock_seconds
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1ResourceUsage):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1ResourceUsage):
+            return True
+
+        return self.to_dict() != other.to_dict()
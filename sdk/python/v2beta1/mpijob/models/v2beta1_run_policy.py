@@ -39,6 +39,7 @@ class V2beta1RunPolicy(object):
         'managed_by': 'str',
         'scheduling_policy': 'V2beta1SchedulingPolicy',
         'suspend': 'bool',
+        'termination_grace_period_seconds': 'int',
         'ttl_seconds_after_finished': 'int'
     }
 
@@ -49,10 +50,11 @@ class V2beta1RunPolicy(object):
         'managed_by': 'managedBy',
         'scheduling_policy': 'schedulingPolicy',
         'suspend': 'suspend',
+        'termination_grace_period_seconds': 'terminationGracePeriodSeconds',
         'ttl_seconds_after_finished': 'ttlSecondsAfterFinished'
     }
 
-    def __init__(self, active_deadline_seconds=None, backoff_limit=None, clean_pod_policy=None, managed_by=None, scheduling_policy=None, suspend=None, ttl_seconds_after_finished=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, active_deadline_seconds=None, backoff_limit=None, clean_pod_policy=None, managed_by=None, scheduling_policy=None, suspend=None, termination_grace_period_seconds=None, ttl_seconds_after_finished=None, local_vars_configuration=None):  # noqa: E501
         """V2beta1RunPolicy - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration.get_default_copy()
@@ -64,6 +66,7 @@ class V2beta1RunPolicy(object):
         self._managed_by = None
         self._scheduling_policy = None
         self._suspend = None
+        self._termination_grace_period_seconds = None
         self._ttl_seconds_after_finished = None
         self.discriminator = None
 
@@ -79,6 +82,8 @@ class V2beta1RunPolicy(object):
             self.scheduling_policy = scheduling_policy
         if suspend is not None:
             self.suspend = suspend
+        if termination_grace_period_seconds is not None:
+            self.termination_grace_period_seconds = termination_grace_period_seconds
         if ttl_seconds_after_finished is not None:
             self.ttl_seconds_after_finished = ttl_seconds_after_finished
 
@@ -218,6 +223,29 @@ class V2beta1RunPolicy(object):
 
         self._suspend = suspend
 
+    @property
+    def termination_grace_period_seconds(self):
+        """Gets the termination_grace_period_seconds of this V2beta1RunPolicy.  # noqa: E501
+
+        TerminationGracePeriodSeconds overrides the terminationGracePeriodSeconds of the launcher and worker pods. It bounds the time that their preStop hooks and processes have to finish when the pods are deleted on cleanup, suspension or restart.  # noqa: E501
+
+        :return: The termination_grace_period_seconds of this V2beta1RunPolicy.  # noqa: E501
+        :rtype: int
+        """
+        return self._termination_grace_period_seconds
+
+    @termination_grace_period_seconds.setter
+    def termination_grace_period_seconds(self, termination_grace_period_seconds):
+        """Sets the termination_grace_period_seconds of this V2beta1RunPolicy.
+
+        TerminationGracePeriodSeconds overrides the terminationGracePeriodSeconds of the launcher and worker pods. It bounds the time that their preStop hooks and processes have to finish when the pods are deleted on cleanup, suspension or restart.  # noqa: E501
+
+        :param termination_grace_period_seconds: The termination_grace_period_seconds of this V2beta1RunPolicy.  # noqa: E501
+        :type termination_grace_period_seconds: int
+        """
+
+        self._termination_grace_period_seconds = termination_grace_period_seconds
+
     @property
     def ttl_seconds_after_finished(self):
         """Gets the ttl_seconds_after_finished of this V2beta1RunPolicy.  # noqa: E501
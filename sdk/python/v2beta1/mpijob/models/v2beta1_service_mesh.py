@@ -0,0 +1,159 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1ServiceMesh(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'native_sidecar': 'bool',
+        'provider': 'str'
+    }
+
+    attribute_map = {
+        'native_sidecar': 'nativeSidecar',
+        'provider': 'provider'
+    }
+
+    def __init__(self, native_sidecar=None, provider='', local_vars_configuration=None):  # noqa: E501
+        """V2beta1ServiceMesh - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._native_sidecar = None
+        self._provider = None
+        self.discriminator = None
+
+        if native_sidecar is not None:
+            self.native_sidecar = native_sidecar
+        self.provider = provider
+
+    @property
+    def native_sidecar(self):
+        """Gets the native_sidecar of this V2beta1ServiceMesh.  # noqa: E501
+
+        NativeSidecar requests the sidecar as a native sidecar, an init container that Kubernetes stops when the launcher container exits. Requires Kubernetes 1.29 and a mesh supporting it. Otherwise, the launcher container calls the shutdown endpoint of the sidecar once its command exits, which requires the command of the launcher container to be set and curl or wget in its image. Defaults to false.  # noqa: E501
+
+        :return: The native_sidecar of this V2beta1ServiceMesh.  # noqa: E501
+        :rtype: bool
+        """
+        return self._native_sidecar
+
+    @native_sidecar.setter
+    def native_sidecar(self, native_sidecar):
+        """Sets the native_sidecar of this V2beta1ServiceMesh.
+
+        NativeSidecar requests the sidecar as a native sidecar, an init container that Kubernetes stops when the launcher container exits. Requires Kubernetes 1.29 and a mesh supporting it. Otherwise, the launcher container calls the shutdown endpoint of the sidecar once its command exits, which requires the command of the launcher container to be set and curl or wget in its image. Defaults to false.  # noqa: E501
+
+        :param native_sidecar: The native_sidecar of this V2beta1ServiceMesh.  # noqa: E501
+        :type native_sidecar: bool
+        """
+
+        self._native_sidecar = native_sidecar
+
+    @property
+    def provider(self):
+        """Gets the provider of this V2beta1ServiceMesh.  # noqa: E501
+
+        Provider is the service mesh. Options are \"Istio\" and \"Linkerd\".  # noqa: E501
+
+        :return: The provider of this V2beta1ServiceMesh.  # noqa: E501
+        :rtype: str
+        """
+        return self._provider
+
+    @provider.setter
+    def provider(self, provider):
+        """Sets the provider of this V2beta1ServiceMesh.
+
+        Provider is the service mesh. Options are \"Istio\" and \"Linkerd\".  # noqa: E501
+
+        :param provider: The provider of this V2beta1ServiceMesh.  # noqa: E501
+        :type provider: str
+        """
+        if self.local_vars_configuration.client_side_validation and provider is None:  # noqa: E501
+            raise ValueError("Invalid value for `provider`, must not be `None`")  # noqa: E501
+
+        self._provider = provider
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1ServiceMesh):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1ServiceMesh):
+            return True
+
+        return self.to_dict() != other.to_dict()
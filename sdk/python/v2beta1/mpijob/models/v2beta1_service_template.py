@@ -0,0 +1,214 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1ServiceTemplate(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'annotations': 'dict(str, str)',
+        'labels': 'dict(str, str)',
+        'ports': 'list[V1ServicePort]',
+        'publish_not_ready_addresses': 'bool'
+    }
+
+    attribute_map = {
+        'annotations': 'annotations',
+        'labels': 'labels',
+        'ports': 'ports',
+        'publish_not_ready_addresses': 'publishNotReadyAddresses'
+    }
+
+    def __init__(self, annotations=None, labels=None, ports=None, publish_not_ready_addresses=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1ServiceTemplate - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._annotations = None
+        self._labels = None
+        self._ports = None
+        self._publish_not_ready_addresses = None
+        self.discriminator = None
+
+        if annotations is not None:
+            self.annotations = annotations
+        if labels is not None:
+            self.labels = labels
+        if ports is not None:
+            self.ports = ports
+        if publish_not_ready_addresses is not None:
+            self.publish_not_ready_addresses = publish_not_ready_addresses
+
+    @property
+    def annotations(self):
+        """Gets the annotations of this V2beta1ServiceTemplate.  # noqa: E501
+
+        Annotations are set in the Service.  # noqa: E501
+
+        :return: The annotations of this V2beta1ServiceTemplate.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._annotations
+
+    @annotations.setter
+    def annotations(self, annotations):
+        """Sets the annotations of this V2beta1ServiceTemplate.
+
+        Annotations are set in the Service.  # noqa: E501
+
+        :param annotations: The annotations of this V2beta1ServiceTemplate.  # noqa: E501
+        :type annotations: dict(str, str)
+        """
+
+        self._annotations = annotations
+
+    @property
+    def labels(self):
+        """Gets the labels of this V2beta1ServiceTemplate.  # noqa: E501
+
+        Labels are set in the Service. The labels set by the operator take precedence.  # noqa: E501
+
+        :return: The labels of this V2beta1ServiceTemplate.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._labels
+
+    @labels.setter
+    def labels(self, labels):
+        """Sets the labels of this V2beta1ServiceTemplate.
+
+        Labels are set in the Service. The labels set by the operator take precedence.  # noqa: E501
+
+        :param labels: The labels of this V2beta1ServiceTemplate.  # noqa: E501
+        :type labels: dict(str, str)
+        """
+
+        self._labels = labels
+
+    @property
+    def ports(self):
+        """Gets the ports of this V2beta1ServiceTemplate.  # noqa: E501
+
+        Ports of the Service, like the ports of the metrics exporters of the workers scraped through the Service.  # noqa: E501
+
+        :return: The ports of this V2beta1ServiceTemplate.  # noqa: E501
+        :rtype: list[V1ServicePort]
+        """
+        return self._ports
+
+    @ports.setter
+    def ports(self, ports):
+        """Sets the ports of this V2beta1ServiceTemplate.
+
+        Ports of the Service, like the ports of the metrics exporters of the workers scraped through the Service.  # noqa: E501
+
+        :param ports: The ports of this V2beta1ServiceTemplate.  # noqa: E501
+        :type ports: list[V1ServicePort]
+        """
+
+        self._ports = ports
+
+    @property
+    def publish_not_ready_addresses(self):
+        """Gets the publish_not_ready_addresses of this V2beta1ServiceTemplate.  # noqa: E501
+
+        PublishNotReadyAddresses resolves the hostnames of the pods before they are ready, like for the workers without readiness probes of applications that contact each other while they start. Defaults to false.  # noqa: E501
+
+        :return: The publish_not_ready_addresses of this V2beta1ServiceTemplate.  # noqa: E501
+        :rtype: bool
+        """
+        return self._publish_not_ready_addresses
+
+    @publish_not_ready_addresses.setter
+    def publish_not_ready_addresses(self, publish_not_ready_addresses):
+        """Sets the publish_not_ready_addresses of this V2beta1ServiceTemplate.
+
+        PublishNotReadyAddresses resolves the hostnames of the pods before they are ready, like for the workers without readiness probes of applications that contact each other while they start. Defaults to false.  # noqa: E501
+
+        :param publish_not_ready_addresses: The publish_not_ready_addresses of this V2beta1ServiceTemplate.  # noqa: E501
+        :type publish_not_ready_addresses: bool
+        """
+
+        self._publish_not_ready_addresses = publish_not_ready_addresses
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1ServiceTemplate):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1ServiceTemplate):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,186 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1Watchdog(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'action': 'str',
+        'heartbeat_dir': 'str',
+        'timeout_seconds': 'int'
+    }
+
+    attribute_map = {
+        'action': 'action',
+        'heartbeat_dir': 'heartbeatDir',
+        'timeout_seconds': 'timeoutSeconds'
+    }
+
+    def __init__(self, action=None, heartbeat_dir=None, timeout_seconds=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1Watchdog - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._action = None
+        self._heartbeat_dir = None
+        self._timeout_seconds = None
+        self.discriminator = None
+
+        if action is not None:
+            self.action = action
+        if heartbeat_dir is not None:
+            self.heartbeat_dir = heartbeat_dir
+        if timeout_seconds is not None:
+            self.timeout_seconds = timeout_seconds
+
+    @property
+    def action(self):
+        """Gets the action of this V2beta1Watchdog.  # noqa: E501
+
+        Action is what the operator does once the MPI processes are stalled. Options are \"Event\" (default), which sets the Stalled condition and records an event, and \"Restart\", which also restarts the launcher and the workers.  # noqa: E501
+
+        :return: The action of this V2beta1Watchdog.  # noqa: E501
+        :rtype: str
+        """
+        return self._action
+
+    @action.setter
+    def action(self, action):
+        """Sets the action of this V2beta1Watchdog.
+
+        Action is what the operator does once the MPI processes are stalled. Options are \"Event\" (default), which sets the Stalled condition and records an event, and \"Restart\", which also restarts the launcher and the workers.  # noqa: E501
+
+        :param action: The action of this V2beta1Watchdog.  # noqa: E501
+        :type action: str
+        """
+
+        self._action = action
+
+    @property
+    def heartbeat_dir(self):
+        """Gets the heartbeat_dir of this V2beta1Watchdog.  # noqa: E501
+
+        HeartbeatDir is a directory, mounted in the launcher and workers, in which the MPI processes modify files as they make progress, like $HEARTBEAT_DIR/rank-$OMPI_COMM_WORLD_RANK after each step. The watchdog only starts once a file is written.  # noqa: E501
+
+        :return: The heartbeat_dir of this V2beta1Watchdog.  # noqa: E501
+        :rtype: str
+        """
+        return self._heartbeat_dir
+
+    @heartbeat_dir.setter
+    def heartbeat_dir(self, heartbeat_dir):
+        """Sets the heartbeat_dir of this V2beta1Watchdog.
+
+        HeartbeatDir is a directory, mounted in the launcher and workers, in which the MPI processes modify files as they make progress, like $HEARTBEAT_DIR/rank-$OMPI_COMM_WORLD_RANK after each step. The watchdog only starts once a file is written.  # noqa: E501
+
+        :param heartbeat_dir: The heartbeat_dir of this V2beta1Watchdog.  # noqa: E501
+        :type heartbeat_dir: str
+        """
+
+        self._heartbeat_dir = heartbeat_dir
+
+    @property
+    def timeout_seconds(self):
+        """Gets the timeout_seconds of this V2beta1Watchdog.  # noqa: E501
+
+        TimeoutSeconds is how long the MPI processes can make no progress before they are stalled. Defaults to 1800.  # noqa: E501
+
+        :return: The timeout_seconds of this V2beta1Watchdog.  # noqa: E501
+        :rtype: int
+        """
+        return self._timeout_seconds
+
+    @timeout_seconds.setter
+    def timeout_seconds(self, timeout_seconds):
+        """Sets the timeout_seconds of this V2beta1Watchdog.
+
+        TimeoutSeconds is how long the MPI processes can make no progress before they are stalled. Defaults to 1800.  # noqa: E501
+
+        :param timeout_seconds: The timeout_seconds of this V2beta1Watchdog.  # noqa: E501
+        :type timeout_seconds: int
+        """
+
+        self._timeout_seconds = timeout_seconds
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1Watchdog):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1Watchdog):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,215 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1WorkerGroup(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'name': 'str',
+        'replicas': 'int',
+        'slots_per_worker': 'int',
+        'template': 'V1PodTemplateSpec'
+    }
+
+    attribute_map = {
+        'name': 'name',
+        'replicas': 'replicas',
+        'slots_per_worker': 'slotsPerWorker',
+        'template': 'template'
+    }
+
+    def __init__(self, name='', replicas=0, slots_per_worker=None, template={}, local_vars_configuration=None):  # noqa: E501
+        """V2beta1WorkerGroup - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._name = None
+        self._replicas = None
+        self._slots_per_worker = None
+        self._template = None
+        self.discriminator = None
+
+        self.name = name
+        self.replicas = replicas
+        if slots_per_worker is not None:
+            self.slots_per_worker = slots_per_worker
+        self.template = template
+
+    @property
+    def name(self):
+        """Gets the name of this V2beta1WorkerGroup.  # noqa: E501
+
+        Name of the group, set in the training.kubeflow.org/worker-group label of its workers.  # noqa: E501
+
+        :return: The name of this V2beta1WorkerGroup.  # noqa: E501
+        :rtype: str
+        """
+        return self._name
+
+    @name.setter
+    def name(self, name):
+        """Sets the name of this V2beta1WorkerGroup.
+
+        Name of the group, set in the training.kubeflow.org/worker-group label of its workers.  # noqa: E501
+
+        :param name: The name of this V2beta1WorkerGroup.  # noqa: E501
+        :type name: str
+        """
+        if self.local_vars_configuration.client_side_validation and name is None:  # noqa: E501
+            raise ValueError("Invalid value for `name`, must not be `None`")  # noqa: E501
+
+        self._name = name
+
+    @property
+    def replicas(self):
+        """Gets the replicas of this V2beta1WorkerGroup.  # noqa: E501
+
+        Replicas is the number of workers of the group.  # noqa: E501
+
+        :return: The replicas of this V2beta1WorkerGroup.  # noqa: E501
+        :rtype: int
+        """
+        return self._replicas
+
+    @replicas.setter
+    def replicas(self, replicas):
+        """Sets the replicas of this V2beta1WorkerGroup.
+
+        Replicas is the number of workers of the group.  # noqa: E501
+
+        :param replicas: The replicas of this V2beta1WorkerGroup.  # noqa: E501
+        :type replicas: int
+        """
+        if self.local_vars_configuration.client_side_validation and replicas is None:  # noqa: E501
+            raise ValueError("Invalid value for `replicas`, must not be `None`")  # noqa: E501
+
+        self._replicas = replicas
+
+    @property
+    def slots_per_worker(self):
+        """Gets the slots_per_worker of this V2beta1WorkerGroup.  # noqa: E501
+
+        SlotsPerWorker is the number of slots of each worker of the group in the hostfile. Defaults to the SlotsPerWorker of the MPIJob.  # noqa: E501
+
+        :return: The slots_per_worker of this V2beta1WorkerGroup.  # noqa: E501
+        :rtype: int
+        """
+        return self._slots_per_worker
+
+    @slots_per_worker.setter
+    def slots_per_worker(self, slots_per_worker):
+        """Sets the slots_per_worker of this V2beta1WorkerGroup.
+
+        SlotsPerWorker is the number of slots of each worker of the group in the hostfile. Defaults to the SlotsPerWorker of the MPIJob.  # noqa: E501
+
+        :param slots_per_worker: The slots_per_worker of this V2beta1WorkerGroup.  # noqa: E501
+        :type slots_per_worker: int
+        """
+
+        self._slots_per_worker = slots_per_worker
+
+    @property
+    def template(self):
+        """Gets the template of this V2beta1WorkerGroup.  # noqa: E501
+
+
+        :return: The template of this V2beta1WorkerGroup.  # noqa: E501
+        :rtype: V1PodTemplateSpec
+        """
+        return self._template
+
+    @template.setter
+    def template(self, template):
+        """Sets the template of this V2beta1WorkerGroup.
+
+
+        :param template: The template of this V2beta1WorkerGroup.  # noqa: E501
+        :type template: V1PodTemplateSpec
+        """
+        if self.local_vars_configuration.client_side_validation and template is None:  # noqa: E501
+            raise ValueError("Invalid value for `template`, must not be `None`")  # noqa: E501
+
+        self._template = template
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1WorkerGroup):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1WorkerGroup):
+            return True
+
+        return self.to_dict() != other.to_dict()
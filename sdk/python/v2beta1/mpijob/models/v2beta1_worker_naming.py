@@ -0,0 +1,186 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1WorkerNaming(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'include_job_hash': 'bool',
+        'index_width': 'int',
+        'prefix': 'str'
+    }
+
+    attribute_map = {
+        'include_job_hash': 'includeJobHash',
+        'index_width': 'indexWidth',
+        'prefix': 'prefix'
+    }
+
+    def __init__(self, include_job_hash=None, index_width=None, prefix=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1WorkerNaming - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._include_job_hash = None
+        self._index_width = None
+        self._prefix = None
+        self.discriminator = None
+
+        if include_job_hash is not None:
+            self.include_job_hash = include_job_hash
+        if index_width is not None:
+            self.index_width = index_width
+        if prefix is not None:
+            self.prefix = prefix
+
+    @property
+    def include_job_hash(self):
+        """Gets the include_job_hash of this V2beta1WorkerNaming.  # noqa: E501
+
+        IncludeJobHash adds 8 hexadecimal digits hashing the UID of the MPIJob after the prefix, so that the names differ between MPIJobs, including the ones recreated with the same name. Defaults to false.  # noqa: E501
+
+        :return: The include_job_hash of this V2beta1WorkerNaming.  # noqa: E501
+        :rtype: bool
+        """
+        return self._include_job_hash
+
+    @include_job_hash.setter
+    def include_job_hash(self, include_job_hash):
+        """Sets the include_job_hash of this V2beta1WorkerNaming.
+
+        IncludeJobHash adds 8 hexadecimal digits hashing the UID of the MPIJob after the prefix, so that the names differ between MPIJobs, including the ones recreated with the same name. Defaults to false.  # noqa: E501
+
+        :param include_job_hash: The include_job_hash of this V2beta1WorkerNaming.  # noqa: E501
+        :type include_job_hash: bool
+        """
+
+        self._include_job_hash = include_job_hash
+
+    @property
+    def index_width(self):
+        """Gets the index_width of this V2beta1WorkerNaming.  # noqa: E501
+
+        IndexWidth pads the indexes of the workers with zeros up to the width, like <mpijob>-worker-007 with 3. Defaults to 0, which doesn't pad them.  # noqa: E501
+
+        :return: The index_width of this V2beta1WorkerNaming.  # noqa: E501
+        :rtype: int
+        """
+        return self._index_width
+
+    @index_width.setter
+    def index_width(self, index_width):
+        """Sets the index_width of this V2beta1WorkerNaming.
+
+        IndexWidth pads the indexes of the workers with zeros up to the width, like <mpijob>-worker-007 with 3. Defaults to 0, which doesn't pad them.  # noqa: E501
+
+        :param index_width: The index_width of this V2beta1WorkerNaming.  # noqa: E501
+        :type index_width: int
+        """
+
+        self._index_width = index_width
+
+    @property
+    def prefix(self):
+        """Gets the prefix of this V2beta1WorkerNaming.  # noqa: E501
+
+        Prefix starts the names of the workers. The names must be unique in the namespace, so the prefix should include the name of the MPIJob, unless IncludeJobHash is true. Defaults to <mpijob>-worker-.  # noqa: E501
+
+        :return: The prefix of this V2beta1WorkerNaming.  # noqa: E501
+        :rtype: str
+        """
+        return self._prefix
+
+    @prefix.setter
+    def prefix(self, prefix):
+        """Sets the prefix of this V2beta1WorkerNaming.
+
+        Prefix starts the names of the workers. The names must be unique in the namespace, so the prefix should include the name of the MPIJob, unless IncludeJobHash is true. Defaults to <mpijob>-worker-.  # noqa: E501
+
+        :param prefix: The prefix of this V2beta1WorkerNaming.  # noqa: E501
+        :type prefix: str
+        """
+
+        self._prefix = prefix
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1WorkerNaming):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1WorkerNaming):
+            return True
+
+        return self.to_dict() != other.to_dict()
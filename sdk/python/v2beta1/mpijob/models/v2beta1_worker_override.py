@@ -0,0 +1,241 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1WorkerOverride(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'end_index': 'int',
+        'env': 'list[V1EnvVar]',
+        'node_selector': 'dict(str, str)',
+        'resources': 'V1ResourceRequirements',
+        'start_index': 'int'
+    }
+
+    attribute_map = {
+        'end_index': 'endIndex',
+        'env': 'env',
+        'node_selector': 'nodeSelector',
+        'resources': 'resources',
+        'start_index': 'startIndex'
+    }
+
+    def __init__(self, end_index=None, env=None, node_selector=None, resources=None, start_index=0, local_vars_configuration=None):  # noqa: E501
+        """V2beta1WorkerOverride - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._end_index = None
+        self._env = None
+        self._node_selector = None
+        self._resources = None
+        self._start_index = None
+        self.discriminator = None
+
+        if end_index is not None:
+            self.end_index = end_index
+        if env is not None:
+            self.env = env
+        if node_selector is not None:
+            self.node_selector = node_selector
+        if resources is not None:
+            self.resources = resources
+        self.start_index = start_index
+
+    @property
+    def end_index(self):
+        """Gets the end_index of this V2beta1WorkerOverride.  # noqa: E501
+
+        EndIndex is the index of the last worker of the range, included. Defaults to StartIndex.  # noqa: E501
+
+        :return: The end_index of this V2beta1WorkerOverride.  # noqa: E501
+        :rtype: int
+        """
+        return self._end_index
+
+    @end_index.setter
+    def end_index(self, end_index):
+        """Sets the end_index of this V2beta1WorkerOverride.
+
+        EndIndex is the index of the last worker of the range, included. Defaults to StartIndex.  # noqa: E501
+
+        :param end_index: The end_index of this V2beta1WorkerOverride.  # noqa: E501
+        :type end_index: int
+        """
+
+        self._end_index = end_index
+
+    @property
+    def env(self):
+        """Gets the env of this V2beta1WorkerOverride.  # noqa: E501
+
+        Env is merged into the environment variables of the first container, replacing the variables with the same name.  # noqa: E501
+
+        :return: The env of this V2beta1WorkerOverride.  # noqa: E501
+        :rtype: list[V1EnvVar]
+        """
+        return self._env
+
+    @env.setter
+    def env(self, env):
+        """Sets the env of this V2beta1WorkerOverride.
+
+        Env is merged into the environment variables of the first container, replacing the variables with the same name.  # noqa: E501
+
+        :param env: The env of this V2beta1WorkerOverride.  # noqa: E501
+        :type env: list[V1EnvVar]
+        """
+
+        self._env = env
+
+    @property
+    def node_selector(self):
+        """Gets the node_selector of this V2beta1WorkerOverride.  # noqa: E501
+
+        NodeSelector is merged into the node selector of the worker pod template, taking precedence.  # noqa: E501
+
+        :return: The node_selector of this V2beta1WorkerOverride.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._node_selector
+
+    @node_selector.setter
+    def node_selector(self, node_selector):
+        """Sets the node_selector of this V2beta1WorkerOverride.
+
+        NodeSelector is merged into the node selector of the worker pod template, taking precedence.  # noqa: E501
+
+        :param node_selector: The node_selector of this V2beta1WorkerOverride.  # noqa: E501
+        :type node_selector: dict(str, str)
+        """
+
+        self._node_selector = node_selector
+
+    @property
+    def resources(self):
+        """Gets the resources of this V2beta1WorkerOverride.  # noqa: E501
+
+
+        :return: The resources of this V2beta1WorkerOverride.  # noqa: E501
+        :rtype: V1ResourceRequirements
+        """
+        return self._resources
+
+    @resources.setter
+    def resources(self, resources):
+        """Sets the resources of this V2beta1WorkerOverride.
+
+
+        :param resources: The resources of this V2beta1WorkerOverride.  # noqa: E501
+        :type resources: V1ResourceRequirements
+        """
+
+        self._resources = resources
+
+    @property
+    def start_index(self):
+        """Gets the start_index of this V2beta1WorkerOverride.  # noqa: E501
+
+        StartIndex is the index of the first worker of the range.  # noqa: E501
+
+        :return: The start_index of this V2beta1WorkerOverride.  # noqa: E501
+        :rtype: int
+        """
+        return self._start_index
+
+    @start_index.setter
+    def start_index(self, start_index):
+        """Sets the start_index of this V2beta1WorkerOverride.
+
+        StartIndex is the index of the first worker of the range.  # noqa: E501
+
+        :param start_index: The start_index of this V2beta1WorkerOverride.  # noqa: E501
+        :type start_index: int
+        """
+        if self.local_vars_configuration.client_side_validation and start_index is None:  # noqa: E501
+            raise ValueError("Invalid value for `start_index`, must not be `None`")  # noqa: E501
+
+        self._start_index = start_index
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1WorkerOverride):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1WorkerOverride):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,244 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import inspect
+import pprint
+import re  # noqa: F401
+import six
+
+from mpijob.configuration import Configuration
+
+
+class V2beta1WorkerPool(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'annotations': 'dict(str, str)',
+        'name': 'str',
+        'node_selector': 'dict(str, str)',
+        'replicas': 'int',
+        'tolerations': 'list[V1Toleration]'
+    }
+
+    attribute_map = {
+        'annotations': 'annotations',
+        'name': 'name',
+        'node_selector': 'nodeSelector',
+        'replicas': 'replicas',
+        'tolerations': 'tolerations'
+    }
+
+    def __init__(self, annotations=None, name='', node_selector=None, replicas=0, tolerations=None, local_vars_configuration=None):  # noqa: E501
+        """V2beta1WorkerPool - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration.get_default_copy()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._annotations = None
+        self._name = None
+        self._node_selector = None
+        self._replicas = None
+        self._tolerations = None
+        self.discriminator = None
+
+        if annotations is not None:
+            self.annotations = annotations
+        self.name = name
+        if node_selector is not None:
+            self.node_selector = node_selector
+        self.replicas = replicas
+        if tolerations is not None:
+            self.tolerations = tolerations
+
+    @property
+    def annotations(self):
+        """Gets the annotations of this V2beta1WorkerPool.  # noqa: E501
+
+        Annotations are set in the workers of the pool, taking precedence over the annotations of the worker pod template, like the multicluster.admiralty.io/elect annotation.  # noqa: E501
+
+        :return: The annotations of this V2beta1WorkerPool.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._annotations
+
+    @annotations.setter
+    def annotations(self, annotations):
+        """Sets the annotations of this V2beta1WorkerPool.
+
+        Annotations are set in the workers of the pool, taking precedence over the annotations of the worker pod template, like the multicluster.admiralty.io/elect annotation.  # noqa: E501
+
+        :param annotations: The annotations of this V2beta1WorkerPool.  # noqa: E501
+        :type annotations: dict(str, str)
+        """
+
+        self._annotations = annotations
+
+    @property
+    def name(self):
+        """Gets the name of this V2beta1WorkerPool.  # noqa: E501
+
+        Name of the pool, set in the training.kubeflow.org/worker-pool label of its workers.  # noqa: E501
+
+        :return: The name of this V2beta1WorkerPool.  # noqa: E501
+        :rtype: str
+        """
+        return self._name
+
+    @name.setter
+    def name(self, name):
+        """Sets the name of this V2beta1WorkerPool.
+
+        Name of the pool, set in the training.kubeflow.org/worker-pool label of its workers.  # noqa: E501
+
+        :param name: The name of this V2beta1WorkerPool.  # noqa: E501
+        :type name: str
+        """
+        if self.local_vars_configuration.client_side_validation and name is None:  # noqa: E501
+            raise ValueError("Invalid value for `name`, must not be `None`")  # noqa: E501
+
+        self._name = name
+
+    @property
+    def node_selector(self):
+        """Gets the node_selector of this V2beta1WorkerPool.  # noqa: E501
+
+        NodeSelector selects the virtual nodes of the cluster. It is merged into the node selector of the worker pod template, taking precedence.  # noqa: E501
+
+        :return: The node_selector of this V2beta1WorkerPool.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._node_selector
+
+    @node_selector.setter
+    def node_selector(self, node_selector):
+        """Sets the node_selector of this V2beta1WorkerPool.
+
+        NodeSelector selects the virtual nodes of the cluster. It is merged into the node selector of the worker pod template, taking precedence.  # noqa: E501
+
+        :param node_selector: The node_selector of this V2beta1WorkerPool.  # noqa: E501
+        :type node_selector: dict(str, str)
+        """
+
+        self._node_selector = node_selector
+
+    @property
+    def replicas(self):
+        """Gets the replicas of this V2beta1WorkerPool.  # noqa: E501
+
+        Replicas is the number of workers of the pool.  # noqa: E501
+
+        :return: The replicas of this V2beta1WorkerPool.  # noqa: E501
+        :rtype: int
+        """
+        return self._replicas
+
+    @replicas.setter
+    def replicas(self, replicas):
+        """Sets the replicas of this V2beta1WorkerPool.
+
+        Replicas is the number of workers of the pool.  # noqa: E501
+
+        :param replicas: The replicas of this V2beta1WorkerPool.  # noqa: E501
+        :type replicas: int
+        """
+        if self.local_vars_configuration.client_side_validation and replicas is None:  # noqa: E501
+            raise ValueError("Invalid value for `replicas`, must not be `None`")  # noqa: E501
+
+        self._replicas = replicas
+
+    @property
+    def tolerations(self):
+        """Gets the tolerations of this V2beta1WorkerPool.  # noqa: E501
+
+        Tolerations are added to the workers of the pool, like the toleration of the taint of virtual-kubelet nodes.  # noqa: E501
+
+        :return: The tolerations of this V2beta1WorkerPool.  # noqa: E501
+        :rtype: list[V1Toleration]
+        """
+        return self._tolerations
+
+    @tolerations.setter
+    def tolerations(self, tolerations):
+        """Sets the tolerations of this V2beta1WorkerPool.
+
+        Tolerations are added to the workers of the pool, like the toleration of the taint of virtual-kubelet nodes.  # noqa: E501
+
+        :param tolerations: The tolerations of this V2beta1WorkerPool.  # noqa: E501
+        :type tolerations: list[V1Toleration]
+        """
+
+        self._tolerations = tolerations
+
+    def to_dict(self, serialize=False):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        def convert(x):
+            if hasattr(x, "to_dict"):
+                args = inspect.getargspec(x.to_dict).args
+                if len(args) == 1:
+                    return x.to_dict()
+                else:
+                    return x.to_dict(serialize)
+            else:
+                return x
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            attr = self.attribute_map.get(attr, attr) if serialize else attr
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: convert(x),
+                    value
+                ))
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], convert(item[1])),
+                    value.items()
+                ))
+            else:
+                result[attr] = convert(value)
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V2beta1WorkerPool):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V2beta1WorkerPool):
+            return True
+
+        return self.to_dict() != other.to_dict()
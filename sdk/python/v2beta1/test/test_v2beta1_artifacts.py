@@ -0,0 +1,55 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+from __future__ import absolute_import
+
+import unittest
+import datetime
+
+import mpijob
+from mpijob.models.v2beta1_artifacts import V2beta1Artifacts  # noqa: E501
+from mpijob.rest import ApiException
+
+class TestV2beta1Artifacts(unittest.TestCase):
+    """V2beta1Artifacts unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def make_instance(self, include_optional):
+        """Test V2beta1Artifacts
+            include_option is a boolean, when False only required
+            params are included, when True both required and
+            optional params are included """
+        # model = mpijob.models.v2beta1_artifacts.V2beta1Artifacts()  # noqa: E501
+        if include_optional :
+            return V2beta1Artifacts(
+                credentials_secret_name = '', 
+                destination = '', 
+                results_path = '', 
+                workers = True
+            )
+        else :
+            return V2beta1Artifacts(
+                destination = '',
+        )
+
+    def testV2beta1Artifacts(self):
+        """Test V2beta1Artifacts"""
+        inst_req_only = self.make_instance(include_optional=False)
+        inst_req_and_optional = self.make_instance(include_optional=True)
+
+if __name__ == '__main__':
+    unittest.main()
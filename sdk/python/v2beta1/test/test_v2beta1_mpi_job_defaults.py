@@ -0,0 +1,54 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+from __future__ import absolute_import
+
+import unittest
+import datetime
+
+import mpijob
+from mpijob.models.v2beta1_mpi_job_defaults import V2beta1MPIJobDefaults  # noqa: E501
+from mpijob.rest import ApiException
+
+class TestV2beta1MPIJobDefaults(unittest.TestCase):
+    """V2beta1MPIJobDefaults unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def make_instance(self, include_optional):
+        """Test V2beta1MPIJobDefaults
+            include_option is a boolean, when False only required
+            params are included, when True both required and
+            optional params are included """
+        # model = mpijob.models.v2beta1_mpi_job_defaults.V2beta1MPIJobDefaults()  # noqa: E501
+        if include_optional :
+            return V2beta1MPIJobDefaults(
+                api_version = '', 
+                kind = '', 
+                metadata = mpijob.models.v1_object_meta.V1ObjectMeta(), 
+                spec = mpijob.models.v2beta1_mpi_job_defaults_spec.V2beta1MPIJobDefaultsSpec()
+            )
+        else :
+            return V2beta1MPIJobDefaults(
+        )
+
+    def testV2beta1MPIJobDefaults(self):
+        """Test V2beta1MPIJobDefaults"""
+        inst_req_only = self.make_instance(include_optional=False)
+        inst_req_and_optional = self.make_instance(include_optional=True)
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,55 @@
+# coding: utf-8
+
+"""
+    mpijob
+
+    Python SDK for MPI-Operator  # noqa: E501
+
+    The version of the OpenAPI document: v2beta1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+from __future__ import absolute_import
+
+import unittest
+import datetime
+
+import mpijob
+from mpijob.models.v2beta1_mpi_job_defaults_spec import V2beta1MPIJobDefaultsSpec  # noqa: E501
+from mpijob.rest import ApiException
+
+class TestV2beta1MPIJobDefaultsSpec(unittest.TestCase):
+    """V2beta1MPIJobDefaultsSpec unit test stubs"""
+
+    def setUp(self):
+        pass
+
+    def tearDown(self):
+        pass
+
+    def make_instance(self, include_optional):
+        """Test V2beta1MPIJobDefaultsSpec
+            include_option is a boolean, when False only required
+            params are included, when True both required and
+            optional params are included """
+        # model = mpijob.models.v2beta1_mpi_job_defaults_spec.V2beta1MPIJobDefaultsSpec()  # noqa: E501
+        if include_optional :
+            return V2beta1MPIJobDefaultsSpec(
+                clean_pod_policy = '', 
+                launcher = mpijob.models.v2beta1_replica_defaults.V2beta1ReplicaDefaults(), 
+                slots_per_worker = 56, 
+                ttl_seconds_after_finished = 56, 
+                worker = mpijob.models.v2beta1_replica_defaults.V2beta1ReplicaDefaults()
+            )
+        else :
+            return V2beta1MPIJobDefaultsSpec(
+        )
+
+    def testV2beta1MPIJobDefaultsSpec(self):
+        """Test V2beta1MPIJobDefaultsSpec"""
+        inst_req_only = self.make_instance(include_optional=False)
+        inst_req_and_optional = self.make_instance(include_optional=True)
+
+if __name__ == '__main__':
+    unittest.main()
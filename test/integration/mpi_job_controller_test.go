@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
@@ -272,6 +273,7 @@ func TestMPIJobWaitWorkers(t *testing.T) {
 		kubeflow.MPIReplicaTypeLauncher: {},
 		kubeflow.MPIReplicaTypeWorker: {
 			Active: 2,
+			Ready:  2,
 		},
 	})
 
@@ -299,6 +301,7 @@ func TestMPIJobWaitWorkers(t *testing.T) {
 		},
 		kubeflow.MPIReplicaTypeWorker: {
 			Active: 2,
+			Ready:  2,
 		},
 	})
 	s.events.verify(t)
@@ -1063,6 +1066,9 @@ func validateMPIJobDependencies(
 	return workerPods, launcherJob
 }
 
+// ignoreReplicaSelectors ignores the selectors of the pods of the replicas.
+var ignoreReplicaSelectors = cmpopts.IgnoreFields(kubeflow.ReplicaStatus{}, "Selector")
+
 func validateMPIJobStatus(ctx context.Context, t *testing.T, client clientset.Interface, job *kubeflow.MPIJob, want map[kubeflow.MPIReplicaType]*kubeflow.ReplicaStatus) *kubeflow.MPIJob {
 	t.Helper()
 	var (
@@ -1076,9 +1082,9 @@ func validateMPIJobStatus(ctx context.Context, t *testing.T, client clientset.In
 			return false, err
 		}
 		got = newJob.Status.ReplicaStatuses
-		return cmp.Equal(want, got), nil
+		return cmp.Equal(want, got, ignoreReplicaSelectors), nil
 	}); err != nil {
-		diff := cmp.Diff(want, got)
+		diff := cmp.Diff(want, got, ignoreReplicaSelectors)
 		t.Fatalf("Waiting for Job status: %v\n(-want,+got)\n%s", err, diff)
 	}
 	return newJob
@@ -0,0 +1,23 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scale measures the controller driving thousands of MPIJobs. It runs
+// the controller in-process against envtest, or against an existing cluster,
+// like a kind cluster, with USE_EXISTING_CLUSTER=true. The pods are never
+// scheduled: the suite fakes their statuses and the statuses of the launcher
+// Jobs. It reports the reconcile throughput, the latency of the work queue
+// and the memory of the controller.
+//
+// The suite only runs when TEST_SCALE_MPIJOBS is set. See `make test_scale`.
+package scale
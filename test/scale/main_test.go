@@ -0,0 +1,59 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scale
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+var (
+	restConfig *rest.Config
+	metrics    = &queueMetrics{}
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv(mpiJobsEnv) == "" {
+		// TestScale is skipped.
+		os.Exit(m.Run())
+	}
+	// The provider must be set before the work queue of the controller is
+	// created.
+	workqueue.SetProvider(metrics)
+	env := &envtest.Environment{
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "..", "manifests", "base"),
+		},
+	}
+	var err error
+	restConfig, err = env.Start()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to start envtest.Environment: %v", err))
+	}
+
+	code := m.Run()
+
+	if err = env.Stop(); err != nil {
+		panic(fmt.Sprintf("Failed to stop envtest.Environment: %v", err))
+	}
+
+	os.Exit(code)
+}
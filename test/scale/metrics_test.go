@@ -0,0 +1,135 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scale
+
+import (
+	"sort"
+	"sync"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// queueName is the name of the work queue of the controller.
+const queueName = "MPIJob"
+
+// queueMetrics records the metrics of the work queue of the controller. The
+// metrics of the other queues are dropped.
+type queueMetrics struct {
+	mu        sync.Mutex
+	depth     int
+	maxDepth  int
+	latencies []float64
+	durations []float64
+}
+
+var _ workqueue.MetricsProvider = &queueMetrics{}
+
+func (m *queueMetrics) NewDepthMetric(name string) workqueue.GaugeMetric {
+	if name != queueName {
+		return noopMetric{}
+	}
+	return depthMetric{m}
+}
+
+func (m *queueMetrics) NewAddsMetric(string) workqueue.CounterMetric {
+	return noopMetric{}
+}
+
+func (m *queueMetrics) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	if name != queueName {
+		return noopMetric{}
+	}
+	return observations{m, &m.latencies}
+}
+
+func (m *queueMetrics) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	if name != queueName {
+		return noopMetric{}
+	}
+	return observations{m, &m.durations}
+}
+
+func (m *queueMetrics) NewUnfinishedWorkSecondsMetric(string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+
+func (m *queueMetrics) NewLongestRunningProcessorSecondsMetric(string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+
+func (m *queueMetrics) NewRetriesMetric(string) workqueue.CounterMetric {
+	return noopMetric{}
+}
+
+// snapshot returns the maximum depth of the queue, the seconds the keys
+// waited in the queue and the seconds of the syncs.
+func (m *queueMetrics) snapshot() (int, []float64, []float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.maxDepth, append([]float64(nil), m.latencies...), append([]float64(nil), m.durations...)
+}
+
+type depthMetric struct {
+	m *queueMetrics
+}
+
+func (d depthMetric) Inc() {
+	d.m.mu.Lock()
+	defer d.m.mu.Unlock()
+	d.m.depth++
+	d.m.maxDepth = max(d.m.maxDepth, d.m.depth)
+}
+
+func (d depthMetric) Dec() {
+	d.m.mu.Lock()
+	defer d.m.mu.Unlock()
+	d.m.depth--
+}
+
+type observations struct {
+	m      *queueMetrics
+	values *[]float64
+}
+
+func (o observations) Observe(value float64) {
+	o.m.mu.Lock()
+	defer o.m.mu.Unlock()
+	*o.values = append(*o.values, value)
+}
+
+type noopMetric struct{}
+
+func (noopMetric) Inc()            {}
+func (noopMetric) Dec()            {}
+func (noopMetric) Set(float64)     {}
+func (noopMetric) Observe(float64) {}
+
+// quantiles returns the 50th, 90th and 99th percentiles and the maximum of
+// the values.
+func quantiles(values []float64) map[string]float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Float64s(values)
+	at := func(q float64) float64 {
+		return values[int(q*float64(len(values)-1))]
+	}
+	return map[string]float64{
+		"p50": at(0.5),
+		"p90": at(0.9),
+		"p99": at(0.99),
+		"max": values[len(values)-1],
+	}
+}
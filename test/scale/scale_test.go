@@ -0,0 +1,350 @@
+// Copyright 2025 The Kubeflow Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scale
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	clientset "github.com/kubeflow/mpi-operator/pkg/client/clientset/versioned"
+	informers "github.com/kubeflow/mpi-operator/pkg/client/informers/externalversions"
+	"github.com/kubeflow/mpi-operator/pkg/controller"
+)
+
+const (
+	mpiJobsEnv     = "TEST_SCALE_MPIJOBS"
+	workersEnv     = "TEST_SCALE_WORKERS"
+	threadinessEnv = "TEST_SCALE_THREADINESS"
+	// The rate limits of the work queue, like --controller-queue-rate-limit
+	// and --controller-queue-burst.
+	queueRateLimitEnv = "TEST_SCALE_QUEUE_RATE_LIMIT"
+	queueBurstEnv     = "TEST_SCALE_QUEUE_BURST"
+	reportEnv         = "TEST_SCALE_REPORT"
+
+	// clientParallelism is the number of requests of the suite in flight.
+	clientParallelism = 32
+	clientQPS         = 500
+	clientBurst       = 1000
+
+	scaleTimeout = 30 * time.Minute
+	pollInterval = time.Second
+)
+
+// unschedulableNodeSelector keeps the pods pending on existing clusters, so
+// that the statuses faked by the suite aren't overwritten by kubelets.
+var unschedulableNodeSelector = map[string]string{"kubeflow.org/scale-test": "unschedulable"}
+
+// report is the result of a run of the suite.
+type report struct {
+	MPIJobs          int `json:"mpiJobs"`
+	WorkersPerMPIJob int `json:"workersPerMPIJob"`
+	Threadiness      int `json:"threadiness"`
+	QueueRateLimit   int `json:"queueRateLimit"`
+	QueueBurst       int `json:"queueBurst"`
+	// LaunchSeconds is the time until every MPIJob has its workers and its
+	// launcher Job.
+	LaunchSeconds float64 `json:"launchSeconds"`
+	// CompletionSeconds is the time until every MPIJob succeeded, once their
+	// launcher Jobs completed.
+	CompletionSeconds float64 `json:"completionSeconds"`
+	Syncs             int     `json:"syncs"`
+	SyncsPerSecond    float64 `json:"syncsPerSecond"`
+	// QueueLatencySeconds are the quantiles of the time the keys waited in
+	// the work queue.
+	QueueLatencySeconds map[string]float64 `json:"queueLatencySeconds"`
+	SyncSeconds         map[string]float64 `json:"syncSeconds"`
+	MaxQueueDepth       int                `json:"maxQueueDepth"`
+	// HeapBytesPerMPIJob is the growth of the heap in use divided by the
+	// number of MPIJobs.
+	HeapBytesPerMPIJob float64 `json:"heapBytesPerMPIJob"`
+}
+
+func TestScale(t *testing.T) {
+	mpiJobs := envInt(t, mpiJobsEnv, 0)
+	if mpiJobs == 0 {
+		t.Skipf("%s isn't set", mpiJobsEnv)
+	}
+	workers := envInt(t, workersEnv, 2)
+	threadiness := envInt(t, threadinessEnv, 2)
+	queueRateLimit := envInt(t, queueRateLimitEnv, 10)
+	queueBurst := envInt(t, queueBurstEnv, 100)
+	ctx, cancel := context.WithTimeout(context.Background(), scaleTimeout)
+	t.Cleanup(cancel)
+
+	cfg := rest.CopyConfig(restConfig)
+	cfg.QPS = clientQPS
+	cfg.Burst = clientBurst
+	kClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		t.Fatalf("Creating kubernetes client: %v", err)
+	}
+	mpiClient, err := clientset.NewForConfig(cfg)
+	if err != nil {
+		t.Fatalf("Creating MPI client: %v", err)
+	}
+	ns, err := kClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "scale-"},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Creating test namespace: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = kClient.CoreV1().Namespaces().Delete(context.Background(), ns.Name, metav1.DeleteOptions{})
+	})
+	t.Logf("Running %d MPIJobs with %d workers in namespace %s", mpiJobs, workers, ns.Name)
+
+	heapBefore := heapInUse()
+	rateLimiter := workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[any](5*time.Millisecond, 1000*time.Second),
+		&workqueue.TypedBucketRateLimiter[any]{Limiter: rate.NewLimiter(rate.Limit(queueRateLimit), queueBurst)},
+	)
+	startController(ctx, t, kClient, mpiClient, ns.Name, threadiness, rateLimiter)
+
+	start := time.Now()
+	err = parallelize(ctx, mpiJobs, func(i int) error {
+		_, err := mpiClient.KubeflowV2beta1().MPIJobs(ns.Name).Create(ctx, newMPIJob(fmt.Sprintf("job-%d", i), workers), metav1.CreateOptions{})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Creating MPIJobs: %v", err)
+	}
+	var pods []corev1.Pod
+	var launchers []batchv1.Job
+	waitFor(ctx, t, "the launch of the MPIJobs", func(ctx context.Context) (bool, error) {
+		podList, err := kClient.CoreV1().Pods(ns.Name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		jobList, err := kClient.BatchV1().Jobs(ns.Name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		pods, launchers = podList.Items, jobList.Items
+		return len(pods) == mpiJobs*workers && len(launchers) == mpiJobs, nil
+	})
+	launch := time.Since(start)
+
+	completionStart := time.Now()
+	err = parallelize(ctx, len(pods), func(i int) error {
+		pod := &pods[i]
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.Conditions = append(pod.Status.Conditions, corev1.PodCondition{
+			Type:   corev1.PodReady,
+			Status: corev1.ConditionTrue,
+		})
+		_, err := kClient.CoreV1().Pods(ns.Name).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Updating worker pods to Running phase: %v", err)
+	}
+	err = parallelize(ctx, len(launchers), func(i int) error {
+		launcher := &launchers[i]
+		launcher.Status.Conditions = append(launcher.Status.Conditions, batchv1.JobCondition{
+			Type:   batchv1.JobComplete,
+			Status: corev1.ConditionTrue,
+		})
+		launcher.Status.Succeeded = 1
+		launcher.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+		_, err := kClient.BatchV1().Jobs(ns.Name).UpdateStatus(ctx, launcher, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Updating launcher Jobs Complete condition: %v", err)
+	}
+	waitFor(ctx, t, "the completion of the MPIJobs", func(ctx context.Context) (bool, error) {
+		list, err := mpiClient.KubeflowV2beta1().MPIJobs(ns.Name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		succeeded := 0
+		for _, mpiJob := range list.Items {
+			if hasCondition(&mpiJob, kubeflow.JobSucceeded) {
+				succeeded++
+			}
+		}
+		return succeeded == mpiJobs, nil
+	})
+	completion := time.Since(completionStart)
+
+	maxDepth, latencies, durations := metrics.snapshot()
+	r := report{
+		MPIJobs:             mpiJobs,
+		WorkersPerMPIJob:    workers,
+		Threadiness:         threadiness,
+		QueueRateLimit:      queueRateLimit,
+		QueueBurst:          queueBurst,
+		LaunchSeconds:       launch.Seconds(),
+		CompletionSeconds:   completion.Seconds(),
+		Syncs:               len(durations),
+		SyncsPerSecond:      float64(len(durations)) / time.Since(start).Seconds(),
+		QueueLatencySeconds: quantiles(latencies),
+		SyncSeconds:         quantiles(durations),
+		MaxQueueDepth:       maxDepth,
+		HeapBytesPerMPIJob:  float64(int64(heapInUse())-int64(heapBefore)) / float64(mpiJobs),
+	}
+	out, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		t.Fatalf("Encoding report: %v", err)
+	}
+	t.Logf("Report:\n%s", out)
+	if path := os.Getenv(reportEnv); path != "" {
+		if err := os.WriteFile(path, out, 0o644); err != nil {
+			t.Fatalf("Writing report: %v", err)
+		}
+	}
+}
+
+// startController runs the controller for the MPIJobs of the namespace, with
+// the rate limits of the clients of the suite.
+func startController(ctx context.Context, t *testing.T, kClient kubernetes.Interface, mpiClient clientset.Interface, namespace string, threadiness int, rateLimiter workqueue.TypedRateLimiter[any]) {
+	t.Helper()
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kClient, 0, kubeinformers.WithNamespace(namespace))
+	mpiInformerFactory := informers.NewSharedInformerFactoryWithOptions(mpiClient, 0, informers.WithNamespace(namespace))
+	ctrl, err := controller.NewMPIJobController(
+		kClient,
+		mpiClient,
+		nil,
+		nil,
+		kubeInformerFactory.Core().V1().ConfigMaps(),
+		kubeInformerFactory.Core().V1().Secrets(),
+		kubeInformerFactory.Core().V1().Services(),
+		kubeInformerFactory.Batch().V1().Jobs(),
+		kubeInformerFactory.Core().V1().Pods(),
+		kubeInformerFactory.Scheduling().V1().PriorityClasses(),
+		mpiInformerFactory.Kubeflow().V2beta1().MPIJobs(),
+		namespace, "",
+		rateLimiter,
+	)
+	if err != nil {
+		t.Fatalf("Creating controller: %v", err)
+	}
+	// The status updates of the pods are synced right away.
+	ctrl.StatusCoalescingWindow = 0
+
+	go kubeInformerFactory.Start(ctx.Done())
+	go mpiInformerFactory.Start(ctx.Done())
+	go func() {
+		if err := ctrl.Run(threadiness, ctx.Done()); err != nil {
+			panic(err)
+		}
+	}()
+}
+
+func newMPIJob(name string, workers int) *kubeflow.MPIJob {
+	template := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			NodeSelector: unschedulableNodeSelector,
+			Containers: []corev1.Container{
+				{
+					Name:  "main",
+					Image: "mpi-image",
+				},
+			},
+		},
+	}
+	return &kubeflow.MPIJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: kubeflow.MPIJobSpec{
+			SlotsPerWorker: ptr.To[int32](1),
+			MPIReplicaSpecs: map[kubeflow.MPIReplicaType]*kubeflow.ReplicaSpec{
+				kubeflow.MPIReplicaTypeLauncher: {
+					Template: template,
+				},
+				kubeflow.MPIReplicaTypeWorker: {
+					Replicas: ptr.To(int32(workers)),
+					Template: template,
+				},
+			},
+		},
+	}
+}
+
+// parallelize calls fn for the pieces with clientParallelism workers, and
+// returns the first error.
+func parallelize(ctx context.Context, pieces int, fn func(i int) error) error {
+	var (
+		once     sync.Once
+		firstErr error
+	)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	workqueue.ParallelizeUntil(ctx, clientParallelism, pieces, func(i int) {
+		if err := fn(i); err != nil {
+			once.Do(func() {
+				firstErr = err
+				cancel()
+			})
+		}
+	})
+	return firstErr
+}
+
+func waitFor(ctx context.Context, t *testing.T, what string, condition wait.ConditionWithContextFunc) {
+	t.Helper()
+	if err := wait.PollUntilContextCancel(ctx, pollInterval, false, condition); err != nil {
+		t.Fatalf("Waiting for %s: %v", what, err)
+	}
+}
+
+func hasCondition(mpiJob *kubeflow.MPIJob, condType kubeflow.JobConditionType) bool {
+	for _, c := range mpiJob.Status.Conditions {
+		if c.Type == condType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func heapInUse() uint64 {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapInuse
+}
+
+func envInt(t *testing.T, name string, defaultValue int) int {
+	t.Helper()
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		t.Fatalf("Invalid %s %q, must be a non-negative integer", name, value)
+	}
+	return n
+}